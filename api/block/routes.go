@@ -0,0 +1,45 @@
+package block
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/gin-gonic/gin"
+)
+
+// BlockService interface defines methods that can be used from `elrondFacade` context variable
+type BlockService interface {
+	GetLogsBloom(blockHash string) (receipt.Bloom, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines block related routes
+func Routes(router *gin.RouterGroup) {
+	router.GET("/:blockhash/logs-bloom", GetLogsBloom)
+}
+
+// GetLogsBloom returns the hex encoded bloom of the event logs emitted in a given block
+func GetLogsBloom(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(BlockService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	blockHash := c.Param("blockhash")
+	if blockHash == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyBlockHash.Error())})
+		return
+	}
+
+	bloom, err := ef.GetLogsBloom(blockHash)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": errors.ErrLogsBloomNotFound.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"logsBloom": hex.EncodeToString(bloom)})
+}
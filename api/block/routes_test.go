@@ -0,0 +1,119 @@
+package block_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	errors2 "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type LogsBloomResponse struct {
+	Error     string `json:"error"`
+	LogsBloom string `json:"logsBloom"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestGetLogsBloom_WithCorrectHashShouldReturnBloom(t *testing.T) {
+	t.Parallel()
+
+	bloom := receipt.NewBloom()
+	bloom[0] = 1
+	facade := mock.Facade{
+		GetLogsBloomHandler: func(blockHash string) (receipt.Bloom, error) {
+			return bloom, nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/block/hash/logs-bloom", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := LogsBloomResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, hex.EncodeToString(bloom), response.LogsBloom)
+}
+
+func TestGetLogsBloom_FacadeErrorsShouldReturnNotFound(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetLogsBloomHandler: func(blockHash string) (receipt.Bloom, error) {
+			return nil, errors.New("not found")
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/block/hash/logs-bloom", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := LogsBloomResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.Equal(t, errors2.ErrLogsBloomNotFound.Error(), response.Error)
+}
+
+func TestGetLogsBloom_FailsWithWrongFacadeTypeConversion(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/block/hash/logs-bloom", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := LogsBloomResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors2.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServer(handler block.BlockService) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	blockRoute := ws.Group("/block")
+	if handler != nil {
+		blockRoute.Use(middleware.WithElrondFacade(handler))
+	}
+	block.Routes(blockRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	blockRoute := ws.Group("/block")
+	block.Routes(blockRoute)
+	return ws
+}
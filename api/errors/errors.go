@@ -51,3 +51,12 @@ var ErrGetTransaction = errors.New("transaction getting failed")
 
 // ErrTxNotFound signals an error happened trying to fetch a transaction
 var ErrTxNotFound = errors.New("transaction was not found")
+
+// ErrReceiptNotFound signals that the receipt of a transaction could not be fetched
+var ErrReceiptNotFound = errors.New("receipt was not found")
+
+// ErrValidationEmptyBlockHash signals an empty block hash was provided
+var ErrValidationEmptyBlockHash = errors.New("BlockHash is empty")
+
+// ErrLogsBloomNotFound signals that the logs bloom of a block could not be fetched
+var ErrLogsBloomNotFound = errors.New("logs bloom was not found")
@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -25,13 +26,15 @@ type Facade struct {
 	GetAccountHandler                              func(address string) (*state.Account, error)
 	GenerateTransactionHandler                     func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler                          func(hash string) (*transaction.Transaction, error)
-	SendTransactionHandler                         func(nonce uint64, sender string, receiver string, value string, gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error)
-	CreateTransactionHandler                       func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error)
+	SendTransactionHandler                         func(nonce uint64, sender string, receiver string, value string, gasPrice uint64, gasLimit uint64, code string, signature []byte, chainID string, version uint32, validAfterRound uint64, validUntilRound uint64) (string, error)
+	CreateTransactionHandler                       func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string, chainID string, version uint32, validAfterRound uint64, validUntilRound uint64) (*transaction.Transaction, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	ExecuteSCQueryHandler                          func(query *process.SCQuery) (*vmcommon.VMOutput, error)
 	StatusMetricsHandler                           func() external.StatusMetricsHandler
+	GetTransactionReceiptHandler                   func(hash string) (*receipt.Receipt, error)
+	GetLogsBloomHandler                            func(blockHash string) (receipt.Bloom, error)
 }
 
 // IsNodeRunning is the mock implementation of a handler's IsNodeRunning method
@@ -100,9 +103,13 @@ func (f *Facade) CreateTransaction(
 	data string,
 	signatureHex string,
 	challenge string,
+	chainID string,
+	version uint32,
+	validAfterRound uint64,
+	validUntilRound uint64,
 ) (*transaction.Transaction, error) {
 
-	return f.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge)
+	return f.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge, chainID, version, validAfterRound, validUntilRound)
 }
 
 // GetTransaction is the mock implementation of a handler's GetTransaction method
@@ -110,9 +117,19 @@ func (f *Facade) GetTransaction(hash string) (*transaction.Transaction, error) {
 	return f.GetTransactionHandler(hash)
 }
 
+// GetTransactionReceipt is the mock implementation of a handler's GetTransactionReceipt method
+func (f *Facade) GetTransactionReceipt(hash string) (*receipt.Receipt, error) {
+	return f.GetTransactionReceiptHandler(hash)
+}
+
+// GetLogsBloom is the mock implementation of a handler's GetLogsBloom method
+func (f *Facade) GetLogsBloom(blockHash string) (receipt.Bloom, error) {
+	return f.GetLogsBloomHandler(blockHash)
+}
+
 // SendTransaction is the mock implementation of a handler's SendTransaction method
-func (f *Facade) SendTransaction(nonce uint64, sender string, receiver string, value string, gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error) {
-	return f.SendTransactionHandler(nonce, sender, receiver, value, gasPrice, gasLimit, code, signature)
+func (f *Facade) SendTransaction(nonce uint64, sender string, receiver string, value string, gasPrice uint64, gasLimit uint64, code string, signature []byte, chainID string, version uint32, validAfterRound uint64, validUntilRound uint64) (string, error) {
+	return f.SendTransactionHandler(nonce, sender, receiver, value, gasPrice, gasLimit, code, signature, chainID, version, validAfterRound, validUntilRound)
 }
 
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
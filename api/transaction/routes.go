@@ -7,16 +7,18 @@ import (
 	"net/http"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-gonic/gin"
 )
 
 // TxService interface defines methods that can be used from `elrondFacade` context variable
 type TxService interface {
-	CreateTransaction(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error)
-	SendTransaction(nonce uint64, sender string, receiver string, value string, gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error)
+	CreateTransaction(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string, chainID string, version uint32, validAfterRound uint64, validUntilRound uint64) (*transaction.Transaction, error)
+	SendTransaction(nonce uint64, sender string, receiver string, value string, gasPrice uint64, gasLimit uint64, code string, signature []byte, chainID string, version uint32, validAfterRound uint64, validUntilRound uint64) (string, error)
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	GetTransaction(hash string) (*transaction.Transaction, error)
+	GetTransactionReceipt(hash string) (*receipt.Receipt, error)
 	IsInterfaceNil() bool
 }
 
@@ -46,6 +48,11 @@ type SendTxRequest struct {
 	GasLimit  uint64 `form:"gasLimit" json:"gasLimit"`
 	Signature string `form:"signature" json:"signature"`
 	Challenge string `form:"challenge" json:"challenge"`
+	ChainID   string `form:"chainID" json:"chainID"`
+	Version   uint32 `form:"version" json:"version"`
+
+	ValidAfterRound uint64 `form:"validAfterRound" json:"validAfterRound,omitempty"`
+	ValidUntilRound uint64 `form:"validUntilRound" json:"validUntilRound,omitempty"`
 }
 
 //TxResponse represents the structure on which the response will be validated against
@@ -58,11 +65,30 @@ type TxResponse struct {
 	Timestamp   uint64 `json:"timestamp"`
 }
 
+// LogResponse represents the structure of an event log emitted while executing a transaction
+type LogResponse struct {
+	Address string   `json:"address"`
+	Topics  []string `json:"topics"`
+	Data    string   `json:"data"`
+}
+
+// ReceiptResponse represents the structure on which the receipt of a transaction will be validated against
+type ReceiptResponse struct {
+	TxHash      string        `json:"txHash"`
+	BlockHash   string        `json:"blockHash"`
+	Fee         string        `json:"fee"`
+	GasRefunded uint64        `json:"gasRefunded"`
+	ReturnCode  string        `json:"returnCode"`
+	ReturnData  []string      `json:"returnData"`
+	Logs        []LogResponse `json:"logs"`
+}
+
 // Routes defines transaction related routes
 func Routes(router *gin.RouterGroup) {
 	router.POST("/send", SendTransaction)
 	router.POST("/send-multiple", SendMultipleTransactions)
 	router.GET("/:txhash", GetTransaction)
+	router.GET("/:txhash/receipt", GetTransactionReceipt)
 }
 
 // SendTransaction will receive a transaction from the client and propagate it for processing
@@ -86,7 +112,7 @@ func SendTransaction(c *gin.Context) {
 		return
 	}
 
-	txHash, err := ef.SendTransaction(gtx.Nonce, gtx.Sender, gtx.Receiver, gtx.Value, gtx.GasPrice, gtx.GasLimit, gtx.Data, signature)
+	txHash, err := ef.SendTransaction(gtx.Nonce, gtx.Sender, gtx.Receiver, gtx.Value, gtx.GasPrice, gtx.GasLimit, gtx.Data, signature, gtx.ChainID, gtx.Version, gtx.ValidAfterRound, gtx.ValidUntilRound)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error())})
 		return
@@ -122,6 +148,10 @@ func SendMultipleTransactions(c *gin.Context) {
 			receivedTx.Data,
 			receivedTx.Signature,
 			receivedTx.Challenge,
+			receivedTx.ChainID,
+			receivedTx.Version,
+			receivedTx.ValidAfterRound,
+			receivedTx.ValidUntilRound,
 		)
 		if err != nil {
 			continue
@@ -168,6 +198,55 @@ func GetTransaction(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"transaction": txResponseFromTransaction(tx)})
 }
 
+// GetTransactionReceipt returns the execution receipt for a given txhash
+func GetTransactionReceipt(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(TxService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error())})
+		return
+	}
+
+	rcpt, err := ef.GetTransactionReceipt(txhash)
+	if err != nil || rcpt == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": errors.ErrReceiptNotFound.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"receipt": receiptResponseFromReceipt(rcpt)})
+}
+
+func receiptResponseFromReceipt(rcpt *receipt.Receipt) ReceiptResponse {
+	response := ReceiptResponse{}
+	response.TxHash = hex.EncodeToString(rcpt.TxHash)
+	response.BlockHash = hex.EncodeToString(rcpt.BlockHash)
+	response.GasRefunded = rcpt.GasRefunded
+	response.ReturnCode = rcpt.ReturnCode
+	if rcpt.Fee != nil {
+		response.Fee = rcpt.Fee.String()
+	}
+	for _, returnData := range rcpt.ReturnData {
+		response.ReturnData = append(response.ReturnData, hex.EncodeToString(returnData))
+	}
+	for _, logEntry := range rcpt.Logs {
+		logResponse := LogResponse{
+			Address: hex.EncodeToString(logEntry.Address),
+			Data:    hex.EncodeToString(logEntry.Data),
+		}
+		for _, topic := range logEntry.Topics {
+			logResponse.Topics = append(logResponse.Topics, hex.EncodeToString(topic))
+		}
+		response.Logs = append(response.Logs, logResponse)
+	}
+
+	return response
+}
+
 func txResponseFromTransaction(tx *transaction.Transaction) TxResponse {
 	response := TxResponse{}
 	response.Nonce = tx.Nonce
@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	tr "github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -32,6 +33,11 @@ type TransactionResponse struct {
 	TxResp *transaction.TxResponse `json:"transaction,omitempty"`
 }
 
+type ReceiptResponse struct {
+	GeneralResponse
+	Receipt *transaction.ReceiptResponse `json:"receipt,omitempty"`
+}
+
 type TransactionHashResponse struct {
 	GeneralResponse
 	TxHash string `json:"txHash,omitempty"`
@@ -122,6 +128,66 @@ func TestGetTransaction_FailsWithWrongFacadeTypeConversion(t *testing.T) {
 	assert.Equal(t, transactionResponse.Error, errors2.ErrInvalidAppContext.Error())
 }
 
+func TestGetTransactionReceipt_WithCorrectHashShouldReturnReceipt(t *testing.T) {
+	t.Parallel()
+
+	rcpt := &receipt.Receipt{
+		TxHash:      []byte("hash"),
+		Fee:         big.NewInt(100),
+		GasRefunded: 5,
+		ReturnCode:  "ok",
+		ReturnData:  [][]byte{[]byte("data")},
+		Logs: []*receipt.Log{
+			{Address: []byte("address"), Topics: [][]byte{[]byte("topic")}, Data: []byte("log data")},
+		},
+	}
+	facade := mock.Facade{
+		GetTransactionReceiptHandler: func(hash string) (*receipt.Receipt, error) {
+			return rcpt, nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/hash/receipt", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	receiptResponse := ReceiptResponse{}
+	loadResponse(resp.Body, &receiptResponse)
+
+	rcptResp := receiptResponse.Receipt
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, hex.EncodeToString(rcpt.TxHash), rcptResp.TxHash)
+	assert.Equal(t, "100", rcptResp.Fee)
+	assert.Equal(t, rcpt.GasRefunded, rcptResp.GasRefunded)
+	assert.Equal(t, rcpt.ReturnCode, rcptResp.ReturnCode)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("data"))}, rcptResp.ReturnData)
+	assert.Equal(t, 1, len(rcptResp.Logs))
+	assert.Equal(t, []string{hex.EncodeToString([]byte("topic"))}, rcptResp.Logs[0].Topics)
+}
+
+func TestGetTransactionReceipt_FacadeErrorsShouldReturnNotFound(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetTransactionReceiptHandler: func(hash string) (*receipt.Receipt, error) {
+			return nil, errors.New("not found")
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/hash/receipt", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	receiptResponse := ReceiptResponse{}
+	loadResponse(resp.Body, &receiptResponse)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.Equal(t, errors2.ErrReceiptNotFound.Error(), receiptResponse.Error)
+	assert.Nil(t, receiptResponse.Receipt)
+}
+
 func TestSendTransaction_ErrorWithWrongFacade(t *testing.T) {
 	t.Parallel()
 
@@ -209,7 +275,7 @@ func TestSendTransaction_ErrorWhenFacadeSendTransactionError(t *testing.T) {
 
 	facade := mock.Facade{
 		SendTransactionHandler: func(nonce uint64, sender string, receiver string, value string,
-			gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error) {
+			gasPrice uint64, gasLimit uint64, code string, signature []byte, chainID string, version uint32, validAfterRound uint64, validUntilRound uint64) (string, error) {
 			return "", errors.New(errorString)
 		},
 	}
@@ -245,23 +311,38 @@ func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 	data := "data"
 	signature := "aabbccdd"
 	txHash := "tx hash"
-
+	chainID := "chain ID"
+	version := uint32(1)
+	validAfterRound := uint64(10)
+	validUntilRound := uint64(20)
+
+	var receivedChainID string
+	var receivedVersion uint32
+	var receivedValidAfterRound, receivedValidUntilRound uint64
 	facade := mock.Facade{
 		SendTransactionHandler: func(nonce uint64, sender string, receiver string, value string,
-			gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error) {
+			gasPrice uint64, gasLimit uint64, code string, signature []byte, chainID string, version uint32, validAfterRound uint64, validUntilRound uint64) (string, error) {
+			receivedChainID = chainID
+			receivedVersion = version
+			receivedValidAfterRound = validAfterRound
+			receivedValidUntilRound = validUntilRound
 			return txHash, nil
 		},
 	}
 	ws := startNodeServer(&facade)
 
 	jsonStr := fmt.Sprintf(
-		`{"nonce": %d, "sender": "%s", "receiver": "%s", "value": "%s", "signature": "%s", "data": "%s"}`,
+		`{"nonce": %d, "sender": "%s", "receiver": "%s", "value": "%s", "signature": "%s", "data": "%s", "chainID": "%s", "version": %d, "validAfterRound": %d, "validUntilRound": %d}`,
 		nonce,
 		sender,
 		receiver,
 		value,
 		signature,
 		data,
+		chainID,
+		version,
+		validAfterRound,
+		validUntilRound,
 	)
 
 	req, _ := http.NewRequest("POST", "/transaction/send", bytes.NewBuffer([]byte(jsonStr)))
@@ -275,6 +356,10 @@ func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.Code)
 	assert.Empty(t, txHashResponse.Error)
 	assert.Equal(t, txHashResponse.TxHash, txHash)
+	assert.Equal(t, chainID, receivedChainID)
+	assert.Equal(t, version, receivedVersion)
+	assert.Equal(t, validAfterRound, receivedValidAfterRound)
+	assert.Equal(t, validUntilRound, receivedValidUntilRound)
 }
 
 func loadResponse(rsp io.Reader, destination interface{}) {
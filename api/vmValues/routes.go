@@ -3,6 +3,7 @@ package vmValues
 import (
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"net/http"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
@@ -18,10 +19,16 @@ type FacadeHandler interface {
 }
 
 // VMValueRequest represents the structure on which user input for generating a new transaction will validate against
+// Caller, Value, BlockNonce and BlockRootHash are optional. BlockNonce or BlockRootHash select the block whose
+// state the query is executed against, defaulting to the last committed block
 type VMValueRequest struct {
-	ScAddress string   `form:"scAddress" json:"scAddress"`
-	FuncName  string   `form:"funcName" json:"funcName"`
-	Args      []string `form:"args"  json:"args"`
+	ScAddress     string   `form:"scAddress" json:"scAddress"`
+	FuncName      string   `form:"funcName" json:"funcName"`
+	Args          []string `form:"args"  json:"args"`
+	Caller        string   `form:"caller" json:"caller,omitempty"`
+	Value         string   `form:"value" json:"value,omitempty"`
+	BlockNonce    *uint64  `form:"blockNonce" json:"blockNonce,omitempty"`
+	BlockRootHash string   `form:"blockRootHash" json:"blockRootHash,omitempty"`
 }
 
 // Routes defines address related routes
@@ -116,10 +123,33 @@ func createSCQuery(request *VMValueRequest) (*process.SCQuery, error) {
 		arguments[i] = append(arguments[i], argBytes...)
 	}
 
+	callerAddress, err := hex.DecodeString(request.Caller)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a valid hex string: %s", request.Caller, err.Error())
+	}
+
+	var callValue *big.Int
+	if len(request.Value) > 0 {
+		var ok bool
+		callValue, ok = big.NewInt(0).SetString(request.Value, 10)
+		if !ok {
+			return nil, fmt.Errorf("'%s' is not a valid number", request.Value)
+		}
+	}
+
+	blockRootHash, err := hex.DecodeString(request.BlockRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a valid hex string: %s", request.BlockRootHash, err.Error())
+	}
+
 	return &process.SCQuery{
-		ScAddress: decodedAddress,
-		FuncName:  request.FuncName,
-		Arguments: arguments,
+		ScAddress:     decodedAddress,
+		FuncName:      request.FuncName,
+		CallerAddr:    callerAddress,
+		CallValue:     callValue,
+		Arguments:     arguments,
+		BlockNonce:    request.BlockNonce,
+		BlockRootHash: blockRootHash,
 	}, nil
 }
 
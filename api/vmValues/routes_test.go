@@ -158,6 +158,64 @@ func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	assert.Contains(t, err.Error(), "'bad arg' is not a valid hex string")
 }
 
+func TestCreateSCQuery_CallerIsNotHexShouldErr(t *testing.T) {
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		Caller:    "bad caller",
+	}
+
+	_, err := createSCQuery(&request)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "'bad caller' is not a valid hex string")
+}
+
+func TestCreateSCQuery_ValueIsNotNumberShouldErr(t *testing.T) {
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		Value:     "bad value",
+	}
+
+	_, err := createSCQuery(&request)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "'bad value' is not a valid number")
+}
+
+func TestExecuteQuery_WithCallerValueAndBlockShouldPassThemToFacade(t *testing.T) {
+	t.Parallel()
+
+	caller := []byte("caller")
+	blockRootHash := []byte("root hash")
+	blockNonce := uint64(22)
+	var receivedQuery *process.SCQuery
+	facade := mock.Facade{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
+			receivedQuery = query
+			return &vmcommon.VMOutput{}, nil
+		},
+	}
+
+	request := VMValueRequest{
+		ScAddress:     DummyScAddress,
+		FuncName:      "function",
+		Args:          []string{},
+		Caller:        hex.EncodeToString(caller),
+		Value:         "1000",
+		BlockNonce:    &blockNonce,
+		BlockRootHash: hex.EncodeToString(blockRootHash),
+	}
+
+	response := vmOutputResponse{}
+	statusCode := doPost(&facade, "/vm-values/query", request, &response)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, caller, receivedQuery.CallerAddr)
+	assert.Equal(t, big.NewInt(1000), receivedQuery.CallValue)
+	assert.Equal(t, blockNonce, *receivedQuery.BlockNonce)
+	assert.Equal(t, blockRootHash, receivedQuery.BlockRootHash)
+}
+
 func TestGetDataValue_FacadeErrorsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,297 @@
+package checker
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgsChecker holds the arguments needed to create a database integrity checker
+type ArgsChecker struct {
+	Store           dataRetriever.StorageService
+	TrieStorer      storage.Storer
+	Marshalizer     marshal.Marshalizer
+	Hasher          hashing.Hasher
+	Uint64Converter typeConverters.Uint64ByteSliceConverter
+	ShardId         uint32
+}
+
+// Issue describes a problem found in the databases
+type Issue struct {
+	Nonce       uint64
+	Hash        []byte
+	Description string
+}
+
+// String returns the human readable form of the issue
+func (i Issue) String() string {
+	return fmt.Sprintf("nonce %d, hash %s: %s", i.Nonce, hex.EncodeToString(i.Hash), i.Description)
+}
+
+// Report holds the result of a database integrity check
+type Report struct {
+	StartNonce     uint64
+	StartHash      []byte
+	CheckedHeaders uint64
+	Issues         []Issue
+}
+
+// IsConsistent returns true if no issue was found
+func (r *Report) IsConsistent() bool {
+	return len(r.Issues) == 0
+}
+
+func (r *Report) addIssue(nonce uint64, hash []byte, format string, args ...interface{}) {
+	r.Issues = append(r.Issues, Issue{
+		Nonce:       nonce,
+		Hash:        hash,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+type checker struct {
+	headersStorer    storage.Storer
+	nonceHashStorer  storage.Storer
+	miniBlocksStorer storage.Storer
+	bootstrapStorer  storage.Storer
+	trieStorer       storage.Storer
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	uint64Converter  typeConverters.Uint64ByteSliceConverter
+	isMetachain      bool
+}
+
+// NewChecker creates a checker that walks the chain stored by a node, starting from its last bootstrap record
+// back to the genesis block
+func NewChecker(args ArgsChecker) (*checker, error) {
+	if check.IfNil(args.Store) {
+		return nil, ErrNilStore
+	}
+	if check.IfNil(args.TrieStorer) {
+		return nil, ErrNilTrieStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return nil, ErrNilUint64Converter
+	}
+
+	isMetachain := args.ShardId == sharding.MetachainShardId
+	headersUnit := dataRetriever.BlockHeaderUnit
+	nonceHashUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(args.ShardId)
+	if isMetachain {
+		headersUnit = dataRetriever.MetaBlockUnit
+		nonceHashUnit = dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	c := &checker{
+		headersStorer:    args.Store.GetStorer(headersUnit),
+		nonceHashStorer:  args.Store.GetStorer(nonceHashUnit),
+		miniBlocksStorer: args.Store.GetStorer(dataRetriever.MiniBlockUnit),
+		bootstrapStorer:  args.Store.GetStorer(dataRetriever.BootstrapUnit),
+		trieStorer:       args.TrieStorer,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		uint64Converter:  args.Uint64Converter,
+		isMetachain:      isMetachain,
+	}
+
+	if check.IfNil(c.headersStorer) || check.IfNil(c.nonceHashStorer) ||
+		check.IfNil(c.miniBlocksStorer) || check.IfNil(c.bootstrapStorer) {
+		return nil, ErrMissingStorageUnit
+	}
+
+	return c, nil
+}
+
+// Check walks the chain from the header found in the last bootstrap record down to the first block after genesis.
+// The genesis block is not saved in storage, so the link of the first block to it is not verified.
+// An error is returned only if the starting point can not be determined, any other problem is added to the report
+func (c *checker) Check() (*Report, error) {
+	lastHeader, err := c.lastBootstrapHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		StartNonce: lastHeader.Nonce,
+		StartHash:  lastHeader.Hash,
+		Issues:     make([]Issue, 0),
+	}
+
+	nonce, hash := lastHeader.Nonce, lastHeader.Hash
+	for nonce > 0 {
+		prevHash, found := c.checkHeader(report, nonce, hash)
+		if found {
+			report.CheckedHeaders++
+		}
+
+		nonce--
+		if nonce == 0 {
+			break
+		}
+
+		hash = c.nextHashToCheck(report, nonce, prevHash)
+		for hash == nil && nonce > 1 {
+			nonce--
+			hash = c.nextHashToCheck(report, nonce, nil)
+		}
+		if hash == nil {
+			break
+		}
+	}
+
+	return report, nil
+}
+
+func (c *checker) lastBootstrapHeader() (bootstrapStorage.BootstrapHeaderInfo, error) {
+	bootStorer, err := bootstrapStorage.NewBootstrapStorer(c.marshalizer, c.bootstrapStorer)
+	if err != nil {
+		return bootstrapStorage.BootstrapHeaderInfo{}, err
+	}
+
+	round := bootStorer.GetHighestRound()
+	if round == 0 {
+		return bootstrapStorage.BootstrapHeaderInfo{}, ErrNoBootstrapData
+	}
+
+	bootData, err := bootStorer.Get(round)
+	if err != nil {
+		return bootstrapStorage.BootstrapHeaderInfo{}, fmt.Errorf("bootstrap record for round %d: %s", round, err.Error())
+	}
+
+	return bootData.LastHeader, nil
+}
+
+// checkHeader verifies the header stored under the given hash and returns its previous hash. The returned flag
+// is false if the header could not be read
+func (c *checker) checkHeader(report *Report, nonce uint64, hash []byte) ([]byte, bool) {
+	buff, err := c.headersStorer.Get(hash)
+	if err != nil {
+		report.addIssue(nonce, hash, "header not found: %s", err.Error())
+		return nil, false
+	}
+
+	computedHash := c.hasher.Compute(string(buff))
+	if !bytes.Equal(computedHash, hash) {
+		report.addIssue(nonce, hash, "header hash mismatch, stored data hashes to %s", hex.EncodeToString(computedHash))
+	}
+
+	header, miniBlockHashes, err := c.unmarshalHeader(buff)
+	if err != nil {
+		report.addIssue(nonce, hash, "header can not be unmarshalled: %s", err.Error())
+		return nil, false
+	}
+
+	if header.GetNonce() != nonce {
+		report.addIssue(nonce, hash, "header has nonce %d, expected %d", header.GetNonce(), nonce)
+	}
+
+	c.checkNonceHashEntry(report, nonce, hash)
+
+	for _, mbHash := range miniBlockHashes {
+		err = c.miniBlocksStorer.Has(mbHash)
+		if err != nil {
+			report.addIssue(nonce, hash, "miniblock %s not found", hex.EncodeToString(mbHash))
+		}
+	}
+
+	rootHash := header.GetRootHash()
+	if !isEmptyRootHash(rootHash) {
+		err = c.trieStorer.Has(rootHash)
+		if err != nil {
+			report.addIssue(nonce, hash, "state root hash %s not found in trie storage", hex.EncodeToString(rootHash))
+		}
+	}
+
+	return header.GetPrevHash(), true
+}
+
+func (c *checker) unmarshalHeader(buff []byte) (data.HeaderHandler, [][]byte, error) {
+	var miniBlockHeaders []block.MiniBlockHeader
+	var header data.HeaderHandler
+
+	if c.isMetachain {
+		metaBlock := &block.MetaBlock{}
+		err := c.marshalizer.Unmarshal(metaBlock, buff)
+		if err != nil {
+			return nil, nil, err
+		}
+		header, miniBlockHeaders = metaBlock, metaBlock.MiniBlockHeaders
+	} else {
+		shardHeader := &block.Header{}
+		err := c.marshalizer.Unmarshal(shardHeader, buff)
+		if err != nil {
+			return nil, nil, err
+		}
+		header, miniBlockHeaders = shardHeader, shardHeader.MiniBlockHeaders
+	}
+
+	miniBlockHashes := make([][]byte, 0, len(miniBlockHeaders))
+	for _, mbHeader := range miniBlockHeaders {
+		miniBlockHashes = append(miniBlockHashes, mbHeader.Hash)
+	}
+
+	return header, miniBlockHashes, nil
+}
+
+func (c *checker) checkNonceHashEntry(report *Report, nonce uint64, hash []byte) {
+	storedHash, err := c.nonceHashStorer.Get(c.uint64Converter.ToByteSlice(nonce))
+	if err != nil {
+		report.addIssue(nonce, hash, "nonce to hash entry not found")
+		return
+	}
+
+	if !bytes.Equal(storedHash, hash) {
+		report.addIssue(nonce, hash, "nonce to hash entry points to %s", hex.EncodeToString(storedHash))
+	}
+}
+
+// nextHashToCheck returns the hash of the header with the given nonce. The previous hash of the already checked
+// header is used if known, otherwise the nonce to hash unit is used to skip over the gap
+func (c *checker) nextHashToCheck(report *Report, nonce uint64, prevHash []byte) []byte {
+	if len(prevHash) > 0 {
+		return prevHash
+	}
+
+	storedHash, err := c.nonceHashStorer.Get(c.uint64Converter.ToByteSlice(nonce))
+	if err != nil {
+		report.addIssue(nonce, nil, "chain gap, no header hash known for this nonce")
+		return nil
+	}
+
+	return storedHash
+}
+
+func isEmptyRootHash(rootHash []byte) bool {
+	for _, b := range rootHash {
+		if b != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *checker) IsInterfaceNil() bool {
+	if c == nil {
+		return true
+	}
+	return false
+}
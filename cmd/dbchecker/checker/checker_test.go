@@ -0,0 +1,227 @@
+package checker_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/dbchecker/checker"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+)
+
+const shardId = uint32(0)
+
+var nonceHashUnit = dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardId)
+
+func createMemUnit() storage.Storer {
+	cache, _ := lrucache.NewCache(10)
+	persist, _ := memorydb.New()
+	unit, _ := storageUnit.NewStorageUnit(cache, persist)
+
+	return unit
+}
+
+func createMockArgs() checker.ArgsChecker {
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.BlockHeaderUnit, createMemUnit())
+	store.AddStorer(dataRetriever.MetaBlockUnit, createMemUnit())
+	store.AddStorer(dataRetriever.MiniBlockUnit, createMemUnit())
+	store.AddStorer(dataRetriever.BootstrapUnit, createMemUnit())
+	store.AddStorer(dataRetriever.MetaHdrNonceHashDataUnit, createMemUnit())
+	store.AddStorer(nonceHashUnit, createMemUnit())
+
+	return checker.ArgsChecker{
+		Store:           store,
+		TrieStorer:      createMemUnit(),
+		Marshalizer:     &marshal.JsonMarshalizer{},
+		Hasher:          sha256.Sha256{},
+		Uint64Converter: uint64ByteSlice.NewBigEndianConverter(),
+		ShardId:         shardId,
+	}
+}
+
+// saveChain stores a consistent chain of shard headers, from nonce 1 to numHeaders, and returns the headers' hashes
+func saveChain(args checker.ArgsChecker, numHeaders uint64) map[uint64][]byte {
+	hashes := make(map[uint64][]byte)
+	prevHash := []byte("genesis hash")
+
+	for nonce := uint64(1); nonce <= numHeaders; nonce++ {
+		mbHash := []byte("miniblock" + string(rune('a'+nonce)))
+		rootHash := []byte("root hash" + string(rune('a'+nonce)))
+		_ = args.Store.Put(dataRetriever.MiniBlockUnit, mbHash, []byte("miniblock"))
+		_ = args.TrieStorer.Put(rootHash, []byte("root node"))
+
+		hdr := &block.Header{
+			Nonce:            nonce,
+			Round:            nonce,
+			PrevHash:         prevHash,
+			RootHash:         rootHash,
+			MiniBlockHeaders: []block.MiniBlockHeader{{Hash: mbHash}},
+		}
+		buff, _ := args.Marshalizer.Marshal(hdr)
+		hash := args.Hasher.Compute(string(buff))
+		_ = args.Store.Put(dataRetriever.BlockHeaderUnit, hash, buff)
+		_ = args.Store.Put(nonceHashUnit, args.Uint64Converter.ToByteSlice(nonce), hash)
+
+		hashes[nonce] = hash
+		prevHash = hash
+	}
+
+	bootStorer, _ := bootstrapStorage.NewBootstrapStorer(args.Marshalizer, args.Store.GetStorer(dataRetriever.BootstrapUnit))
+	_ = bootStorer.Put(int64(numHeaders), bootstrapStorage.BootstrapData{
+		LastHeader: bootstrapStorage.BootstrapHeaderInfo{
+			ShardId: shardId,
+			Nonce:   numHeaders,
+			Hash:    hashes[numHeaders],
+		},
+	})
+	_ = bootStorer.SaveLastRound(int64(numHeaders))
+
+	return hashes
+}
+
+func issuesContain(report *checker.Report, nonce uint64, description string) bool {
+	for _, issue := range report.Issues {
+		if issue.Nonce == nonce && strings.Contains(issue.Description, description) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestNewChecker_NilStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Store = nil
+	c, err := checker.NewChecker(args)
+
+	assert.Nil(t, c)
+	assert.Equal(t, checker.ErrNilStore, err)
+}
+
+func TestNewChecker_NilTrieStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.TrieStorer = nil
+	c, err := checker.NewChecker(args)
+
+	assert.Nil(t, c)
+	assert.Equal(t, checker.ErrNilTrieStorer, err)
+}
+
+func TestNewChecker_MissingUnitShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.ShardId = 1
+	c, err := checker.NewChecker(args)
+
+	assert.Nil(t, c)
+	assert.Equal(t, checker.ErrMissingStorageUnit, err)
+}
+
+func TestNewChecker_MetachainShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.ShardId = sharding.MetachainShardId
+	c, err := checker.NewChecker(args)
+
+	assert.Nil(t, err)
+	assert.False(t, c.IsInterfaceNil())
+}
+
+func TestChecker_CheckWithoutBootstrapDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	c, _ := checker.NewChecker(createMockArgs())
+	report, err := c.Check()
+
+	assert.Nil(t, report)
+	assert.Equal(t, checker.ErrNoBootstrapData, err)
+}
+
+func TestChecker_CheckConsistentChainShouldReportNoIssues(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	hashes := saveChain(args, 5)
+	c, _ := checker.NewChecker(args)
+
+	report, err := c.Check()
+
+	assert.Nil(t, err)
+	assert.True(t, report.IsConsistent())
+	assert.Equal(t, uint64(5), report.CheckedHeaders)
+	assert.Equal(t, uint64(5), report.StartNonce)
+	assert.Equal(t, hashes[5], report.StartHash)
+}
+
+func TestChecker_CheckMissingDataShouldReportIssues(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	hashes := saveChain(args, 5)
+	_ = args.Store.GetStorer(nonceHashUnit).Remove(args.Uint64Converter.ToByteSlice(4))
+	_ = args.Store.GetStorer(dataRetriever.MiniBlockUnit).Remove([]byte("miniblock" + string(rune('a'+3))))
+	_ = args.TrieStorer.Remove([]byte("root hash" + string(rune('a'+2))))
+	_ = args.Store.GetStorer(dataRetriever.BlockHeaderUnit).Remove(hashes[1])
+	c, _ := checker.NewChecker(args)
+
+	report, err := c.Check()
+
+	assert.Nil(t, err)
+	assert.False(t, report.IsConsistent())
+	assert.Equal(t, 4, len(report.Issues))
+	assert.Equal(t, uint64(4), report.CheckedHeaders)
+	assert.True(t, issuesContain(report, 4, "nonce to hash entry not found"))
+	assert.True(t, issuesContain(report, 3, "miniblock"))
+	assert.True(t, issuesContain(report, 2, "state root hash"))
+	assert.True(t, issuesContain(report, 1, "header not found"))
+}
+
+func TestChecker_CheckGapShouldContinueFromNonceHashUnit(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	hashes := saveChain(args, 5)
+	_ = args.Store.GetStorer(dataRetriever.BlockHeaderUnit).Remove(hashes[4])
+	_ = args.Store.GetStorer(dataRetriever.BlockHeaderUnit).Remove(hashes[3])
+	_ = args.Store.GetStorer(nonceHashUnit).Remove(args.Uint64Converter.ToByteSlice(3))
+	c, _ := checker.NewChecker(args)
+
+	report, err := c.Check()
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), report.CheckedHeaders)
+	assert.Equal(t, 2, len(report.Issues))
+	assert.True(t, issuesContain(report, 4, "header not found"))
+	assert.True(t, issuesContain(report, 3, "chain gap"))
+}
+
+func TestChecker_CheckTamperedHeaderShouldReportHashMismatch(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	hashes := saveChain(args, 3)
+	_ = args.Store.Put(dataRetriever.BlockHeaderUnit, hashes[2], []byte(`{"Nonce":2}`))
+	c, _ := checker.NewChecker(args)
+
+	report, err := c.Check()
+
+	assert.Nil(t, err)
+	assert.True(t, issuesContain(report, 2, "header hash mismatch"))
+}
@@ -0,0 +1,24 @@
+package checker
+
+import "errors"
+
+// ErrNilStore signals that a nil storage service has been provided
+var ErrNilStore = errors.New("nil storage service")
+
+// ErrNilTrieStorer signals that a nil trie storer has been provided
+var ErrNilTrieStorer = errors.New("nil trie storer")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilUint64Converter signals that a nil uint64 byte slice converter has been provided
+var ErrNilUint64Converter = errors.New("nil uint64 byte slice converter")
+
+// ErrMissingStorageUnit signals that one of the needed storage units was not found in the storage service
+var ErrMissingStorageUnit = errors.New("missing storage unit")
+
+// ErrNoBootstrapData signals that the bootstrap unit holds no record, so there is no starting point for the check
+var ErrNoBootstrapData = errors.New("no bootstrap data found")
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/dbchecker/checker"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtools"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/urfave/cli"
+)
+
+var errInconsistentDatabase = errors.New("the databases are inconsistent, a resync is needed")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = dbtools.HelpTemplate
+	app.Name = "Database integrity checker"
+	app.Version = "v0.0.1"
+	app.Usage = "This binary opens a stopped node's databases in read only mode and walks the chain from the last " +
+		"bootstrap record back to genesis, reporting any missing or inconsistent data"
+	app.Flags = dbtools.GlobalFlags()
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return checkDatabases(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+}
+
+func checkDatabases(ctx *cli.Context) error {
+	dbs, err := dbtools.OpenDatabases(ctx)
+	if err != nil {
+		return err
+	}
+	defer dbs.Close()
+
+	dbChecker, err := checker.NewChecker(checker.ArgsChecker{
+		Store:           dbs.Store,
+		TrieStorer:      dbs.TrieStorer,
+		Marshalizer:     dbs.Marshalizer,
+		Hasher:          dbs.Hasher,
+		Uint64Converter: uint64ByteSlice.NewBigEndianConverter(),
+		ShardId:         dbs.ShardId,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("checking databases in %s\n", dbs.DBFolder)
+	report, err := dbChecker.Check()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("started from nonce %d, hash %s\n", report.StartNonce, hex.EncodeToString(report.StartHash))
+	fmt.Printf("checked headers: %d\n", report.CheckedHeaders)
+	if report.IsConsistent() {
+		fmt.Println("no issues found")
+		return nil
+	}
+
+	fmt.Printf("found %d issue(s):\n", len(report.Issues))
+	for _, issue := range report.Issues {
+		fmt.Printf("\t%s\n", issue.String())
+	}
+
+	return errInconsistentDatabase
+}
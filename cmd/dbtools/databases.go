@@ -0,0 +1,100 @@
+package dbtools
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/offlineStorage"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/urfave/cli"
+)
+
+// MetachainShardName is the value of the shard flag selecting the metachain
+const MetachainShardName = "metachain"
+
+// Databases holds the databases of a stopped node, opened in read only mode, together with
+// the components needed to decode their content
+type Databases struct {
+	DBFolder    string
+	ShardId     uint32
+	Store       *offlineStorage.StorageService
+	TrieStorer  *storageUnit.Unit
+	Hasher      hashing.Hasher
+	Marshalizer marshal.Marshalizer
+}
+
+// OpenDatabases opens the databases of the node described by the global flags. The returned
+// databases must be released by calling Close
+func OpenDatabases(ctx *cli.Context) (*Databases, error) {
+	generalConfig := &config.Config{}
+	err := core.LoadTomlFile(generalConfig, ctx.GlobalString(ConfigurationFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	shardName := ctx.GlobalString(Shard.Name)
+	shardId, err := ParseShardId(shardName)
+	if err != nil {
+		return nil, err
+	}
+
+	hasher, err := offlineStorage.HasherFromConfig(generalConfig)
+	if err != nil {
+		return nil, err
+	}
+	marshalizer, err := offlineStorage.MarshalizerFromConfig(generalConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	dbFolder := offlineStorage.NodeDBFolder(ctx.GlobalString(WorkingDirectory.Name), shardName)
+	store, err := offlineStorage.NewStorageService(offlineStorage.ArgsStorageService{
+		Config:      generalConfig,
+		DBFolder:    dbFolder,
+		ShardId:     shardId,
+		NumOfShards: uint32(ctx.GlobalUint(NumOfShards.Name)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	trieStorer, err := offlineStorage.NewReadOnlyUnit(generalConfig.AccountsTrieStorage, dbFolder, "")
+	if err != nil {
+		_ = store.Close()
+		return nil, err
+	}
+
+	return &Databases{
+		DBFolder:    dbFolder,
+		ShardId:     shardId,
+		Store:       store,
+		TrieStorer:  trieStorer,
+		Hasher:      hasher,
+		Marshalizer: marshalizer,
+	}, nil
+}
+
+// Close releases the opened databases. The stored data is never changed
+func (dbs *Databases) Close() {
+	_ = dbs.Store.Close()
+	_ = dbs.TrieStorer.Close()
+}
+
+// ParseShardId converts the value of the shard flag, a shard index or MetachainShardName, to a shard id
+func ParseShardId(shardName string) (uint32, error) {
+	if shardName == MetachainShardName {
+		return sharding.MetachainShardId, nil
+	}
+
+	shardId, err := strconv.ParseUint(shardName, 10, 32)
+	if err != nil {
+		return 0, errors.New("invalid shard " + shardName)
+	}
+
+	return uint32(shardId), nil
+}
@@ -0,0 +1,35 @@
+package dbtools_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtools"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseShardId_MetachainShouldWork(t *testing.T) {
+	t.Parallel()
+
+	shardId, err := dbtools.ParseShardId(dbtools.MetachainShardName)
+
+	assert.Nil(t, err)
+	assert.Equal(t, sharding.MetachainShardId, shardId)
+}
+
+func TestParseShardId_ShardIndexShouldWork(t *testing.T) {
+	t.Parallel()
+
+	shardId, err := dbtools.ParseShardId("3")
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(3), shardId)
+}
+
+func TestParseShardId_InvalidValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := dbtools.ParseShardId("meta")
+
+	assert.NotNil(t, err)
+}
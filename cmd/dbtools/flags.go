@@ -0,0 +1,56 @@
+package dbtools
+
+import (
+	"github.com/urfave/cli"
+)
+
+// HelpTemplate is the help template shared by the database tools
+const HelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}{{if .VisibleCommands}} command [command options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .VisibleCommands}}
+COMMANDS:
+   {{range .VisibleCommands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}{{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+`
+
+var (
+	// WorkingDirectory defines a flag for the working directory of the node whose databases are opened
+	WorkingDirectory = cli.StringFlag{
+		Name:  "working-directory",
+		Usage: "The working directory of the stopped node. The databases are searched in its db subfolder",
+		Value: "",
+	}
+	// Shard defines a flag for the shard of the node whose databases are opened
+	Shard = cli.StringFlag{
+		Name:  "shard",
+		Usage: "The shard the node was started in: a shard index or " + MetachainShardName,
+		Value: "0",
+	}
+	// NumOfShards defines a flag for the number of shards, needed to open the metachain databases
+	NumOfShards = cli.UintFlag{
+		Name:  "num-of-shards",
+		Usage: "The number of shards in the network",
+		Value: 1,
+	}
+	// ConfigurationFile defines a flag for the path to the node's main toml configuration file
+	ConfigurationFile = cli.StringFlag{
+		Name:  "config",
+		Usage: "The main configuration file the node was started with",
+		Value: "./config/config.toml",
+	}
+)
+
+// GlobalFlags returns the flags needed by OpenDatabases
+func GlobalFlags() []cli.Flag {
+	return []cli.Flag{WorkingDirectory, Shard, NumOfShards, ConfigurationFile}
+}
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtools"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbviewer/viewer"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/urfave/cli"
+)
+
+// dataViewer defines the operations offered by the storage viewer
+type dataViewer interface {
+	ShardHeaderByHash(hash []byte) (*block.Header, error)
+	ShardHeaderByNonce(shardId uint32, nonce uint64) (*block.Header, error)
+	MetaBlockByHash(hash []byte) (*block.MetaBlock, error)
+	MetaBlockByNonce(nonce uint64) (*block.MetaBlock, error)
+	MiniBlock(hash []byte) (*block.MiniBlock, error)
+	Transaction(hash []byte) (interface{}, error)
+	Account(address []byte, rootHash []byte) (*state.Account, error)
+	Bootstrap(round int64) (*bootstrapStorage.BootstrapData, error)
+}
+
+var (
+	// hash defines a flag for the hex encoded hash of the requested item
+	hash = cli.StringFlag{
+		Name:  "hash",
+		Usage: "The hex encoded hash of the requested item",
+	}
+	// nonce defines a flag for the nonce of the requested header, used when no hash is provided
+	nonce = cli.Uint64Flag{
+		Name:  "nonce",
+		Usage: "The nonce of the requested header, used when no hash is provided",
+	}
+	// headerShard defines a flag for the shard of the requested header
+	headerShard = cli.UintFlag{
+		Name:  "header-shard",
+		Usage: "The shard of the requested header, defaults to the node's shard",
+	}
+	// address defines a flag for the hex encoded address of the requested account
+	address = cli.StringFlag{
+		Name:  "address",
+		Usage: "The hex encoded address of the requested account",
+	}
+	// rootHash defines a flag for the state root hash in which the account is searched
+	rootHash = cli.StringFlag{
+		Name:  "root-hash",
+		Usage: "The hex encoded state root hash, defaults to the root hash of the last committed header",
+	}
+	// round defines a flag for the round of the requested bootstrap record
+	round = cli.Int64Flag{
+		Name:  "round",
+		Usage: "The round of the requested bootstrap record, defaults to the latest one",
+	}
+
+	errMissingHashOrNonce = errors.New("either the hash or the nonce must be provided")
+	errMissingHash        = errors.New("the hash must be provided")
+	errMissingAddress     = errors.New("the address must be provided")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = dbtools.HelpTemplate
+	app.Name = "Database viewer"
+	app.Version = "v0.0.1"
+	app.Usage = "This binary opens a stopped node's databases in read only mode and prints the requested items as JSON"
+	app.Flags = dbtools.GlobalFlags()
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "header",
+			Usage:  "prints a shard header, by hash or by nonce",
+			Flags:  []cli.Flag{hash, nonce, headerShard},
+			Action: withViewer(printShardHeader),
+		},
+		{
+			Name:   "metablock",
+			Usage:  "prints a metablock, by hash or by nonce",
+			Flags:  []cli.Flag{hash, nonce},
+			Action: withViewer(printMetaBlock),
+		},
+		{
+			Name:   "miniblock",
+			Usage:  "prints a miniblock, by hash",
+			Flags:  []cli.Flag{hash},
+			Action: withViewer(printMiniBlock),
+		},
+		{
+			Name:   "transaction",
+			Usage:  "prints a transaction, smart contract result or reward transaction, by hash",
+			Flags:  []cli.Flag{hash},
+			Action: withViewer(printTransaction),
+		},
+		{
+			Name:   "account",
+			Usage:  "prints an account, by address",
+			Flags:  []cli.Flag{address, rootHash},
+			Action: withViewer(printAccount),
+		},
+		{
+			Name:   "bootstrap",
+			Usage:  "prints a bootstrap record, by round",
+			Flags:  []cli.Flag{round},
+			Action: withViewer(printBootstrap),
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+}
+
+// withViewer opens the databases and creates the viewer used by the command's handler. The object returned by
+// the handler is printed as JSON
+func withViewer(handler func(ctx *cli.Context, v dataViewer, shardId uint32) (interface{}, error)) func(ctx *cli.Context) error {
+	return func(ctx *cli.Context) error {
+		dbs, err := dbtools.OpenDatabases(ctx)
+		if err != nil {
+			return err
+		}
+		defer dbs.Close()
+
+		v, err := viewer.NewViewer(viewer.ArgsViewer{
+			Store:           dbs.Store,
+			TrieStorer:      dbs.TrieStorer,
+			Marshalizer:     dbs.Marshalizer,
+			Hasher:          dbs.Hasher,
+			Uint64Converter: uint64ByteSlice.NewBigEndianConverter(),
+			ShardId:         dbs.ShardId,
+		})
+		if err != nil {
+			return err
+		}
+
+		obj, err := handler(ctx, v, dbs.ShardId)
+		if err != nil {
+			return err
+		}
+
+		buff, err := viewer.ToJSON(obj)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(buff))
+		return nil
+	}
+}
+
+func printShardHeader(ctx *cli.Context, v dataViewer, shardId uint32) (interface{}, error) {
+	if ctx.IsSet(hash.Name) {
+		hdrHash, err := hex.DecodeString(ctx.String(hash.Name))
+		if err != nil {
+			return nil, err
+		}
+		return v.ShardHeaderByHash(hdrHash)
+	}
+	if !ctx.IsSet(nonce.Name) {
+		return nil, errMissingHashOrNonce
+	}
+
+	hdrShardId := shardId
+	if ctx.IsSet(headerShard.Name) {
+		hdrShardId = uint32(ctx.Uint(headerShard.Name))
+	}
+
+	return v.ShardHeaderByNonce(hdrShardId, ctx.Uint64(nonce.Name))
+}
+
+func printMetaBlock(ctx *cli.Context, v dataViewer, _ uint32) (interface{}, error) {
+	if ctx.IsSet(hash.Name) {
+		hdrHash, err := hex.DecodeString(ctx.String(hash.Name))
+		if err != nil {
+			return nil, err
+		}
+		return v.MetaBlockByHash(hdrHash)
+	}
+	if !ctx.IsSet(nonce.Name) {
+		return nil, errMissingHashOrNonce
+	}
+
+	return v.MetaBlockByNonce(ctx.Uint64(nonce.Name))
+}
+
+func printMiniBlock(ctx *cli.Context, v dataViewer, _ uint32) (interface{}, error) {
+	mbHash, err := hashFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.MiniBlock(mbHash)
+}
+
+func printTransaction(ctx *cli.Context, v dataViewer, _ uint32) (interface{}, error) {
+	txHash, err := hashFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.Transaction(txHash)
+}
+
+func printAccount(ctx *cli.Context, v dataViewer, _ uint32) (interface{}, error) {
+	if !ctx.IsSet(address.Name) {
+		return nil, errMissingAddress
+	}
+	addressBytes, err := hex.DecodeString(ctx.String(address.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	var stateRootHash []byte
+	if ctx.IsSet(rootHash.Name) {
+		stateRootHash, err = hex.DecodeString(ctx.String(rootHash.Name))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return v.Account(addressBytes, stateRootHash)
+}
+
+func printBootstrap(ctx *cli.Context, v dataViewer, _ uint32) (interface{}, error) {
+	return v.Bootstrap(ctx.Int64(round.Name))
+}
+
+func hashFromContext(ctx *cli.Context) ([]byte, error) {
+	if !ctx.IsSet(hash.Name) {
+		return nil, errMissingHash
+	}
+
+	return hex.DecodeString(ctx.String(hash.Name))
+}
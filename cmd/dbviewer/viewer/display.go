@@ -0,0 +1,108 @@
+package viewer
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+var bigIntType = reflect.TypeOf(big.Int{})
+
+// ToJSON returns the indented JSON form of the provided object. Unlike the standard encoding, byte slices
+// (hashes, addresses, signatures) are written as hex strings and big integers as decimal strings. As in the
+// standard encoding, the empty fields tagged with omitempty are skipped
+func ToJSON(obj interface{}) ([]byte, error) {
+	return json.MarshalIndent(toDisplayable(reflect.ValueOf(obj)), "", "  ")
+}
+
+func toDisplayable(value reflect.Value) interface{} {
+	switch value.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		if value.Type().Elem() == bigIntType {
+			return value.Interface().(*big.Int).String()
+		}
+		return toDisplayable(value.Elem())
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return hex.EncodeToString(value.Bytes())
+		}
+		fallthrough
+	case reflect.Array:
+		items := make([]interface{}, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			items = append(items, toDisplayable(value.Index(i)))
+		}
+		return items
+	case reflect.Map:
+		fields := make(map[string]interface{}, value.Len())
+		for _, key := range value.MapKeys() {
+			fields[toMapKey(key)] = toDisplayable(value.MapIndex(key))
+		}
+		return fields
+	case reflect.Struct:
+		if value.Type() == bigIntType {
+			bigValue := value.Interface().(big.Int)
+			return bigValue.String()
+		}
+		fields := make(map[string]interface{}, value.NumField())
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if field.PkgPath != "" {
+				// unexported field
+				continue
+			}
+			if isOmitEmpty(field) && isEmptyValue(value.Field(i)) {
+				continue
+			}
+			fields[field.Name] = toDisplayable(value.Field(i))
+		}
+		return fields
+	default:
+		return value.Interface()
+	}
+}
+
+func isOmitEmpty(field reflect.StructField) bool {
+	options := strings.Split(field.Tag.Get("json"), ",")
+	for _, option := range options[1:] {
+		if option == "omitempty" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isEmptyValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return value.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return value.IsNil()
+	case reflect.Bool:
+		return !value.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return value.Uint() == 0
+	default:
+		return false
+	}
+}
+
+func toMapKey(key reflect.Value) string {
+	if key.Kind() == reflect.String {
+		return key.String()
+	}
+
+	keyBuff, _ := json.Marshal(toDisplayable(key))
+
+	return string(keyBuff)
+}
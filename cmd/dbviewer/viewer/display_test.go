@@ -0,0 +1,86 @@
+package viewer_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/dbviewer/viewer"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToJSON_ShouldEncodeBytesAsHexAndBigIntsAsDecimal(t *testing.T) {
+	t.Parallel()
+
+	account := &state.Account{
+		Nonce:    1,
+		Balance:  big.NewInt(1000),
+		CodeHash: []byte{0xAB, 0xCD},
+	}
+
+	buff, err := viewer.ToJSON(account)
+
+	assert.Nil(t, err)
+	expected := `{
+  "Balance": "1000",
+  "CodeHash": "abcd",
+  "Nonce": 1,
+  "RootHash": ""
+}`
+	assert.Equal(t, expected, string(buff))
+}
+
+func TestToJSON_ShouldHandleSlicesMapsAndNils(t *testing.T) {
+	t.Parallel()
+
+	obj := struct {
+		Hashes [][]byte
+		Counts map[uint32]string
+		Value  *big.Int
+	}{
+		Hashes: [][]byte{{0x01}, {0x02}},
+		Counts: map[uint32]string{1: "one"},
+	}
+
+	buff, err := viewer.ToJSON(obj)
+
+	assert.Nil(t, err)
+	expected := `{
+  "Counts": {
+    "1": "one"
+  },
+  "Hashes": [
+    "01",
+    "02"
+  ],
+  "Value": null
+}`
+	assert.Equal(t, expected, string(buff))
+}
+
+func TestToJSON_ShouldShowMultiSigAccountFields(t *testing.T) {
+	t.Parallel()
+
+	account := &state.Account{
+		Nonce:             1,
+		Balance:           big.NewInt(1000),
+		MultiSigThreshold: 1,
+		MultiSigPubKeys:   [][]byte{{0xAB}, {0xCD}},
+	}
+
+	buff, err := viewer.ToJSON(account)
+
+	assert.Nil(t, err)
+	expected := `{
+  "Balance": "1000",
+  "CodeHash": "",
+  "MultiSigPubKeys": [
+    "ab",
+    "cd"
+  ],
+  "MultiSigThreshold": 1,
+  "Nonce": 1,
+  "RootHash": ""
+}`
+	assert.Equal(t, expected, string(buff))
+}
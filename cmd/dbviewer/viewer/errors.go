@@ -0,0 +1,30 @@
+package viewer
+
+import "errors"
+
+// ErrNilStore signals that a nil storage service has been provided
+var ErrNilStore = errors.New("nil storage service")
+
+// ErrNilTrieStorer signals that a nil trie storer has been provided
+var ErrNilTrieStorer = errors.New("nil trie storer")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilUint64Converter signals that a nil uint64 byte slice converter has been provided
+var ErrNilUint64Converter = errors.New("nil uint64 byte slice converter")
+
+// ErrMissingStorageUnit signals that the storage unit holding the requested data was not opened
+var ErrMissingStorageUnit = errors.New("missing storage unit")
+
+// ErrNoBootstrapData signals that the bootstrap unit holds no record
+var ErrNoBootstrapData = errors.New("no bootstrap data found")
+
+// ErrTransactionNotFound signals that the hash was not found in any of the transactions units
+var ErrTransactionNotFound = errors.New("transaction not found")
+
+// ErrAccountNotFound signals that the address was not found in the accounts trie
+var ErrAccountNotFound = errors.New("account not found")
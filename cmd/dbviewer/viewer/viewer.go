@@ -0,0 +1,265 @@
+package viewer
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgsViewer holds the arguments needed to create a storage viewer
+type ArgsViewer struct {
+	Store           dataRetriever.StorageService
+	TrieStorer      storage.Storer
+	Marshalizer     marshal.Marshalizer
+	Hasher          hashing.Hasher
+	Uint64Converter typeConverters.Uint64ByteSliceConverter
+	ShardId         uint32
+}
+
+type viewer struct {
+	store           dataRetriever.StorageService
+	trieStorer      storage.Storer
+	marshalizer     marshal.Marshalizer
+	hasher          hashing.Hasher
+	uint64Converter typeConverters.Uint64ByteSliceConverter
+	shardId         uint32
+}
+
+// NewViewer creates a viewer that reads and decodes the data stored by a node
+func NewViewer(args ArgsViewer) (*viewer, error) {
+	if check.IfNil(args.Store) {
+		return nil, ErrNilStore
+	}
+	if check.IfNil(args.TrieStorer) {
+		return nil, ErrNilTrieStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return nil, ErrNilUint64Converter
+	}
+
+	return &viewer{
+		store:           args.Store,
+		trieStorer:      args.TrieStorer,
+		marshalizer:     args.Marshalizer,
+		hasher:          args.Hasher,
+		uint64Converter: args.Uint64Converter,
+		shardId:         args.ShardId,
+	}, nil
+}
+
+// ShardHeaderByHash returns the shard header stored under the given hash
+func (v *viewer) ShardHeaderByHash(hash []byte) (*block.Header, error) {
+	header := &block.Header{}
+	err := v.getAndUnmarshal(dataRetriever.BlockHeaderUnit, hash, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// ShardHeaderByNonce returns the header with the given nonce from the given shard
+func (v *viewer) ShardHeaderByNonce(shardId uint32, nonce uint64) (*block.Header, error) {
+	hash, err := v.hashByNonce(dataRetriever.ShardHdrNonceHashDataUnit+dataRetriever.UnitType(shardId), nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.ShardHeaderByHash(hash)
+}
+
+// MetaBlockByHash returns the metablock stored under the given hash
+func (v *viewer) MetaBlockByHash(hash []byte) (*block.MetaBlock, error) {
+	metaBlock := &block.MetaBlock{}
+	err := v.getAndUnmarshal(dataRetriever.MetaBlockUnit, hash, metaBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return metaBlock, nil
+}
+
+// MetaBlockByNonce returns the metablock with the given nonce
+func (v *viewer) MetaBlockByNonce(nonce uint64) (*block.MetaBlock, error) {
+	hash, err := v.hashByNonce(dataRetriever.MetaHdrNonceHashDataUnit, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.MetaBlockByHash(hash)
+}
+
+// MiniBlock returns the miniblock stored under the given hash
+func (v *viewer) MiniBlock(hash []byte) (*block.MiniBlock, error) {
+	miniBlock := &block.MiniBlock{}
+	err := v.getAndUnmarshal(dataRetriever.MiniBlockUnit, hash, miniBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return miniBlock, nil
+}
+
+// Transaction returns the transaction, smart contract result or reward transaction stored under the given hash
+func (v *viewer) Transaction(hash []byte) (interface{}, error) {
+	txUnits := []struct {
+		unitType dataRetriever.UnitType
+		tx       interface{}
+	}{
+		{dataRetriever.TransactionUnit, &transaction.Transaction{}},
+		{dataRetriever.UnsignedTransactionUnit, &smartContractResult.SmartContractResult{}},
+		{dataRetriever.RewardTransactionUnit, &rewardTx.RewardTx{}},
+	}
+
+	for _, txUnit := range txUnits {
+		storer := v.store.GetStorer(txUnit.unitType)
+		if check.IfNil(storer) {
+			continue
+		}
+
+		buff, err := storer.Get(hash)
+		if err != nil {
+			continue
+		}
+
+		err = v.marshalizer.Unmarshal(txUnit.tx, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		return txUnit.tx, nil
+	}
+
+	return nil, ErrTransactionNotFound
+}
+
+// Account returns the account found at the given address in the state with the given root hash. If no root
+// hash is provided, the state of the last header saved in the bootstrap unit is used
+func (v *viewer) Account(address []byte, rootHash []byte) (*state.Account, error) {
+	var err error
+	if len(rootHash) == 0 {
+		rootHash, err = v.lastRootHash()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tr, err := trie.NewTrie(v.trieStorer, v.marshalizer, v.hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	stateTrie, err := tr.Recreate(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	buff, err := stateTrie.Get(address)
+	if err != nil {
+		return nil, err
+	}
+	if buff == nil {
+		return nil, ErrAccountNotFound
+	}
+
+	account := &state.Account{}
+	err = v.marshalizer.Unmarshal(account, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// Bootstrap returns the bootstrap record saved in the given round. The latest record is returned for round 0
+func (v *viewer) Bootstrap(round int64) (*bootstrapStorage.BootstrapData, error) {
+	bootStorer, err := bootstrapStorage.NewBootstrapStorer(v.marshalizer, v.store.GetStorer(dataRetriever.BootstrapUnit))
+	if err != nil {
+		return nil, err
+	}
+
+	if round == 0 {
+		round = bootStorer.GetHighestRound()
+		if round == 0 {
+			return nil, ErrNoBootstrapData
+		}
+	}
+
+	bootData, err := bootStorer.Get(round)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bootData, nil
+}
+
+func (v *viewer) lastRootHash() ([]byte, error) {
+	bootData, err := v.Bootstrap(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.shardId == sharding.MetachainShardId {
+		metaBlock, err := v.MetaBlockByHash(bootData.LastHeader.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		return metaBlock.RootHash, nil
+	}
+
+	header, err := v.ShardHeaderByHash(bootData.LastHeader.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return header.RootHash, nil
+}
+
+func (v *viewer) hashByNonce(unitType dataRetriever.UnitType, nonce uint64) ([]byte, error) {
+	storer := v.store.GetStorer(unitType)
+	if check.IfNil(storer) {
+		return nil, ErrMissingStorageUnit
+	}
+
+	return storer.Get(v.uint64Converter.ToByteSlice(nonce))
+}
+
+func (v *viewer) getAndUnmarshal(unitType dataRetriever.UnitType, key []byte, obj interface{}) error {
+	storer := v.store.GetStorer(unitType)
+	if check.IfNil(storer) {
+		return ErrMissingStorageUnit
+	}
+
+	buff, err := storer.Get(key)
+	if err != nil {
+		return err
+	}
+
+	return v.marshalizer.Unmarshal(obj, buff)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (v *viewer) IsInterfaceNil() bool {
+	if v == nil {
+		return true
+	}
+	return false
+}
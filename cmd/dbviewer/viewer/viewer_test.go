@@ -0,0 +1,204 @@
+package viewer_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/dbviewer/viewer"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMemUnit() storage.Storer {
+	cache, _ := lrucache.NewCache(10)
+	persist, _ := memorydb.New()
+	unit, _ := storageUnit.NewStorageUnit(cache, persist)
+
+	return unit
+}
+
+func createMockArgs() viewer.ArgsViewer {
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.BlockHeaderUnit, createMemUnit())
+	store.AddStorer(dataRetriever.MetaBlockUnit, createMemUnit())
+	store.AddStorer(dataRetriever.MiniBlockUnit, createMemUnit())
+	store.AddStorer(dataRetriever.TransactionUnit, createMemUnit())
+	store.AddStorer(dataRetriever.UnsignedTransactionUnit, createMemUnit())
+	store.AddStorer(dataRetriever.RewardTransactionUnit, createMemUnit())
+	store.AddStorer(dataRetriever.BootstrapUnit, createMemUnit())
+	store.AddStorer(dataRetriever.MetaHdrNonceHashDataUnit, createMemUnit())
+	store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit, createMemUnit())
+
+	return viewer.ArgsViewer{
+		Store:           store,
+		TrieStorer:      createMemUnit(),
+		Marshalizer:     &marshal.JsonMarshalizer{},
+		Hasher:          sha256.Sha256{},
+		Uint64Converter: uint64ByteSlice.NewBigEndianConverter(),
+		ShardId:         0,
+	}
+}
+
+func saveObject(args viewer.ArgsViewer, unitType dataRetriever.UnitType, obj interface{}) []byte {
+	buff, _ := args.Marshalizer.Marshal(obj)
+	hash := args.Hasher.Compute(string(buff))
+	_ = args.Store.Put(unitType, hash, buff)
+
+	return hash
+}
+
+func TestNewViewer_NilStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Store = nil
+	v, err := viewer.NewViewer(args)
+
+	assert.Nil(t, v)
+	assert.Equal(t, viewer.ErrNilStore, err)
+}
+
+func TestNewViewer_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Marshalizer = nil
+	v, err := viewer.NewViewer(args)
+
+	assert.Nil(t, v)
+	assert.Equal(t, viewer.ErrNilMarshalizer, err)
+}
+
+func TestNewViewer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	v, err := viewer.NewViewer(createMockArgs())
+
+	assert.Nil(t, err)
+	assert.False(t, v.IsInterfaceNil())
+}
+
+func TestViewer_ShardHeaderByNonceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	hdr := &block.Header{Nonce: 7, Round: 8}
+	hash := saveObject(args, dataRetriever.BlockHeaderUnit, hdr)
+	_ = args.Store.Put(dataRetriever.ShardHdrNonceHashDataUnit, args.Uint64Converter.ToByteSlice(7), hash)
+	v, _ := viewer.NewViewer(args)
+
+	recovered, err := v.ShardHeaderByNonce(0, 7)
+
+	assert.Nil(t, err)
+	assert.Equal(t, hdr, recovered)
+
+	_, err = v.ShardHeaderByNonce(1, 7)
+	assert.Equal(t, viewer.ErrMissingStorageUnit, err)
+}
+
+func TestViewer_MetaBlockByNonceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	metaBlock := &block.MetaBlock{Nonce: 3}
+	hash := saveObject(args, dataRetriever.MetaBlockUnit, metaBlock)
+	_ = args.Store.Put(dataRetriever.MetaHdrNonceHashDataUnit, args.Uint64Converter.ToByteSlice(3), hash)
+	v, _ := viewer.NewViewer(args)
+
+	recovered, err := v.MetaBlockByNonce(3)
+
+	assert.Nil(t, err)
+	assert.Equal(t, metaBlock, recovered)
+}
+
+func TestViewer_MiniBlockShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("tx")}, ReceiverShardID: 1}
+	hash := saveObject(args, dataRetriever.MiniBlockUnit, miniBlock)
+	v, _ := viewer.NewViewer(args)
+
+	recovered, err := v.MiniBlock(hash)
+
+	assert.Nil(t, err)
+	assert.Equal(t, miniBlock, recovered)
+}
+
+func TestViewer_TransactionShouldSearchAllTransactionsUnits(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	tx := &transaction.Transaction{Nonce: 1, Value: big.NewInt(10)}
+	txHash := saveObject(args, dataRetriever.TransactionUnit, tx)
+	rewardTransaction := &rewardTx.RewardTx{Round: 2, Value: big.NewInt(20)}
+	rewardTxHash := saveObject(args, dataRetriever.RewardTransactionUnit, rewardTransaction)
+	v, _ := viewer.NewViewer(args)
+
+	recovered, err := v.Transaction(txHash)
+	assert.Nil(t, err)
+	assert.Equal(t, tx, recovered)
+
+	recovered, err = v.Transaction(rewardTxHash)
+	assert.Nil(t, err)
+	assert.Equal(t, rewardTransaction, recovered)
+
+	recovered, err = v.Transaction([]byte("missing"))
+	assert.Nil(t, recovered)
+	assert.Equal(t, viewer.ErrTransactionNotFound, err)
+}
+
+func TestViewer_AccountFromLastHeaderStateShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	address := []byte("12345678901234567890123456789012")
+	account := &state.Account{Nonce: 4, Balance: big.NewInt(100)}
+	accountBuff, _ := args.Marshalizer.Marshal(account)
+
+	tr, _ := trie.NewTrie(args.TrieStorer, args.Marshalizer, args.Hasher)
+	_ = tr.Update(address, accountBuff)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	hdrHash := saveObject(args, dataRetriever.BlockHeaderUnit, &block.Header{Nonce: 1, RootHash: rootHash})
+	bootStorer, _ := bootstrapStorage.NewBootstrapStorer(args.Marshalizer, args.Store.GetStorer(dataRetriever.BootstrapUnit))
+	_ = bootStorer.Put(1, bootstrapStorage.BootstrapData{
+		LastHeader: bootstrapStorage.BootstrapHeaderInfo{Nonce: 1, Hash: hdrHash},
+	})
+	_ = bootStorer.SaveLastRound(1)
+	v, _ := viewer.NewViewer(args)
+
+	recovered, err := v.Account(address, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, account.Nonce, recovered.Nonce)
+	assert.Equal(t, account.Balance, recovered.Balance)
+
+	recovered, err = v.Account([]byte("missing address"), rootHash)
+	assert.Nil(t, recovered)
+	assert.Equal(t, viewer.ErrAccountNotFound, err)
+}
+
+func TestViewer_BootstrapWithoutDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	v, _ := viewer.NewViewer(createMockArgs())
+
+	bootData, err := v.Bootstrap(0)
+
+	assert.Nil(t, bootData)
+	assert.Equal(t, viewer.ErrNoBootstrapData, err)
+}
@@ -57,6 +57,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/block/poolsCleaner"
+	"github.com/ElrondNetwork/elrond-go/process/block/poolsPersister"
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
@@ -64,6 +65,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
 	"github.com/ElrondNetwork/elrond-go/process/peer"
+	"github.com/ElrondNetwork/elrond-go/process/receipts"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
@@ -76,6 +78,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	"github.com/btcsuite/btcd/btcec"
 	libp2pCrypto "github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/urfave/cli"
@@ -160,6 +164,8 @@ type Process struct {
 	BlockProcessor        process.BlockProcessor
 	BlackListHandler      process.BlackListHandler
 	BootStorer            process.BootStorer
+	TxPoolsPersister      process.TxPoolsPersister
+	ReceiptsHandler       process.ReceiptsHandler
 }
 
 type coreComponentsFactoryArgs struct {
@@ -513,6 +519,8 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		args.state,
 		args.network,
 		args.economicsData,
+		[]byte(args.coreComponents.config.GeneralSettings.NetworkID),
+		args.coreComponents.config.GeneralSettings.MinTransactionVersion,
 	)
 	if err != nil {
 		return nil, err
@@ -560,6 +568,15 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 
 	log.Trace("Validator stats created", "validatorStatsRootHash", validatorStatsRootHash)
 
+	doubleSigningVerifier, err := systemSmartContracts.NewDoubleSigningVerifier(
+		args.crypto.BlockSignKeyGen,
+		args.crypto.SingleSigner,
+		args.core.Marshalizer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	genesisBlocks, err := generateGenesisHeadersAndApplyInitialBalances(
 		args.core,
 		args.state,
@@ -568,6 +585,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		args.nodesConfig,
 		args.genesisConfig,
 		args.economicsData,
+		doubleSigningVerifier,
 	)
 	if err != nil {
 		return nil, err
@@ -584,6 +602,15 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
+	receiptsHandler, err := receipts.NewReceiptsHandler(
+		args.data.Store.GetStorer(dataRetriever.ReceiptsUnit),
+		args.core.Marshalizer,
+		args.core.Hasher,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	blockProcessor, err := newBlockProcessor(
 		args,
 		resolversFinder,
@@ -592,11 +619,18 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		rounder,
 		bootStorer,
 		validatorStatisticsProcessor,
+		doubleSigningVerifier,
+		receiptsHandler,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	txPoolsPersister, err := newTxPoolsPersister(args)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Process{
 		InterceptorsContainer: interceptorsContainer,
 		ResolversFinder:       resolversFinder,
@@ -605,9 +639,33 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		BlockProcessor:        blockProcessor,
 		BlackListHandler:      blackListHandler,
 		BootStorer:            bootStorer,
+		TxPoolsPersister:      txPoolsPersister,
+		ReceiptsHandler:       receiptsHandler,
 	}, nil
 }
 
+func newTxPoolsPersister(args *processComponentsFactoryArgs) (process.TxPoolsPersister, error) {
+	arg := poolsPersister.ArgTxPoolsPersister{
+		Storer:           args.data.Store.GetStorer(dataRetriever.TxPoolUnit),
+		Store:            args.data.Store,
+		Marshalizer:      args.core.Marshalizer,
+		Accounts:         args.state.AccountsAdapter,
+		AddrConverter:    args.state.AddressConverter,
+		ShardCoordinator: args.shardCoordinator,
+	}
+
+	if args.shardCoordinator.SelfId() < args.shardCoordinator.NumberOfShards() {
+		arg.TxPool = args.data.Datapool.Transactions()
+		arg.UnsignedTxPool = args.data.Datapool.UnsignedTransactions()
+		arg.RewardTxPool = args.data.Datapool.RewardTransactions()
+	} else {
+		arg.TxPool = args.data.MetaDatapool.Transactions()
+		arg.UnsignedTxPool = args.data.MetaDatapool.UnsignedTransactions()
+	}
+
+	return poolsPersister.NewTxPoolsPersister(arg)
+}
+
 func prepareGenesisBlock(args *processComponentsFactoryArgs, genesisBlocks map[uint32]data.HeaderHandler) error {
 	genesisBlock, ok := genesisBlocks[args.shardCoordinator.SelfId()]
 	if !ok {
@@ -716,6 +774,17 @@ func getMarshalizerFromConfig(cfg *config.Config) (marshal.Marshalizer, error) {
 	return nil, errors.New("no marshalizer provided in config file")
 }
 
+func getTxSelectionStrategyFromConfig(cfg config.TxSelectionConfig) (process.TxSelectionStrategy, error) {
+	switch cfg.Strategy {
+	case "PoolOrder":
+		return preprocess.NewPoolOrderSelection(), nil
+	case "FeeMaximizing":
+		return preprocess.NewFeeMaximizingSelection(cfg.ReservedGasPercent)
+	}
+
+	return nil, errors.New("no transactions selection strategy provided in config file")
+}
+
 func getTrie(
 	cfg config.StorageConfig,
 	marshalizer marshal.Marshalizer,
@@ -809,6 +878,8 @@ func createShardDataStoreFromConfig(
 	var bootstrapUnit *storageUnit.Unit
 	var heartbeatStorageUnit *storageUnit.Unit
 	var statusMetricsStorageUnit *storageUnit.Unit
+	var txPoolUnit *storageUnit.Unit
+	var receiptsUnit *storageUnit.Unit
 	var err error
 
 	defer func() {
@@ -850,6 +921,12 @@ func createShardDataStoreFromConfig(
 			if statusMetricsStorageUnit != nil {
 				_ = statusMetricsStorageUnit.DestroyUnit()
 			}
+			if txPoolUnit != nil {
+				_ = txPoolUnit.DestroyUnit()
+			}
+			if receiptsUnit != nil {
+				_ = receiptsUnit.DestroyUnit()
+			}
 		}
 	}()
 
@@ -952,6 +1029,22 @@ func createShardDataStoreFromConfig(
 		return nil, err
 	}
 
+	txPoolUnit, err = storageUnit.NewStorageUnitFromConf(
+		getCacherFromConfig(config.TxPoolStorage.Cache),
+		getDBFromConfig(config.TxPoolStorage.DB, uniqueID),
+		getBloomFromConfig(config.TxPoolStorage.Bloom))
+	if err != nil {
+		return nil, err
+	}
+
+	receiptsUnit, err = storageUnit.NewStorageUnitFromConf(
+		getCacherFromConfig(config.ReceiptsStorage.Cache),
+		getDBFromConfig(config.ReceiptsStorage.DB, uniqueID),
+		getBloomFromConfig(config.ReceiptsStorage.Bloom))
+	if err != nil {
+		return nil, err
+	}
+
 	store := dataRetriever.NewChainStorer()
 	store.AddStorer(dataRetriever.TransactionUnit, txUnit)
 	store.AddStorer(dataRetriever.MiniBlockUnit, miniBlockUnit)
@@ -966,6 +1059,8 @@ func createShardDataStoreFromConfig(
 	store.AddStorer(dataRetriever.HeartbeatUnit, heartbeatStorageUnit)
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
+	store.AddStorer(dataRetriever.TxPoolUnit, txPoolUnit)
+	store.AddStorer(dataRetriever.ReceiptsUnit, receiptsUnit)
 
 	return store, err
 }
@@ -981,6 +1076,8 @@ func createMetaChainDataStoreFromConfig(
 	var bootstrapUnit *storageUnit.Unit
 	var heartbeatStorageUnit *storageUnit.Unit
 	var statusMetricsStorageUnit *storageUnit.Unit
+	var txPoolUnit *storageUnit.Unit
+	var receiptsUnit *storageUnit.Unit
 
 	var err error
 
@@ -1025,6 +1122,12 @@ func createMetaChainDataStoreFromConfig(
 			if statusMetricsStorageUnit != nil {
 				_ = statusMetricsStorageUnit.DestroyUnit()
 			}
+			if txPoolUnit != nil {
+				_ = txPoolUnit.DestroyUnit()
+			}
+			if receiptsUnit != nil {
+				_ = receiptsUnit.DestroyUnit()
+			}
 		}
 	}()
 
@@ -1130,6 +1233,22 @@ func createMetaChainDataStoreFromConfig(
 		return nil, err
 	}
 
+	txPoolUnit, err = storageUnit.NewStorageUnitFromConf(
+		getCacherFromConfig(config.TxPoolStorage.Cache),
+		getDBFromConfig(config.TxPoolStorage.DB, uniqueID),
+		getBloomFromConfig(config.TxPoolStorage.Bloom))
+	if err != nil {
+		return nil, err
+	}
+
+	receiptsUnit, err = storageUnit.NewStorageUnitFromConf(
+		getCacherFromConfig(config.ReceiptsStorage.Cache),
+		getDBFromConfig(config.ReceiptsStorage.DB, uniqueID),
+		getBloomFromConfig(config.ReceiptsStorage.Bloom))
+	if err != nil {
+		return nil, err
+	}
+
 	store := dataRetriever.NewChainStorer()
 	store.AddStorer(dataRetriever.MetaBlockUnit, metaBlockUnit)
 	store.AddStorer(dataRetriever.MetaShardDataUnit, shardDataUnit)
@@ -1146,6 +1265,8 @@ func createMetaChainDataStoreFromConfig(
 	store.AddStorer(dataRetriever.HeartbeatUnit, heartbeatStorageUnit)
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
+	store.AddStorer(dataRetriever.TxPoolUnit, txPoolUnit)
+	store.AddStorer(dataRetriever.ReceiptsUnit, receiptsUnit)
 
 	return store, err
 }
@@ -1157,7 +1278,11 @@ func createShardDataPoolFromConfig(
 
 	log.Debug("creatingShardDataPool from config")
 
-	txPool, err := shardedData.NewShardedData(getCacherFromConfig(config.TxDataPool))
+	txPool, err := shardedData.NewShardedTxData(
+		config.TxDataPool.Size,
+		config.TxDataPool.MaxTxsPerSender,
+		config.TxDataPool.MinGasPriceIncreasePercent,
+	)
 	if err != nil {
 		log.Error("error creating txpool")
 		return nil, err
@@ -1269,7 +1394,11 @@ func createMetaDataPoolFromConfig(
 		return nil, err
 	}
 
-	txPool, err := shardedData.NewShardedData(getCacherFromConfig(config.TxDataPool))
+	txPool, err := shardedData.NewShardedTxData(
+		config.TxDataPool.Size,
+		config.TxDataPool.MaxTxsPerSender,
+		config.TxDataPool.MinGasPriceIncreasePercent,
+	)
 	if err != nil {
 		log.Error("error creating txpool")
 		return nil, err
@@ -1393,6 +1522,8 @@ func newInterceptorAndResolverContainerFactory(
 	state *State,
 	network *Network,
 	economics *economics.EconomicsData,
+	chainID []byte,
+	minTxVersion uint32,
 ) (process.InterceptorsContainerFactory, dataRetriever.ResolversContainerFactory, process.BlackListHandler, error) {
 
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
@@ -1405,6 +1536,8 @@ func newInterceptorAndResolverContainerFactory(
 			state,
 			network,
 			economics,
+			chainID,
+			minTxVersion,
 		)
 	}
 	if shardCoordinator.SelfId() == sharding.MetachainShardId {
@@ -1417,6 +1550,8 @@ func newInterceptorAndResolverContainerFactory(
 			network,
 			state,
 			economics,
+			chainID,
+			minTxVersion,
 		)
 	}
 
@@ -1432,6 +1567,8 @@ func newShardInterceptorAndResolverContainerFactory(
 	state *State,
 	network *Network,
 	economics *economics.EconomicsData,
+	chainID []byte,
+	minTxVersion uint32,
 ) (process.InterceptorsContainerFactory, dataRetriever.ResolversContainerFactory, process.BlackListHandler, error) {
 
 	headerBlackList := timecache.NewTimeCache(timeSpanForBadHeaders)
@@ -1453,6 +1590,8 @@ func newShardInterceptorAndResolverContainerFactory(
 		MaxTxNonceDeltaAllowed,
 		economics,
 		headerBlackList,
+		chainID,
+		minTxVersion,
 	)
 	if err != nil {
 		return nil, nil, nil, err
@@ -1488,6 +1627,8 @@ func newMetaInterceptorAndResolverContainerFactory(
 	network *Network,
 	state *State,
 	economics *economics.EconomicsData,
+	chainID []byte,
+	minTxVersion uint32,
 ) (process.InterceptorsContainerFactory, dataRetriever.ResolversContainerFactory, process.BlackListHandler, error) {
 
 	headerBlackList := timecache.NewTimeCache(timeSpanForBadHeaders)
@@ -1509,6 +1650,8 @@ func newMetaInterceptorAndResolverContainerFactory(
 		MaxTxNonceDeltaAllowed,
 		economics,
 		headerBlackList,
+		chainID,
+		minTxVersion,
 	)
 	if err != nil {
 		return nil, nil, nil, err
@@ -1542,6 +1685,7 @@ func generateGenesisHeadersAndApplyInitialBalances(
 	nodesSetup *sharding.NodesSetup,
 	genesisConfig *sharding.Genesis,
 	economics *economics.EconomicsData,
+	doubleSigningVerifier vm.DoubleSigningVerifier,
 ) (map[uint32]data.HeaderHandler, error) {
 	//TODO change this rudimentary startup for metachain nodes
 	// Talk between Adrian, Robert and Iulian, did not want it to be discarded:
@@ -1623,6 +1767,7 @@ func generateGenesisHeadersAndApplyInitialBalances(
 		MetaDatapool:             dataComponents.MetaDatapool,
 		Economics:                economics,
 		ValidatorStatsRootHash:   validatorStatsRootHash,
+		DoubleSigningVerifier:    doubleSigningVerifier,
 	}
 
 	if shardCoordinator.SelfId() != sharding.MetachainShardId {
@@ -1770,6 +1915,8 @@ func newBlockProcessor(
 	rounder consensus.Rounder,
 	bootStorer process.BootStorer,
 	validatorStatisticsProcessor process.ValidatorStatisticsProcessor,
+	doubleSigningVerifier vm.DoubleSigningVerifier,
+	receiptsHandler process.ReceiptsHandler,
 ) (process.BlockProcessor, error) {
 
 	shardCoordinator := processArgs.shardCoordinator
@@ -1802,6 +1949,11 @@ func newBlockProcessor(
 		return nil, err
 	}
 
+	txSelection, err := getTxSelectionStrategyFromConfig(processArgs.coreComponents.config.TxSelection)
+	if err != nil {
+		return nil, err
+	}
+
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
 		return newShardBlockProcessor(
 			resolversFinder,
@@ -1820,6 +1972,11 @@ func newBlockProcessor(
 			bootStorer,
 			processArgs.gasSchedule,
 			processArgs.requestedItemsHandler,
+			txSelection,
+			processArgs.crypto,
+			receiptsHandler,
+			[]byte(processArgs.coreComponents.config.GeneralSettings.NetworkID),
+			processArgs.coreComponents.config.GeneralSettings.MinTransactionVersion,
 		)
 	}
 	if shardCoordinator.SelfId() == sharding.MetachainShardId {
@@ -1840,6 +1997,9 @@ func newBlockProcessor(
 			rounder,
 			bootStorer,
 			processArgs.requestedItemsHandler,
+			txSelection,
+			doubleSigningVerifier,
+			receiptsHandler,
 		)
 	}
 
@@ -1863,6 +2023,11 @@ func newShardBlockProcessor(
 	bootStorer process.BootStorer,
 	gasSchedule map[string]map[string]uint64,
 	requestedItemsHandler dataRetriever.RequestedItemsHandler,
+	txSelection process.TxSelectionStrategy,
+	crypto *Crypto,
+	receiptsHandler process.ReceiptsHandler,
+	chainID []byte,
+	minTxVersion uint32,
 ) (process.BlockProcessor, error) {
 	argsParser, err := smartContract.NewAtArgumentParser()
 	if err != nil {
@@ -1951,6 +2116,7 @@ func newShardBlockProcessor(
 		economics,
 		txTypeHandler,
 		gasHandler,
+		receiptsHandler,
 	)
 	if err != nil {
 		return nil, err
@@ -1976,6 +2142,19 @@ func newShardBlockProcessor(
 		state.AddressConverter,
 		shardCoordinator,
 		rewardsTxInterim,
+		scForwarder,
+		core.Hasher,
+		core.Marshalizer,
+		economics,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureSetHandler, err := transaction.NewSignatureSetHandler(
+		core.Marshalizer,
+		crypto.TxSignKeyGen,
+		crypto.TxSingleSigner,
 	)
 	if err != nil {
 		return nil, err
@@ -1991,6 +2170,10 @@ func newShardBlockProcessor(
 		rewardsTxHandler,
 		txTypeHandler,
 		economics,
+		scForwarder,
+		signatureSetHandler,
+		chainID,
+		minTxVersion,
 	)
 	if err != nil {
 		return nil, errors.New("could not create transaction statisticsProcessor: " + err.Error())
@@ -2018,6 +2201,7 @@ func newShardBlockProcessor(
 		economics,
 		miniBlocksCompacter,
 		gasHandler,
+		txSelection,
 	)
 	if err != nil {
 		return nil, err
@@ -2046,11 +2230,27 @@ func newShardBlockProcessor(
 		shardCoordinator,
 		data.Datapool,
 		state.AddressConverter,
+		rounder,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	governanceUpdaters, err := createShardGovernanceUpdaters(economics, nodesCoordinator, vmFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	argsGovernance := scToProtocol.ArgGovernanceApplier{
+		Marshalizer: core.Marshalizer,
+		Storer:      data.Store.GetStorer(dataRetriever.BootstrapUnit),
+		Updaters:    governanceUpdaters,
+	}
+	governanceApplier, err := scToProtocol.NewGovernanceApplier(argsGovernance)
+	if err != nil {
+		return nil, err
+	}
+
 	argumentsBaseProcessor := block.ArgBaseProcessor{
 		Accounts:                     state.AccountsAdapter,
 		ForkDetector:                 forkDetector,
@@ -2069,11 +2269,13 @@ func newShardBlockProcessor(
 		Rounder:                      rounder,
 		ValidatorStatisticsProcessor: statisticsProcessor,
 		BootStorer:                   bootStorer,
+		ReceiptsHandler:              receiptsHandler,
 	}
 	arguments := block.ArgShardProcessor{
 		ArgBaseProcessor: argumentsBaseProcessor,
-		DataPool:         data.Datapool,
-		TxsPoolsCleaner:  txPoolsCleaner,
+		DataPool:          data.Datapool,
+		TxsPoolsCleaner:   txPoolsCleaner,
+		GovernanceApplier: governanceApplier,
 	}
 
 	blockProcessor, err := block.NewShardProcessor(arguments)
@@ -2089,6 +2291,39 @@ func newShardBlockProcessor(
 	return blockProcessor, nil
 }
 
+// createGovernanceUpdaters returns, for each parameter namespace governance can change, the component which applies
+// the changes on this node
+func createGovernanceUpdaters(
+	economicsData process.ParametersUpdater,
+	nodesCoordinator sharding.NodesCoordinator,
+) (map[string]process.ParametersUpdater, error) {
+	consensusUpdater, ok := nodesCoordinator.(process.ParametersUpdater)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	return map[string]process.ParametersUpdater{
+		core.GovernanceEconomicsNamespace: economicsData,
+		core.GovernanceConsensusNamespace: consensusUpdater,
+	}, nil
+}
+
+// createShardGovernanceUpdaters adds to the governance updaters the gas schedule of the VMs a shard runs
+func createShardGovernanceUpdaters(
+	economicsData process.ParametersUpdater,
+	nodesCoordinator sharding.NodesCoordinator,
+	gasScheduleUpdater process.ParametersUpdater,
+) (map[string]process.ParametersUpdater, error) {
+	updaters, err := createGovernanceUpdaters(economicsData, nodesCoordinator)
+	if err != nil {
+		return nil, err
+	}
+
+	updaters[core.GovernanceGasScheduleNamespace] = gasScheduleUpdater
+
+	return updaters, nil
+}
+
 func newMetaBlockProcessor(
 	resolversFinder dataRetriever.ResolversFinder,
 	shardCoordinator sharding.Coordinator,
@@ -2105,6 +2340,9 @@ func newMetaBlockProcessor(
 	rounder consensus.Rounder,
 	bootStorer process.BootStorer,
 	requestedItemsHandler dataRetriever.RequestedItemsHandler,
+	txSelection process.TxSelectionStrategy,
+	doubleSigningVerifier vm.DoubleSigningVerifier,
+	receiptsHandler process.ReceiptsHandler,
 ) (process.BlockProcessor, error) {
 
 	argsHook := hooks.ArgBlockChainHook{
@@ -2116,7 +2354,7 @@ func newMetaBlockProcessor(
 		Marshalizer:      core.Marshalizer,
 		Uint64Converter:  core.Uint64ByteSliceConverter,
 	}
-	vmFactory, err := metachain.NewVMContainerFactory(argsHook, economics)
+	vmFactory, err := metachain.NewVMContainerFactory(argsHook, economics, doubleSigningVerifier)
 	if err != nil {
 		return nil, err
 	}
@@ -2177,6 +2415,7 @@ func newMetaBlockProcessor(
 		economics,
 		txTypeHandler,
 		gasHandler,
+		receiptsHandler,
 	)
 	if err != nil {
 		return nil, err
@@ -2225,6 +2464,7 @@ func newMetaBlockProcessor(
 		economics,
 		miniBlocksCompacter,
 		gasHandler,
+		txSelection,
 	)
 	if err != nil {
 		return nil, err
@@ -2248,7 +2488,11 @@ func newMetaBlockProcessor(
 		return nil, err
 	}
 
-	scDataGetter, err := smartContract.NewSCQueryService(vmContainer, economics.MaxGasLimitPerBlock())
+	argsQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer:      vmContainer,
+		GasLimitPerBlock: economics.MaxGasLimitPerBlock(),
+	}
+	scDataGetter, err := smartContract.NewSCQueryService(argsQueryService)
 	if err != nil {
 		return nil, err
 	}
@@ -2268,6 +2512,24 @@ func newMetaBlockProcessor(
 		return nil, err
 	}
 
+	governanceUpdaters, err := createGovernanceUpdaters(economics, nodesCoordinator)
+	if err != nil {
+		return nil, err
+	}
+
+	argsGovernance := scToProtocol.ArgGovernanceToProtocol{
+		ArgGovernanceApplier: scToProtocol.ArgGovernanceApplier{
+			Marshalizer: core.Marshalizer,
+			Storer:      data.Store.GetStorer(dataRetriever.BootstrapUnit),
+			Updaters:    governanceUpdaters,
+		},
+		ScQuery: scDataGetter,
+	}
+	governanceToProtocol, err := scToProtocol.NewGovernanceToProtocol(argsGovernance)
+	if err != nil {
+		return nil, err
+	}
+
 	argumentsBaseProcessor := block.ArgBaseProcessor{
 		Accounts:                     state.AccountsAdapter,
 		ForkDetector:                 forkDetector,
@@ -2286,13 +2548,15 @@ func newMetaBlockProcessor(
 		ValidatorStatisticsProcessor: validatorStatisticsProcessor,
 		Rounder:                      rounder,
 		BootStorer:                   bootStorer,
+		ReceiptsHandler:              receiptsHandler,
 	}
 	arguments := block.ArgMetaProcessor{
-		ArgBaseProcessor:   argumentsBaseProcessor,
-		DataPool:           data.MetaDatapool,
-		SCDataGetter:       scDataGetter,
-		SCToProtocol:       smartContractToProtocol,
-		PeerChangesHandler: smartContractToProtocol,
+		ArgBaseProcessor:     argumentsBaseProcessor,
+		DataPool:             data.MetaDatapool,
+		SCDataGetter:         scDataGetter,
+		SCToProtocol:         smartContractToProtocol,
+		GovernanceToProtocol: governanceToProtocol,
+		PeerChangesHandler:   smartContractToProtocol,
 	}
 
 	metaProcessor, err := block.NewMetaProcessor(arguments)
@@ -2350,11 +2614,12 @@ func getCacherFromConfig(cfg config.CacheConfig) storageUnit.CacheConfig {
 
 func getDBFromConfig(cfg config.DBConfig, uniquePath string) storageUnit.DBConfig {
 	return storageUnit.DBConfig{
-		FilePath:          filepath.Join(uniquePath, cfg.FilePath),
-		Type:              storageUnit.DBType(cfg.Type),
-		MaxBatchSize:      cfg.MaxBatchSize,
-		BatchDelaySeconds: cfg.BatchDelaySeconds,
-		MaxOpenFiles:      cfg.MaxOpenFiles,
+		FilePath:             filepath.Join(uniquePath, cfg.FilePath),
+		Type:                 storageUnit.DBType(cfg.Type),
+		MaxBatchSize:         cfg.MaxBatchSize,
+		BatchDelaySeconds:    cfg.BatchDelaySeconds,
+		MaxOpenFiles:         cfg.MaxOpenFiles,
+		CompressionThreshold: cfg.CompressionThreshold,
 	}
 }
 
@@ -2368,8 +2633,9 @@ func getBloomFromConfig(cfg config.BloomFilterConfig) storageUnit.BloomConfig {
 	}
 
 	return storageUnit.BloomConfig{
-		Size:     cfg.Size,
-		HashFunc: hashFuncs,
+		Size:                   cfg.Size,
+		HashFunc:               hashFuncs,
+		PersistIntervalSeconds: cfg.PersistIntervalSeconds,
 	}
 }
 
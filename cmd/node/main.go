@@ -27,6 +27,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	factoryState "github.com/ElrondNetwork/elrond-go/data/state/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/display"
@@ -38,13 +39,17 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	"github.com/google/gops/agent"
 	"github.com/urfave/cli"
 )
@@ -748,17 +753,28 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	}
 
 	log.Trace("creating api resolver structure")
+	doubleSigningVerifier, err := systemSmartContracts.NewDoubleSigningVerifier(
+		cryptoComponents.BlockSignKeyGen,
+		cryptoComponents.SingleSigner,
+		coreComponents.Marshalizer,
+	)
+	if err != nil {
+		return err
+	}
+
 	apiResolver, err := createApiResolver(
-		stateComponents.AccountsAdapter,
+		coreComponents.Trie,
 		stateComponents.AddressConverter,
 		dataComponents.Store,
 		dataComponents.Blkc,
+		coreComponents.Hasher,
 		coreComponents.Marshalizer,
 		coreComponents.Uint64ByteSliceConverter,
 		shardCoordinator,
 		statusHandlersInfo.StatusMetrics,
 		gasSchedule,
 		economicsData,
+		doubleSigningVerifier,
 	)
 	if err != nil {
 		return err
@@ -781,6 +797,16 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	err = metrics.StartStorageStatisticsPolling(
+		coreComponents.StatusHandler,
+		generalConfig.GeneralSettings.StatusPollingIntervalSec,
+		dataComponents.Store,
+		shardCoordinator,
+	)
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating elrond node facade")
 	restAPIServerDebugMode := ctx.GlobalBool(restApiDebug.Name)
 	ef := facade.NewElrondNodeFacade(currentNode, apiResolver, restAPIServerDebugMode)
@@ -807,12 +833,24 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	log.Debug("restoring the pending transactions")
+	err = processComponents.TxPoolsPersister.LoadPools()
+	log.LogIfError(err)
+
 	log.Info("application is now running")
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 	<-sigs
 	log.Info("terminating at user's signal...")
 
+	log.Debug("saving the pending transactions")
+	err = processComponents.TxPoolsPersister.SavePools()
+	log.LogIfError(err)
+
+	log.Debug("closing the storage units")
+	err = dataComponents.Store.CloseAll()
+	log.LogIfError(err)
+
 	if rm != nil {
 		err = rm.Close()
 		log.LogIfError(err)
@@ -1043,6 +1081,39 @@ func getConsensusGroupSize(nodesConfig *sharding.NodesSetup, shardCoordinator sh
 	return 0, state.ErrUnknownShardId
 }
 
+// createTxValidator creates the validator used by the node to check the transactions submitted through the API,
+// over the transactions pool of the node's shard
+func createTxValidator(
+	config *config.Config,
+	shardCoordinator sharding.Coordinator,
+	core *factory.Core,
+	state *factory.State,
+	data *factory.Data,
+	crypto *factory.Crypto,
+) (process.TxValidator, error) {
+	var txPool dataRetriever.ShardedDataCacherNotifier
+	if shardCoordinator.SelfId() == sharding.MetachainShardId {
+		txPool = data.MetaDatapool.Transactions()
+	} else {
+		txPool = data.Datapool.Transactions()
+	}
+
+	signatureSetHandler, err := transaction.NewSignatureSetHandler(core.Marshalizer, crypto.TxSignKeyGen, crypto.TxSingleSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataValidators.NewTxValidator(
+		state.AccountsAdapter,
+		shardCoordinator,
+		txPool,
+		factory.MaxTxNonceDeltaAllowed,
+		[]byte(config.GeneralSettings.NetworkID),
+		config.GeneralSettings.MinTransactionVersion,
+		signatureSetHandler,
+	)
+}
+
 func createNode(
 	config *config.Config,
 	preferencesConfig *config.ConfigPreferences,
@@ -1070,6 +1141,11 @@ func createNode(
 		return nil, err
 	}
 
+	txValidator, err := createTxValidator(config, shardCoordinator, core, state, data, crypto)
+	if err != nil {
+		return nil, err
+	}
+
 	nd, err := node.NewNode(
 		node.WithMessenger(network.NetMessenger),
 		node.WithHasher(core.Hasher),
@@ -1109,6 +1185,10 @@ func createNode(
 		node.WithBlackListHandler(process.BlackListHandler),
 		node.WithBootStorer(process.BootStorer),
 		node.WithRequestedItemsHandler(requestedItemsHandler),
+		node.WithReceiptsHandler(process.ReceiptsHandler),
+		node.WithChainID([]byte(config.GeneralSettings.NetworkID)),
+		node.WithMinTransactionVersion(config.GeneralSettings.MinTransactionVersion),
+		node.WithTxValidator(txValidator),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
@@ -1208,22 +1288,40 @@ func startStatisticsMonitor(file *os.File, config config.ResourceStatsConfig, lo
 }
 
 func createApiResolver(
-	accnts state.AccountsAdapter,
+	accountsTrie data.Trie,
 	addrConv state.AddressConverter,
 	storageService dataRetriever.StorageService,
 	blockChain data.ChainHandler,
+	hasher hashing.Hasher,
 	marshalizer marshal.Marshalizer,
 	uint64Converter typeConverters.Uint64ByteSliceConverter,
 	shardCoordinator sharding.Coordinator,
 	statusMetrics external.StatusMetricsHandler,
 	gasSchedule map[string]map[string]uint64,
 	economics *economics.EconomicsData,
+	doubleSigningVerifier vm.DoubleSigningVerifier,
 ) (facade.ApiResolver, error) {
 	var vmFactory process.VirtualMachinesContainerFactory
 	var err error
 
+	// the queries get their own accounts adapter as its trie is recreated at the requested block before each query
+	queryTrie, err := accountsTrie.Recreate(make([]byte, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	accountFactory, err := factoryState.NewAccountFactoryCreator(factoryState.UserAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	queryAccounts, err := state.NewAccountsDB(queryTrie, hasher, marshalizer, accountFactory)
+	if err != nil {
+		return nil, err
+	}
+
 	argsHook := hooks.ArgBlockChainHook{
-		Accounts:         accnts,
+		Accounts:         queryAccounts,
 		AddrConv:         addrConv,
 		StorageService:   storageService,
 		BlockChain:       blockChain,
@@ -1233,7 +1331,7 @@ func createApiResolver(
 	}
 
 	if shardCoordinator.SelfId() == sharding.MetachainShardId {
-		vmFactory, err = metachain.NewVMContainerFactory(argsHook, economics)
+		vmFactory, err = metachain.NewVMContainerFactory(argsHook, economics, doubleSigningVerifier)
 		if err != nil {
 			return nil, err
 		}
@@ -1249,7 +1347,17 @@ func createApiResolver(
 		return nil, err
 	}
 
-	scQueryService, err := smartContract.NewSCQueryService(vmContainer, economics.MaxGasLimitPerBlock())
+	argsQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer:      vmContainer,
+		GasLimitPerBlock: economics.MaxGasLimitPerBlock(),
+		Accounts:         queryAccounts,
+		BlockChain:       blockChain,
+		StorageService:   storageService,
+		Marshalizer:      marshalizer,
+		Uint64Converter:  uint64Converter,
+		ShardCoordinator: shardCoordinator,
+	}
+	scQueryService, err := smartContract.NewSCQueryService(argsQueryService)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/appStatusPolling"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+// unitMetricsProvider defines the storers able to report their cache, bloom filter and persister counters
+type unitMetricsProvider interface {
+	Metrics() storageUnit.UnitMetrics
+}
+
+var monitoredStorageUnits = map[dataRetriever.UnitType]string{
+	dataRetriever.TransactionUnit:          "transactions",
+	dataRetriever.MiniBlockUnit:            "miniblocks",
+	dataRetriever.PeerChangesUnit:          "peer_changes",
+	dataRetriever.BlockHeaderUnit:          "block_headers",
+	dataRetriever.MetaBlockUnit:            "meta_blocks",
+	dataRetriever.MetaShardDataUnit:        "meta_shard_data",
+	dataRetriever.MetaPeerDataUnit:         "meta_peer_data",
+	dataRetriever.UnsignedTransactionUnit:  "unsigned_transactions",
+	dataRetriever.RewardTransactionUnit:    "reward_transactions",
+	dataRetriever.MetaHdrNonceHashDataUnit: "meta_hdr_nonce_hash",
+	dataRetriever.HeartbeatUnit:            "heartbeat",
+	dataRetriever.BootstrapUnit:            "bootstrap",
+	dataRetriever.StatusMetricsUnit:        "status_metrics",
+}
+
+// StartStorageStatisticsPolling will periodically publish the hit/miss and latency metrics of every storage unit
+func StartStorageStatisticsPolling(
+	ash core.AppStatusHandler,
+	pollingInterval int,
+	store dataRetriever.StorageService,
+	shardCoordinator sharding.Coordinator,
+) error {
+	if ash == nil {
+		return errors.New("nil AppStatusHandler")
+	}
+	if store == nil || store.IsInterfaceNil() {
+		return errors.New("nil storage service")
+	}
+	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
+		return errors.New("nil shard coordinator")
+	}
+
+	appStatusPollingHandler, err := appStatusPolling.NewAppStatusPolling(ash, pollingInterval)
+	if err != nil {
+		return errors.New("cannot init AppStatusPolling")
+	}
+
+	units := make(map[string]unitMetricsProvider)
+	for unitType, name := range monitoredStorageUnits {
+		addUnitMetricsProvider(units, store, unitType, name)
+	}
+	for i := uint32(0); i < shardCoordinator.NumberOfShards(); i++ {
+		unitType := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(i)
+		addUnitMetricsProvider(units, store, unitType, fmt.Sprintf("shard_hdr_nonce_hash_%d", i))
+	}
+
+	err = appStatusPollingHandler.RegisterPollingFunc(func(appStatusHandler core.AppStatusHandler) {
+		publishStorageMetrics(appStatusHandler, units)
+	})
+	if err != nil {
+		return errors.New("cannot register handler func for storage statistics")
+	}
+
+	appStatusPollingHandler.Poll()
+
+	return nil
+}
+
+func addUnitMetricsProvider(
+	units map[string]unitMetricsProvider,
+	store dataRetriever.StorageService,
+	unitType dataRetriever.UnitType,
+	name string,
+) {
+	storer := store.GetStorer(unitType)
+	if storer == nil || storer.IsInterfaceNil() {
+		return
+	}
+
+	provider, ok := storer.(unitMetricsProvider)
+	if !ok {
+		return
+	}
+
+	units[name] = provider
+}
+
+func publishStorageMetrics(appStatusHandler core.AppStatusHandler, units map[string]unitMetricsProvider) {
+	totalCacheHits := uint64(0)
+	totalCacheMisses := uint64(0)
+
+	for name, provider := range units {
+		unitMetrics := provider.Metrics()
+		prefix := core.MetricStoragePrefix + name
+
+		appStatusHandler.SetUInt64Value(prefix+core.MetricStorageCacheHitsSuffix, unitMetrics.CacheHits)
+		appStatusHandler.SetUInt64Value(prefix+core.MetricStorageCacheMissesSuffix, unitMetrics.CacheMisses)
+		appStatusHandler.SetUInt64Value(prefix+core.MetricStorageBloomNegativesSuffix, unitMetrics.BloomNegatives)
+		appStatusHandler.SetUInt64Value(prefix+core.MetricStorageBloomFalsePositivesSuffix, unitMetrics.BloomFalsePositives)
+		appStatusHandler.SetUInt64Value(prefix+core.MetricStoragePersisterReadsSuffix, unitMetrics.PersisterReads)
+		appStatusHandler.SetUInt64Value(prefix+core.MetricStoragePersisterWritesSuffix, unitMetrics.PersisterWrites)
+		appStatusHandler.SetUInt64Value(
+			prefix+core.MetricStoragePersisterReadLatencySuffix,
+			uint64(unitMetrics.AverageReadLatency()/time.Microsecond),
+		)
+		appStatusHandler.SetUInt64Value(
+			prefix+core.MetricStoragePersisterWriteLatencySuffix,
+			uint64(unitMetrics.AverageWriteLatency()/time.Microsecond),
+		)
+
+		totalCacheHits += unitMetrics.CacheHits
+		totalCacheMisses += unitMetrics.CacheMisses
+	}
+
+	appStatusHandler.SetUInt64Value(core.MetricStorageCacheHits, totalCacheHits)
+	appStatusHandler.SetUInt64Value(core.MetricStorageCacheMisses, totalCacheMisses)
+}
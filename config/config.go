@@ -7,19 +7,34 @@ type CacheConfig struct {
 	Shards uint32 `json:"shards"`
 }
 
+// TxPoolConfig will map the transactions pool configuration
+type TxPoolConfig struct {
+	Size                       uint32 `json:"size"`
+	MaxTxsPerSender            uint32 `json:"maxTxsPerSender"`
+	MinGasPriceIncreasePercent uint32 `json:"minGasPriceIncreasePercent"`
+}
+
+// TxSelectionConfig will map the configuration of the strategy used to select the transactions of a miniblock
+type TxSelectionConfig struct {
+	Strategy           string `json:"strategy"`
+	ReservedGasPercent uint32 `json:"reservedGasPercent"`
+}
+
 // DBConfig will map the json db configuration
 type DBConfig struct {
-	FilePath          string `json:"file"`
-	Type              string `json:"type"`
-	BatchDelaySeconds int    `json:"batchDelaySeconds"`
-	MaxBatchSize      int    `json:"maxBatchSize"`
-	MaxOpenFiles      int    `json:"maxOpenFiles"`
+	FilePath             string `json:"file"`
+	Type                 string `json:"type"`
+	BatchDelaySeconds    int    `json:"batchDelaySeconds"`
+	MaxBatchSize         int    `json:"maxBatchSize"`
+	MaxOpenFiles         int    `json:"maxOpenFiles"`
+	CompressionThreshold int    `json:"compressionThreshold"`
 }
 
 // BloomFilterConfig will map the json bloom filter configuration
 type BloomFilterConfig struct {
-	Size     uint     `json:"size"`
-	HashFunc []string `json:"hashFunc"`
+	Size                   uint     `json:"size"`
+	HashFunc               []string `json:"hashFunc"`
+	PersistIntervalSeconds int      `json:"persistIntervalSeconds"`
 }
 
 // StorageConfig will map the json storage unit configuration
@@ -65,6 +80,8 @@ type Config struct {
 	ShardHdrNonceHashStorage   StorageConfig
 	MetaHdrNonceHashStorage    StorageConfig
 	StatusMetricsStorage       StorageConfig
+	TxPoolStorage              StorageConfig
+	ReceiptsStorage            StorageConfig
 
 	ShardDataStorage StorageConfig
 	BootstrapStorage StorageConfig
@@ -80,7 +97,7 @@ type Config struct {
 	PeerBlockBodyDataPool       CacheConfig
 	BlockHeaderDataPool         CacheConfig
 	BlockHeaderNoncesDataPool   CacheConfig
-	TxDataPool                  CacheConfig
+	TxDataPool                  TxPoolConfig
 	UnsignedTransactionDataPool CacheConfig
 	RewardTransactionDataPool   CacheConfig
 	MetaBlockBodyDataPool       CacheConfig
@@ -89,6 +106,8 @@ type Config struct {
 	ShardHeadersDataPool          CacheConfig
 	MetaHeaderNoncesDataPool      CacheConfig
 
+	TxSelection TxSelectionConfig
+
 	Logger         LoggerConfig
 	Address        AddressConfig
 	Hasher         TypeConfig
@@ -144,6 +163,7 @@ type HeartbeatConfig struct {
 type GeneralSettingsConfig struct {
 	DestinationShardAsObserver string
 	NetworkID                  string
+	MinTransactionVersion      uint32
 	StatusPollingIntervalSec   int
 }
 
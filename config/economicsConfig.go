@@ -12,6 +12,7 @@ type RewardsSettings struct {
 	CommunityPercentage            float64
 	LeaderPercentage               float64
 	BurnPercentage                 float64
+	DeveloperPercentage            float64
 	DenominationCoefficientForView string
 }
 
@@ -28,10 +29,23 @@ type ValidatorSettings struct {
 	UnBoundPeriod string
 }
 
+// ESDTSettings will hold the fungible token settings
+type ESDTSettings struct {
+	BaseIssuingCost string
+}
+
+// GovernanceSettings will hold the on-chain governance settings
+type GovernanceSettings struct {
+	VotingPeriod string
+	MinQuorum    string
+}
+
 // ConfigEconomics will hold economics config
 type ConfigEconomics struct {
 	EconomicsAddresses EconomicsAddresses
 	RewardsSettings    RewardsSettings
 	FeeSettings        FeeSettings
 	ValidatorSettings  ValidatorSettings
+	ESDTSettings       ESDTSettings
+	GovernanceSettings GovernanceSettings
 }
@@ -23,6 +23,7 @@ type commonMessenger struct {
 
 // BroadcastConsensusMessage will send on consensus topic the consensus message
 func (cm *commonMessenger) BroadcastConsensusMessage(message *consensus.Message) error {
+	message.ShardId = cm.shardCoordinator.SelfId()
 	signature, err := cm.signMessage(message)
 	if err != nil {
 		return err
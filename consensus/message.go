@@ -12,6 +12,7 @@ type Message struct {
 	MsgType         int
 	TimeStamp       uint64
 	RoundIndex      int64
+	ShardId         uint32
 }
 
 // NewConsensusMessage creates a new Message object
@@ -35,3 +36,10 @@ func NewConsensusMessage(
 		RoundIndex:      roundIndex,
 	}
 }
+
+// DoubleSigningEvidence holds two messages signed by the same validator, in the same round and for the same
+// message type, but for different block headers
+type DoubleSigningEvidence struct {
+	FirstMessage  *Message
+	SecondMessage *Message
+}
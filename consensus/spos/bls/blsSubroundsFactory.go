@@ -136,6 +136,7 @@ func (fct *factory) generateStartRoundSubround() error {
 		processingThresholdPercent,
 		getSubroundName,
 		fct.worker.ExecuteStoredMessages,
+		fct.initConsensusThreshold,
 	)
 	if err != nil {
 		return err
@@ -156,6 +156,7 @@ func (fct *factory) generateStartRoundSubround() error {
 		processingThresholdPercent,
 		getSubroundName,
 		fct.worker.ExecuteStoredMessages,
+		fct.initConsensusThreshold,
 	)
 
 	if err != nil {
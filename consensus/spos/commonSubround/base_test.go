@@ -115,3 +115,7 @@ func getSubroundName(subroundId int) string {
 // executeStoredMessages tries to execute all the messages received which are valid for execution
 func executeStoredMessages() {
 }
+
+// initConsensusThreshold sets the thresholds for the current consensus group size
+func initConsensusThreshold() {
+}
@@ -20,6 +20,7 @@ type SubroundStartRound struct {
 	processingThresholdPercentage int
 	getSubroundName               func(subroundId int) string
 	executeStoredMessages         func()
+	initConsensusThreshold        func()
 
 	appStatusHandler core.AppStatusHandler
 	indexer          indexer.Indexer
@@ -32,6 +33,7 @@ func NewSubroundStartRound(
 	processingThresholdPercentage int,
 	getSubroundName func(subroundId int) string,
 	executeStoredMessages func(),
+	initConsensusThreshold func(),
 ) (*SubroundStartRound, error) {
 	err := checkNewSubroundStartRoundParams(
 		baseSubround,
@@ -45,6 +47,7 @@ func NewSubroundStartRound(
 		processingThresholdPercentage,
 		getSubroundName,
 		executeStoredMessages,
+		initConsensusThreshold,
 		statusHandler.NewNilStatusHandler(),
 		indexer.NewNilIndexer(),
 	}
@@ -254,6 +257,12 @@ func (sr *SubroundStartRound) generateNextConsensusGroup(roundIndex int64) error
 
 	sr.SetConsensusGroup(nextConsensusGroup)
 
+	// the consensus group size can be changed by governance, so the thresholds follow the size of the formed group
+	if len(nextConsensusGroup) != sr.ConsensusGroupSize() {
+		sr.SetConsensusGroupSize(len(nextConsensusGroup))
+		sr.initConsensusThreshold()
+	}
+
 	sr.BlockProcessor().SetConsensusData(randomSeed, uint64(sr.RoundIndex), currentHeader.GetEpoch(), shardId)
 
 	return nil
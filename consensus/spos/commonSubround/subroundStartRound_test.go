@@ -2,6 +2,7 @@ package commonSubround_test
 
 import (
 	"errors"
+	"math/big"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ func defaultSubroundStartRoundFromSubround(sr *spos.Subround) (*commonSubround.S
 		processingThresholdPercent,
 		getSubroundName,
 		executeStoredMessages,
+		initConsensusThreshold,
 	)
 
 	return startRound, err
@@ -54,6 +56,7 @@ func initSubroundStartRoundWithContainer(container spos.ConsensusCoreHandler) *c
 		processingThresholdPercent,
 		getSubroundName,
 		executeStoredMessages,
+		initConsensusThreshold,
 	)
 
 	return srStartRound
@@ -73,6 +76,7 @@ func TestSubroundStartRound_NewSubroundStartRoundNilSubroundShouldFail(t *testin
 		processingThresholdPercent,
 		getSubroundName,
 		executeStoredMessages,
+		initConsensusThreshold,
 	)
 
 	assert.Nil(t, srStartRound)
@@ -425,3 +429,46 @@ func TestSubroundStartRound_GenerateNextConsensusGroupShouldReturnErr(t *testing
 
 	assert.Equal(t, err, err2)
 }
+
+func TestSubroundStartRound_GenerateNextConsensusGroupShouldUpdateTheConsensusGroupSize(t *testing.T) {
+	t.Parallel()
+
+	validatorGroupSelector := &mock.NodesCoordinatorMock{}
+	validatorGroupSelector.ComputeValidatorsGroupCalled = func(
+		bytes []byte,
+		round uint64,
+		shardId uint32,
+	) ([]sharding.Validator, error) {
+		return []sharding.Validator{
+			mock.NewValidatorMock(big.NewInt(0), 0, []byte("A"), []byte("A")),
+			mock.NewValidatorMock(big.NewInt(0), 0, []byte("B"), []byte("B")),
+			mock.NewValidatorMock(big.NewInt(0), 0, []byte("C"), []byte("C")),
+		}, nil
+	}
+	container := mock.InitConsensusCore()
+	container.SetValidatorGroupSelector(validatorGroupSelector)
+
+	consensusState := initConsensusState()
+	ch := make(chan bool, 1)
+	sr, _ := defaultSubround(consensusState, ch, container)
+	thresholdInitialized := 0
+	srStartRound, _ := commonSubround.NewSubroundStartRound(
+		sr,
+		extend,
+		processingThresholdPercent,
+		getSubroundName,
+		executeStoredMessages,
+		func() {
+			thresholdInitialized++
+		},
+	)
+
+	err := srStartRound.GenerateNextConsensusGroup(0)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, consensusState.ConsensusGroupSize())
+	assert.Equal(t, 1, thresholdInitialized)
+
+	err = srStartRound.GenerateNextConsensusGroup(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, thresholdInitialized)
+}
@@ -150,3 +150,6 @@ var ErrNilExecuteStoredMessages = errors.New("executeStoredMessages is nil")
 
 // ErrNilAppStatusHandler defines the error for setting a nil AppStatusHandler
 var ErrNilAppStatusHandler = errors.New("nil AppStatusHandler")
+
+// ErrInvalidShardId is raised when a consensus message was signed for the consensus of another shard
+var ErrInvalidShardId = errors.New("shard id is invalid")
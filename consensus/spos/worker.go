@@ -1,6 +1,7 @@
 package spos
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"sync"
@@ -45,6 +46,10 @@ type Worker struct {
 
 	mapHashConsensusMessage map[string][]*consensus.Message
 	mutHashConsensusMessage sync.RWMutex
+
+	signedMessages       map[int64]map[string]*consensus.Message
+	doubleSigningHandler func(evidence *consensus.DoubleSigningEvidence)
+	mutSignedMessages    sync.Mutex
 }
 
 // NewWorker creates a new Worker object
@@ -107,6 +112,7 @@ func NewWorker(
 	go wrk.checkChannels()
 
 	wrk.mapHashConsensusMessage = make(map[string][]*consensus.Message)
+	wrk.signedMessages = make(map[int64]map[string]*consensus.Message)
 
 	return &wrk, nil
 }
@@ -190,6 +196,14 @@ func (wrk *Worker) AddReceivedMessageCall(messageType consensus.MessageType, rec
 	wrk.mutReceivedMessagesCalls.Unlock()
 }
 
+// SetDoubleSigningHandler sets the function which will be called with the evidence each time a validator is
+// detected signing two different block headers in the same round
+func (wrk *Worker) SetDoubleSigningHandler(handler func(evidence *consensus.DoubleSigningEvidence)) {
+	wrk.mutSignedMessages.Lock()
+	wrk.doubleSigningHandler = handler
+	wrk.mutSignedMessages.Unlock()
+}
+
 // RemoveAllReceivedMessagesCalls removes all the functions handlers
 func (wrk *Worker) RemoveAllReceivedMessagesCalls() {
 	wrk.mutReceivedMessagesCalls.Lock()
@@ -240,6 +254,10 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, _ func(buffToS
 		"round", cnsDta.RoundIndex,
 	)
 
+	if cnsDta.ShardId != wrk.shardCoordinator.SelfId() {
+		return ErrInvalidShardId
+	}
+
 	senderOK := wrk.consensusState.IsNodeInEligibleList(string(cnsDta.PubKey))
 	if !senderOK {
 		return ErrSenderNotOk
@@ -261,6 +279,10 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, _ func(buffToS
 		return ErrInvalidSignature
 	}
 
+	if wrk.consensusService.IsMessageWithBlockHeader(msgType) || wrk.consensusService.IsMessageWithSignature(msgType) {
+		wrk.checkDoubleSigning(cnsDta)
+	}
+
 	if wrk.consensusService.IsMessageWithBlockHeader(msgType) {
 		headerHash := cnsDta.BlockHeaderHash
 		header := wrk.blockProcessor.DecodeBlockHeader(cnsDta.SubRoundData)
@@ -347,6 +369,57 @@ func (wrk *Worker) checkSignature(cnsDta *consensus.Message) error {
 	return err
 }
 
+// checkDoubleSigning remembers the first signed message of each validator for every round and message type, and
+// reports the evidence when a second message from the same validator refers to a different block header. Messages
+// for rounds more than one round ahead are not remembered, so they can not fill the memory
+func (wrk *Worker) checkDoubleSigning(cnsDta *consensus.Message) {
+	if cnsDta.RoundIndex > wrk.consensusState.RoundIndex+1 {
+		return
+	}
+
+	wrk.mutSignedMessages.Lock()
+	for round := range wrk.signedMessages {
+		if round < wrk.consensusState.RoundIndex {
+			delete(wrk.signedMessages, round)
+		}
+	}
+
+	roundMessages, ok := wrk.signedMessages[cnsDta.RoundIndex]
+	if !ok {
+		roundMessages = make(map[string]*consensus.Message)
+		wrk.signedMessages[cnsDta.RoundIndex] = roundMessages
+	}
+
+	key := fmt.Sprintf("%d_%s", cnsDta.MsgType, cnsDta.PubKey)
+	firstMessage, ok := roundMessages[key]
+	if !ok {
+		roundMessages[key] = cnsDta
+		wrk.mutSignedMessages.Unlock()
+		return
+	}
+	handler := wrk.doubleSigningHandler
+	wrk.mutSignedMessages.Unlock()
+
+	if bytes.Equal(firstMessage.BlockHeaderHash, cnsDta.BlockHeaderHash) {
+		return
+	}
+
+	log.Warn("double signing detected",
+		"msg type", wrk.consensusService.GetStringValue(consensus.MessageType(cnsDta.MsgType)),
+		"from", core.GetTrimmedPk(hex.EncodeToString(cnsDta.PubKey)),
+		"round", cnsDta.RoundIndex,
+		"first header hash", firstMessage.BlockHeaderHash,
+		"second header hash", cnsDta.BlockHeaderHash,
+	)
+
+	if handler != nil {
+		handler(&consensus.DoubleSigningEvidence{
+			FirstMessage:  firstMessage,
+			SecondMessage: cnsDta,
+		})
+	}
+}
+
 func (wrk *Worker) executeReceivedMessages(cnsDta *consensus.Message) {
 	wrk.mutReceivedMessages.Lock()
 
@@ -876,6 +876,133 @@ func TestWorker_ProcessReceivedMessageOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWorker_ProcessReceivedMessageSameHeaderTwiceShouldNotReportDoubleSigning(t *testing.T) {
+	t.Parallel()
+
+	wrk := initWorker()
+	reported := false
+	wrk.SetDoubleSigningHandler(func(evidence *consensus.DoubleSigningEvidence) {
+		reported = true
+	})
+
+	pubKey := []byte(wrk.ConsensusState().ConsensusGroup()[0])
+	for i := 0; i < 2; i++ {
+		cnsMsg := consensus.NewConsensusMessage([]byte("header hash"), nil, pubKey, []byte("sig"), int(bn.MtSignature), 0, 0)
+		buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+		err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, nil)
+		assert.Nil(t, err)
+	}
+
+	assert.False(t, reported)
+}
+
+func TestWorker_ProcessReceivedMessageDifferentHeadersSameRoundShouldReportDoubleSigning(t *testing.T) {
+	t.Parallel()
+
+	wrk := initWorker()
+	var evidence *consensus.DoubleSigningEvidence
+	wrk.SetDoubleSigningHandler(func(ev *consensus.DoubleSigningEvidence) {
+		evidence = ev
+	})
+
+	pubKey := []byte(wrk.ConsensusState().ConsensusGroup()[0])
+	first := consensus.NewConsensusMessage([]byte("first hash"), nil, pubKey, []byte("sig1"), int(bn.MtSignature), 0, 0)
+	buff, _ := wrk.Marshalizer().Marshal(first)
+	_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, nil)
+
+	second := consensus.NewConsensusMessage([]byte("second hash"), nil, pubKey, []byte("sig2"), int(bn.MtSignature), 0, 0)
+	buff, _ = wrk.Marshalizer().Marshal(second)
+	_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, nil)
+
+	assert.NotNil(t, evidence)
+	assert.Equal(t, first, evidence.FirstMessage)
+	assert.Equal(t, second, evidence.SecondMessage)
+}
+
+func TestWorker_ProcessReceivedMessageDifferentHeadersFromDifferentSignersShouldNotReportDoubleSigning(t *testing.T) {
+	t.Parallel()
+
+	wrk := initWorker()
+	reported := false
+	wrk.SetDoubleSigningHandler(func(evidence *consensus.DoubleSigningEvidence) {
+		reported = true
+	})
+
+	consensusGroup := wrk.ConsensusState().ConsensusGroup()
+	first := consensus.NewConsensusMessage([]byte("first hash"), nil, []byte(consensusGroup[0]), []byte("sig"), int(bn.MtSignature), 0, 0)
+	buff, _ := wrk.Marshalizer().Marshal(first)
+	_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, nil)
+
+	second := consensus.NewConsensusMessage([]byte("second hash"), nil, []byte(consensusGroup[1]), []byte("sig"), int(bn.MtSignature), 0, 0)
+	buff, _ = wrk.Marshalizer().Marshal(second)
+	_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, nil)
+
+	assert.False(t, reported)
+}
+
+func TestWorker_ProcessReceivedMessageDifferentHeadersInDifferentRoundsShouldNotReportDoubleSigning(t *testing.T) {
+	t.Parallel()
+
+	wrk := initWorker()
+	reported := false
+	wrk.SetDoubleSigningHandler(func(evidence *consensus.DoubleSigningEvidence) {
+		reported = true
+	})
+
+	pubKey := []byte(wrk.ConsensusState().ConsensusGroup()[0])
+	first := consensus.NewConsensusMessage([]byte("first hash"), nil, pubKey, []byte("sig"), int(bn.MtSignature), 0, 0)
+	buff, _ := wrk.Marshalizer().Marshal(first)
+	_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, nil)
+
+	second := consensus.NewConsensusMessage([]byte("second hash"), nil, pubKey, []byte("sig"), int(bn.MtSignature), 0, 1)
+	buff, _ = wrk.Marshalizer().Marshal(second)
+	_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, nil)
+
+	assert.False(t, reported)
+}
+
+func TestWorker_ProcessReceivedMessageFarFutureRoundShouldNotReportDoubleSigning(t *testing.T) {
+	t.Parallel()
+
+	wrk := initWorker()
+	reported := false
+	wrk.SetDoubleSigningHandler(func(evidence *consensus.DoubleSigningEvidence) {
+		reported = true
+	})
+
+	futureRound := wrk.ConsensusState().RoundIndex + 2
+	pubKey := []byte(wrk.ConsensusState().ConsensusGroup()[0])
+	first := consensus.NewConsensusMessage([]byte("first hash"), nil, pubKey, []byte("sig1"), int(bn.MtSignature), 0, futureRound)
+	buff, _ := wrk.Marshalizer().Marshal(first)
+	_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, nil)
+
+	second := consensus.NewConsensusMessage([]byte("second hash"), nil, pubKey, []byte("sig2"), int(bn.MtSignature), 0, futureRound)
+	buff, _ = wrk.Marshalizer().Marshal(second)
+	_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, nil)
+
+	assert.False(t, reported)
+}
+
+func TestWorker_ProcessReceivedMessageWrongShardIdShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wrk := initWorker()
+	cnsMsg := consensus.NewConsensusMessage(
+		[]byte("header hash"),
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		[]byte("sig"),
+		int(bn.MtSignature),
+		0,
+		0,
+	)
+	cnsMsg.ShardId = 1
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+
+	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, nil)
+	assert.Equal(t, spos.ErrInvalidShardId, err)
+}
+
 func TestWorker_CheckSelfStateShouldErrMessageFromItself(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
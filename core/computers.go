@@ -1,5 +1,9 @@
 package core
 
+import (
+	"math/big"
+)
+
 // MaxInt32 returns the maximum of two given numbers
 func MaxInt32(a int32, b int32) int32 {
 	if a > b {
@@ -47,3 +51,16 @@ func MinUint64(a uint64, b uint64) uint64 {
 	}
 	return b
 }
+
+// GetPercentageOfValue returns the given percentage of the value, rounded down
+func GetPercentageOfValue(value *big.Int, percentage float64) *big.Int {
+	x := new(big.Float).SetInt(value)
+	y := big.NewFloat(percentage)
+
+	z := new(big.Float).Mul(x, y)
+
+	op := big.NewInt(0)
+	result, _ := z.Int(op)
+
+	return result
+}
@@ -1,6 +1,7 @@
 package core_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -78,3 +79,10 @@ func TestMinUint64ShouldReturnA(t *testing.T) {
 	b := uint64(11)
 	assert.Equal(t, a, core.MinUint64(a, b))
 }
+
+func TestGetPercentageOfValue(t *testing.T) {
+	assert.Equal(t, big.NewInt(0), core.GetPercentageOfValue(big.NewInt(100), 0))
+	assert.Equal(t, big.NewInt(10), core.GetPercentageOfValue(big.NewInt(100), 0.1))
+	assert.Equal(t, big.NewInt(3), core.GetPercentageOfValue(big.NewInt(7), 0.5))
+	assert.Equal(t, big.NewInt(100), core.GetPercentageOfValue(big.NewInt(100), 1))
+}
@@ -26,6 +26,68 @@ const ConsensusTopic = "consensus"
 // GenesisBlockNonce is the nonce of the genesis block
 const GenesisBlockNonce = 0
 
+// RelayedTransaction is the data prefix of a relayed transaction, followed by @ and the hex encoded user transaction
+const RelayedTransaction = "relayedTx"
+
+// MultiSigRegistration is the data prefix of a transaction sent by an account to itself to become a multisignature
+// account, followed by the hex encoded threshold and the hex encoded public keys, separated by @
+const MultiSigRegistration = "multiSigRegister"
+
+// DepositNodeRewards is the function of the metachain smart contracts owning nodes through which the shards pay them
+// the rewards of a node, followed by @ and the hex encoded public key of the node
+const DepositNodeRewards = "depositRewards"
+
+// BuiltInFunctionESDTTransfer is the name of the protocol function which moves fungible tokens between accounts,
+// followed by the hex encoded token name and the hex encoded value, separated by @
+const BuiltInFunctionESDTTransfer = "ESDTTransfer"
+
+// BuiltInFunctionESDTFreeze is the name of the protocol function through which the token owner freezes the tokens of
+// an account, followed by @ and the hex encoded token name
+const BuiltInFunctionESDTFreeze = "ESDTFreeze"
+
+// BuiltInFunctionESDTUnFreeze is the name of the protocol function through which the token owner unfreezes the
+// tokens of an account, followed by @ and the hex encoded token name
+const BuiltInFunctionESDTUnFreeze = "ESDTUnFreeze"
+
+// BuiltInFunctionClaimDeveloperRewards is the name of the protocol function through which the owner of a smart contract
+// claims the share of the call fees accumulated by the contract
+const BuiltInFunctionClaimDeveloperRewards = "ClaimDeveloperRewards"
+
+// BuiltInFunctionChangeOwnerAddress is the name of the protocol function through which the owner of a smart contract
+// hands it over to another address, followed by @ and the hex encoded address of the new owner
+const BuiltInFunctionChangeOwnerAddress = "ChangeOwnerAddress"
+
+// BuiltInFunctionUpgradeContract is the name of the protocol function through which the owner of an upgradable smart
+// contract replaces its code, followed by the hex encoded new code and the hex encoded arguments of its init function,
+// separated by @
+const BuiltInFunctionUpgradeContract = "UpgradeContract"
+
+// CodeMetadataLen is the number of bytes which can follow the VM type in the argument of a deploy transaction, holding
+// the properties of the deployed code
+const CodeMetadataLen = 1
+
+// CodeMetadataUpgradable is the code metadata flag of a smart contract whose code can be replaced by its owner
+const CodeMetadataUpgradable = byte(1)
+
+// ElrondProtectedKeyPrefix is the prefix of the account storage keys which can be written only by the protocol
+const ElrondProtectedKeyPrefix = "elrond"
+
+// ESDTKeyIdentifier is the identifier which follows the protected prefix in the storage keys of fungible tokens
+const ESDTKeyIdentifier = "esdt"
+
+// GovernanceEconomicsNamespace is the prefix of the names of the economics parameters which can be changed by governance
+const GovernanceEconomicsNamespace = "economics"
+
+// GovernanceConsensusNamespace is the prefix of the names of the consensus parameters which can be changed by governance
+const GovernanceConsensusNamespace = "consensus"
+
+// GovernanceGasScheduleNamespace is the prefix of the names of the gas costs which can be changed by governance. A gas
+// cost is named by its section and its name in the gas schedule, as in gasSchedule.BaseOperationCost.StorePerByte
+const GovernanceGasScheduleNamespace = "gasSchedule"
+
+// GovernanceNamespaceSeparator separates the namespace from the name of a parameter changed by governance
+const GovernanceNamespaceSeparator = "."
+
 // MetricCurrentRound is the metric for monitoring the current round of a node
 const MetricCurrentRound = "erd_current_round"
 
@@ -185,3 +247,40 @@ const MetricCommunityPercentage = "erd_metric_community_percentage"
 
 //MetricDenominationCoefficient is the metric for denomination coefficient that is used in views
 const MetricDenominationCoefficient = "erc_metric_denomination_coefficient"
+
+//MetricStoragePrefix is the prefix of all the metrics published for the storage units
+const MetricStoragePrefix = "erd_storage_"
+
+//MetricStorageCacheHits is the metric for the number of cache hits summed over all the storage units
+const MetricStorageCacheHits = "erd_storage_cache_hits"
+
+//MetricStorageCacheMisses is the metric for the number of cache misses summed over all the storage units
+const MetricStorageCacheMisses = "erd_storage_cache_misses"
+
+//MetricStorageCacheHitsSuffix is the suffix of the per storage unit cache hits metric
+const MetricStorageCacheHitsSuffix = "_cache_hits"
+
+//MetricStorageCacheMissesSuffix is the suffix of the per storage unit cache misses metric
+const MetricStorageCacheMissesSuffix = "_cache_misses"
+
+//MetricStorageBloomNegativesSuffix is the suffix of the per storage unit metric counting the lookups
+//stopped by the bloom filter
+const MetricStorageBloomNegativesSuffix = "_bloom_negatives"
+
+//MetricStorageBloomFalsePositivesSuffix is the suffix of the per storage unit metric counting the lookups
+//that passed the bloom filter but were not found in the persister
+const MetricStorageBloomFalsePositivesSuffix = "_bloom_false_positives"
+
+//MetricStoragePersisterReadsSuffix is the suffix of the per storage unit persister reads metric
+const MetricStoragePersisterReadsSuffix = "_persister_reads"
+
+//MetricStoragePersisterWritesSuffix is the suffix of the per storage unit persister writes metric
+const MetricStoragePersisterWritesSuffix = "_persister_writes"
+
+//MetricStoragePersisterReadLatencySuffix is the suffix of the per storage unit average persister read latency
+//metric, expressed in microseconds
+const MetricStoragePersisterReadLatencySuffix = "_persister_read_latency_us"
+
+//MetricStoragePersisterWriteLatencySuffix is the suffix of the per storage unit average persister write latency
+//metric, expressed in microseconds
+const MetricStoragePersisterWriteLatencySuffix = "_persister_write_latency_us"
@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
+	"github.com/ElrondNetwork/elrond-go/process/receipts"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	processTransaction "github.com/ElrondNetwork/elrond-go/process/transaction"
@@ -92,6 +93,7 @@ type ArgsMetaGenesisBlockCreator struct {
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
 	MetaDatapool             dataRetriever.MetaPoolsHolder
 	ValidatorStatsRootHash   []byte
+	DoubleSigningVerifier    vm.DoubleSigningVerifier
 }
 
 // CreateMetaGenesisBlock creates the meta genesis block
@@ -132,6 +134,9 @@ func CreateMetaGenesisBlock(
 	if args.MetaDatapool == nil || args.MetaDatapool.IsInterfaceNil() {
 		return nil, process.ErrNilMetaBlocksPool
 	}
+	if args.DoubleSigningVerifier == nil || args.DoubleSigningVerifier.IsInterfaceNil() {
+		return nil, process.ErrNilDoubleSigningVerifier
+	}
 
 	txProcessor, systemSmartContracts, err := createProcessorsForMetaGenesisBlock(args)
 	if err != nil {
@@ -193,7 +198,7 @@ func createProcessorsForMetaGenesisBlock(
 		Marshalizer:      args.Marshalizer,
 		Uint64Converter:  args.Uint64ByteSliceConverter,
 	}
-	virtualMachineFactory, err := metachain.NewVMContainerFactory(argsHook, args.Economics)
+	virtualMachineFactory, err := metachain.NewVMContainerFactory(argsHook, args.Economics, args.DoubleSigningVerifier)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -240,6 +245,15 @@ func createProcessorsForMetaGenesisBlock(
 		return nil, nil, err
 	}
 
+	receiptsHandler, err := receipts.NewReceiptsHandler(
+		args.Store.GetStorer(dataRetriever.ReceiptsUnit),
+		args.Marshalizer,
+		args.Hasher,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	scProcessor, err := smartContract.NewSmartContractProcessor(
 		vmContainer,
 		argsParser,
@@ -254,6 +268,7 @@ func createProcessorsForMetaGenesisBlock(
 		&metachain.TransactionFeeHandler{},
 		txTypeHandler,
 		gasHandler,
+		receiptsHandler,
 	)
 	if err != nil {
 		return nil, nil, err
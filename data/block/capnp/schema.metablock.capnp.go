@@ -270,6 +270,236 @@ func (s PeerDataCapn_List) ToArray() []PeerDataCapn {
 }
 func (s PeerDataCapn_List) Set(i int, item PeerDataCapn) { C.PointerList(s).Set(i, C.Object(item)) }
 
+type GovernanceDataCapn C.Struct
+
+func NewGovernanceDataCapn(s *C.Segment) GovernanceDataCapn {
+	return GovernanceDataCapn(s.NewStruct(16, 2))
+}
+func NewRootGovernanceDataCapn(s *C.Segment) GovernanceDataCapn {
+	return GovernanceDataCapn(s.NewRootStruct(16, 2))
+}
+func AutoNewGovernanceDataCapn(s *C.Segment) GovernanceDataCapn {
+	return GovernanceDataCapn(s.NewStructAR(16, 2))
+}
+func ReadRootGovernanceDataCapn(s *C.Segment) GovernanceDataCapn {
+	return GovernanceDataCapn(s.Root(0).ToStruct())
+}
+func (s GovernanceDataCapn) ProposalId() uint64          { return C.Struct(s).Get64(0) }
+func (s GovernanceDataCapn) SetProposalId(v uint64)      { C.Struct(s).Set64(0, v) }
+func (s GovernanceDataCapn) Parameter() string           { return C.Struct(s).GetObject(0).ToText() }
+func (s GovernanceDataCapn) SetParameter(v string)       { C.Struct(s).SetObject(0, s.Segment.NewText(v)) }
+func (s GovernanceDataCapn) Value() string               { return C.Struct(s).GetObject(1).ToText() }
+func (s GovernanceDataCapn) SetValue(v string)           { C.Struct(s).SetObject(1, s.Segment.NewText(v)) }
+func (s GovernanceDataCapn) ActivationNonce() uint64     { return C.Struct(s).Get64(8) }
+func (s GovernanceDataCapn) SetActivationNonce(v uint64) { C.Struct(s).Set64(8, v) }
+func (s GovernanceDataCapn) WriteJSON(w io.Writer) error {
+	b := bufio.NewWriter(w)
+	var err error
+	var buf []byte
+	_ = buf
+	err = b.WriteByte('{')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"proposalId\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.ProposalId()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"parameter\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.Parameter()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"value\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.Value()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"activationNonce\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.ActivationNonce()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte('}')
+	if err != nil {
+		return err
+	}
+	err = b.Flush()
+	return err
+}
+func (s GovernanceDataCapn) MarshalJSON() ([]byte, error) {
+	b := bytes.Buffer{}
+	err := s.WriteJSON(&b)
+	return b.Bytes(), err
+}
+func (s GovernanceDataCapn) WriteCapLit(w io.Writer) error {
+	b := bufio.NewWriter(w)
+	var err error
+	var buf []byte
+	_ = buf
+	err = b.WriteByte('(')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("proposalId = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.ProposalId()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("parameter = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.Parameter()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("value = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.Value()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("activationNonce = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.ActivationNonce()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(')')
+	if err != nil {
+		return err
+	}
+	err = b.Flush()
+	return err
+}
+func (s GovernanceDataCapn) MarshalCapLit() ([]byte, error) {
+	b := bytes.Buffer{}
+	err := s.WriteCapLit(&b)
+	return b.Bytes(), err
+}
+
+type GovernanceDataCapn_List C.PointerList
+
+func NewGovernanceDataCapnList(s *C.Segment, sz int) GovernanceDataCapn_List {
+	return GovernanceDataCapn_List(s.NewCompositeList(16, 2, sz))
+}
+func (s GovernanceDataCapn_List) Len() int { return C.PointerList(s).Len() }
+func (s GovernanceDataCapn_List) At(i int) GovernanceDataCapn {
+	return GovernanceDataCapn(C.PointerList(s).At(i).ToStruct())
+}
+func (s GovernanceDataCapn_List) ToArray() []GovernanceDataCapn {
+	n := s.Len()
+	a := make([]GovernanceDataCapn, n)
+	for i := 0; i < n; i++ {
+		a[i] = s.At(i)
+	}
+	return a
+}
+func (s GovernanceDataCapn_List) Set(i int, item GovernanceDataCapn) {
+	C.PointerList(s).Set(i, C.Object(item))
+}
+
 type ShardMiniBlockHeaderCapn C.Struct
 
 func NewShardMiniBlockHeaderCapn(s *C.Segment) ShardMiniBlockHeaderCapn {
@@ -994,9 +1224,9 @@ func (s ShardDataCapn_List) Set(i int, item ShardDataCapn) { C.PointerList(s).Se
 
 type MetaBlockCapn C.Struct
 
-func NewMetaBlockCapn(s *C.Segment) MetaBlockCapn      { return MetaBlockCapn(s.NewStruct(32, 11)) }
-func NewRootMetaBlockCapn(s *C.Segment) MetaBlockCapn  { return MetaBlockCapn(s.NewRootStruct(32, 11)) }
-func AutoNewMetaBlockCapn(s *C.Segment) MetaBlockCapn  { return MetaBlockCapn(s.NewStructAR(32, 11)) }
+func NewMetaBlockCapn(s *C.Segment) MetaBlockCapn      { return MetaBlockCapn(s.NewStruct(32, 12)) }
+func NewRootMetaBlockCapn(s *C.Segment) MetaBlockCapn  { return MetaBlockCapn(s.NewRootStruct(32, 12)) }
+func AutoNewMetaBlockCapn(s *C.Segment) MetaBlockCapn  { return MetaBlockCapn(s.NewStructAR(32, 12)) }
 func ReadRootMetaBlockCapn(s *C.Segment) MetaBlockCapn { return MetaBlockCapn(s.Root(0).ToStruct()) }
 func (s MetaBlockCapn) Nonce() uint64                  { return C.Struct(s).Get64(0) }
 func (s MetaBlockCapn) SetNonce(v uint64)              { C.Struct(s).Set64(0, v) }
@@ -1040,6 +1270,12 @@ func (s MetaBlockCapn) MiniBlockHeaders() MiniBlockHeaderCapn_List {
 func (s MetaBlockCapn) SetMiniBlockHeaders(v MiniBlockHeaderCapn_List) {
 	C.Struct(s).SetObject(10, C.Object(v))
 }
+func (s MetaBlockCapn) GovernanceChanges() GovernanceDataCapn_List {
+	return GovernanceDataCapn_List(C.Struct(s).GetObject(11))
+}
+func (s MetaBlockCapn) SetGovernanceChanges(v GovernanceDataCapn_List) {
+	C.Struct(s).SetObject(11, C.Object(v))
+}
 func (s MetaBlockCapn) WriteJSON(w io.Writer) error {
 	b := bufio.NewWriter(w)
 	var err error
@@ -1391,6 +1627,39 @@ func (s MetaBlockCapn) WriteJSON(w io.Writer) error {
 			return err
 		}
 	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"governanceChanges\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.GovernanceChanges()
+		{
+			err = b.WriteByte('[')
+			if err != nil {
+				return err
+			}
+			for i, s := range s.ToArray() {
+				if i != 0 {
+					_, err = b.WriteString(", ")
+				}
+				if err != nil {
+					return err
+				}
+				err = s.WriteJSON(b)
+				if err != nil {
+					return err
+				}
+			}
+			err = b.WriteByte(']')
+		}
+		if err != nil {
+			return err
+		}
+	}
 	err = b.WriteByte('}')
 	if err != nil {
 		return err
@@ -1754,6 +2023,39 @@ func (s MetaBlockCapn) WriteCapLit(w io.Writer) error {
 			return err
 		}
 	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("governanceChanges = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.GovernanceChanges()
+		{
+			err = b.WriteByte('[')
+			if err != nil {
+				return err
+			}
+			for i, s := range s.ToArray() {
+				if i != 0 {
+					_, err = b.WriteString(", ")
+				}
+				if err != nil {
+					return err
+				}
+				err = s.WriteCapLit(b)
+				if err != nil {
+					return err
+				}
+			}
+			err = b.WriteByte(']')
+		}
+		if err != nil {
+			return err
+		}
+	}
 	err = b.WriteByte(')')
 	if err != nil {
 		return err
@@ -1770,7 +2072,7 @@ func (s MetaBlockCapn) MarshalCapLit() ([]byte, error) {
 type MetaBlockCapn_List C.PointerList
 
 func NewMetaBlockCapnList(s *C.Segment, sz int) MetaBlockCapn_List {
-	return MetaBlockCapn_List(s.NewCompositeList(32, 11, sz))
+	return MetaBlockCapn_List(s.NewCompositeList(32, 12, sz))
 }
 func (s MetaBlockCapn_List) Len() int { return C.PointerList(s).Len() }
 func (s MetaBlockCapn_List) At(i int) MetaBlockCapn {
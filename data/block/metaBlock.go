@@ -23,6 +23,7 @@ const (
 	PeerUnJailed
 	PeerSlashed
 	PeerReStake
+	PeerTopUp
 )
 
 func (pa PeerAction) String() string {
@@ -41,6 +42,8 @@ func (pa PeerAction) String() string {
 		return "PeerSlashed"
 	case PeerReStake:
 		return "PeerReStake"
+	case PeerTopUp:
+		return "PeerTopUp"
 	default:
 		return fmt.Sprintf("Unknown type (%d)", pa)
 	}
@@ -57,6 +60,15 @@ type PeerData struct {
 	ValueChange *big.Int   `capid:"4"`
 }
 
+// GovernanceData holds a protocol parameter change accepted by governance, carried by the metablock from which it
+// applies
+type GovernanceData struct {
+	ProposalID      uint64 `capid:"0"`
+	Parameter       string `capid:"1"`
+	Value           string `capid:"2"`
+	ActivationNonce uint64 `capid:"3"`
+}
+
 // ShardMiniBlockHeader holds data for one shard miniblock header
 type ShardMiniBlockHeader struct {
 	Hash            []byte `capid:"0"`
@@ -97,6 +109,7 @@ type MetaBlock struct {
 	ValidatorStatsRootHash []byte            `capid:"13"`
 	TxCount                uint32            `capid:"14"`
 	MiniBlockHeaders       []MiniBlockHeader `capid:"15"`
+	GovernanceChanges      []GovernanceData  `capid:"16"`
 }
 
 // Save saves the serialized data of a PeerData into a stream through Capnp protocol
@@ -188,6 +201,32 @@ func PeerDataCapnToGo(src capnp.PeerDataCapn, dest *PeerData) *PeerData {
 	return dest
 }
 
+// GovernanceDataGoToCapn is a helper function to copy fields from a GovernanceData object to a GovernanceDataCapn
+// object
+func GovernanceDataGoToCapn(seg *capn.Segment, src *GovernanceData) capnp.GovernanceDataCapn {
+	dest := capnp.AutoNewGovernanceDataCapn(seg)
+	dest.SetProposalId(src.ProposalID)
+	dest.SetParameter(src.Parameter)
+	dest.SetValue(src.Value)
+	dest.SetActivationNonce(src.ActivationNonce)
+
+	return dest
+}
+
+// GovernanceDataCapnToGo is a helper function to copy fields from a GovernanceDataCapn object to a GovernanceData
+// object
+func GovernanceDataCapnToGo(src capnp.GovernanceDataCapn, dest *GovernanceData) *GovernanceData {
+	if dest == nil {
+		dest = &GovernanceData{}
+	}
+	dest.ProposalID = src.ProposalId()
+	dest.Parameter = src.Parameter()
+	dest.Value = src.Value()
+	dest.ActivationNonce = src.ActivationNonce()
+
+	return dest
+}
+
 // ShardMiniBlockHeaderGoToCapn is a helper function to copy fields from a ShardMiniBlockHeader object to a
 // ShardMiniBlockHeaderCapn object
 func ShardMiniBlockHeaderGoToCapn(seg *capn.Segment, src *ShardMiniBlockHeader) capnp.ShardMiniBlockHeaderCapn {
@@ -300,6 +339,16 @@ func MetaBlockGoToCapn(seg *capn.Segment, src *MetaBlock) capnp.MetaBlockCapn {
 		dest.SetMiniBlockHeaders(miniBlockList)
 	}
 
+	if len(src.GovernanceChanges) > 0 {
+		typedList := capnp.NewGovernanceDataCapnList(seg, len(src.GovernanceChanges))
+		plist := capn.PointerList(typedList)
+
+		for i, elem := range src.GovernanceChanges {
+			_ = plist.Set(i, capn.Object(GovernanceDataGoToCapn(seg, &elem)))
+		}
+		dest.SetGovernanceChanges(typedList)
+	}
+
 	dest.SetSignature(src.Signature)
 	dest.SetPubKeysBitmap(src.PubKeysBitmap)
 	dest.SetPrevHash(src.PrevHash)
@@ -340,6 +389,12 @@ func MetaBlockCapnToGo(src capnp.MetaBlockCapn, dest *MetaBlock) *MetaBlock {
 		dest.MiniBlockHeaders[i] = *MiniBlockHeaderCapnToGo(src.MiniBlockHeaders().At(i), nil)
 	}
 
+	n = src.GovernanceChanges().Len()
+	dest.GovernanceChanges = make([]GovernanceData, n)
+	for i := 0; i < n; i++ {
+		dest.GovernanceChanges[i] = *GovernanceDataCapnToGo(src.GovernanceChanges().At(i), nil)
+	}
+
 	dest.Signature = src.Signature()
 	dest.PubKeysBitmap = src.PubKeysBitmap()
 	dest.PrevHash = src.PrevHash()
@@ -88,6 +88,13 @@ func TestMetaBlock_SaveLoad(t *testing.T) {
 		TxCount:         uint32(10),
 	}
 
+	gd := block.GovernanceData{
+		ProposalID:      uint64(2),
+		Parameter:       "economics.MinGasPrice",
+		Value:           "1000",
+		ActivationNonce: uint64(1),
+	}
+
 	mb := block.MetaBlock{
 		Nonce:                  uint64(1),
 		Epoch:                  uint32(1),
@@ -105,6 +112,7 @@ func TestMetaBlock_SaveLoad(t *testing.T) {
 		ValidatorStatsRootHash: []byte("rootHash"),
 		MiniBlockHeaders:       []block.MiniBlockHeader{mbHdr},
 		LeaderSignature:        []byte("leader_sign"),
+		GovernanceChanges:      []block.GovernanceData{gd},
 	}
 	var b bytes.Buffer
 	err := mb.Save(&b)
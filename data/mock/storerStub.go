@@ -7,6 +7,7 @@ type StorerStub struct {
 	RemoveCalled      func(key []byte) error
 	ClearCacheCalled  func()
 	DestroyUnitCalled func() error
+	CloseCalled       func() error
 }
 
 func (ss *StorerStub) Put(key, data []byte) error {
@@ -29,6 +30,13 @@ func (ss *StorerStub) ClearCache() {
 	ss.ClearCacheCalled()
 }
 
+func (ss *StorerStub) Close() error {
+	if ss.CloseCalled != nil {
+		return ss.CloseCalled()
+	}
+	return nil
+}
+
 func (ss *StorerStub) DestroyUnit() error {
 	return ss.DestroyUnitCalled()
 }
@@ -0,0 +1,62 @@
+package receipt
+
+import (
+	"github.com/ElrondNetwork/elrond-go/hashing"
+)
+
+// BloomLen is the number of bytes of a logs bloom
+const BloomLen = 256
+
+// numBitsPerValue is the number of bits set in the bloom for each added value
+const numBitsPerValue = 3
+
+// Bloom is a filter of the addresses and topics of the logs emitted in a block. Clients test it to find out which
+// blocks can hold the events they are interested in, without reading all the receipts of each block
+type Bloom []byte
+
+// NewBloom creates an empty logs bloom
+func NewBloom() Bloom {
+	return make(Bloom, BloomLen)
+}
+
+// Add sets in the bloom the bits selected by the hash of the provided value
+func (b Bloom) Add(hasher hashing.Hasher, value []byte) {
+	for _, bitIndex := range bloomBitIndexes(hasher, value) {
+		b[bitIndex/8] |= 1 << (bitIndex % 8)
+	}
+}
+
+// Test returns false if the value was surely not added in the bloom and true if it might have been added
+func (b Bloom) Test(hasher hashing.Hasher, value []byte) bool {
+	if len(b) != BloomLen {
+		return false
+	}
+
+	for _, bitIndex := range bloomBitIndexes(hasher, value) {
+		if b[bitIndex/8]&(1<<(bitIndex%8)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AddLog sets in the bloom the address and the topics of the provided log
+func (b Bloom) AddLog(hasher hashing.Hasher, log *Log) {
+	b.Add(hasher, log.Address)
+	for _, topic := range log.Topics {
+		b.Add(hasher, topic)
+	}
+}
+
+// bloomBitIndexes derives the bit positions of a value from pairs of bytes of its hash
+func bloomBitIndexes(hasher hashing.Hasher, value []byte) []uint {
+	hash := hasher.Compute(string(value))
+	indexes := make([]uint, 0, numBitsPerValue)
+	for i := 0; i+1 < len(hash) && len(indexes) < numBitsPerValue; i += 2 {
+		index := (uint(hash[i])<<8 | uint(hash[i+1])) % (BloomLen * 8)
+		indexes = append(indexes, index)
+	}
+
+	return indexes
+}
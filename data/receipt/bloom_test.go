@@ -0,0 +1,53 @@
+package receipt_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBloom_EmptyShouldNotContainValues(t *testing.T) {
+	t.Parallel()
+
+	bloom := receipt.NewBloom()
+
+	assert.Equal(t, receipt.BloomLen, len(bloom))
+	assert.False(t, bloom.Test(sha256.Sha256{}, []byte("topic")))
+}
+
+func TestBloom_AddShouldContainValue(t *testing.T) {
+	t.Parallel()
+
+	hasher := sha256.Sha256{}
+	bloom := receipt.NewBloom()
+	bloom.Add(hasher, []byte("topic"))
+
+	assert.True(t, bloom.Test(hasher, []byte("topic")))
+	assert.False(t, bloom.Test(hasher, []byte("other topic")))
+}
+
+func TestBloom_AddLogShouldContainAddressAndTopics(t *testing.T) {
+	t.Parallel()
+
+	hasher := sha256.Sha256{}
+	bloom := receipt.NewBloom()
+	bloom.AddLog(hasher, &receipt.Log{
+		Address: []byte("address"),
+		Topics:  [][]byte{[]byte("topic1"), []byte("topic2")},
+		Data:    []byte("data"),
+	})
+
+	assert.True(t, bloom.Test(hasher, []byte("address")))
+	assert.True(t, bloom.Test(hasher, []byte("topic1")))
+	assert.True(t, bloom.Test(hasher, []byte("topic2")))
+}
+
+func TestBloom_TestWrongLengthShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	bloom := receipt.Bloom([]byte{0xFF})
+
+	assert.False(t, bloom.Test(sha256.Sha256{}, []byte("topic")))
+}
@@ -0,0 +1,24 @@
+package receipt
+
+import (
+	"math/big"
+)
+
+// Log is an event emitted by a smart contract while processing a transaction
+type Log struct {
+	Address []byte   `json:"address"`
+	Topics  [][]byte `json:"topics"`
+	Data    []byte   `json:"data"`
+}
+
+// Receipt holds the outcome of a transaction processed by a smart contract, so clients can tell whether the call
+// succeeded without reading the account state
+type Receipt struct {
+	TxHash      []byte   `json:"txHash"`
+	BlockHash   []byte   `json:"blockHash"`
+	Fee         *big.Int `json:"fee"`
+	GasRefunded uint64   `json:"gasRefunded"`
+	ReturnCode  string   `json:"returnCode"`
+	ReturnData  [][]byte `json:"returnData,omitempty"`
+	Logs        []*Log   `json:"logs,omitempty"`
+}
@@ -12,9 +12,9 @@ import (
 
 type RewardTxCapn C.Struct
 
-func NewRewardTxCapn(s *C.Segment) RewardTxCapn      { return RewardTxCapn(s.NewStruct(16, 2)) }
-func NewRootRewardTxCapn(s *C.Segment) RewardTxCapn  { return RewardTxCapn(s.NewRootStruct(16, 2)) }
-func AutoNewRewardTxCapn(s *C.Segment) RewardTxCapn  { return RewardTxCapn(s.NewStructAR(16, 2)) }
+func NewRewardTxCapn(s *C.Segment) RewardTxCapn      { return RewardTxCapn(s.NewStruct(16, 3)) }
+func NewRootRewardTxCapn(s *C.Segment) RewardTxCapn  { return RewardTxCapn(s.NewRootStruct(16, 3)) }
+func AutoNewRewardTxCapn(s *C.Segment) RewardTxCapn  { return RewardTxCapn(s.NewStructAR(16, 3)) }
 func ReadRootRewardTxCapn(s *C.Segment) RewardTxCapn { return RewardTxCapn(s.Root(0).ToStruct()) }
 func (s RewardTxCapn) Round() uint64                 { return C.Struct(s).Get64(0) }
 func (s RewardTxCapn) SetRound(v uint64)             { C.Struct(s).Set64(0, v) }
@@ -26,6 +26,8 @@ func (s RewardTxCapn) RcvAddr() []byte               { return C.Struct(s).GetObj
 func (s RewardTxCapn) SetRcvAddr(v []byte)           { C.Struct(s).SetObject(1, s.Segment.NewData(v)) }
 func (s RewardTxCapn) ShardId() uint32               { return C.Struct(s).Get32(12) }
 func (s RewardTxCapn) SetShardId(v uint32)           { C.Struct(s).Set32(12, v) }
+func (s RewardTxCapn) PubKey() []byte                { return C.Struct(s).GetObject(2).ToData() }
+func (s RewardTxCapn) SetPubKey(v []byte)            { C.Struct(s).SetObject(2, s.Segment.NewData(v)) }
 func (s RewardTxCapn) WriteJSON(w io.Writer) error {
 	b := bufio.NewWriter(w)
 	var err error
@@ -126,6 +128,25 @@ func (s RewardTxCapn) WriteJSON(w io.Writer) error {
 			return err
 		}
 	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"pubKey\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.PubKey()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
 	err = b.WriteByte('}')
 	if err != nil {
 		return err
@@ -238,6 +259,25 @@ func (s RewardTxCapn) WriteCapLit(w io.Writer) error {
 			return err
 		}
 	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("pubKey = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.PubKey()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
 	err = b.WriteByte(')')
 	if err != nil {
 		return err
@@ -254,7 +294,7 @@ func (s RewardTxCapn) MarshalCapLit() ([]byte, error) {
 type RewardTxCapn_List C.PointerList
 
 func NewRewardTxCapnList(s *C.Segment, sz int) RewardTxCapn_List {
-	return RewardTxCapn_List(s.NewCompositeList(16, 2, sz))
+	return RewardTxCapn_List(s.NewCompositeList(16, 3, sz))
 }
 func (s RewardTxCapn_List) Len() int { return C.PointerList(s).Len() }
 func (s RewardTxCapn_List) At(i int) RewardTxCapn {
@@ -8,13 +8,15 @@ import (
 	capn "github.com/glycerine/go-capnproto"
 )
 
-// RewardTx holds the data for a reward transaction
+// RewardTx holds the data for a reward transaction. PubKey is the public key of the node which earned the reward, so
+// the rewards paid to a smart contract on behalf of many nodes can be split by node
 type RewardTx struct {
 	Round   uint64   `capid:"1" json:"round"`
 	Epoch   uint32   `capid:"2" json:"epoch"`
 	Value   *big.Int `capid:"3" json:"value"`
 	RcvAddr []byte   `capid:"4" json:"receiver"`
 	ShardId uint32   `capid:"5" json:"shardId"`
+	PubKey  []byte   `capid:"6" json:"pubKey"`
 }
 
 // Save saves the serialized data of a RewardTx into a stream through Capnp protocol
@@ -57,6 +59,7 @@ func RewardTxCapnToGo(src capnp.RewardTxCapn, dest *RewardTx) *RewardTx {
 
 	dest.RcvAddr = src.RcvAddr()
 	dest.ShardId = src.ShardId()
+	dest.PubKey = src.PubKey()
 
 	return dest
 }
@@ -71,6 +74,7 @@ func RewardTxGoToCapn(seg *capn.Segment, src *RewardTx) capnp.RewardTxCapn {
 	dest.SetValue(value)
 	dest.SetRcvAddr(src.RcvAddr)
 	dest.SetShardId(src.ShardId)
+	dest.SetPubKey(src.PubKey)
 
 	return dest
 }
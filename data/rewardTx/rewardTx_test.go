@@ -16,6 +16,7 @@ func TestRewardTx_SaveLoad(t *testing.T) {
 		Value:   big.NewInt(1),
 		RcvAddr: []byte("receiver_address"),
 		ShardId: 10,
+		PubKey:  []byte("node_public_key"),
 	}
 
 	var b bytes.Buffer
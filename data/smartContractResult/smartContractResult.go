@@ -8,6 +8,20 @@ import (
 	capn "github.com/glycerine/go-capnproto"
 )
 
+// CallType defines the role of a smart contract result in a call between smart contracts
+type CallType uint8
+
+const (
+	// DirectCall is a smart contract result which is not part of an asynchronous call
+	DirectCall CallType = iota
+	// AsynchronousCall is a call made by a smart contract to a smart contract from another shard, whose result is
+	// returned to the caller
+	AsynchronousCall
+	// AsynchronousCallBack carries the result of an asynchronous call back to the calling smart contract, which
+	// processes it in its callBack function
+	AsynchronousCallBack
+)
+
 // SmartContractResult holds all the data needed for a value transfer
 type SmartContractResult struct {
 	Nonce    uint64   `capid:"0" json:"nonce"`
@@ -19,6 +33,10 @@ type SmartContractResult struct {
 	TxHash   []byte   `capid:"6" json:"txHash"`
 	GasLimit uint64   `capid:"7" json:"gasLimit"`
 	GasPrice uint64   `capid:"8" json:"gasPrice"`
+	// CallType and OriginalSender are set on the smart contract results of asynchronous calls. The original sender
+	// is the account which started the call chain and receives the gas left after the callback
+	CallType       CallType `capid:"9" json:"callType,omitempty"`
+	OriginalSender []byte   `capid:"10" json:"originalSender,omitempty"`
 }
 
 // Save saves the serialized data of a SmartContractResult into a stream through Capnp protocol
@@ -3,6 +3,7 @@ package state
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 )
 
@@ -12,6 +13,17 @@ type Account struct {
 	Balance  *big.Int
 	CodeHash []byte
 	RootHash []byte
+	// MultiSigThreshold and MultiSigPubKeys are set only for multisignature accounts, whose transactions must be
+	// signed by at least MultiSigThreshold of the MultiSigPubKeys. They are omitted from the serialized form of
+	// the other accounts
+	MultiSigThreshold uint32   `json:",omitempty"`
+	MultiSigPubKeys   [][]byte `json:",omitempty"`
+	// OwnerAddress, DeveloperReward and CodeMetadata are set only for smart contract accounts. The owner can claim
+	// the developer reward, which accumulates a share of the fees paid for calling the contract, and can replace the
+	// code if the code metadata marks it as upgradable
+	OwnerAddress    []byte   `json:",omitempty"`
+	DeveloperReward *big.Int `json:",omitempty"`
+	CodeMetadata    []byte   `json:",omitempty"`
 
 	addressContainer AddressContainer
 	code             []byte
@@ -85,6 +97,75 @@ func (a *Account) SetBalanceWithJournal(balance *big.Int) error {
 	return a.accountTracker.SaveAccount(a)
 }
 
+//------- multisignature
+
+// IsMultiSig returns true if the transactions of this account must be authorized by a signature set
+func (a *Account) IsMultiSig() bool {
+	return a.MultiSigThreshold > 0
+}
+
+// SetMultiSigWithJournal sets the signature threshold and the public keys authorizing the account's transactions,
+// saving the old ones before changing
+func (a *Account) SetMultiSigWithJournal(threshold uint32, pubKeys [][]byte) error {
+	entry, err := NewJournalEntryMultiSig(a, a.MultiSigThreshold, a.MultiSigPubKeys)
+	if err != nil {
+		return err
+	}
+
+	a.accountTracker.Journalize(entry)
+	a.MultiSigThreshold = threshold
+	a.MultiSigPubKeys = pubKeys
+
+	return a.accountTracker.SaveAccount(a)
+}
+
+//------- smart contract owner
+
+// SetOwnerAddressWithJournal sets the address of the smart contract owner, saving the old one before changing
+func (a *Account) SetOwnerAddressWithJournal(ownerAddress []byte) error {
+	entry, err := NewJournalEntryOwnerAddress(a, a.OwnerAddress)
+	if err != nil {
+		return err
+	}
+
+	a.accountTracker.Journalize(entry)
+	a.OwnerAddress = ownerAddress
+
+	return a.accountTracker.SaveAccount(a)
+}
+
+// SetDeveloperRewardWithJournal sets the reward the smart contract owner can claim, saving the old one before
+// changing
+func (a *Account) SetDeveloperRewardWithJournal(developerReward *big.Int) error {
+	entry, err := NewJournalEntryDeveloperReward(a, a.DeveloperReward)
+	if err != nil {
+		return err
+	}
+
+	a.accountTracker.Journalize(entry)
+	a.DeveloperReward = developerReward
+
+	return a.accountTracker.SaveAccount(a)
+}
+
+// IsUpgradable returns true if the owner of the smart contract can replace its code
+func (a *Account) IsUpgradable() bool {
+	return len(a.CodeMetadata) > 0 && a.CodeMetadata[0]&core.CodeMetadataUpgradable != 0
+}
+
+// SetCodeMetadataWithJournal sets the properties of the smart contract code, saving the old ones before changing
+func (a *Account) SetCodeMetadataWithJournal(codeMetadata []byte) error {
+	entry, err := NewJournalEntryCodeMetadata(a, a.CodeMetadata)
+	if err != nil {
+		return err
+	}
+
+	a.accountTracker.Journalize(entry)
+	a.CodeMetadata = codeMetadata
+
+	return a.accountTracker.SaveAccount(a)
+}
+
 //------- code / code hash
 
 // GetCodeHash returns the code hash associated with this account
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/mock"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/stretchr/testify/assert"
@@ -202,6 +203,117 @@ func TestAccount_SetBalanceWithJournal(t *testing.T) {
 	assert.Equal(t, 1, saveAccountCalled)
 }
 
+func TestAccount_SetMultiSigWithJournal(t *testing.T) {
+	t.Parallel()
+
+	journalizeCalled := 0
+	saveAccountCalled := 0
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+			journalizeCalled++
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			saveAccountCalled++
+			return nil
+		},
+	}
+
+	acc, err := state.NewAccount(&mock.AddressMock{}, tracker)
+	assert.Nil(t, err)
+	assert.False(t, acc.IsMultiSig())
+
+	pubKeys := [][]byte{[]byte("pk1"), []byte("pk2"), []byte("pk3")}
+	err = acc.SetMultiSigWithJournal(2, pubKeys)
+
+	assert.Nil(t, err)
+	assert.True(t, acc.IsMultiSig())
+	assert.Equal(t, uint32(2), acc.MultiSigThreshold)
+	assert.Equal(t, pubKeys, acc.MultiSigPubKeys)
+	assert.Equal(t, 1, journalizeCalled)
+	assert.Equal(t, 1, saveAccountCalled)
+}
+
+func TestAccount_SetOwnerAddressWithJournal(t *testing.T) {
+	t.Parallel()
+
+	journalizeCalled := 0
+	saveAccountCalled := 0
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+			journalizeCalled++
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			saveAccountCalled++
+			return nil
+		},
+	}
+
+	acc, err := state.NewAccount(&mock.AddressMock{}, tracker)
+	assert.Nil(t, err)
+
+	owner := []byte("owner")
+	err = acc.SetOwnerAddressWithJournal(owner)
+
+	assert.Nil(t, err)
+	assert.Equal(t, owner, acc.OwnerAddress)
+	assert.Equal(t, 1, journalizeCalled)
+	assert.Equal(t, 1, saveAccountCalled)
+}
+
+func TestAccount_SetDeveloperRewardWithJournal(t *testing.T) {
+	t.Parallel()
+
+	journalizeCalled := 0
+	saveAccountCalled := 0
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+			journalizeCalled++
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			saveAccountCalled++
+			return nil
+		},
+	}
+
+	acc, err := state.NewAccount(&mock.AddressMock{}, tracker)
+	assert.Nil(t, err)
+
+	reward := big.NewInt(42)
+	err = acc.SetDeveloperRewardWithJournal(reward)
+
+	assert.Nil(t, err)
+	assert.Equal(t, reward, acc.DeveloperReward)
+	assert.Equal(t, 1, journalizeCalled)
+	assert.Equal(t, 1, saveAccountCalled)
+}
+
+func TestAccount_SetCodeMetadataWithJournal(t *testing.T) {
+	t.Parallel()
+
+	journalizeCalled := 0
+	saveAccountCalled := 0
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+			journalizeCalled++
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			saveAccountCalled++
+			return nil
+		},
+	}
+
+	acc, err := state.NewAccount(&mock.AddressMock{}, tracker)
+	assert.Nil(t, err)
+	assert.False(t, acc.IsUpgradable())
+
+	err = acc.SetCodeMetadataWithJournal([]byte{core.CodeMetadataUpgradable})
+
+	assert.Nil(t, err)
+	assert.True(t, acc.IsUpgradable())
+	assert.Equal(t, 1, journalizeCalled)
+	assert.Equal(t, 1, saveAccountCalled)
+}
+
 func TestAccount_SetCodeHashWithJournal(t *testing.T) {
 	t.Parallel()
 
@@ -436,6 +436,7 @@ func (adb *AccountsDB) RecreateTrie(rootHash []byte) error {
 	}
 
 	adb.mainTrie = newTrie
+	adb.dataTries.Reset()
 	return nil
 }
 
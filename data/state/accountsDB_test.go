@@ -765,3 +765,30 @@ func TestAccountsDB_RecreateTrieOkValsShouldWork(t *testing.T) {
 	assert.True(t, wasCalled)
 
 }
+
+func TestAccountsDB_RecreateTrieShouldDropCachedDataTries(t *testing.T) {
+	t.Parallel()
+
+	numRecreateCalls := 0
+	trieStub := &mock.TrieStub{}
+	trieStub.RecreateCalled = func(root []byte) (data.Trie, error) {
+		numRecreateCalls++
+		return trieStub, nil
+	}
+
+	_, account, adb := generateAddressAccountAccountsDB(trieStub)
+	account.SetRootHash(make([]byte, state.HashLength))
+
+	_ = adb.LoadDataTrie(account)
+	_ = adb.LoadDataTrie(account)
+	assert.Equal(t, 1, numRecreateCalls)
+
+	err := adb.RecreateTrie(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numRecreateCalls)
+
+	//data trie must be recreated from the new main trie, not served from cache
+	err = adb.LoadDataTrie(account)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, numRecreateCalls)
+}
@@ -134,6 +134,9 @@ var ErrBech32WrongAddr = errors.New("wrong bech32 string")
 // ErrNilStake signals that the provided stake is nil
 var ErrNilStake = errors.New("stake is nil")
 
+// ErrNilTopUpValue signals that the provided top up value is nil
+var ErrNilTopUpValue = errors.New("top up value is nil")
+
 // ErrNilSchnorrPublicKey signals that the provided schnorr public is nil
 var ErrNilSchnorrPublicKey = errors.New("schnorr public key is nil")
 
@@ -39,6 +39,149 @@ func (jeb *JournalEntryBalance) IsInterfaceNil() bool {
 	return false
 }
 
+//------- JournalEntryMultiSig
+
+// JournalEntryMultiSig is used to revert a change of the multisignature settings of an account
+type JournalEntryMultiSig struct {
+	account      *Account
+	oldThreshold uint32
+	oldPubKeys   [][]byte
+}
+
+// NewJournalEntryMultiSig outputs a new JournalEntry implementation used to revert a multisignature settings change
+func NewJournalEntryMultiSig(account *Account, oldThreshold uint32, oldPubKeys [][]byte) (*JournalEntryMultiSig, error) {
+	if account == nil {
+		return nil, ErrNilAccountHandler
+	}
+
+	return &JournalEntryMultiSig{
+		account:      account,
+		oldThreshold: oldThreshold,
+		oldPubKeys:   oldPubKeys,
+	}, nil
+}
+
+// Revert applies undo operation
+func (jems *JournalEntryMultiSig) Revert() (AccountHandler, error) {
+	jems.account.MultiSigThreshold = jems.oldThreshold
+	jems.account.MultiSigPubKeys = jems.oldPubKeys
+
+	return jems.account, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jems *JournalEntryMultiSig) IsInterfaceNil() bool {
+	if jems == nil {
+		return true
+	}
+	return false
+}
+
+//------- JournalEntryOwnerAddress
+
+// JournalEntryOwnerAddress is used to revert a change of the smart contract owner
+type JournalEntryOwnerAddress struct {
+	account         *Account
+	oldOwnerAddress []byte
+}
+
+// NewJournalEntryOwnerAddress outputs a new JournalEntry implementation used to revert a smart contract owner change
+func NewJournalEntryOwnerAddress(account *Account, oldOwnerAddress []byte) (*JournalEntryOwnerAddress, error) {
+	if account == nil {
+		return nil, ErrNilAccountHandler
+	}
+
+	return &JournalEntryOwnerAddress{
+		account:         account,
+		oldOwnerAddress: oldOwnerAddress,
+	}, nil
+}
+
+// Revert applies undo operation
+func (jeoa *JournalEntryOwnerAddress) Revert() (AccountHandler, error) {
+	jeoa.account.OwnerAddress = jeoa.oldOwnerAddress
+
+	return jeoa.account, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jeoa *JournalEntryOwnerAddress) IsInterfaceNil() bool {
+	if jeoa == nil {
+		return true
+	}
+	return false
+}
+
+//------- JournalEntryDeveloperReward
+
+// JournalEntryDeveloperReward is used to revert a change of the reward claimable by the smart contract owner
+type JournalEntryDeveloperReward struct {
+	account            *Account
+	oldDeveloperReward *big.Int
+}
+
+// NewJournalEntryDeveloperReward outputs a new JournalEntry implementation used to revert a developer reward change
+func NewJournalEntryDeveloperReward(account *Account, oldDeveloperReward *big.Int) (*JournalEntryDeveloperReward, error) {
+	if account == nil {
+		return nil, ErrNilAccountHandler
+	}
+
+	return &JournalEntryDeveloperReward{
+		account:            account,
+		oldDeveloperReward: oldDeveloperReward,
+	}, nil
+}
+
+// Revert applies undo operation
+func (jedr *JournalEntryDeveloperReward) Revert() (AccountHandler, error) {
+	jedr.account.DeveloperReward = jedr.oldDeveloperReward
+
+	return jedr.account, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jedr *JournalEntryDeveloperReward) IsInterfaceNil() bool {
+	if jedr == nil {
+		return true
+	}
+	return false
+}
+
+//------- JournalEntryCodeMetadata
+
+// JournalEntryCodeMetadata is used to revert a change of the smart contract code properties
+type JournalEntryCodeMetadata struct {
+	account         *Account
+	oldCodeMetadata []byte
+}
+
+// NewJournalEntryCodeMetadata outputs a new JournalEntry implementation used to revert a code metadata change
+func NewJournalEntryCodeMetadata(account *Account, oldCodeMetadata []byte) (*JournalEntryCodeMetadata, error) {
+	if account == nil {
+		return nil, ErrNilAccountHandler
+	}
+
+	return &JournalEntryCodeMetadata{
+		account:         account,
+		oldCodeMetadata: oldCodeMetadata,
+	}, nil
+}
+
+// Revert applies undo operation
+func (jecm *JournalEntryCodeMetadata) Revert() (AccountHandler, error) {
+	jecm.account.CodeMetadata = jecm.oldCodeMetadata
+
+	return jecm.account, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jecm *JournalEntryCodeMetadata) IsInterfaceNil() bool {
+	if jecm == nil {
+		return true
+	}
+	return false
+}
+
 //------- JournalEntryDataTrieUpdates
 
 // JournalEntryDataTrieUpdates stores all the updates done to the account's data trie,
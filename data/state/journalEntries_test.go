@@ -41,3 +41,99 @@ func TestNewJournalEntryBalance_RevertOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, balance, accnt.Balance)
 }
+
+//------- JournalEntryMultiSig
+
+func TestNewJournalEntryMultiSig_NilAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	entry, err := state.NewJournalEntryMultiSig(nil, 0, nil)
+
+	assert.Nil(t, entry)
+	assert.Equal(t, state.ErrNilAccountHandler, err)
+}
+
+func TestNewJournalEntryMultiSig_RevertOkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	accnt, _ := state.NewAccount(mock.NewAddressMock(), &mock.AccountTrackerStub{})
+	accnt.MultiSigThreshold = 2
+	accnt.MultiSigPubKeys = [][]byte{[]byte("pk1"), []byte("pk2")}
+	entry, _ := state.NewJournalEntryMultiSig(accnt, 0, nil)
+	_, err := entry.Revert()
+
+	assert.Nil(t, err)
+	assert.False(t, accnt.IsMultiSig())
+	assert.Nil(t, accnt.MultiSigPubKeys)
+}
+
+//------- JournalEntryOwnerAddress
+
+func TestNewJournalEntryOwnerAddress_NilAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	entry, err := state.NewJournalEntryOwnerAddress(nil, nil)
+
+	assert.Nil(t, entry)
+	assert.Equal(t, state.ErrNilAccountHandler, err)
+}
+
+func TestNewJournalEntryOwnerAddress_RevertOkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	oldOwner := []byte("old owner")
+	accnt, _ := state.NewAccount(mock.NewAddressMock(), &mock.AccountTrackerStub{})
+	accnt.OwnerAddress = []byte("new owner")
+	entry, _ := state.NewJournalEntryOwnerAddress(accnt, oldOwner)
+	_, err := entry.Revert()
+
+	assert.Nil(t, err)
+	assert.Equal(t, oldOwner, accnt.OwnerAddress)
+}
+
+//------- JournalEntryCodeMetadata
+
+func TestNewJournalEntryCodeMetadata_NilAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	entry, err := state.NewJournalEntryCodeMetadata(nil, nil)
+
+	assert.Nil(t, entry)
+	assert.Equal(t, state.ErrNilAccountHandler, err)
+}
+
+func TestNewJournalEntryCodeMetadata_RevertOkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	accnt, _ := state.NewAccount(mock.NewAddressMock(), &mock.AccountTrackerStub{})
+	accnt.CodeMetadata = []byte{1}
+	entry, _ := state.NewJournalEntryCodeMetadata(accnt, nil)
+	_, err := entry.Revert()
+
+	assert.Nil(t, err)
+	assert.False(t, accnt.IsUpgradable())
+}
+
+//------- JournalEntryDeveloperReward
+
+func TestNewJournalEntryDeveloperReward_NilAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	entry, err := state.NewJournalEntryDeveloperReward(nil, nil)
+
+	assert.Nil(t, entry)
+	assert.Equal(t, state.ErrNilAccountHandler, err)
+}
+
+func TestNewJournalEntryDeveloperReward_RevertOkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	oldReward := big.NewInt(10)
+	accnt, _ := state.NewAccount(mock.NewAddressMock(), &mock.AccountTrackerStub{})
+	accnt.DeveloperReward = big.NewInt(20)
+	entry, _ := state.NewJournalEntryDeveloperReward(accnt, oldReward)
+	_, err := entry.Revert()
+
+	assert.Nil(t, err)
+	assert.Equal(t, oldReward, accnt.DeveloperReward)
+}
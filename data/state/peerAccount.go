@@ -30,6 +30,7 @@ type PeerAccount struct {
 	SchnorrPublicKey []byte
 	Address          []byte
 	Stake            *big.Int
+	TopUpValue       *big.Int
 
 	JailTime      TimePeriod
 	PastJailTimes []TimePeriod
@@ -68,6 +69,7 @@ func NewPeerAccount(
 
 	return &PeerAccount{
 		Stake:            big.NewInt(0),
+		TopUpValue:       big.NewInt(0),
 		addressContainer: addressContainer,
 		accountTracker:   tracker,
 		dataTrieTracker:  NewTrackableDataTrie(nil),
@@ -236,6 +238,24 @@ func (a *PeerAccount) SetStakeWithJournal(stake *big.Int) error {
 	return a.accountTracker.SaveAccount(a)
 }
 
+// SetTopUpValueWithJournal sets the account's share of the stake its owner added above the minimum, saving the old
+// state before changing
+func (a *PeerAccount) SetTopUpValueWithJournal(topUpValue *big.Int) error {
+	if topUpValue == nil {
+		return ErrNilTopUpValue
+	}
+
+	entry, err := NewPeerJournalEntryTopUpValue(a, a.TopUpValue)
+	if err != nil {
+		return err
+	}
+
+	a.accountTracker.Journalize(entry)
+	a.TopUpValue = topUpValue
+
+	return a.accountTracker.SaveAccount(a)
+}
+
 // SetJailTimeWithJournal sets the account's jail time, saving the old state before changing
 func (a *PeerAccount) SetJailTimeWithJournal(jailTime TimePeriod) error {
 	entry, err := NewPeerJournalEntryJailTime(a, a.JailTime)
@@ -309,6 +309,36 @@ func TestPeerAccount_SetStakeWithJournal(t *testing.T) {
 	assert.Equal(t, 1, saveAccountCalled)
 }
 
+func TestPeerAccount_SetTopUpValueWithJournal(t *testing.T) {
+	t.Parallel()
+
+	journalizeCalled := 0
+	saveAccountCalled := 0
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+			journalizeCalled++
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			saveAccountCalled++
+			return nil
+		},
+	}
+
+	acc, err := state.NewPeerAccount(&mock.AddressMock{}, tracker)
+	assert.Nil(t, err)
+
+	err = acc.SetTopUpValueWithJournal(nil)
+	assert.Equal(t, state.ErrNilTopUpValue, err)
+
+	topUpValue := big.NewInt(1000)
+	err = acc.SetTopUpValueWithJournal(topUpValue)
+
+	assert.Nil(t, err)
+	assert.Equal(t, topUpValue, acc.TopUpValue)
+	assert.Equal(t, 1, journalizeCalled)
+	assert.Equal(t, 1, saveAccountCalled)
+}
+
 func TestPeerAccount_SetCurrentShardIdWithJournal(t *testing.T) {
 	t.Parallel()
 
@@ -145,6 +145,42 @@ func (pjes *PeerJournalEntryStake) IsInterfaceNil() bool {
 	return false
 }
 
+//------- PeerJournalEntryTopUpValue
+
+// PeerJournalEntryTopUpValue is used to revert a top up value change
+type PeerJournalEntryTopUpValue struct {
+	account       *PeerAccount
+	oldTopUpValue *big.Int
+}
+
+// NewPeerJournalEntryTopUpValue outputs a new PeerJournalEntryTopUpValue implementation used to revert a top up
+// value change
+func NewPeerJournalEntryTopUpValue(account *PeerAccount, oldTopUpValue *big.Int) (*PeerJournalEntryTopUpValue, error) {
+	if account == nil {
+		return nil, ErrNilAccountHandler
+	}
+
+	return &PeerJournalEntryTopUpValue{
+		account:       account,
+		oldTopUpValue: oldTopUpValue,
+	}, nil
+}
+
+// Revert applies undo operation
+func (pjet *PeerJournalEntryTopUpValue) Revert() (AccountHandler, error) {
+	pjet.account.TopUpValue = pjet.oldTopUpValue
+
+	return pjet.account, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pjet *PeerJournalEntryTopUpValue) IsInterfaceNil() bool {
+	if pjet == nil {
+		return true
+	}
+	return false
+}
+
 // PeerJournalEntryJailTime is used to revert a balance change
 type PeerJournalEntryJailTime struct {
 	account     *PeerAccount
@@ -129,6 +129,27 @@ func TestPeerJournalEntryStake_RevertOkValsShouldWork(t *testing.T) {
 	assert.Equal(t, stake.Uint64(), accnt.Stake.Uint64())
 }
 
+func TestPeerJournalEntryTopUpValue_NilAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	entry, err := state.NewPeerJournalEntryTopUpValue(nil, nil)
+
+	assert.Nil(t, entry)
+	assert.Equal(t, state.ErrNilAccountHandler, err)
+}
+
+func TestPeerJournalEntryTopUpValue_RevertOkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	topUpValue := big.NewInt(999)
+	accnt, _ := state.NewPeerAccount(mock.NewAddressMock(), &mock.AccountTrackerStub{})
+	entry, _ := state.NewPeerJournalEntryTopUpValue(accnt, topUpValue)
+	_, err := entry.Revert()
+
+	assert.Nil(t, err)
+	assert.Equal(t, topUpValue, accnt.TopUpValue)
+}
+
 func TestPeerJournalEntryJailTime_NilAccountShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -21,6 +21,16 @@ type Transaction struct {
 	Data      string   `capid:"6" json:"data,omitempty"`
 	Signature []byte   `capid:"7" json:"signature,omitempty"`
 	Challenge []byte   `capid:"8" json:"challenge,omitempty"`
+	ChainID   []byte   `capid:"9" json:"chainID"`
+	Version   uint32   `capid:"10" json:"version"`
+	// Signatures holds the signature set of a transaction sent from a multisignature account, one entry for each
+	// registered public key, in the registration order. An empty entry means the key did not sign. The set replaces the
+	// single Signature, and each signer signs the transaction without Signature and Signatures
+	Signatures [][]byte `capid:"11" json:"signatures,omitempty"`
+	// ValidAfterRound and ValidUntilRound bound the rounds in which the transaction can be executed. A zero value
+	// leaves that end of the window open
+	ValidAfterRound uint64 `capid:"12" json:"validAfterRound,omitempty"`
+	ValidUntilRound uint64 `capid:"13" json:"validUntilRound,omitempty"`
 }
 
 // Save saves the serialized data of a Transaction into a stream through Capnp protocol
@@ -75,6 +85,22 @@ func TransactionCapnToGo(src capnp.TransactionCapn, dest *Transaction) *Transact
 	dest.Signature = src.Signature()
 	// Challenge
 	dest.Challenge = src.Challenge()
+	// ChainID
+	dest.ChainID = src.ChainID()
+	// Version
+	dest.Version = src.Version()
+	// Signatures
+	n := src.Signatures().Len()
+	if n > 0 {
+		dest.Signatures = make([][]byte, n)
+		for i := 0; i < n; i++ {
+			dest.Signatures[i] = src.Signatures().At(i)
+		}
+	}
+	// ValidAfterRound
+	dest.ValidAfterRound = src.ValidAfterRound()
+	// ValidUntilRound
+	dest.ValidUntilRound = src.ValidUntilRound()
 
 	return dest
 }
@@ -93,6 +119,17 @@ func TransactionGoToCapn(seg *capn.Segment, src *Transaction) capnp.TransactionC
 	dest.SetData(src.Data)
 	dest.SetSignature(src.Signature)
 	dest.SetChallenge(src.Challenge)
+	dest.SetChainID(src.ChainID)
+	dest.SetVersion(src.Version)
+	if len(src.Signatures) > 0 {
+		signatures := seg.NewDataList(len(src.Signatures))
+		for i := range src.Signatures {
+			signatures.Set(i, src.Signatures[i])
+		}
+		dest.SetSignatures(signatures)
+	}
+	dest.SetValidAfterRound(src.ValidAfterRound)
+	dest.SetValidUntilRound(src.ValidUntilRound)
 
 	return dest
 }
@@ -157,6 +194,16 @@ func (tx *Transaction) SetSndAddress(addr []byte) {
 	tx.SndAddr = addr
 }
 
+// IsNotYetValidInRound returns true if the transaction can be executed only in a later round
+func (tx *Transaction) IsNotYetValidInRound(round uint64) bool {
+	return round < tx.ValidAfterRound
+}
+
+// IsExpiredInRound returns true if the transaction can not be executed anymore in the given round
+func (tx *Transaction) IsExpiredInRound(round uint64) bool {
+	return tx.ValidUntilRound > 0 && round > tx.ValidUntilRound
+}
+
 // MarshalJSON converts the Transaction data type into its corresponding equivalent in byte slice.
 // Note that Value data type is converted in a string
 func (tx *Transaction) MarshalJSON() ([]byte, error) {
@@ -165,37 +212,52 @@ func (tx *Transaction) MarshalJSON() ([]byte, error) {
 		valAsString = tx.Value.String()
 	}
 	return json.Marshal(&struct {
-		Nonce     uint64 `json:"nonce"`
-		Value     string `json:"value"`
-		RcvAddr   []byte `json:"receiver"`
-		SndAddr   []byte `json:"sender"`
-		GasPrice  uint64 `json:"gasPrice,omitempty"`
-		GasLimit  uint64 `json:"gasLimit,omitempty"`
-		Data      string `json:"data,omitempty"`
-		Signature []byte `json:"signature,omitempty"`
+		Nonce           uint64   `json:"nonce"`
+		Value           string   `json:"value"`
+		RcvAddr         []byte   `json:"receiver"`
+		SndAddr         []byte   `json:"sender"`
+		GasPrice        uint64   `json:"gasPrice,omitempty"`
+		GasLimit        uint64   `json:"gasLimit,omitempty"`
+		Data            string   `json:"data,omitempty"`
+		Signature       []byte   `json:"signature,omitempty"`
+		ChainID         []byte   `json:"chainID"`
+		Version         uint32   `json:"version"`
+		Signatures      [][]byte `json:"signatures,omitempty"`
+		ValidAfterRound uint64   `json:"validAfterRound,omitempty"`
+		ValidUntilRound uint64   `json:"validUntilRound,omitempty"`
 	}{
-		Nonce:     tx.Nonce,
-		Value:     valAsString,
-		RcvAddr:   tx.RcvAddr,
-		SndAddr:   tx.SndAddr,
-		GasPrice:  tx.GasPrice,
-		GasLimit:  tx.GasLimit,
-		Data:      tx.Data,
-		Signature: tx.Signature,
+		Nonce:           tx.Nonce,
+		Value:           valAsString,
+		RcvAddr:         tx.RcvAddr,
+		SndAddr:         tx.SndAddr,
+		GasPrice:        tx.GasPrice,
+		GasLimit:        tx.GasLimit,
+		Data:            tx.Data,
+		Signature:       tx.Signature,
+		ChainID:         tx.ChainID,
+		Version:         tx.Version,
+		Signatures:      tx.Signatures,
+		ValidAfterRound: tx.ValidAfterRound,
+		ValidUntilRound: tx.ValidUntilRound,
 	})
 }
 
 // UnmarshalJSON converts the provided bytes into a Transaction data type.
 func (tx *Transaction) UnmarshalJSON(dataBuff []byte) error {
 	aux := &struct {
-		Nonce     uint64 `json:"nonce"`
-		Value     string `json:"value"`
-		RcvAddr   []byte `json:"receiver"`
-		SndAddr   []byte `json:"sender"`
-		GasPrice  uint64 `json:"gasPrice,omitempty"`
-		GasLimit  uint64 `json:"gasLimit,omitempty"`
-		Data      string `json:"data,omitempty"`
-		Signature []byte `json:"signature,omitempty"`
+		Nonce           uint64   `json:"nonce"`
+		Value           string   `json:"value"`
+		RcvAddr         []byte   `json:"receiver"`
+		SndAddr         []byte   `json:"sender"`
+		GasPrice        uint64   `json:"gasPrice,omitempty"`
+		GasLimit        uint64   `json:"gasLimit,omitempty"`
+		Data            string   `json:"data,omitempty"`
+		Signature       []byte   `json:"signature,omitempty"`
+		ChainID         []byte   `json:"chainID"`
+		Version         uint32   `json:"version"`
+		Signatures      [][]byte `json:"signatures,omitempty"`
+		ValidAfterRound uint64   `json:"validAfterRound,omitempty"`
+		ValidUntilRound uint64   `json:"validUntilRound,omitempty"`
 	}{}
 	if err := json.Unmarshal(dataBuff, &aux); err != nil {
 		return err
@@ -207,6 +269,11 @@ func (tx *Transaction) UnmarshalJSON(dataBuff []byte) error {
 	tx.GasLimit = aux.GasLimit
 	tx.Data = aux.Data
 	tx.Signature = aux.Signature
+	tx.ChainID = aux.ChainID
+	tx.Version = aux.Version
+	tx.Signatures = aux.Signatures
+	tx.ValidAfterRound = aux.ValidAfterRound
+	tx.ValidUntilRound = aux.ValidUntilRound
 
 	var ok bool
 	tx.Value, ok = big.NewInt(0).SetString(aux.Value, 10)
@@ -21,6 +21,11 @@ func TestTransaction_SaveLoad(t *testing.T) {
 		Data:      "tx_data",
 		Signature: []byte("signature"),
 		Challenge: []byte("challenge"),
+		ChainID:   []byte("chain ID"),
+		Version:   uint32(1),
+
+		ValidAfterRound: uint64(10),
+		ValidUntilRound: uint64(20),
 	}
 
 	var b bytes.Buffer
@@ -32,6 +37,31 @@ func TestTransaction_SaveLoad(t *testing.T) {
 	assert.Equal(t, loadTx, tx)
 }
 
+func TestTransaction_SaveLoadWithSignatures(t *testing.T) {
+	tx := transaction.Transaction{
+		Nonce:      uint64(1),
+		Value:      big.NewInt(1),
+		RcvAddr:    []byte("receiver_address"),
+		SndAddr:    []byte("sender_address"),
+		GasPrice:   uint64(10000),
+		GasLimit:   uint64(1000),
+		Data:       "tx_data",
+		ChainID:    []byte("chain ID"),
+		Version:    uint32(1),
+		Signatures: [][]byte{[]byte("signature 1"), {}, []byte("signature 3")},
+	}
+
+	var b bytes.Buffer
+	_ = tx.Save(&b)
+
+	loadTx := transaction.Transaction{}
+	_ = loadTx.Load(&b)
+
+	assert.Equal(t, tx.Signatures[0], loadTx.Signatures[0])
+	assert.Equal(t, 0, len(loadTx.Signatures[1]))
+	assert.Equal(t, tx.Signatures[2], loadTx.Signatures[2])
+}
+
 func TestTransaction_GetData(t *testing.T) {
 	t.Parallel()
 
@@ -121,6 +151,9 @@ func TestTransaction_MarshalUnmarshalJsonShouldWork(t *testing.T) {
 		GasLimit:  5678,
 		Data:      "data",
 		Signature: []byte("signature"),
+
+		ValidAfterRound: 10,
+		ValidUntilRound: 20,
 	}
 
 	buff, err := json.Marshal(tx)
@@ -133,3 +166,25 @@ func TestTransaction_MarshalUnmarshalJsonShouldWork(t *testing.T) {
 	buffAsString := string(buff)
 	assert.Contains(t, buffAsString, "\""+value.String()+"\"")
 }
+
+func TestTransaction_IsNotYetValidInRound(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{ValidAfterRound: 10}
+
+	assert.True(t, tx.IsNotYetValidInRound(9))
+	assert.False(t, tx.IsNotYetValidInRound(10))
+	assert.False(t, tx.IsNotYetValidInRound(11))
+	assert.False(t, (&transaction.Transaction{}).IsNotYetValidInRound(0))
+}
+
+func TestTransaction_IsExpiredInRound(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{ValidUntilRound: 10}
+
+	assert.False(t, tx.IsExpiredInRound(9))
+	assert.False(t, tx.IsExpiredInRound(10))
+	assert.True(t, tx.IsExpiredInRound(11))
+	assert.False(t, (&transaction.Transaction{}).IsExpiredInRound(1000))
+}
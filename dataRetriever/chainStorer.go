@@ -108,6 +108,23 @@ func (bc *ChainStorer) GetAll(unitType UnitType, keys [][]byte) (map[string][]by
 	return m, nil
 }
 
+// CloseAll closes all the storage units of the storage service. It tries to close every unit and
+// returns the last encountered error, if any
+func (bc *ChainStorer) CloseAll() error {
+	bc.lock.Lock()
+	defer bc.lock.Unlock()
+
+	var lastErr error
+	for _, v := range bc.chain {
+		err := v.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
 // Destroy removes the underlying files/resources used by the storage service
 func (bc *ChainStorer) Destroy() error {
 	bc.lock.Lock()
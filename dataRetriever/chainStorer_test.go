@@ -157,6 +157,31 @@ func TestDestroy_ReturnsCorrectly(t *testing.T) {
 	assert.True(t, destroyCalled)
 }
 
+func TestCloseAll_ShouldCloseAllStorersAndReturnError(t *testing.T) {
+	closeError := errors.New("error")
+	numCloseCalls := 0
+	failingStorer := &mock.StorerStub{
+		CloseCalled: func() error {
+			numCloseCalls++
+			return closeError
+		},
+	}
+	storer := &mock.StorerStub{
+		CloseCalled: func() error {
+			numCloseCalls++
+			return nil
+		},
+	}
+	b := dataRetriever.NewChainStorer()
+	b.AddStorer(1, failingStorer)
+	b.AddStorer(2, storer)
+
+	err := b.CloseAll()
+
+	assert.Equal(t, closeError, err)
+	assert.Equal(t, 2, numCloseCalls)
+}
+
 func TestBlockChain_GetStorer(t *testing.T) {
 	t.Parallel()
 
@@ -202,3 +202,6 @@ var ErrNilCurrBlockTxs = errors.New("nil current block txs holder")
 
 // ErrNilRequestedItemsHandler signals that a nil requested items handler was provided
 var ErrNilRequestedItemsHandler = errors.New("nil requested items handler")
+
+// ErrTooManyNoncesRequested signals that a request holds more nonces than MaxNoncesPerSenderRequest
+var ErrTooManyNoncesRequested = errors.New("too many nonces requested")
@@ -39,6 +39,10 @@ const (
 	BootstrapUnit UnitType = 11
 	//StatusMetricsUnit is the status metrics storage unit identifier
 	StatusMetricsUnit UnitType = 12
+	// TxPoolUnit is the storage unit identifier for the transactions left in pools when the node was stopped
+	TxPoolUnit UnitType = 13
+	// ReceiptsUnit is the storage unit identifier for the transaction receipts and the logs blooms of the blocks
+	ReceiptsUnit UnitType = 14
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -182,6 +186,11 @@ type ShardedDataCacherNotifier interface {
 	CreateShardStore(cacheId string)
 }
 
+// TxsBySenderNoncesSearcher defines a transactions pool able to search the pending transactions of a sender by nonce
+type TxsBySenderNoncesSearcher interface {
+	SearchTxsBySenderNonces(sender []byte, nonces []uint64) []data.TransactionHandler
+}
+
 // ShardIdHashMap represents a map for shardId and hash
 type ShardIdHashMap interface {
 	Load(shardId uint32) ([]byte, bool)
@@ -251,6 +260,8 @@ type StorageService interface {
 	// GetAll gets all the elements with keys in the keys array, from the selected storage unit
 	// If there is a missing key in the unit, it returns an error
 	GetAll(unitType UnitType, keys [][]byte) (map[string][]byte, error)
+	// CloseAll closes all the storage units of the storage service
+	CloseAll() error
 	// Destroy removes the underlying files/resources used by the storage service
 	Destroy() error
 	// IsInterfaceNil returns true if there is no value under the interface
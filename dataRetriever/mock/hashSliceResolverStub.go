@@ -3,9 +3,10 @@ package mock
 import "github.com/ElrondNetwork/elrond-go/p2p"
 
 type HashSliceResolverStub struct {
-	RequestDataFromHashCalled      func(hash []byte) error
-	ProcessReceivedMessageCalled   func(message p2p.MessageP2P) error
-	RequestDataFromHashArrayCalled func(hashes [][]byte) error
+	RequestDataFromHashCalled         func(hash []byte) error
+	ProcessReceivedMessageCalled      func(message p2p.MessageP2P) error
+	RequestDataFromHashArrayCalled    func(hashes [][]byte) error
+	RequestDataFromSenderNoncesCalled func(sender []byte, nonces []uint64) error
 }
 
 func (hsrs *HashSliceResolverStub) RequestDataFromHash(hash []byte) error {
@@ -32,6 +33,14 @@ func (hsrs *HashSliceResolverStub) RequestDataFromHashArray(hashes [][]byte) err
 	return errNotImplemented
 }
 
+func (hsrs *HashSliceResolverStub) RequestDataFromSenderNonces(sender []byte, nonces []uint64) error {
+	if hsrs.RequestDataFromSenderNoncesCalled != nil {
+		return hsrs.RequestDataFromSenderNoncesCalled(sender, nonces)
+	}
+
+	return errNotImplemented
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hsrs *HashSliceResolverStub) IsInterfaceNil() bool {
 	if hsrs == nil {
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
@@ -17,6 +18,7 @@ type ShardedDataStub struct {
 	ClearShardStoreCalled         func(cacheId string)
 	RemoveSetOfDataFromPoolCalled func(keys [][]byte, destCacheId string)
 	CreateShardStoreCalled        func(destCacheId string)
+	SearchTxsBySenderNoncesCalled func(sender []byte, nonces []uint64) []data.TransactionHandler
 }
 
 func (sd *ShardedDataStub) RegisterHandler(handler func(key []byte)) {
@@ -35,6 +37,10 @@ func (sd *ShardedDataStub) SearchFirstData(key []byte) (value interface{}, ok bo
 	return sd.SearchFirstDataCalled(key)
 }
 
+func (sd *ShardedDataStub) SearchTxsBySenderNonces(sender []byte, nonces []uint64) []data.TransactionHandler {
+	return sd.SearchTxsBySenderNoncesCalled(sender, nonces)
+}
+
 func (sd *ShardedDataStub) RemoveData(key []byte, cacheId string) {
 	sd.RemoveDataCalled(key, cacheId)
 }
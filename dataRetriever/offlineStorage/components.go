@@ -0,0 +1,31 @@
+package offlineStorage
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// HasherFromConfig returns the hasher the node uses for the provided configuration
+func HasherFromConfig(cfg *config.Config) (hashing.Hasher, error) {
+	switch cfg.Hasher.Type {
+	case "sha256":
+		return sha256.Sha256{}, nil
+	case "blake2b":
+		return blake2b.Blake2b{}, nil
+	}
+
+	return nil, ErrUnknownHasher
+}
+
+// MarshalizerFromConfig returns the marshalizer the node uses for the provided configuration
+func MarshalizerFromConfig(cfg *config.Config) (marshal.Marshalizer, error) {
+	switch cfg.Marshalizer.Type {
+	case "json":
+		return &marshal.JsonMarshalizer{}, nil
+	}
+
+	return nil, ErrUnknownMarshalizer
+}
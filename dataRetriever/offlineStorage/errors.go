@@ -0,0 +1,15 @@
+package offlineStorage
+
+import "errors"
+
+// ErrNilConfig signals that a nil config has been provided
+var ErrNilConfig = errors.New("nil config")
+
+// ErrInvalidNumberOfShards signals that an invalid number of shards has been provided
+var ErrInvalidNumberOfShards = errors.New("invalid number of shards")
+
+// ErrUnknownHasher signals that the configured hasher type is not known
+var ErrUnknownHasher = errors.New("no hasher provided in config file")
+
+// ErrUnknownMarshalizer signals that the configured marshalizer type is not known
+var ErrUnknownMarshalizer = errors.New("no marshalizer provided in config file")
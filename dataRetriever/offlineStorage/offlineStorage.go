@@ -0,0 +1,199 @@
+package offlineStorage
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+// the node's databases folder layout, as created by cmd/node
+const (
+	defaultDBPath      = "db"
+	defaultEpochString = "Epoch"
+	defaultShardString = "Shard"
+)
+
+// ArgsStorageService holds the arguments needed to open a stopped node's storage units
+type ArgsStorageService struct {
+	Config      *config.Config
+	DBFolder    string
+	ShardId     uint32
+	NumOfShards uint32
+}
+
+// StorageService is a chain storer holding read only units. The units must be released by calling Close,
+// as some of them are shared between several unit types
+type StorageService struct {
+	*dataRetriever.ChainStorer
+	units []*storageUnit.Unit
+}
+
+// NodeDBFolder returns the folder in which a node started from the provided working directory keeps its databases.
+// The shard is either a shard index or "metachain"
+func NodeDBFolder(workingDir string, shard string) string {
+	return filepath.Join(
+		workingDir,
+		defaultDBPath,
+		fmt.Sprintf("%s_%d", defaultEpochString, 0),
+		fmt.Sprintf("%s_%s", defaultShardString, shard))
+}
+
+// NewStorageService opens, in read only mode, the storage units of a stopped node. The units are mapped the same
+// way the node maps them so the data is found under the same unit types
+func NewStorageService(args ArgsStorageService) (*StorageService, error) {
+	if args.Config == nil {
+		return nil, ErrNilConfig
+	}
+	if args.NumOfShards == 0 {
+		return nil, ErrInvalidNumberOfShards
+	}
+
+	ss := &StorageService{
+		ChainStorer: dataRetriever.NewChainStorer(),
+		units:       make([]*storageUnit.Unit, 0),
+	}
+
+	var err error
+	if args.ShardId == sharding.MetachainShardId {
+		err = ss.openMetachainUnits(args)
+	} else {
+		err = ss.openShardUnits(args)
+	}
+	if err != nil {
+		_ = ss.Close()
+		return nil, err
+	}
+
+	return ss, nil
+}
+
+func (ss *StorageService) openShardUnits(args ArgsStorageService) error {
+	cfg := args.Config
+	unitsConfig := map[dataRetriever.UnitType]config.StorageConfig{
+		dataRetriever.TransactionUnit:          cfg.TxStorage,
+		dataRetriever.MiniBlockUnit:            cfg.MiniBlocksStorage,
+		dataRetriever.PeerChangesUnit:          cfg.PeerBlockBodyStorage,
+		dataRetriever.BlockHeaderUnit:          cfg.BlockHeaderStorage,
+		dataRetriever.MetaBlockUnit:            cfg.MetaBlockStorage,
+		dataRetriever.UnsignedTransactionUnit:  cfg.UnsignedTransactionStorage,
+		dataRetriever.RewardTransactionUnit:    cfg.RewardTxStorage,
+		dataRetriever.MetaHdrNonceHashDataUnit: cfg.MetaHdrNonceHashStorage,
+		dataRetriever.BootstrapUnit:            cfg.BootstrapStorage,
+	}
+
+	for unitType, storageConfig := range unitsConfig {
+		unit, err := ss.openUnit(storageConfig, args.DBFolder, "")
+		if err != nil {
+			return err
+		}
+		ss.AddStorer(unitType, unit)
+	}
+
+	return ss.openShardHdrNonceHashUnit(cfg.ShardHdrNonceHashStorage, args.DBFolder, args.ShardId)
+}
+
+func (ss *StorageService) openMetachainUnits(args ArgsStorageService) error {
+	cfg := args.Config
+	unitsConfig := map[dataRetriever.UnitType]config.StorageConfig{
+		dataRetriever.MetaBlockUnit:            cfg.MetaBlockStorage,
+		dataRetriever.MetaShardDataUnit:        cfg.ShardDataStorage,
+		dataRetriever.MetaPeerDataUnit:         cfg.PeerDataStorage,
+		dataRetriever.BlockHeaderUnit:          cfg.BlockHeaderStorage,
+		dataRetriever.MetaHdrNonceHashDataUnit: cfg.MetaHdrNonceHashStorage,
+		dataRetriever.TransactionUnit:          cfg.TxStorage,
+		dataRetriever.UnsignedTransactionUnit:  cfg.UnsignedTransactionStorage,
+		dataRetriever.BootstrapUnit:            cfg.BootstrapStorage,
+	}
+
+	for unitType, storageConfig := range unitsConfig {
+		unit, err := ss.openUnit(storageConfig, args.DBFolder, "")
+		if err != nil {
+			return err
+		}
+		ss.AddStorer(unitType, unit)
+	}
+
+	// the metachain node writes its miniblocks in the unsigned transactions unit
+	ss.AddStorer(dataRetriever.MiniBlockUnit, ss.GetStorer(dataRetriever.UnsignedTransactionUnit))
+
+	for i := uint32(0); i < args.NumOfShards; i++ {
+		err := ss.openShardHdrNonceHashUnit(cfg.ShardHdrNonceHashStorage, args.DBFolder, i)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ss *StorageService) openShardHdrNonceHashUnit(storageConfig config.StorageConfig, dbFolder string, shardId uint32) error {
+	unit, err := ss.openUnit(storageConfig, dbFolder, fmt.Sprintf("%d", shardId))
+	if err != nil {
+		return err
+	}
+
+	ss.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit+dataRetriever.UnitType(shardId), unit)
+
+	return nil
+}
+
+func (ss *StorageService) openUnit(storageConfig config.StorageConfig, dbFolder string, pathSuffix string) (*storageUnit.Unit, error) {
+	unit, err := NewReadOnlyUnit(storageConfig, dbFolder, pathSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	ss.units = append(ss.units, unit)
+
+	return unit, nil
+}
+
+// Close closes all the opened units. The stored data is never changed
+func (ss *StorageService) Close() error {
+	var lastErr error
+	for _, unit := range ss.units {
+		err := unit.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+	ss.units = nil
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ss *StorageService) IsInterfaceNil() bool {
+	if ss == nil {
+		return true
+	}
+	return false
+}
+
+// NewReadOnlyUnit opens the database described by the storage config, found in the node's databases folder,
+// in read only mode. The path suffix is used by the sharded units
+func NewReadOnlyUnit(storageConfig config.StorageConfig, dbFolder string, pathSuffix string) (*storageUnit.Unit, error) {
+	cacheConf := storageUnit.CacheConfig{
+		Size:   storageConfig.Cache.Size,
+		Type:   storageUnit.CacheType(storageConfig.Cache.Type),
+		Shards: storageConfig.Cache.Shards,
+	}
+	dbConf := storageUnit.DBConfig{
+		FilePath:             filepath.Join(dbFolder, storageConfig.DB.FilePath) + pathSuffix,
+		Type:                 storageUnit.DBType(storageConfig.DB.Type),
+		MaxOpenFiles:         storageConfig.DB.MaxOpenFiles,
+		CompressionThreshold: storageConfig.DB.CompressionThreshold,
+	}
+
+	unit, err := storageUnit.NewReadOnlyStorageUnitFromConf(cacheConf, dbConf)
+	if err != nil {
+		return nil, errors.New("error opening " + dbConf.FilePath + ": " + err.Error())
+	}
+
+	return unit, nil
+}
@@ -0,0 +1,158 @@
+package offlineStorage_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/offlineStorage"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/stretchr/testify/assert"
+)
+
+func createStorageConfig(dbName string) config.StorageConfig {
+	return config.StorageConfig{
+		Cache: config.CacheConfig{Type: "LRU", Size: 10},
+		DB:    config.DBConfig{FilePath: dbName, Type: "LvlDBSerial", MaxOpenFiles: 10},
+	}
+}
+
+func createMockConfig() *config.Config {
+	return &config.Config{
+		TxStorage:                  createStorageConfig("Transactions"),
+		MiniBlocksStorage:          createStorageConfig("MiniBlocks"),
+		PeerBlockBodyStorage:       createStorageConfig("PeerBlocks"),
+		BlockHeaderStorage:         createStorageConfig("BlockHeaders"),
+		MetaBlockStorage:           createStorageConfig("MetaBlock"),
+		UnsignedTransactionStorage: createStorageConfig("UnsignedTransactions"),
+		RewardTxStorage:            createStorageConfig("RewardTransactions"),
+		MetaHdrNonceHashStorage:    createStorageConfig("MetaHdrHashNonce"),
+		ShardHdrNonceHashStorage:   createStorageConfig("ShardHdrHashNonce"),
+		BootstrapStorage:           createStorageConfig("BootstrapData"),
+		ShardDataStorage:           createStorageConfig("ShardData"),
+		PeerDataStorage:            createStorageConfig("PeerData"),
+	}
+}
+
+// createDatabases creates the databases the way a node would and writes the key in each of them
+func createDatabases(t *testing.T, dbFolder string, dbNames []string, key []byte) {
+	for _, dbName := range dbNames {
+		db, err := leveldb.NewSerialDB(filepath.Join(dbFolder, dbName), 1, 1, 10)
+		assert.Nil(t, err)
+		_ = db.Put(key, []byte(dbName))
+		_ = db.Close()
+	}
+}
+
+func TestNodeDBFolder(t *testing.T) {
+	t.Parallel()
+
+	folder := offlineStorage.NodeDBFolder("workdir", "metachain")
+
+	assert.Equal(t, filepath.Join("workdir", "db", "Epoch_0", "Shard_metachain"), folder)
+}
+
+func TestNewStorageService_NilConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ss, err := offlineStorage.NewStorageService(offlineStorage.ArgsStorageService{NumOfShards: 1})
+
+	assert.Nil(t, ss)
+	assert.Equal(t, offlineStorage.ErrNilConfig, err)
+}
+
+func TestNewStorageService_InvalidNumOfShardsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ss, err := offlineStorage.NewStorageService(offlineStorage.ArgsStorageService{Config: createMockConfig()})
+
+	assert.Nil(t, ss)
+	assert.Equal(t, offlineStorage.ErrInvalidNumberOfShards, err)
+}
+
+func TestNewStorageService_MissingDatabaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dbFolder, _ := ioutil.TempDir("", "offline_storage")
+	defer func() {
+		_ = os.RemoveAll(dbFolder)
+	}()
+
+	ss, err := offlineStorage.NewStorageService(offlineStorage.ArgsStorageService{
+		Config:      createMockConfig(),
+		DBFolder:    dbFolder,
+		ShardId:     0,
+		NumOfShards: 1,
+	})
+
+	assert.Nil(t, ss)
+	assert.NotNil(t, err)
+}
+
+func TestNewStorageService_ShardShouldOpenUnitsReadOnly(t *testing.T) {
+	t.Parallel()
+
+	dbFolder, _ := ioutil.TempDir("", "offline_storage")
+	defer func() {
+		_ = os.RemoveAll(dbFolder)
+	}()
+	key := []byte("key")
+	createDatabases(t, dbFolder, []string{"Transactions", "MiniBlocks", "PeerBlocks", "BlockHeaders", "MetaBlock",
+		"UnsignedTransactions", "RewardTransactions", "MetaHdrHashNonce", "ShardHdrHashNonce1", "BootstrapData"}, key)
+
+	ss, err := offlineStorage.NewStorageService(offlineStorage.ArgsStorageService{
+		Config:      createMockConfig(),
+		DBFolder:    dbFolder,
+		ShardId:     1,
+		NumOfShards: 2,
+	})
+	assert.Nil(t, err)
+
+	val, err := ss.Get(dataRetriever.ShardHdrNonceHashDataUnit+1, key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("ShardHdrHashNonce1"), val)
+
+	val, err = ss.Get(dataRetriever.MiniBlockUnit, key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("MiniBlocks"), val)
+
+	err = ss.Put(dataRetriever.BlockHeaderUnit, key, []byte("value"))
+	assert.Equal(t, storage.ErrReadOnlyPersister, err)
+
+	assert.Nil(t, ss.Close())
+}
+
+func TestNewStorageService_MetachainShouldMapMiniBlocksToUnsignedTransactions(t *testing.T) {
+	t.Parallel()
+
+	dbFolder, _ := ioutil.TempDir("", "offline_storage")
+	defer func() {
+		_ = os.RemoveAll(dbFolder)
+	}()
+	key := []byte("key")
+	createDatabases(t, dbFolder, []string{"MetaBlock", "ShardData", "PeerData", "BlockHeaders", "MetaHdrHashNonce",
+		"Transactions", "UnsignedTransactions", "BootstrapData", "ShardHdrHashNonce0", "ShardHdrHashNonce1"}, key)
+
+	ss, err := offlineStorage.NewStorageService(offlineStorage.ArgsStorageService{
+		Config:      createMockConfig(),
+		DBFolder:    dbFolder,
+		ShardId:     sharding.MetachainShardId,
+		NumOfShards: 2,
+	})
+	assert.Nil(t, err)
+
+	val, err := ss.Get(dataRetriever.MiniBlockUnit, key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("UnsignedTransactions"), val)
+
+	val, err = ss.Get(dataRetriever.ShardHdrNonceHashDataUnit+1, key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("ShardHdrHashNonce1"), val)
+
+	assert.Nil(t, ss.Close())
+}
@@ -18,6 +18,8 @@ func (rdt RequestDataType) String() string {
 		return "hash array type"
 	case NonceType:
 		return "nonce type"
+	case SenderNoncesType:
+		return "sender nonces type"
 	default:
 		return fmt.Sprintf("unknown type %d", rdt)
 	}
@@ -30,6 +32,8 @@ const (
 	HashArrayType
 	// NonceType indicates that the request data object is of type nonce (uint64)
 	NonceType
+	// SenderNoncesType indicates that the request data object contains a serialised SenderNonces object
+	SenderNoncesType
 )
 
 // RequestData holds the requested data
@@ -39,6 +43,16 @@ type RequestData struct {
 	Value []byte
 }
 
+// MaxNoncesPerSenderRequest is the maximum number of nonces a SenderNoncesType request may hold
+const MaxNoncesPerSenderRequest = 100
+
+// SenderNonces holds a sender address together with the nonces of its transactions that are requested
+// This struct will be serialized as the value of a SenderNoncesType request
+type SenderNonces struct {
+	Sender []byte
+	Nonces []uint64
+}
+
 // Unmarshal sets the fields according to p2p.MessageP2P.Data() contents
 // Errors if something went wrong
 func (rd *RequestData) Unmarshal(marshalizer marshal.Marshalizer, message p2p.MessageP2P) error {
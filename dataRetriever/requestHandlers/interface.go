@@ -5,3 +5,9 @@ type HashSliceResolver interface {
 	RequestDataFromHashArray(hashes [][]byte) error
 	IsInterfaceNil() bool
 }
+
+// SenderNoncesResolver can request the transactions of a sender by their nonces
+type SenderNoncesResolver interface {
+	RequestDataFromSenderNonces(sender []byte, nonces []uint64) error
+	IsInterfaceNil() bool
+}
@@ -182,6 +182,59 @@ func (rrh *resolverRequestHandler) requestByHashes(destShardID uint32, hashes []
 	}()
 }
 
+// RequestTransactionsBySenderNonces method asks the connected peers for the transactions of a sender having the
+// provided nonces. Nonces requested recently are skipped
+func (rrh *resolverRequestHandler) RequestTransactionsBySenderNonces(destShardID uint32, sender []byte, nonces []uint64) {
+	rrh.sweepIfNeeded()
+
+	unrequestedNonces := make([]uint64, 0, len(nonces))
+	for _, nonce := range nonces {
+		key := fmt.Sprintf("%s-%d", sender, nonce)
+		if rrh.requestedItemsHandler.Has(key) {
+			continue
+		}
+
+		unrequestedNonces = append(unrequestedNonces, nonce)
+		err := rrh.requestedItemsHandler.Add(key)
+		if err != nil {
+			log.Trace("add requested item with error",
+				"error", err.Error(),
+				"key", key)
+		}
+	}
+
+	if len(unrequestedNonces) == 0 {
+		return
+	}
+
+	log.Trace("requesting transactions by sender nonces from network",
+		"sender", sender,
+		"num nonces", len(unrequestedNonces),
+		"topic", rrh.txRequestTopic,
+		"shard", destShardID,
+	)
+
+	resolver, err := rrh.resolversFinder.CrossShardResolver(rrh.txRequestTopic, destShardID)
+	if err != nil {
+		log.Error("missing resolver",
+			"topic", rrh.txRequestTopic,
+			"shard", destShardID,
+		)
+		return
+	}
+
+	txResolver, ok := resolver.(SenderNoncesResolver)
+	if !ok {
+		log.Debug("wrong assertion type when creating transaction resolver")
+		return
+	}
+
+	err = txResolver.RequestDataFromSenderNonces(sender, unrequestedNonces)
+	if err != nil {
+		log.Debug("RequestDataFromSenderNonces", "error", err.Error())
+	}
+}
+
 // RequestUnsignedTransactions method asks for unsigned transactions from the connected peers
 func (rrh *resolverRequestHandler) RequestUnsignedTransactions(destShardID uint32, scrHashes [][]byte) {
 	rrh.requestByHashes(destShardID, scrHashes, rrh.scrRequestTopic)
@@ -443,6 +443,79 @@ func TestResolverRequestHandler_RequestTransactionShouldRequestTransactions(t *t
 	time.Sleep(time.Second)
 }
 
+func TestResolverRequestHandler_RequestTransactionsBySenderNoncesShouldRequestUnrequestedNonces(t *testing.T) {
+	t.Parallel()
+
+	requestedSender := []byte(nil)
+	requestedNonces := []uint64(nil)
+	txResolver := &mock.HashSliceResolverStub{
+		RequestDataFromSenderNoncesCalled: func(sender []byte, nonces []uint64) error {
+			requestedSender = sender
+			requestedNonces = nonces
+			return nil
+		},
+	}
+
+	addedKeys := make([]string, 0)
+	rrh, _ := NewShardResolverRequestHandler(
+		&mock.ResolversFinderStub{
+			CrossShardResolverCalled: func(baseTopic string, crossShard uint32) (resolver dataRetriever.Resolver, e error) {
+				assert.Equal(t, "txTopic", baseTopic)
+				return txResolver, nil
+			},
+		},
+		&mock.RequestedItemsHandlerStub{
+			HasCalled: func(key string) bool {
+				return key == "sender-4"
+			},
+			AddCalled: func(key string) error {
+				addedKeys = append(addedKeys, key)
+				return nil
+			},
+		},
+		"txTopic",
+		"topic",
+		"topic",
+		"topic",
+		"topic",
+		"topic",
+		1,
+	)
+
+	rrh.RequestTransactionsBySenderNonces(0, []byte("sender"), []uint64{3, 4, 5})
+
+	assert.Equal(t, []byte("sender"), requestedSender)
+	assert.Equal(t, []uint64{3, 5}, requestedNonces)
+	assert.Equal(t, []string{"sender-3", "sender-5"}, addedKeys)
+}
+
+func TestResolverRequestHandler_RequestTransactionsBySenderNoncesAllRequestedShouldNotRequest(t *testing.T) {
+	t.Parallel()
+
+	rrh, _ := NewShardResolverRequestHandler(
+		&mock.ResolversFinderStub{
+			CrossShardResolverCalled: func(baseTopic string, crossShard uint32) (resolver dataRetriever.Resolver, e error) {
+				assert.Fail(t, "should have not searched the resolver")
+				return nil, nil
+			},
+		},
+		&mock.RequestedItemsHandlerStub{
+			HasCalled: func(key string) bool {
+				return true
+			},
+		},
+		"txTopic",
+		"topic",
+		"topic",
+		"topic",
+		"topic",
+		"topic",
+		1,
+	)
+
+	rrh.RequestTransactionsBySenderNonces(0, []byte("sender"), []uint64{3, 4})
+}
+
 func TestResolverRequestHandler_RequestTransactionErrorsOnRequestShouldNotPanic(t *testing.T) {
 	t.Parallel()
 
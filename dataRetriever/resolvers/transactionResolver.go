@@ -77,6 +77,8 @@ func (txRes *TxResolver) ProcessReceivedMessage(message p2p.MessageP2P, _ func(b
 		return txRes.Send(buff, message.Peer())
 	case dataRetriever.HashArrayType:
 		return txRes.resolveTxRequestByHashArray(rd.Value, message.Peer())
+	case dataRetriever.SenderNoncesType:
+		return txRes.resolveTxRequestBySenderNonces(rd.Value, message.Peer())
 	default:
 		return dataRetriever.ErrRequestTypeNotImplemented
 	}
@@ -147,6 +149,50 @@ func (txRes *TxResolver) resolveTxRequestByHashArray(hashesBuff []byte, pid p2p.
 	return nil
 }
 
+func (txRes *TxResolver) resolveTxRequestBySenderNonces(senderNoncesBuff []byte, pid p2p.PeerID) error {
+	searcher, ok := txRes.txPool.(dataRetriever.TxsBySenderNoncesSearcher)
+	if !ok {
+		return dataRetriever.ErrRequestTypeNotImplemented
+	}
+
+	senderNonces := &dataRetriever.SenderNonces{}
+	err := txRes.marshalizer.Unmarshal(senderNonces, senderNoncesBuff)
+	if err != nil {
+		return err
+	}
+	if len(senderNonces.Nonces) > dataRetriever.MaxNoncesPerSenderRequest {
+		return dataRetriever.ErrTooManyNoncesRequested
+	}
+
+	txs := searcher.SearchTxsBySenderNonces(senderNonces.Sender, senderNonces.Nonces)
+	if len(txs) == 0 {
+		return nil
+	}
+
+	txsBuffSlice := make([][]byte, 0, len(txs))
+	for _, tx := range txs {
+		txBuff, err := txRes.marshalizer.Marshal(tx)
+		if err != nil {
+			return err
+		}
+		txsBuffSlice = append(txsBuffSlice, txBuff)
+	}
+
+	buffsToSend, err := txRes.dataPacker.PackDataInChunks(txsBuffSlice, maxBuffToSendBulkTransactions)
+	if err != nil {
+		return err
+	}
+
+	for _, buff := range buffsToSend {
+		err = txRes.Send(buff, pid)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // RequestDataFromHash requests a transaction from other peers having input the tx hash
 func (txRes *TxResolver) RequestDataFromHash(hash []byte) error {
 	return txRes.SendOnRequestTopic(&dataRetriever.RequestData{
@@ -168,6 +214,22 @@ func (txRes *TxResolver) RequestDataFromHashArray(hashes [][]byte) error {
 	})
 }
 
+// RequestDataFromSenderNonces requests from other peers the transactions of a sender having the provided nonces
+func (txRes *TxResolver) RequestDataFromSenderNonces(sender []byte, nonces []uint64) error {
+	buffSenderNonces, err := txRes.marshalizer.Marshal(&dataRetriever.SenderNonces{
+		Sender: sender,
+		Nonces: nonces,
+	})
+	if err != nil {
+		return err
+	}
+
+	return txRes.SendOnRequestTopic(&dataRetriever.RequestData{
+		Type:  dataRetriever.SenderNoncesType,
+		Value: buffSenderNonces,
+	})
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (txRes *TxResolver) IsInterfaceNil() bool {
 	if txRes == nil {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
@@ -405,6 +406,138 @@ func TestTxResolver_ProcessReceivedMessageRequestedTwoSmallTransactionsShouldCal
 	assert.True(t, sendSliceWasCalled)
 }
 
+func TestTxResolver_ProcessReceivedMessageSenderNoncesPoolNotSearchableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	txPool := struct {
+		dataRetriever.ShardedDataCacherNotifier
+	}{
+		ShardedDataCacherNotifier: &mock.ShardedDataStub{},
+	}
+	txRes, _ := NewTxResolver(
+		&mock.TopicResolverSenderStub{},
+		txPool,
+		&mock.StorerStub{},
+		marshalizer,
+		&mock.DataPackerStub{},
+	)
+
+	buff, _ := marshalizer.Marshal(&dataRetriever.SenderNonces{Sender: []byte("sender"), Nonces: []uint64{1}})
+	requestData, _ := marshalizer.Marshal(&dataRetriever.RequestData{Type: dataRetriever.SenderNoncesType, Value: buff})
+
+	err := txRes.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: requestData}, nil)
+
+	assert.Equal(t, dataRetriever.ErrRequestTypeNotImplemented, err)
+}
+
+func TestTxResolver_ProcessReceivedMessageSenderNoncesNothingFoundShouldNotSend(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	txRes, _ := NewTxResolver(
+		&mock.TopicResolverSenderStub{
+			SendCalled: func(buff []byte, peer p2p.PeerID) error {
+				assert.Fail(t, "should have not sent")
+				return nil
+			},
+		},
+		&mock.ShardedDataStub{
+			SearchTxsBySenderNoncesCalled: func(sender []byte, nonces []uint64) []data.TransactionHandler {
+				return nil
+			},
+		},
+		&mock.StorerStub{},
+		marshalizer,
+		&mock.DataPackerStub{},
+	)
+
+	buff, _ := marshalizer.Marshal(&dataRetriever.SenderNonces{Sender: []byte("sender"), Nonces: []uint64{1}})
+	requestData, _ := marshalizer.Marshal(&dataRetriever.RequestData{Type: dataRetriever.SenderNoncesType, Value: buff})
+
+	err := txRes.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: requestData}, nil)
+
+	assert.Nil(t, err)
+}
+
+func TestTxResolver_ProcessReceivedMessageSenderNoncesTooManyNoncesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	txRes, _ := NewTxResolver(
+		&mock.TopicResolverSenderStub{
+			SendCalled: func(buff []byte, peer p2p.PeerID) error {
+				assert.Fail(t, "should have not sent")
+				return nil
+			},
+		},
+		&mock.ShardedDataStub{
+			SearchTxsBySenderNoncesCalled: func(sender []byte, nonces []uint64) []data.TransactionHandler {
+				assert.Fail(t, "should have not searched")
+				return nil
+			},
+		},
+		&mock.StorerStub{},
+		marshalizer,
+		&mock.DataPackerStub{},
+	)
+
+	nonces := make([]uint64, dataRetriever.MaxNoncesPerSenderRequest+1)
+	buff, _ := marshalizer.Marshal(&dataRetriever.SenderNonces{Sender: []byte("sender"), Nonces: nonces})
+	requestData, _ := marshalizer.Marshal(&dataRetriever.RequestData{Type: dataRetriever.SenderNoncesType, Value: buff})
+
+	err := txRes.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: requestData}, nil)
+
+	assert.Equal(t, dataRetriever.ErrTooManyNoncesRequested, err)
+}
+
+func TestTxResolver_ProcessReceivedMessageSenderNoncesShouldSearchAndSend(t *testing.T) {
+	t.Parallel()
+
+	sender := []byte("sender")
+	nonces := []uint64{3, 4}
+	tx3 := &transaction.Transaction{Nonce: 3, SndAddr: sender}
+	tx4 := &transaction.Transaction{Nonce: 4, SndAddr: sender}
+
+	marshalizer := &mock.MarshalizerMock{}
+	packedTxs := make([][]byte, 0)
+	sentBuffs := make([][]byte, 0)
+	txRes, _ := NewTxResolver(
+		&mock.TopicResolverSenderStub{
+			SendCalled: func(buff []byte, peer p2p.PeerID) error {
+				sentBuffs = append(sentBuffs, buff)
+				return nil
+			},
+		},
+		&mock.ShardedDataStub{
+			SearchTxsBySenderNoncesCalled: func(snd []byte, n []uint64) []data.TransactionHandler {
+				assert.Equal(t, sender, snd)
+				assert.Equal(t, nonces, n)
+				return []data.TransactionHandler{tx3, tx4}
+			},
+		},
+		&mock.StorerStub{},
+		marshalizer,
+		&mock.DataPackerStub{
+			PackDataInChunksCalled: func(data [][]byte, limit int) ([][]byte, error) {
+				packedTxs = data
+				return [][]byte{[]byte("chunk")}, nil
+			},
+		},
+	)
+
+	buff, _ := marshalizer.Marshal(&dataRetriever.SenderNonces{Sender: sender, Nonces: nonces})
+	requestData, _ := marshalizer.Marshal(&dataRetriever.RequestData{Type: dataRetriever.SenderNoncesType, Value: buff})
+
+	err := txRes.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: requestData}, nil)
+
+	tx3Buff, _ := marshalizer.Marshal(tx3)
+	tx4Buff, _ := marshalizer.Marshal(tx4)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{tx3Buff, tx4Buff}, packedTxs)
+	assert.Equal(t, [][]byte{[]byte("chunk")}, sentBuffs)
+}
+
 //------- RequestTransactionFromHash
 
 func TestTxResolver_RequestDataFromHashShouldWork(t *testing.T) {
@@ -469,3 +602,37 @@ func TestTxResolver_RequestDataFromHashArrayShouldWork(t *testing.T) {
 	}, requested)
 
 }
+
+//------- RequestDataFromSenderNonces
+
+func TestTxResolver_RequestDataFromSenderNoncesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	requested := &dataRetriever.RequestData{}
+
+	res := &mock.TopicResolverSenderStub{}
+	res.SendOnRequestTopicCalled = func(rd *dataRetriever.RequestData) error {
+		requested = rd
+		return nil
+	}
+
+	sender := []byte("sender")
+	nonces := []uint64{5, 7}
+
+	marshalizer := &mock.MarshalizerMock{}
+	txRes, _ := NewTxResolver(
+		res,
+		&mock.ShardedDataStub{},
+		&mock.StorerStub{},
+		marshalizer,
+		&mock.DataPackerStub{},
+	)
+
+	buff, _ := marshalizer.Marshal(&dataRetriever.SenderNonces{Sender: sender, Nonces: nonces})
+
+	assert.Nil(t, txRes.RequestDataFromSenderNonces(sender, nonces))
+	assert.Equal(t, &dataRetriever.RequestData{
+		Type:  dataRetriever.SenderNoncesType,
+		Value: buff,
+	}, requested)
+}
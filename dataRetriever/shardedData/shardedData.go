@@ -3,9 +3,11 @@ package shardedData
 import (
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/logger"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 )
 
 var log = logger.GetOrCreate("dataretriever/shardeddata")
@@ -23,6 +25,7 @@ type shardedData struct {
 	//  data hashes that have that shard as destination
 	shardedDataStore map[string]*shardStore
 	cacherConfig     storageUnit.CacheConfig
+	newCacher        func() (storage.Cacher, error)
 
 	mutAddedDataHandlers sync.RWMutex
 	addedDataHandlers    []func(key []byte)
@@ -35,28 +38,44 @@ type shardStore struct {
 
 // NewShardedData is responsible for creating an empty pool of data
 func NewShardedData(cacherConfig storageUnit.CacheConfig) (*shardedData, error) {
-	err := verifyCacherConfig(cacherConfig)
-	if err != nil {
-		return nil, err
+	newCacher := func() (storage.Cacher, error) {
+		return storageUnit.NewCache(cacherConfig.Type, cacherConfig.Size, cacherConfig.Shards)
 	}
 
-	return &shardedData{
+	return newShardedData(cacherConfig, newCacher)
+}
+
+// NewShardedTxData creates an empty pool of transactions. Each shard store is a transactions cache that groups the
+// transactions by sender and allows a pending transaction to be replaced by one paying a higher gas price
+func NewShardedTxData(size uint32, maxTxsPerSender uint32, minGasPriceIncreasePercent uint32) (*shardedData, error) {
+	newCacher := func() (storage.Cacher, error) {
+		return txcache.NewTxCache(int(size), int(maxTxsPerSender), minGasPriceIncreasePercent)
+	}
+
+	return newShardedData(storageUnit.CacheConfig{Size: size}, newCacher)
+}
+
+func newShardedData(cacherConfig storageUnit.CacheConfig, newCacher func() (storage.Cacher, error)) (*shardedData, error) {
+	sd := &shardedData{
 		cacherConfig:         cacherConfig,
+		newCacher:            newCacher,
 		mutShardedDataStore:  sync.RWMutex{},
 		shardedDataStore:     make(map[string]*shardStore),
 		mutAddedDataHandlers: sync.RWMutex{},
 		addedDataHandlers:    make([]func(key []byte), 0),
-	}, nil
-}
+	}
+
+	_, err := sd.newShardStore("")
+	if err != nil {
+		return nil, err
+	}
 
-func verifyCacherConfig(cacherConfig storageUnit.CacheConfig) error {
-	_, err := newShardStore("", cacherConfig)
-	return err
+	return sd, nil
 }
 
 // newShardStore is responsible for creating an empty shardStore
-func newShardStore(cacheId string, cacherConfig storageUnit.CacheConfig) (*shardStore, error) {
-	cacher, err := storageUnit.NewCache(cacherConfig.Type, cacherConfig.Size, cacherConfig.Shards)
+func (sd *shardedData) newShardStore(cacheId string) (*shardStore, error) {
+	cacher, err := sd.newCacher()
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +94,7 @@ func (sd *shardedData) CreateShardStore(cacheId string) {
 }
 
 func (sd *shardedData) newShardStoreNoLock(cacheId string) *shardStore {
-	shardStore, err := newShardStore(cacheId, sd.cacherConfig)
+	shardStore, err := sd.newShardStore(cacheId)
 	if err != nil {
 		log.Debug("newShardStore", "error", err.Error())
 	}
@@ -113,9 +132,9 @@ func (sd *shardedData) AddData(key []byte, data interface{}, cacheId string) {
 	}
 	sd.mutShardedDataStore.Unlock()
 
-	found, _ := mp.DataStore.HasOrAdd(key, data)
+	notAdded, _ := mp.DataStore.HasOrAdd(key, data)
 
-	if !found {
+	if !notAdded {
 		sd.mutAddedDataHandlers.RLock()
 		for _, handler := range sd.addedDataHandlers {
 			go handler(key)
@@ -144,6 +163,30 @@ func (sd *shardedData) SearchFirstData(key []byte) (value interface{}, ok bool)
 	return nil, false
 }
 
+// SearchTxsBySenderNonces searches, against all the transaction caches, the pending transactions of the sender
+// having the provided nonces. Shard stores that do not index the transactions by sender are skipped
+func (sd *shardedData) SearchTxsBySenderNonces(sender []byte, nonces []uint64) []data.TransactionHandler {
+	txs := make([]data.TransactionHandler, 0)
+
+	sd.mutShardedDataStore.RLock()
+	defer sd.mutShardedDataStore.RUnlock()
+
+	for _, store := range sd.shardedDataStore {
+		if store == nil || store.DataStore == nil {
+			continue
+		}
+
+		txCache, ok := store.DataStore.(*txcache.TxCache)
+		if !ok {
+			continue
+		}
+
+		txs = append(txs, txCache.GetTransactionsBySenderAndNonces(sender, nonces)...)
+	}
+
+	return txs
+}
+
 // RemoveSetOfDataFromPool removes a list of keys from the corresponding pool
 func (sd *shardedData) RemoveSetOfDataFromPool(keys [][]byte, cacheId string) {
 	for _, key := range keys {
@@ -10,7 +10,9 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -43,6 +45,28 @@ func TestNewShardedData_GoodConfigShouldWork(t *testing.T) {
 	assert.NotNil(t, sd)
 }
 
+func TestNewShardedTxData_BadSizeShouldErr(t *testing.T) {
+	sd, err := shardedData.NewShardedTxData(0, 10, 10)
+	assert.Equal(t, storage.ErrCacheSizeInvalid, err)
+	assert.Nil(t, sd)
+}
+
+func TestNewShardedTxData_BadMaxTxsPerSenderShouldErr(t *testing.T) {
+	sd, err := shardedData.NewShardedTxData(10, 0, 10)
+	assert.Equal(t, storage.ErrInvalidMaxTxsPerSender, err)
+	assert.Nil(t, sd)
+}
+
+func TestNewShardedTxData_ShardStoresShouldBeTxCaches(t *testing.T) {
+	sd, err := shardedData.NewShardedTxData(10, 10, 10)
+	assert.Nil(t, err)
+
+	sd.AddData([]byte("hash_tx1"), &transaction.Transaction{Nonce: 1}, "1")
+
+	_, isTxCache := sd.ShardDataStore("1").(*txcache.TxCache)
+	assert.True(t, isTxCache)
+}
+
 func TestShardedData_AddData(t *testing.T) {
 	t.Parallel()
 
@@ -292,6 +316,29 @@ func TestShardedData_RegisterAddedDataHandlerNotAddedShouldNotCall(t *testing.T)
 	assert.Equal(t, 1, len(sd.AddedDataHandlers()))
 }
 
+func TestShardedData_RegisterAddedDataHandlerReplacementRefusedShouldNotCall(t *testing.T) {
+	t.Parallel()
+
+	chCalled := make(chan []byte, 1)
+	f := func(key []byte) {
+		chCalled <- key
+	}
+
+	sd, _ := shardedData.NewShardedTxData(10, 10, 10)
+	sd.AddData([]byte("hash_tx1"), &transaction.Transaction{Nonce: 1, SndAddr: []byte("alice"), GasPrice: 100}, "0")
+	sd.RegisterHandler(f)
+	//same sender and nonce without enough gas price increase, should not call as the data was not added
+	sd.AddData([]byte("hash_tx2"), &transaction.Transaction{Nonce: 1, SndAddr: []byte("alice"), GasPrice: 105}, "0")
+
+	select {
+	case <-chCalled:
+		assert.Fail(t, "should have not been called")
+	case <-time.After(timeoutWaitForWaitGroups):
+	}
+
+	assert.False(t, sd.ShardDataStore("0").Has([]byte("hash_tx2")))
+}
+
 func TestShardedData_SearchFirstDataNotFoundShouldRetNilAndFalse(t *testing.T) {
 	t.Parallel()
 
@@ -315,3 +362,33 @@ func TestShardedData_SearchFirstDataFoundShouldRetResults(t *testing.T) {
 	assert.NotNil(t, value)
 	assert.True(t, ok)
 }
+
+func TestShardedData_SearchTxsBySenderNoncesShouldSearchAllTxCaches(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := shardedData.NewShardedTxData(100, 100, 10)
+
+	sd.AddData([]byte("hash1"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 1}, "0")
+	sd.AddData([]byte("hash2"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 2}, "0_1")
+	sd.AddData([]byte("hash3"), &transaction.Transaction{SndAddr: []byte("bob"), Nonce: 2}, "0")
+
+	txs := sd.SearchTxsBySenderNonces([]byte("alice"), []uint64{1, 2, 3})
+
+	nonces := make([]uint64, 0)
+	for _, tx := range txs {
+		assert.Equal(t, []byte("alice"), tx.GetSndAddress())
+		nonces = append(nonces, tx.GetNonce())
+	}
+	assert.ElementsMatch(t, []uint64{1, 2}, nonces)
+}
+
+func TestShardedData_SearchTxsBySenderNoncesNotTxCachesShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := shardedData.NewShardedData(defaultTestConfig)
+
+	sd.AddData([]byte("hash1"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 1}, "0")
+
+	txs := sd.SearchTxsBySenderNonces([]byte("alice"), []uint64{1})
+	assert.Equal(t, 0, len(txs))
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/logger"
@@ -166,9 +167,13 @@ func (ef *ElrondNodeFacade) CreateTransaction(
 	data string,
 	signatureHex string,
 	challenge string,
+	chainID string,
+	version uint32,
+	validAfterRound uint64,
+	validUntilRound uint64,
 ) (*transaction.Transaction, error) {
 
-	return ef.node.CreateTransaction(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge)
+	return ef.node.CreateTransaction(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge, chainID, version, validAfterRound, validUntilRound)
 }
 
 // SendTransaction will send a new transaction on the topic channel
@@ -181,9 +186,13 @@ func (ef *ElrondNodeFacade) SendTransaction(
 	gasLimit uint64,
 	transactionData string,
 	signature []byte,
+	chainID string,
+	version uint32,
+	validAfterRound uint64,
+	validUntilRound uint64,
 ) (string, error) {
 
-	return ef.node.SendTransaction(nonce, senderHex, receiverHex, value, gasPrice, gasLimit, transactionData, signature)
+	return ef.node.SendTransaction(nonce, senderHex, receiverHex, value, gasPrice, gasLimit, transactionData, signature, chainID, version, validAfterRound, validUntilRound)
 }
 
 // SendBulkTransactions will send a bulk of transactions on the topic channel
@@ -196,6 +205,16 @@ func (ef *ElrondNodeFacade) GetTransaction(hash string) (*transaction.Transactio
 	return ef.node.GetTransaction(hash)
 }
 
+// GetTransactionReceipt gets the receipt of the executed transaction with a specified hash
+func (ef *ElrondNodeFacade) GetTransactionReceipt(hash string) (*receipt.Receipt, error) {
+	return ef.node.GetTransactionReceipt(hash)
+}
+
+// GetLogsBloom gets the bloom of the event logs emitted in the block with a specified hash
+func (ef *ElrondNodeFacade) GetLogsBloom(blockHash string) (receipt.Bloom, error) {
+	return ef.node.GetLogsBloom(blockHash)
+}
+
 // GetAccount returns an accountResponse containing information
 // about the account correlated with provided address
 func (ef *ElrondNodeFacade) GetAccount(address string) (*state.Account, error) {
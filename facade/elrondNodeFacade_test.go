@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/facade/mock"
@@ -212,6 +213,44 @@ func TestElrondFacade_GetTransactionWithUnknowHashShouldReturnNilAndNoError(t *t
 	assert.Nil(t, tx)
 }
 
+func TestElrondFacade_GetTransactionReceiptShouldReturnReceiptFromNode(t *testing.T) {
+	testHash := "testHash"
+	testReceipt := &receipt.Receipt{ReturnCode: "ok"}
+	node := &mock.NodeMock{
+		GetTransactionReceiptHandler: func(hash string) (*receipt.Receipt, error) {
+			if hash == testHash {
+				return testReceipt, nil
+			}
+			return nil, errors.New("receipt not found")
+		},
+	}
+
+	ef := createElrondNodeFacadeWithMockResolver(node)
+
+	rcpt, err := ef.GetTransactionReceipt(testHash)
+	assert.Nil(t, err)
+	assert.Equal(t, testReceipt, rcpt)
+}
+
+func TestElrondFacade_GetLogsBloomShouldReturnBloomFromNode(t *testing.T) {
+	testHash := "testHash"
+	testBloom := receipt.NewBloom()
+	node := &mock.NodeMock{
+		GetLogsBloomHandler: func(blockHash string) (receipt.Bloom, error) {
+			if blockHash == testHash {
+				return testBloom, nil
+			}
+			return nil, errors.New("bloom not found")
+		},
+	}
+
+	ef := createElrondNodeFacadeWithMockResolver(node)
+
+	bloom, err := ef.GetLogsBloom(testHash)
+	assert.Nil(t, err)
+	assert.Equal(t, testBloom, bloom)
+}
+
 func TestElrondNodeFacade_SetSyncer(t *testing.T) {
 	node := &mock.NodeMock{}
 
@@ -229,7 +268,7 @@ func TestElrondNodeFacade_SendTransaction(t *testing.T) {
 		return "", nil
 	}
 	ef := createElrondNodeFacadeWithMockResolver(node)
-	_, _ = ef.SendTransaction(1, "test", "test", "0", 0, 0, "code", []byte{})
+	_, _ = ef.SendTransaction(1, "test", "test", "0", 0, 0, "code", []byte{}, "chainID", 1, 0, 0)
 	assert.Equal(t, called, 1)
 }
 
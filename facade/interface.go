@@ -3,6 +3,7 @@ package facade
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -30,10 +31,10 @@ type NodeWrapper interface {
 
 	//CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-		gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error)
+		gasLimit uint64, data string, signatureHex string, challenge string, chainID string, version uint32, validAfterRound uint64, validUntilRound uint64) (*transaction.Transaction, error)
 
 	//SendTransaction will send a new transaction on the 'send transactions pipe' channel
-	SendTransaction(nonce uint64, senderHex string, receiverHex string, value string, gasPrice uint64, gasLimit uint64, transactionData string, signature []byte) (string, error)
+	SendTransaction(nonce uint64, senderHex string, receiverHex string, value string, gasPrice uint64, gasLimit uint64, transactionData string, signature []byte, chainID string, version uint32, validAfterRound uint64, validUntilRound uint64) (string, error)
 
 	//SendBulkTransactions will send a bulk of transactions on the 'send transactions pipe' channel
 	SendBulkTransactions(txs []*transaction.Transaction) (uint64, error)
@@ -41,6 +42,12 @@ type NodeWrapper interface {
 	//GetTransaction gets the transaction
 	GetTransaction(hash string) (*transaction.Transaction, error)
 
+	//GetTransactionReceipt gets the receipt of an executed transaction
+	GetTransactionReceipt(hash string) (*receipt.Receipt, error)
+
+	//GetLogsBloom gets the bloom of the event logs emitted in a block
+	GetLogsBloom(blockHash string) (receipt.Bloom, error)
+
 	// GetAccount returns an accountResponse containing information
 	//  about the account corelated with provided address
 	GetAccount(address string) (*state.Account, error)
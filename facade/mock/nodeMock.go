@@ -3,6 +3,7 @@ package mock
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
@@ -19,7 +20,7 @@ type NodeMock struct {
 	GetBalanceHandler          func(address string) (*big.Int, error)
 	GenerateTransactionHandler func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-		gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error)
+		gasLimit uint64, data string, signatureHex string, challenge string, chainID string, version uint32, validAfterRound uint64, validUntilRound uint64) (*transaction.Transaction, error)
 	GetTransactionHandler                          func(hash string) (*transaction.Transaction, error)
 	SendTransactionHandler                         func(nonce uint64, sender string, receiver string, amount string, code string, signature []byte) (string, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
@@ -28,6 +29,8 @@ type NodeMock struct {
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	GetHeartbeatsHandler                           func() []heartbeat.PubKeyHeartbeat
+	GetTransactionReceiptHandler                   func(hash string) (*receipt.Receipt, error)
+	GetLogsBloomHandler                            func(blockHash string) (receipt.Bloom, error)
 }
 
 func (nm *NodeMock) Address() (string, error) {
@@ -63,16 +66,24 @@ func (nm *NodeMock) GenerateTransaction(sender string, receiver string, amount s
 }
 
 func (nm *NodeMock) CreateTransaction(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-	gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error) {
+	gasLimit uint64, data string, signatureHex string, challenge string, chainID string, version uint32, validAfterRound uint64, validUntilRound uint64) (*transaction.Transaction, error) {
 
-	return nm.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge)
+	return nm.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge, chainID, version, validAfterRound, validUntilRound)
 }
 
 func (nm *NodeMock) GetTransaction(hash string) (*transaction.Transaction, error) {
 	return nm.GetTransactionHandler(hash)
 }
 
-func (nm *NodeMock) SendTransaction(nonce uint64, sender string, receiver string, value string, gasPrice uint64, gasLimit uint64, transactionData string, signature []byte) (string, error) {
+func (nm *NodeMock) GetTransactionReceipt(hash string) (*receipt.Receipt, error) {
+	return nm.GetTransactionReceiptHandler(hash)
+}
+
+func (nm *NodeMock) GetLogsBloom(blockHash string) (receipt.Bloom, error) {
+	return nm.GetLogsBloomHandler(blockHash)
+}
+
+func (nm *NodeMock) SendTransaction(nonce uint64, sender string, receiver string, value string, gasPrice uint64, gasLimit uint64, transactionData string, signature []byte, chainID string, version uint32, validAfterRound uint64, validUntilRound uint64) (string, error) {
 	return nm.SendTransactionHandler(nonce, sender, receiver, value, transactionData, signature)
 }
 
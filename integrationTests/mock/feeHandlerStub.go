@@ -13,6 +13,7 @@ type FeeHandlerStub struct {
 	MaxGasLimitPerBlockCalled    func() uint64
 	ComputeGasLimitCalled        func(tx process.TransactionWithFeeHandler) uint64
 	ComputeFeeCalled             func(tx process.TransactionWithFeeHandler) *big.Int
+	DeveloperPercentageCalled    func() float64
 	CheckValidityTxValuesCalled  func(tx process.TransactionWithFeeHandler) error
 }
 
@@ -53,6 +54,13 @@ func (fhs *FeeHandlerStub) CheckValidityTxValues(tx process.TransactionWithFeeHa
 	return nil
 }
 
+func (fhs *FeeHandlerStub) DeveloperPercentage() float64 {
+	if fhs.DeveloperPercentageCalled != nil {
+		return fhs.DeveloperPercentageCalled()
+	}
+	return 0
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (fhs *FeeHandlerStub) IsInterfaceNil() bool {
 	if fhs == nil {
@@ -223,6 +223,8 @@ func putDeploySCToDataPool(
 		GasPrice: nodes[0].EconomicsData.GetMinGasPrice(),
 		GasLimit: nodes[0].EconomicsData.MaxGasLimitPerBlock() - 1,
 		Data:     scCodeString + "@" + hex.EncodeToString(factory.ArwenVirtualMachine),
+		ChainID:  integrationTests.IntegrationTestsChainID,
+		Version:  integrationTests.MinTransactionVersion,
 	}
 	txHash, _ := core.CalculateHash(integrationTests.TestMarshalizer, integrationTests.TestHasher, tx)
 
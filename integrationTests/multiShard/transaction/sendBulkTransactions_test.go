@@ -180,6 +180,8 @@ func generateTx(sender crypto.PrivateKey, receiver crypto.PublicKey) *transactio
 		Data:      "",
 		Signature: nil,
 		Challenge: nil,
+		ChainID:   integrationTests.IntegrationTestsChainID,
+		Version:   integrationTests.MinTransactionVersion,
 	}
 	marshalizedTxBeforeSigning, _ := json.Marshal(tx)
 	signer := singlesig.SchnorrSigner{}
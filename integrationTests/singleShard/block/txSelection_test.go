@@ -0,0 +1,85 @@
+package block
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTxSelection_FeeMaximizingShouldCollectMoreFeesThanPoolOrder fills the pool with the transactions of two senders:
+// one pays a higher gas price, the other one pays less per gas unit but sets a much higher gas limit on transactions
+// consuming the same gas. The pool order strategy ranks the senders by gas price, so it should collect less fees
+// than the fee maximizing strategy
+func TestTxSelection_FeeMaximizingShouldCollectMoreFeesThanPoolOrder(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	feeMaximizing, _ := preprocess.NewFeeMaximizingSelection(20)
+
+	poolOrderFees := collectedFeesInOneBlock(preprocess.NewPoolOrderSelection())
+	feeMaximizingFees := collectedFeesInOneBlock(feeMaximizing)
+
+	assert.True(t, poolOrderFees.Cmp(big.NewInt(0)) > 0)
+	assert.True(t, feeMaximizingFees.Cmp(poolOrderFees) > 0)
+}
+
+func collectedFeesInOneBlock(txSelection process.TxSelectionStrategy) *big.Int {
+	node := integrationTests.NewTestProcessorNodeWithTxSelectionStrategy(1, 0, 0, "", txSelection)
+	defer func() {
+		_ = node.Messenger.Close()
+	}()
+
+	numTxsPerSender := integrationTests.MaxGasLimitPerBlock / integrationTests.MinTxGasLimit
+	highGasPriceSender := []byte("high gas price sender 0000000000")
+	highGasLimitSender := []byte("high gas limit sender 0000000000")
+	receiver := []byte("receiver 00000000000000000000000")
+
+	balance := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(20), nil)
+	integrationTests.MintAddress(node.AccntState, highGasPriceSender, balance)
+	integrationTests.MintAddress(node.AccntState, highGasLimitSender, balance)
+
+	txsByHash := make(map[string]*transaction.Transaction)
+	addTxs := func(sender []byte, gasPrice uint64, gasLimit uint64) {
+		for nonce := uint64(0); nonce < numTxsPerSender; nonce++ {
+			tx := &transaction.Transaction{
+				Nonce:    nonce,
+				Value:    big.NewInt(1),
+				RcvAddr:  receiver,
+				SndAddr:  sender,
+				GasPrice: gasPrice,
+				GasLimit: gasLimit,
+				ChainID:  integrationTests.IntegrationTestsChainID,
+				Version:  integrationTests.MinTransactionVersion,
+			}
+			txBuff, _ := integrationTests.TestMarshalizer.Marshal(tx)
+			txHash := integrationTests.TestHasher.Compute(string(txBuff))
+			txsByHash[string(txHash)] = tx
+
+			node.ShardDataPool.Transactions().AddData(txHash, tx, process.ShardCacherIdentifier(0, 0))
+		}
+	}
+	addTxs(highGasPriceSender, integrationTests.MinTxGasPrice*2, integrationTests.MinTxGasLimit)
+	addTxs(highGasLimitSender, integrationTests.MinTxGasPrice, integrationTests.MinTxGasLimit*5)
+
+	_, _, txHashes := node.ProposeBlock(1, 1)
+
+	collectedFees := big.NewInt(0)
+	for _, txHash := range txHashes {
+		tx, ok := txsByHash[string(txHash)]
+		if !ok {
+			continue
+		}
+
+		fee := big.NewInt(0).SetUint64(tx.GasPrice)
+		fee.Mul(fee, big.NewInt(0).SetUint64(tx.GasLimit))
+		collectedFees.Add(collectedFees, fee)
+	}
+
+	return collectedFees
+}
@@ -63,6 +63,8 @@ func TestNode_RequestInterceptTransactionWithMessenger(t *testing.T) {
 		Data:     txData,
 		GasLimit: integrationTests.MinTxGasLimit + txDataCost,
 		GasPrice: integrationTests.MinTxGasPrice,
+		ChainID:  integrationTests.IntegrationTestsChainID,
+		Version:  integrationTests.MinTransactionVersion,
 	}
 
 	txBuff, _ := integrationTests.TestMarshalizer.Marshal(&tx)
@@ -35,6 +35,8 @@ func TestExecTransaction_SelfTransactionShouldWork(t *testing.T) {
 		GasPrice: 1,
 		SndAddr:  address.Bytes(),
 		RcvAddr:  address.Bytes(),
+		ChainID:  integrationTests.IntegrationTestsChainID,
+		Version:  integrationTests.MinTransactionVersion,
 	}
 
 	err := txProcessor.ProcessTransaction(tx, 0)
@@ -71,6 +73,8 @@ func TestExecTransaction_SelfTransactionWithRevertShouldWork(t *testing.T) {
 		RcvAddr:  address.Bytes(),
 		GasLimit: 2,
 		GasPrice: 2,
+		ChainID:  integrationTests.IntegrationTestsChainID,
+		Version:  integrationTests.MinTransactionVersion,
 	}
 
 	err := txProcessor.ProcessTransaction(tx, 0)
@@ -130,6 +134,8 @@ func testExecTransactionsMoreTxWithRevert(
 			GasLimit: gasLimit,
 			SndAddr:  sender.Bytes(),
 			RcvAddr:  receiver.Bytes(),
+			ChainID:  integrationTests.IntegrationTestsChainID,
+			Version:  integrationTests.MinTransactionVersion,
 		}
 
 		err := txProcessor.ProcessTransaction(tx, 0)
@@ -1032,6 +1032,8 @@ func createAndExecTxs(
 			Value:   big.NewInt(int64(txVal)),
 			SndAddr: addr[sender].Bytes(),
 			RcvAddr: addr[receiver].Bytes(),
+			ChainID: integrationTests.IntegrationTestsChainID,
+			Version: integrationTests.MinTransactionVersion,
 		}
 
 		startTime := time.Now()
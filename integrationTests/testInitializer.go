@@ -59,6 +59,12 @@ import (
 var stepDelay = time.Second
 var p2pBootstrapStepDelay = 5 * time.Second
 
+// IntegrationTestsChainID is the chain ID used by the integration tests nodes and transactions
+var IntegrationTestsChainID = []byte("integration tests chain ID")
+
+// MinTransactionVersion is the minimum transaction version accepted by the integration tests nodes
+var MinTransactionVersion = uint32(1)
+
 // GetConnectableAddress returns a non circuit, non windows default connectable address for provided messenger
 func GetConnectableAddress(mes p2p.Messenger) string {
 	for _, addr := range mes.Addresses() {
@@ -92,7 +98,7 @@ func CreateMessengerWithKadDht(ctx context.Context, initialAddr string) p2p.Mess
 // CreateTestShardDataPool creates a test data pool for shard nodes
 func CreateTestShardDataPool(txPool dataRetriever.ShardedDataCacherNotifier) dataRetriever.PoolsHolder {
 	if txPool == nil {
-		txPool, _ = shardedData.NewShardedData(storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache, Shards: 1})
+		txPool, _ = shardedData.NewShardedTxData(100000, 100000, 10)
 	}
 
 	uTxPool, _ := shardedData.NewShardedData(storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache, Shards: 1})
@@ -144,7 +150,7 @@ func CreateTestMetaDataPool() dataRetriever.MetaPoolsHolder {
 	shardHeadersNoncesCacher, _ := storageUnit.NewCache(cacherCfg.Type, cacherCfg.Size, cacherCfg.Shards)
 	shardHeadersNonces, _ := dataPool.NewNonceSyncMapCacher(shardHeadersNoncesCacher, uint64ByteSlice.NewBigEndianConverter())
 
-	txPool, _ := shardedData.NewShardedData(storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache, Shards: 1})
+	txPool, _ := shardedData.NewShardedTxData(100000, 100000, 10)
 	uTxPool, _ := shardedData.NewShardedData(storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache, Shards: 1})
 
 	currTxs, _ := dataPool.NewCurrentBlockPool()
@@ -185,6 +191,8 @@ func CreateShardStore(numOfShards uint32) dataRetriever.StorageService {
 	store.AddStorer(dataRetriever.MetaHdrNonceHashDataUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.BootstrapUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.StatusMetricsUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.TxPoolUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.ReceiptsUnit, CreateMemUnit())
 
 	for i := uint32(0); i < numOfShards; i++ {
 		hdrNonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(i)
@@ -205,6 +213,8 @@ func CreateMetaStore(coordinator sharding.Coordinator) dataRetriever.StorageServ
 	store.AddStorer(dataRetriever.MiniBlockUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.BootstrapUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.StatusMetricsUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.TxPoolUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.ReceiptsUnit, CreateMemUnit())
 
 	for i := uint32(0); i < coordinator.NumberOfShards(); i++ {
 		store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit+dataRetriever.UnitType(i), CreateMemUnit())
@@ -369,6 +379,7 @@ func CreateGenesisMetaBlock(
 		MetaDatapool:             metaDataPool,
 		Economics:                economics,
 		ValidatorStatsRootHash:   []byte("validator stats root hash"),
+		DoubleSigningVerifier:    TestDoubleSigningVerifier,
 	}
 
 	if shardCoordinator.SelfId() != sharding.MetachainShardId {
@@ -556,6 +567,10 @@ func CreateSimpleTxProcessor(accnts state.AccountsAdapter) process.TransactionPr
 				return fee
 			},
 		},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		IntegrationTestsChainID,
+		MinTransactionVersion,
 	)
 
 	return txProcessor
@@ -909,6 +924,8 @@ func CreateAndSendTransaction(
 		Data:     txData,
 		GasPrice: MinTxGasPrice,
 		GasLimit: MinTxGasLimit*100 + uint64(len(txData)),
+		ChainID:  IntegrationTestsChainID,
+		Version:  MinTransactionVersion,
 	}
 
 	txBuff, _ := TestMarshalizer.Marshal(tx)
@@ -946,6 +963,8 @@ func generateTransferTx(
 		Data:     "",
 		GasLimit: gasLimit,
 		GasPrice: gasPrice,
+		ChainID:  IntegrationTestsChainID,
+		Version:  MinTransactionVersion,
 	}
 	txBuff, _ := TestMarshalizer.Marshal(&tx)
 	signer := &singlesig.SchnorrSigner{}
@@ -967,6 +986,8 @@ func generateTx(
 		GasPrice: args.gasPrice,
 		GasLimit: args.gasLimit,
 		Data:     args.data,
+		ChainID:  IntegrationTestsChainID,
+		Version:  MinTransactionVersion,
 	}
 	txBuff, _ := TestMarshalizer.Marshal(tx)
 	tx.Signature, _ = signer.Sign(skSign, txBuff)
@@ -14,10 +14,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
 	"github.com/ElrondNetwork/elrond-go/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -41,11 +43,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
+	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	procFactory "github.com/ElrondNetwork/elrond-go/process/factory"
 	metaProcess "github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/receipts"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	scToProtocol2 "github.com/ElrondNetwork/elrond-go/process/scToProtocol"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
@@ -53,6 +57,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/ElrondNetwork/elrond-vm/iele/elrond/node/endpoint"
 	"github.com/pkg/errors"
@@ -76,6 +81,13 @@ var TestKeyGenForAccounts = signing.NewKeyGenerator(kyber.NewBlakeSHA256Ed25519(
 // TestUint64Converter represents an uint64 to byte slice converter
 var TestUint64Converter = uint64ByteSlice.NewBigEndianConverter()
 
+// TestDoubleSigningVerifier represents a double signing verifier for consensus messages signed with schnorr
+var TestDoubleSigningVerifier, _ = systemSmartContracts.NewDoubleSigningVerifier(
+	TestKeyGenForAccounts,
+	&singlesig.SchnorrSigner{},
+	TestMarshalizer,
+)
+
 // MinTxGasPrice defines minimum gas price required by a transaction
 //TODO refactor all tests to pass with a non zero value
 var MinTxGasPrice = uint64(10)
@@ -151,6 +163,8 @@ type TestProcessorNode struct {
 	PreProcessorsContainer process.PreProcessorsContainer
 	MiniBlocksCompacter    process.MiniBlocksCompacter
 	GasHandler             process.GasHandler
+	ReceiptsHandler        process.ReceiptsHandler
+	TxSelectionStrategy    process.TxSelectionStrategy
 
 	ForkDetector          process.ForkDetector
 	BlockProcessor        process.BlockProcessor
@@ -180,6 +194,24 @@ func NewTestProcessorNode(
 	txSignPrivKeyShardId uint32,
 	initialNodeAddr string,
 ) *TestProcessorNode {
+	return NewTestProcessorNodeWithTxSelectionStrategy(
+		maxShards,
+		nodeShardId,
+		txSignPrivKeyShardId,
+		initialNodeAddr,
+		preprocess.NewPoolOrderSelection(),
+	)
+}
+
+// NewTestProcessorNodeWithTxSelectionStrategy returns a new TestProcessorNode instance which packs the transactions
+// in miniblocks using the given selection strategy
+func NewTestProcessorNodeWithTxSelectionStrategy(
+	maxShards uint32,
+	nodeShardId uint32,
+	txSignPrivKeyShardId uint32,
+	initialNodeAddr string,
+	txSelection process.TxSelectionStrategy,
+) *TestProcessorNode {
 
 	shardCoordinator, _ := sharding.NewMultiShardCoordinator(maxShards, nodeShardId)
 
@@ -199,9 +231,10 @@ func NewTestProcessorNode(
 
 	messenger := CreateMessengerWithKadDht(context.Background(), initialNodeAddr)
 	tpn := &TestProcessorNode{
-		ShardCoordinator: shardCoordinator,
-		Messenger:        messenger,
-		NodesCoordinator: nodesCoordinator,
+		ShardCoordinator:    shardCoordinator,
+		Messenger:           messenger,
+		NodesCoordinator:    nodesCoordinator,
+		TxSelectionStrategy: txSelection,
 	}
 
 	tpn.NodeKeys = &TestKeyPair{
@@ -266,7 +299,11 @@ func (tpn *TestProcessorNode) initTestNode() {
 	tpn.initRequestedItemsHandler()
 	tpn.initResolvers()
 	tpn.initInnerProcessors()
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData.MaxGasLimitPerBlock())
+	argsQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer:      tpn.VMContainer,
+		GasLimitPerBlock: tpn.EconomicsData.MaxGasLimitPerBlock(),
+	}
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsQueryService)
 	tpn.GenesisBlocks = CreateGenesisBlocks(
 		tpn.AccntState,
 		TestAddressConverter,
@@ -290,7 +327,11 @@ func (tpn *TestProcessorNode) initTestNode() {
 	)
 	tpn.setGenesisBlock()
 	tpn.initNode()
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData.MaxGasLimitPerBlock())
+	argsQueryService = smartContract.ArgsNewSCQueryService{
+		VmContainer:      tpn.VMContainer,
+		GasLimitPerBlock: tpn.EconomicsData.MaxGasLimitPerBlock(),
+	}
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsQueryService)
 	tpn.addHandlersForCounters()
 	tpn.addGenesisBlocksIntoStorage()
 }
@@ -328,7 +369,7 @@ func (tpn *TestProcessorNode) initEconomicsData() {
 		&config.ConfigEconomics{
 			EconomicsAddresses: config.EconomicsAddresses{
 				CommunityAddress: "addr1",
-				BurnAddress:      "addr2",
+				BurnAddress:      "deadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef",
 			},
 			RewardsSettings: config.RewardsSettings{
 				RewardsValue:        "1000",
@@ -345,6 +386,13 @@ func (tpn *TestProcessorNode) initEconomicsData() {
 				StakeValue:    "500",
 				UnBoundPeriod: "5",
 			},
+			ESDTSettings: config.ESDTSettings{
+				BaseIssuingCost: "1000",
+			},
+			GovernanceSettings: config.GovernanceSettings{
+				VotingPeriod: "1000",
+				MinQuorum:    "1000",
+			},
 		},
 	)
 
@@ -376,6 +424,8 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			maxTxNonceDeltaAllowed,
 			tpn.EconomicsData,
 			tpn.BlackListHandler,
+			IntegrationTestsChainID,
+			MinTransactionVersion,
 		)
 
 		tpn.InterceptorsContainer, err = interceptorContainerFactory.Create()
@@ -401,6 +451,8 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			maxTxNonceDeltaAllowed,
 			tpn.EconomicsData,
 			tpn.BlackListHandler,
+			IntegrationTestsChainID,
+			MinTransactionVersion,
 		)
 
 		tpn.InterceptorsContainer, err = interceptorContainerFactory.Create()
@@ -473,6 +525,12 @@ func createAndAddIeleVM(
 }
 
 func (tpn *TestProcessorNode) initInnerProcessors() {
+	tpn.ReceiptsHandler, _ = receipts.NewReceiptsHandler(
+		tpn.Storage.GetStorer(dataRetriever.ReceiptsUnit),
+		TestMarshalizer,
+		TestHasher,
+	)
+
 	if tpn.ShardCoordinator.SelfId() == sharding.MetachainShardId {
 		tpn.initMetaInnerProcessors()
 		return
@@ -500,6 +558,10 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 		TestAddressConverter,
 		tpn.ShardCoordinator,
 		rewardsInter,
+		tpn.ScrForwarder,
+		TestHasher,
+		TestMarshalizer,
+		tpn.EconomicsData,
 	)
 
 	argsHook := hooks.ArgBlockChainHook{
@@ -540,6 +602,13 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 		tpn.EconomicsData,
 		txTypeHandler,
 		tpn.GasHandler,
+		tpn.ReceiptsHandler,
+	)
+
+	signatureSetHandler, _ := transaction.NewSignatureSetHandler(
+		TestMarshalizer,
+		TestKeyGenForAccounts,
+		tpn.OwnAccount.SingleSigner,
 	)
 
 	tpn.TxProcessor, _ = transaction.NewTxProcessor(
@@ -552,6 +621,10 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 		rewardsHandler,
 		txTypeHandler,
 		tpn.EconomicsData,
+		tpn.ScrForwarder,
+		signatureSetHandler,
+		IntegrationTestsChainID,
+		MinTransactionVersion,
 	)
 
 	tpn.MiniBlocksCompacter, _ = preprocess.NewMiniBlocksCompaction(tpn.EconomicsData, tpn.ShardCoordinator, tpn.GasHandler)
@@ -573,6 +646,7 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 		tpn.EconomicsData,
 		tpn.MiniBlocksCompacter,
 		tpn.GasHandler,
+		tpn.getTxSelectionStrategy(),
 	)
 	tpn.PreProcessorsContainer, _ = fact.Create()
 
@@ -610,7 +684,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		Uint64Converter:  TestUint64Converter,
 	}
 
-	vmFactory, _ := metaProcess.NewVMContainerFactory(argsHook, tpn.EconomicsData.EconomicsData)
+	vmFactory, _ := metaProcess.NewVMContainerFactory(argsHook, tpn.EconomicsData.EconomicsData, TestDoubleSigningVerifier)
 
 	tpn.VMContainer, _ = vmFactory.Create()
 	tpn.BlockchainHook, _ = vmFactory.BlockChainHookImpl().(*hooks.BlockChainHookImpl)
@@ -634,6 +708,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		tpn.EconomicsData,
 		txTypeHandler,
 		tpn.GasHandler,
+		tpn.ReceiptsHandler,
 	)
 	tpn.ScProcessor = scProcessor
 	tpn.TxProcessor, _ = transaction.NewMetaTxProcessor(
@@ -659,6 +734,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		tpn.EconomicsData.EconomicsData,
 		tpn.MiniBlocksCompacter,
 		tpn.GasHandler,
+		tpn.getTxSelectionStrategy(),
 	)
 	tpn.PreProcessorsContainer, _ = fact.Create()
 
@@ -673,6 +749,14 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 	)
 }
 
+func (tpn *TestProcessorNode) getTxSelectionStrategy() process.TxSelectionStrategy {
+	if check.IfNil(tpn.TxSelectionStrategy) {
+		tpn.TxSelectionStrategy = preprocess.NewPoolOrderSelection()
+	}
+
+	return tpn.TxSelectionStrategy
+}
+
 func (tpn *TestProcessorNode) addMockVm(blockchainHook vmcommon.BlockchainHook) {
 	mockVM, _ := mock.NewOneSCExecutorMockVM(blockchainHook, TestHasher)
 	mockVM.GasForOperation = OpGasValueForMockVm
@@ -716,6 +800,7 @@ func (tpn *TestProcessorNode) initBlockProcessor() {
 				return nil
 			},
 		},
+		ReceiptsHandler: tpn.ReceiptsHandler,
 	}
 
 	if tpn.ShardCoordinator.SelfId() == sharding.MetachainShardId {
@@ -732,22 +817,42 @@ func (tpn *TestProcessorNode) initBlockProcessor() {
 			ScQuery:     tpn.SCQueryService,
 		}
 		scToProtocol, _ := scToProtocol2.NewStakingToPeer(argsStakingToPeer)
+		argsGovernanceToProtocol := scToProtocol2.ArgGovernanceToProtocol{
+			ArgGovernanceApplier: scToProtocol2.ArgGovernanceApplier{
+				Marshalizer: TestMarshalizer,
+				Storer:      tpn.Storage.GetStorer(dataRetriever.BootstrapUnit),
+				Updaters: map[string]process.ParametersUpdater{
+					core.GovernanceEconomicsNamespace: tpn.EconomicsData,
+				},
+			},
+			ScQuery: tpn.SCQueryService,
+		}
+		governanceToProtocol, _ := scToProtocol2.NewGovernanceToProtocol(argsGovernanceToProtocol)
 		arguments := block.ArgMetaProcessor{
-			ArgBaseProcessor:   argumentsBase,
-			DataPool:           tpn.MetaDataPool,
-			SCDataGetter:       tpn.SCQueryService,
-			SCToProtocol:       scToProtocol,
-			PeerChangesHandler: scToProtocol,
+			ArgBaseProcessor:     argumentsBase,
+			DataPool:             tpn.MetaDataPool,
+			SCDataGetter:         tpn.SCQueryService,
+			SCToProtocol:         scToProtocol,
+			GovernanceToProtocol: governanceToProtocol,
+			PeerChangesHandler:   scToProtocol,
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
 	} else {
 		argumentsBase.BlockChainHook = tpn.BlockchainHook
 		argumentsBase.TxCoordinator = tpn.TxCoordinator
+		governanceApplier, _ := scToProtocol2.NewGovernanceApplier(scToProtocol2.ArgGovernanceApplier{
+			Marshalizer: TestMarshalizer,
+			Storer:      tpn.Storage.GetStorer(dataRetriever.BootstrapUnit),
+			Updaters: map[string]process.ParametersUpdater{
+				core.GovernanceEconomicsNamespace: tpn.EconomicsData,
+			},
+		})
 		arguments := block.ArgShardProcessor{
-			ArgBaseProcessor: argumentsBase,
-			DataPool:         tpn.ShardDataPool,
-			TxsPoolsCleaner:  &mock.TxPoolsCleanerMock{},
+			ArgBaseProcessor:  argumentsBase,
+			DataPool:          tpn.ShardDataPool,
+			TxsPoolsCleaner:   &mock.TxPoolsCleanerMock{},
+			GovernanceApplier: governanceApplier,
 		}
 
 		tpn.BlockProcessor, err = block.NewShardProcessor(arguments)
@@ -768,6 +873,27 @@ func (tpn *TestProcessorNode) setGenesisBlock() {
 func (tpn *TestProcessorNode) initNode() {
 	var err error
 
+	var txPool dataRetriever.ShardedDataCacherNotifier
+	if tpn.ShardCoordinator.SelfId() == sharding.MetachainShardId {
+		txPool = tpn.MetaDataPool.Transactions()
+	} else {
+		txPool = tpn.ShardDataPool.Transactions()
+	}
+	signatureSetHandler, _ := transaction.NewSignatureSetHandler(
+		TestMarshalizer,
+		TestKeyGenForAccounts,
+		tpn.OwnAccount.SingleSigner,
+	)
+	txValidator, _ := dataValidators.NewTxValidator(
+		tpn.AccntState,
+		tpn.ShardCoordinator,
+		txPool,
+		maxTxNonceDeltaAllowed,
+		IntegrationTestsChainID,
+		MinTransactionVersion,
+		signatureSetHandler,
+	)
+
 	tpn.Node, err = node.NewNode(
 		node.WithMessenger(tpn.Messenger),
 		node.WithMarshalizer(TestMarshalizer),
@@ -795,6 +921,9 @@ func (tpn *TestProcessorNode) initNode() {
 		node.WithDataStore(tpn.Storage),
 		node.WithSyncer(&mock.SyncTimerMock{}),
 		node.WithBlackListHandler(tpn.BlackListHandler),
+		node.WithChainID(IntegrationTestsChainID),
+		node.WithMinTransactionVersion(MinTransactionVersion),
+		node.WithTxValidator(txValidator),
 	)
 	if err != nil {
 		fmt.Printf("Error creating node: %s\n", err.Error())
@@ -826,6 +955,10 @@ func (tpn *TestProcessorNode) SendTransaction(tx *dataTransaction.Transaction) (
 		tx.GasLimit,
 		tx.Data,
 		tx.Signature,
+		string(tx.ChainID),
+		tx.Version,
+		tx.ValidAfterRound,
+		tx.ValidUntilRound,
 	)
 	return txHash, err
 }
@@ -87,7 +87,11 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 	tpn.initBootstrapper()
 	tpn.setGenesisBlock()
 	tpn.initNode()
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData.MaxGasLimitPerBlock())
+	argsQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer:      tpn.VMContainer,
+		GasLimitPerBlock: tpn.EconomicsData.MaxGasLimitPerBlock(),
+	}
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsQueryService)
 	tpn.addHandlersForCounters()
 	tpn.addGenesisBlocksIntoStorage()
 }
@@ -131,6 +135,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 				return nil
 			},
 		},
+		ReceiptsHandler: tpn.ReceiptsHandler,
 	}
 
 	if tpn.ShardCoordinator.SelfId() == sharding.MetachainShardId {
@@ -139,11 +144,12 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		argumentsBase.ForkDetector = tpn.ForkDetector
 		argumentsBase.TxCoordinator = &mock.TransactionCoordinatorMock{}
 		arguments := block.ArgMetaProcessor{
-			ArgBaseProcessor:   argumentsBase,
-			DataPool:           tpn.MetaDataPool,
-			SCDataGetter:       &mock.ScQueryMock{},
-			SCToProtocol:       &mock.SCToProtocolStub{},
-			PeerChangesHandler: &mock.PeerChangesHandler{},
+			ArgBaseProcessor:     argumentsBase,
+			DataPool:             tpn.MetaDataPool,
+			SCDataGetter:         &mock.ScQueryMock{},
+			SCToProtocol:         &mock.SCToProtocolStub{},
+			GovernanceToProtocol: &mock.GovernanceChangesHandlerStub{},
+			PeerChangesHandler:   &mock.PeerChangesHandler{},
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
@@ -154,9 +160,10 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		argumentsBase.BlockChainHook = tpn.BlockchainHook
 		argumentsBase.TxCoordinator = tpn.TxCoordinator
 		arguments := block.ArgShardProcessor{
-			ArgBaseProcessor: argumentsBase,
-			DataPool:         tpn.ShardDataPool,
-			TxsPoolsCleaner:  &mock.TxPoolsCleanerMock{},
+			ArgBaseProcessor:  argumentsBase,
+			DataPool:          tpn.ShardDataPool,
+			TxsPoolsCleaner:   &mock.TxPoolsCleanerMock{},
+			GovernanceApplier: &mock.GovernanceChangesHandlerStub{},
 		}
 
 		tpn.BlockProcessor, err = block.NewShardProcessor(arguments)
@@ -174,6 +174,8 @@ func runWASMVMBenchmark(
 		Data:      scCodeString + "@" + hex.EncodeToString(factory.ArwenVirtualMachine),
 		Signature: nil,
 		Challenge: nil,
+		ChainID:   vm.ChainID,
+		Version:   vm.MinTransactionVersion,
 	}
 
 	txProc, accnts, blockchainHook := vm.CreateTxProcessorArwenVMWithGasSchedule(tb, ownerNonce, ownerAddressBytes, ownerBalance, gasSchedule)
@@ -199,6 +201,8 @@ func runWASMVMBenchmark(
 		Data:      "_main",
 		Signature: nil,
 		Challenge: nil,
+		ChainID:   vm.ChainID,
+		Version:   vm.MinTransactionVersion,
 	}
 
 	for i := 0; i < numRun; i++ {
@@ -338,6 +342,8 @@ func TestWASMNamespacing(t *testing.T) {
 		Data:      scCodeString + "@" + hex.EncodeToString(factory.ArwenVirtualMachine),
 		Signature: nil,
 		Challenge: nil,
+		ChainID:   vm.ChainID,
+		Version:   vm.MinTransactionVersion,
 	}
 
 	txProc, accnts, blockchainHook := vm.CreatePreparedTxProcessorAndAccountsWithVMs(t, ownerNonce, ownerAddressBytes, ownerBalance)
@@ -368,6 +374,8 @@ func TestWASMNamespacing(t *testing.T) {
 		Data:      "main",
 		Signature: nil,
 		Challenge: nil,
+		ChainID:   vm.ChainID,
+		Version:   vm.MinTransactionVersion,
 	}
 
 	err = txProc.ProcessTransaction(tx, round)
@@ -399,6 +407,8 @@ func TestWASMMetering(t *testing.T) {
 		Data:      scCodeString + "@" + hex.EncodeToString(factory.ArwenVirtualMachine),
 		Signature: nil,
 		Challenge: nil,
+		ChainID:   vm.ChainID,
+		Version:   vm.MinTransactionVersion,
 	}
 
 	txProc, accnts, blockchainHook := vm.CreatePreparedTxProcessorAndAccountsWithVMs(t, ownerNonce, ownerAddressBytes, ownerBalance)
@@ -429,6 +439,8 @@ func TestWASMMetering(t *testing.T) {
 		Data:      "_main",
 		Signature: nil,
 		Challenge: nil,
+		ChainID:   vm.ChainID,
+		Version:   vm.MinTransactionVersion,
 	}
 
 	err = txProc.ProcessTransaction(tx, round)
@@ -57,7 +57,11 @@ func setupTestContext(t *testing.T) testContext {
 	vmContainer, blockChainHook := vm.CreateVMAndBlockchainHook(context.Accounts, gasSchedule)
 	context.TxProcessor = vm.CreateTxProcessorWithOneSCExecutorWithVMs(context.Accounts, vmContainer, blockChainHook)
 	context.ScAddress, _ = blockChainHook.NewAddress(context.Owner.Address, context.Owner.Nonce, factory.ArwenVirtualMachine)
-	context.QueryService, _ = smartContract.NewSCQueryService(vmContainer, math.MaxInt32)
+	argsQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer:      vmContainer,
+		GasLimitPerBlock: math.MaxInt32,
+	}
+	context.QueryService, _ = smartContract.NewSCQueryService(argsQueryService)
 
 	return context
 }
@@ -116,6 +120,8 @@ func (context *testContext) deploySC(wasmPath string, parametersString string) {
 		GasPrice: 1,
 		GasLimit: math.MaxInt32,
 		Data:     txData,
+		ChainID:  vm.ChainID,
+		Version:  vm.MinTransactionVersion,
 	}
 
 	err := context.TxProcessor.ProcessTransaction(tx, context.Round)
@@ -151,6 +157,8 @@ func (context *testContext) executeSCWithValue(sender *testParticipant, txData s
 		GasPrice: 1,
 		GasLimit: math.MaxInt32,
 		Data:     txData,
+		ChainID:  vm.ChainID,
+		Version:  vm.MinTransactionVersion,
 	}
 
 	err := context.TxProcessor.ProcessTransaction(tx, context.Round)
@@ -34,6 +34,8 @@ func TestVMInvalidSmartContractCodeShouldNotGenerateAccount(t *testing.T) {
 		Data:     string(scCode) + "@" + hex.EncodeToString(factory.IELEVirtualMachine),
 		GasPrice: gasPrice,
 		GasLimit: gasLimit,
+		ChainID:  vm.ChainID,
+		Version:  vm.MinTransactionVersion,
 	}
 
 	// tx is not processed due to the invalid sc code
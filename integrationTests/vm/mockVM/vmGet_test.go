@@ -7,9 +7,12 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/vm"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
@@ -25,7 +28,11 @@ func TestVmGetShouldReturnValue(t *testing.T) {
 		GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 			return mockVM, nil
 		}}
-	service, _ := smartContract.NewSCQueryService(vmContainer, uint64(math.MaxUint64))
+	argsQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer:      vmContainer,
+		GasLimitPerBlock: uint64(math.MaxUint64),
+	}
+	service, _ := smartContract.NewSCQueryService(argsQueryService)
 
 	functionName := "Get"
 	query := process.SCQuery{
@@ -41,6 +48,90 @@ func TestVmGetShouldReturnValue(t *testing.T) {
 	assert.Equal(t, expectedValueForVar, returnData)
 }
 
+func TestVmGetAtPastStateShouldReturnPastValue(t *testing.T) {
+	vmOpGas := uint64(0)
+	senderAddressBytes := []byte("12345678901234567890123456789012")
+	senderNonce := uint64(11)
+	senderBalance := big.NewInt(100000000)
+	round := uint64(444)
+	gasPrice := uint64(1)
+	gasLimit := vmOpGas
+	transferOnCalls := big.NewInt(0)
+
+	initialValueForInternalVariable := uint64(45)
+	scCode := fmt.Sprintf("aaaa@%s@%X", hex.EncodeToString(factory.InternalTestingVM), initialValueForInternalVariable)
+
+	txProc, accnts := vm.CreatePreparedTxProcessorAndAccountsWithMockedVM(t, vmOpGas, senderNonce, senderAddressBytes, senderBalance)
+	deployContract(
+		t,
+		senderAddressBytes,
+		senderNonce,
+		transferOnCalls,
+		gasPrice,
+		gasLimit,
+		scCode,
+		round,
+		txProc,
+		accnts,
+	)
+	rootHashAfterDeploy, _ := accnts.RootHash()
+
+	destinationAddressBytes, _ := hex.DecodeString("0000000000000000ffff1a2983b179a480a60c4308da48f13b4480dbb4d33132")
+	addValue := uint64(128)
+	txRun := vm.CreateTx(
+		t,
+		senderAddressBytes,
+		destinationAddressBytes,
+		senderNonce+1,
+		transferOnCalls,
+		gasPrice,
+		gasLimit,
+		fmt.Sprintf("Add@%X", addValue),
+	)
+
+	err := txProc.ProcessTransaction(txRun, round)
+	assert.Nil(t, err)
+	rootHashAfterAdd, err := accnts.Commit()
+	assert.Nil(t, err)
+
+	mockVM := vm.CreateOneSCExecutorMockVM(accnts)
+	argsQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			}},
+		GasLimitPerBlock: uint64(math.MaxUint64),
+		Accounts:         accnts,
+		BlockChain: &mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{RootHash: rootHashAfterAdd}
+			},
+		},
+		StorageService:   &mock.ChainStorerMock{},
+		Marshalizer:      &marshal.JsonMarshalizer{},
+		Uint64Converter:  &mock.Uint64ByteSliceConverterMock{},
+		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(1),
+	}
+	service, _ := smartContract.NewSCQueryService(argsQueryService)
+
+	query := process.SCQuery{
+		ScAddress:     destinationAddressBytes,
+		FuncName:      "Get",
+		Arguments:     [][]byte{},
+		BlockRootHash: rootHashAfterDeploy,
+	}
+	vmOutput, err := service.ExecuteQuery(&query)
+	assert.Nil(t, err)
+	returnData, _ := vmOutput.GetFirstReturnData(vmcommon.AsBigInt)
+	assert.Equal(t, big.NewInt(0).SetUint64(initialValueForInternalVariable), returnData)
+
+	query.BlockRootHash = nil
+	vmOutput, err = service.ExecuteQuery(&query)
+	assert.Nil(t, err)
+	returnData, _ = vmOutput.GetFirstReturnData(vmcommon.AsBigInt)
+	assert.Equal(t, big.NewInt(0).SetUint64(initialValueForInternalVariable+addValue), returnData)
+}
+
 func deploySmartContract(t *testing.T) (state.AccountsAdapter, []byte, *big.Int) {
 	vmOpGas := uint64(0)
 	senderAddressBytes := []byte("12345678901234567890123456789012")
@@ -63,8 +63,8 @@ func TestStakingUnstakingAndUnboundingOnMultiShardEnvironment(t *testing.T) {
 	///////////------- send stake tx and check sender's balance
 	var txData string
 	for _, node := range nodes {
-		pubKey, _ := node.NodeKeys.Pk.ToByteArray()
-		txData = "stake" + "@" + hex.EncodeToString(pubKey)
+		// the test nodes share the same mocked block signing key, while every staked node key has to be unique
+		txData = "stake" + "@" + hex.EncodeToString(node.OwnAccount.PkTxSignBytes)
 		integrationTests.CreateAndSendTransaction(node, node.EconomicsData.StakeValue(), factory.StakingSCAddress, txData)
 	}
 
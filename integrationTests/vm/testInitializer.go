@@ -36,6 +36,12 @@ var testHasher = sha256.Sha256{}
 var oneShardCoordinator = mock.NewMultiShardsCoordinatorMock(2)
 var addrConv, _ = addressConverters.NewPlainAddressConverter(32, "0x")
 
+// ChainID is the chain ID of the transactions processed by the vm tests processors
+var ChainID = []byte("vm tests chain ID")
+
+// MinTransactionVersion is the minimum transaction version accepted by the vm tests processors
+var MinTransactionVersion = uint32(1)
+
 type accountFactory struct {
 }
 
@@ -145,6 +151,7 @@ func CreateTxProcessorWithOneSCExecutorMockVM(accnts state.AccountsAdapter, opGa
 		&mock.GasHandlerMock{
 			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
 		},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	txProcessor, _ := transaction.NewTxProcessor(
@@ -157,6 +164,10 @@ func CreateTxProcessorWithOneSCExecutorMockVM(accnts state.AccountsAdapter, opGa
 		&mock.UnsignedTxHandlerMock{},
 		txTypeHandler,
 		&mock.FeeHandlerStub{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		ChainID,
+		MinTransactionVersion,
 	)
 
 	return txProcessor
@@ -246,6 +257,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		&mock.GasHandlerMock{
 			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
 		},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	txProcessor, _ := transaction.NewTxProcessor(
@@ -258,6 +270,10 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		&mock.UnsignedTxHandlerMock{},
 		txTypeHandler,
 		&mock.FeeHandlerStub{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		ChainID,
+		MinTransactionVersion,
 	)
 
 	return txProcessor
@@ -379,6 +395,8 @@ func CreateTx(
 		Data:     txData,
 		GasPrice: gasPrice,
 		GasLimit: gasLimit,
+		ChainID:  ChainID,
+		Version:  MinTransactionVersion,
 	}
 	assert.NotNil(tb, tx)
 
@@ -402,6 +420,8 @@ func CreateDeployTx(
 		Data:     scCodeAndVMType,
 		GasPrice: gasPrice,
 		GasLimit: gasLimit,
+		ChainID:  ChainID,
+		Version:  MinTransactionVersion,
 	}
 }
 
@@ -446,7 +466,11 @@ func GetAccountsBalance(addrBytes []byte, accnts state.AccountsAdapter) *big.Int
 
 func GetIntValueFromSC(gasSchedule map[string]map[string]uint64, accnts state.AccountsAdapter, scAddressBytes []byte, funcName string, args ...[]byte) *big.Int {
 	vmContainer, _ := CreateVMAndBlockchainHook(accnts, gasSchedule)
-	scQueryService, _ := smartContract.NewSCQueryService(vmContainer, uint64(math.MaxUint64))
+	argsQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer:      vmContainer,
+		GasLimitPerBlock: uint64(math.MaxUint64),
+	}
+	scQueryService, _ := smartContract.NewSCQueryService(argsQueryService)
 
 	vmOutput, _ := scQueryService.ExecuteQuery(&process.SCQuery{
 		ScAddress: scAddressBytes,
@@ -466,6 +490,8 @@ func CreateTopUpTx(nonce uint64, value *big.Int, scAddrress []byte, sndAddress [
 		GasPrice: 0,
 		GasLimit: 5000000,
 		Data:     "topUp@00",
+		ChainID:  ChainID,
+		Version:  MinTransactionVersion,
 	}
 }
 
@@ -484,6 +510,8 @@ func CreateTransferTx(
 		GasPrice: 0,
 		GasLimit: 5000000,
 		Data:     "transfer@" + hex.EncodeToString(rcvAddress) + "@" + hex.EncodeToString(value.Bytes()),
+		ChainID:  ChainID,
+		Version:  MinTransactionVersion,
 	}
 }
 
@@ -502,5 +530,7 @@ func CreateTransferTokenTx(
 		GasPrice: 0,
 		GasLimit: 5000000,
 		Data:     "transferToken@" + hex.EncodeToString(rcvAddress) + "@" + hex.EncodeToString(value.Bytes()),
+		ChainID:  ChainID,
+		Version:  MinTransactionVersion,
 	}
 }
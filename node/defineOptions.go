@@ -454,3 +454,44 @@ func WithRequestedItemsHandler(requestedItemsHandler dataRetriever.RequestedItem
 		return nil
 	}
 }
+
+// WithReceiptsHandler sets up a receipts handler for the Node
+func WithReceiptsHandler(receiptsHandler process.ReceiptsHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(receiptsHandler) {
+			return ErrNilReceiptsHandler
+		}
+		n.receiptsHandler = receiptsHandler
+		return nil
+	}
+}
+
+// WithTxValidator sets up the validator used to check the transactions submitted through the Node
+func WithTxValidator(txValidator process.TxValidator) Option {
+	return func(n *Node) error {
+		if check.IfNil(txValidator) {
+			return ErrNilTxValidator
+		}
+		n.txValidator = txValidator
+		return nil
+	}
+}
+
+// WithChainID sets up the chain ID the transactions are signed for on the Node
+func WithChainID(chainID []byte) Option {
+	return func(n *Node) error {
+		if len(chainID) == 0 {
+			return ErrInvalidChainID
+		}
+		n.chainID = chainID
+		return nil
+	}
+}
+
+// WithMinTransactionVersion sets up the minimum transaction version accepted by the Node
+func WithMinTransactionVersion(minTransactionVersion uint32) Option {
+	return func(n *Node) error {
+		n.minTransactionVersion = minTransactionVersion
+		return nil
+	}
+}
@@ -897,3 +897,87 @@ func TestWithRequestedItemsHandler_OkRequestedItemsHandlerShouldWork(t *testing.
 	assert.True(t, node.requestedItemsHandler == requestedItemsHeanlder)
 	assert.Nil(t, err)
 }
+
+func TestWithReceiptsHandler_NilReceiptsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithReceiptsHandler(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilReceiptsHandler, err)
+}
+
+func TestWithReceiptsHandler_OkReceiptsHandlerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	receiptsHandler := &mock.ReceiptsHandlerStub{}
+	opt := WithReceiptsHandler(receiptsHandler)
+	err := opt(node)
+
+	assert.True(t, node.receiptsHandler == receiptsHandler)
+	assert.Nil(t, err)
+}
+
+func TestWithTxValidator_NilTxValidatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithTxValidator(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilTxValidator, err)
+}
+
+func TestWithTxValidator_OkTxValidatorShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	txValidator := &mock.TxValidatorStub{}
+	opt := WithTxValidator(txValidator)
+	err := opt(node)
+
+	assert.True(t, node.txValidator == txValidator)
+	assert.Nil(t, err)
+}
+
+func TestWithChainID_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithChainID(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrInvalidChainID, err)
+}
+
+func TestWithChainID_OkChainIDShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	chainID := []byte("chainID")
+	opt := WithChainID(chainID)
+	err := opt(node)
+
+	assert.Equal(t, chainID, node.chainID)
+	assert.Nil(t, err)
+}
+
+func TestWithMinTransactionVersion_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithMinTransactionVersion(2)
+	err := opt(node)
+
+	assert.Equal(t, uint32(2), node.minTransactionVersion)
+	assert.Nil(t, err)
+}
@@ -135,3 +135,15 @@ var ErrInvalidValue = errors.New("invalid value")
 
 // ErrNilBootStorer signals that a nil boot storer was provided
 var ErrNilBootStorer = errors.New("nil boot storer")
+
+// ErrNilReceiptsHandler signals that a nil receipts handler was provided
+var ErrNilReceiptsHandler = errors.New("nil receipts handler")
+
+// ErrNilTxValidator signals that a nil transaction validator was provided
+var ErrNilTxValidator = errors.New("nil transaction validator")
+
+// ErrInvalidChainID signals that an invalid chain ID has been provided
+var ErrInvalidChainID = errors.New("invalid chain ID")
+
+// ErrSenderNotInSelfShard signals that the node can not send a transaction from an address outside its own shard
+var ErrSenderNotInSelfShard = errors.New("transaction sender is not in the node's shard")
@@ -1,6 +1,10 @@
 package node
 
-import "github.com/ElrondNetwork/elrond-go/node/heartbeat"
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+)
 
 func (n *Node) HeartbeatMonitor() *heartbeat.Monitor {
 	return n.heartbeatMonitor
@@ -9,3 +13,15 @@ func (n *Node) HeartbeatMonitor() *heartbeat.Monitor {
 func (n *Node) HeartbeatSender() *heartbeat.Sender {
 	return n.heartbeatSender
 }
+
+func (n *Node) CreateDoubleSigningReport(evidence *consensus.DoubleSigningEvidence) (*transaction.Transaction, error) {
+	return n.createDoubleSigningReport(evidence)
+}
+
+func (n *Node) MarkDoubleSigningReported(message *consensus.Message) bool {
+	return n.markDoubleSigningReported(message)
+}
+
+func (n *Node) SetNextDoubleSigningNonce(nonce uint64) {
+	n.nextDoubleSigningNonce = nonce
+}
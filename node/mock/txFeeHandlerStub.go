@@ -13,6 +13,7 @@ type FeeHandlerStub struct {
 	ComputeGasLimitCalled       func(tx process.TransactionWithFeeHandler) uint64
 	ComputeFeeCalled            func(tx process.TransactionWithFeeHandler) *big.Int
 	CheckValidityTxValuesCalled func(tx process.TransactionWithFeeHandler) error
+	DeveloperPercentageCalled   func() float64
 }
 
 func (fhs *FeeHandlerStub) MaxGasLimitPerBlock() uint64 {
@@ -31,6 +32,13 @@ func (fhs *FeeHandlerStub) CheckValidityTxValues(tx process.TransactionWithFeeHa
 	return fhs.CheckValidityTxValuesCalled(tx)
 }
 
+func (fhs *FeeHandlerStub) DeveloperPercentage() float64 {
+	if fhs.DeveloperPercentageCalled != nil {
+		return fhs.DeveloperPercentageCalled()
+	}
+	return 0
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (fhs *FeeHandlerStub) IsInterfaceNil() bool {
 	if fhs == nil {
@@ -7,20 +7,22 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	goSync "sync"
 	"sync/atomic"
 	"time"
 
-	nodeCmdFactory "github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
@@ -33,13 +35,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
-	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/process/sync/storageBootstrap"
 	procTx "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	vmFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
 )
 
 // SendTransactionsPipe is the pipe used for sending new transactions
@@ -48,8 +50,18 @@ const SendTransactionsPipe = "send transactions pipe"
 // HeartbeatTopic is the topic used for heartbeat signaling
 const HeartbeatTopic = "heartbeat"
 
+// doubleSigningReportsRoundsKept is the number of rounds a reported double signing is remembered, so it is not
+// reported again
+const doubleSigningReportsRoundsKept = 100
+
 var log = logger.GetOrCreate("node")
 
+// doubleSigningReport identifies a double signing by the validator and the round
+type doubleSigningReport struct {
+	pubKey string
+	round  int64
+}
+
 // Option represents a functional configuration parameter that can operate
 //  over the None struct.
 type Option func(*Node) error
@@ -109,6 +121,16 @@ type Node struct {
 	blackListHandler      process.BlackListHandler
 	bootStorer            process.BootStorer
 	requestedItemsHandler dataRetriever.RequestedItemsHandler
+	receiptsHandler       process.ReceiptsHandler
+	txValidator           process.TxValidator
+
+	chainID               []byte
+	minTransactionVersion uint32
+
+	mutDoubleSigningReports goSync.Mutex
+	reportedDoubleSignings  map[doubleSigningReport]struct{}
+	mutDoubleSigningNonce   goSync.Mutex
+	nextDoubleSigningNonce  uint64
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -131,6 +153,7 @@ func NewNode(opts ...Option) (*Node, error) {
 		ctx:                      context.Background(),
 		currentSendingGoRoutines: 0,
 		appStatusHandler:         statusHandler.NewNilStatusHandler(),
+		reportedDoubleSignings:   make(map[doubleSigningReport]struct{}),
 	}
 	for _, opt := range opts {
 		err := opt(node)
@@ -284,6 +307,8 @@ func (n *Node) StartConsensus() error {
 		return err
 	}
 
+	worker.SetDoubleSigningHandler(n.reportDoubleSigning)
+
 	err = n.createConsensusTopic(worker, n.shardCoordinator)
 	if err != nil {
 		return err
@@ -324,6 +349,129 @@ func (n *Node) StartConsensus() error {
 	return nil
 }
 
+// reportDoubleSigning reports to the staking system smart contract the validator that signed two different headers in
+// the same round, so it gets slashed. It is called on the consensus messages path, so the transaction is created and
+// sent on a separate go routine, and only once for a validator and a round
+func (n *Node) reportDoubleSigning(evidence *consensus.DoubleSigningEvidence) {
+	if !n.markDoubleSigningReported(evidence.FirstMessage) {
+		return
+	}
+
+	go n.sendDoubleSigningReport(evidence)
+}
+
+// markDoubleSigningReported returns false if the double signing was already reported. The reports older than
+// doubleSigningReportsRoundsKept rounds are forgotten
+func (n *Node) markDoubleSigningReported(message *consensus.Message) bool {
+	n.mutDoubleSigningReports.Lock()
+	defer n.mutDoubleSigningReports.Unlock()
+
+	for report := range n.reportedDoubleSignings {
+		if report.round+doubleSigningReportsRoundsKept < message.RoundIndex {
+			delete(n.reportedDoubleSignings, report)
+		}
+	}
+
+	report := doubleSigningReport{
+		pubKey: string(message.PubKey),
+		round:  message.RoundIndex,
+	}
+	_, alreadyReported := n.reportedDoubleSignings[report]
+	if alreadyReported {
+		return false
+	}
+
+	n.reportedDoubleSignings[report] = struct{}{}
+
+	return true
+}
+
+// sendDoubleSigningReport sends, from the node's own address, the transaction reporting the double signing. The reports
+// are sent one at a time, as the account nonce does not include the reports still pending in the pool
+func (n *Node) sendDoubleSigningReport(evidence *consensus.DoubleSigningEvidence) {
+	validator := core.GetTrimmedPk(hex.EncodeToString(evidence.FirstMessage.PubKey))
+
+	n.mutDoubleSigningNonce.Lock()
+	defer n.mutDoubleSigningNonce.Unlock()
+
+	tx, err := n.createDoubleSigningReport(evidence)
+	if err != nil {
+		log.Warn("double signing could not be reported", "validator", validator, "error", err.Error())
+		return
+	}
+
+	_, err = n.SendBulkTransactions([]*transaction.Transaction{tx})
+	if err != nil {
+		log.Warn("double signing report could not be sent", "validator", validator, "error", err.Error())
+		return
+	}
+
+	n.nextDoubleSigningNonce = tx.Nonce + 1
+	log.Info("double signing reported", "validator", validator, "nonce", tx.Nonce)
+}
+
+// createDoubleSigningReport creates the transaction reporting the double signing. The nonce is the account nonce,
+// unless a report sent before used it already. It must be called under mutDoubleSigningNonce
+func (n *Node) createDoubleSigningReport(evidence *consensus.DoubleSigningEvidence) (*transaction.Transaction, error) {
+	if n.txSignPubKey == nil {
+		return nil, ErrNilPublicKey
+	}
+	if n.txSignPrivKey == nil {
+		return nil, ErrNilPrivateKey
+	}
+	if n.txSingleSigner == nil || n.txSingleSigner.IsInterfaceNil() {
+		return nil, ErrNilSingleSig
+	}
+	if n.feeHandler == nil || n.feeHandler.IsInterfaceNil() {
+		return nil, ErrNilTxFeeHandler
+	}
+
+	firstMessage, err := n.marshalizer.Marshal(evidence.FirstMessage)
+	if err != nil {
+		return nil, err
+	}
+	secondMessage, err := n.marshalizer.Marshal(evidence.SecondMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, senderAddress, receiverAddress, senderShardId, err := n.generateBulkTransactionsPrepareParams(
+		hex.EncodeToString(vmFactory.StakingSCAddress),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if senderShardId != n.shardCoordinator.SelfId() {
+		return nil, ErrSenderNotInSelfShard
+	}
+	if nonce < n.nextDoubleSigningNonce {
+		nonce = n.nextDoubleSigningNonce
+	}
+
+	tx := &transaction.Transaction{
+		Nonce:    nonce,
+		Value:    big.NewInt(0),
+		RcvAddr:  receiverAddress,
+		SndAddr:  senderAddress,
+		GasPrice: minTxGasPrice,
+		Data:     fmt.Sprintf("reportDoubleSign@%s@%s", hex.EncodeToString(firstMessage), hex.EncodeToString(secondMessage)),
+		ChainID:  n.chainID,
+		Version:  n.minTransactionVersion,
+	}
+	tx.GasLimit = n.feeHandler.ComputeGasLimit(tx)
+
+	txBuff, err := n.marshalizer.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+	tx.Signature, err = n.txSingleSigner.Sign(n.txSignPrivKey, txBuff)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
 // GetBalance gets the balance for a specific address
 func (n *Node) GetBalance(addressHex string) (*big.Int, error) {
 	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() || n.accounts == nil || n.accounts.IsInterfaceNil() {
@@ -535,7 +683,12 @@ func (n *Node) SendTransaction(
 	gasPrice uint64,
 	gasLimit uint64,
 	transactionData string,
-	signature []byte) (string, error) {
+	signature []byte,
+	chainID string,
+	version uint32,
+	validAfterRound uint64,
+	validUntilRound uint64,
+) (string, error) {
 
 	if n.shardCoordinator == nil || n.shardCoordinator.IsInterfaceNil() {
 		return "", ErrNilShardCoordinator
@@ -567,6 +720,11 @@ func (n *Node) SendTransaction(
 		GasLimit:  gasLimit,
 		Data:      transactionData,
 		Signature: signature,
+		ChainID:   []byte(chainID),
+		Version:   version,
+
+		ValidAfterRound: validAfterRound,
+		ValidUntilRound: validUntilRound,
 	}
 
 	err = n.validateTx(&tx)
@@ -640,9 +798,8 @@ func (n *Node) SendBulkTransactions(txs []*transaction.Transaction) (uint64, err
 }
 
 func (n *Node) validateTx(tx *transaction.Transaction) error {
-	txValidator, err := dataValidators.NewTxValidator(n.accounts, n.shardCoordinator, nodeCmdFactory.MaxTxNonceDeltaAllowed)
-	if err != nil {
-		return nil
+	if check.IfNil(n.txValidator) {
+		return ErrNilTxValidator
 	}
 
 	marshalizedTx, err := n.marshalizer.Marshal(tx)
@@ -659,6 +816,8 @@ func (n *Node) validateTx(tx *transaction.Transaction) error {
 		n.addrConverter,
 		n.shardCoordinator,
 		n.feeHandler,
+		n.chainID,
+		n.minTransactionVersion,
 	)
 	if err != nil {
 		return err
@@ -669,7 +828,7 @@ func (n *Node) validateTx(tx *transaction.Transaction) error {
 		return err
 	}
 
-	return txValidator.CheckTxValidity(intTx)
+	return n.txValidator.CheckTxValidity(intTx)
 }
 
 func (n *Node) sendBulkTransactionsFromShard(transactions [][]byte, senderShardId uint32) error {
@@ -716,6 +875,10 @@ func (n *Node) CreateTransaction(
 	data string,
 	signatureHex string,
 	challenge string,
+	chainID string,
+	version uint32,
+	validAfterRound uint64,
+	validUntilRound uint64,
 ) (*transaction.Transaction, error) {
 
 	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() {
@@ -761,6 +924,11 @@ func (n *Node) CreateTransaction(
 		Data:      data,
 		Signature: signatureBytes,
 		Challenge: challengeBytes,
+		ChainID:   []byte(chainID),
+		Version:   version,
+
+		ValidAfterRound: validAfterRound,
+		ValidUntilRound: validUntilRound,
 	}, nil
 }
 
@@ -769,6 +937,34 @@ func (n *Node) GetTransaction(hash string) (*transaction.Transaction, error) {
 	return nil, fmt.Errorf("not yet implemented")
 }
 
+// GetTransactionReceipt returns the receipt of an executed transaction given its hex encoded hash
+func (n *Node) GetTransactionReceipt(hash string) (*receipt.Receipt, error) {
+	if check.IfNil(n.receiptsHandler) {
+		return nil, ErrNilReceiptsHandler
+	}
+
+	txHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.receiptsHandler.GetReceipt(txHash)
+}
+
+// GetLogsBloom returns the bloom of the event logs emitted in a block given its hex encoded hash
+func (n *Node) GetLogsBloom(blockHash string) (receipt.Bloom, error) {
+	if check.IfNil(n.receiptsHandler) {
+		return nil, ErrNilReceiptsHandler
+	}
+
+	headerHash, err := hex.DecodeString(blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.receiptsHandler.GetLogsBloom(headerHash)
+}
+
 // GetCurrentPublicKey will return the current node's public key
 func (n *Node) GetCurrentPublicKey() string {
 	if n.txSignPubKey != nil {
@@ -198,6 +198,8 @@ func (n *Node) generateAndSignSingleTx(
 		RcvAddr:  rcvAddrBytes,
 		SndAddr:  sndAddrBytes,
 		Data:     data,
+		ChainID:  n.chainID,
+		Version:  n.minTransactionVersion,
 	}
 
 	marshalizedTx, err := n.marshalizer.Marshal(&tx)
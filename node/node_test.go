@@ -15,9 +15,11 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -27,7 +29,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	vmFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -581,8 +585,10 @@ func TestCreateTransaction_NilAddrConverterShouldErr(t *testing.T) {
 	txData := "-"
 	signature := "-"
 	challenge := "-"
+	chainID := "chainID"
+	version := uint32(1)
 
-	tx, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature, challenge)
+	tx, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature, challenge, chainID, version, 0, 0)
 
 	assert.Nil(t, tx)
 	assert.Equal(t, node.ErrNilAddressConverter, err)
@@ -611,8 +617,10 @@ func TestCreateTransaction_NilAccountsAdapterShouldErr(t *testing.T) {
 	txData := "-"
 	signature := "-"
 	challenge := "-"
+	chainID := "chainID"
+	version := uint32(1)
 
-	tx, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature, challenge)
+	tx, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature, challenge, chainID, version, 0, 0)
 
 	assert.Nil(t, tx)
 	assert.Equal(t, node.ErrNilAccountsAdapter, err)
@@ -642,8 +650,10 @@ func TestCreateTransaction_InvalidSignatureShouldErr(t *testing.T) {
 	txData := "-"
 	signature := "-"
 	challenge := "af4e5"
+	chainID := "chainID"
+	version := uint32(1)
 
-	tx, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature, challenge)
+	tx, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature, challenge, chainID, version, 0, 0)
 
 	assert.Nil(t, tx)
 	assert.NotNil(t, err)
@@ -673,14 +683,18 @@ func TestCreateTransaction_OkValsShouldWork(t *testing.T) {
 	txData := "-"
 	signature := "617eff4f"
 	challenge := "aff64e"
+	chainID := "chainID"
+	version := uint32(1)
 
-	tx, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature, challenge)
+	tx, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature, challenge, chainID, version, 0, 0)
 
 	assert.NotNil(t, tx)
 	assert.Nil(t, err)
 	assert.Equal(t, nonce, tx.Nonce)
 	assert.Equal(t, value, tx.Value)
 	assert.True(t, bytes.Equal([]byte(receiver), tx.RcvAddr))
+	assert.Equal(t, []byte(chainID), tx.ChainID)
+	assert.Equal(t, version, tx.Version)
 }
 
 func TestSendBulkTransactions_NoTxShouldErr(t *testing.T) {
@@ -716,12 +730,36 @@ func TestSendTransaction_ShouldWork(t *testing.T) {
 	hasher := &mock.HasherFake{}
 	adrConverter := mock.NewAddressConverterFake(32, "0x")
 
+	txValidatorCalled := false
 	n, _ := node.NewNode(
 		node.WithMarshalizer(marshalizer),
 		node.WithAddressConverter(adrConverter),
 		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
 		node.WithMessenger(mes),
 		node.WithHasher(hasher),
+		node.WithKeyGenForAccounts(&mock.KeyGenMock{
+			PublicKeyFromByteArrayMock: func(b []byte) (crypto.PublicKey, error) {
+				return nil, nil
+			},
+		}),
+		node.WithTxSingleSigner(&mock.SinglesignStub{
+			VerifyCalled: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				return nil
+			},
+		}),
+		node.WithTxFeeHandler(&mock.FeeHandlerStub{
+			CheckValidityTxValuesCalled: func(tx process.TransactionWithFeeHandler) error {
+				return nil
+			},
+		}),
+		node.WithChainID([]byte("chainID")),
+		node.WithMinTransactionVersion(1),
+		node.WithTxValidator(&mock.TxValidatorStub{
+			CheckTxValidityCalled: func(txValidatorHandler process.TxValidatorHandler) error {
+				txValidatorCalled = true
+				return nil
+			},
+		}),
 	)
 
 	nonce := uint64(50)
@@ -730,6 +768,10 @@ func TestSendTransaction_ShouldWork(t *testing.T) {
 	receiver := createDummyHexAddress(64)
 	txData := "data"
 	signature := []byte("signature")
+	chainID := "chainID"
+	version := uint32(1)
+	validAfterRound := uint64(10)
+	validUntilRound := uint64(20)
 
 	senderBuff, _ := adrConverter.CreateAddressFromHex(sender)
 	receiverBuff, _ := adrConverter.CreateAddressFromHex(receiver)
@@ -742,7 +784,11 @@ func TestSendTransaction_ShouldWork(t *testing.T) {
 		0,
 		0,
 		txData,
-		signature)
+		signature,
+		chainID,
+		version,
+		validAfterRound,
+		validUntilRound)
 
 	marshalizedTx, _ := marshalizer.Marshal(&transaction.Transaction{
 		Nonce:     nonce,
@@ -751,12 +797,54 @@ func TestSendTransaction_ShouldWork(t *testing.T) {
 		RcvAddr:   receiverBuff.Bytes(),
 		Data:      txData,
 		Signature: signature,
+		ChainID:   []byte(chainID),
+		Version:   version,
+
+		ValidAfterRound: validAfterRound,
+		ValidUntilRound: validUntilRound,
 	})
 	txHexHashExpected := hex.EncodeToString(hasher.Compute(string(marshalizedTx)))
 
 	assert.Nil(t, err)
 	assert.Equal(t, txHexHashExpected, txHexHashResulted)
 	assert.True(t, txSent)
+	assert.True(t, txValidatorCalled)
+}
+
+func TestSendTransaction_NilTxValidatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txSent := false
+	mes := &mock.MessengerStub{
+		BroadcastOnChannelCalled: func(pipe string, topic string, buff []byte) {
+			txSent = true
+		},
+	}
+	adrConverter := mock.NewAddressConverterFake(32, "0x")
+	n, _ := node.NewNode(
+		node.WithMarshalizer(&mock.MarshalizerFake{}),
+		node.WithAddressConverter(adrConverter),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithMessenger(mes),
+		node.WithHasher(&mock.HasherFake{}),
+	)
+
+	_, err := n.SendTransaction(
+		0,
+		createDummyHexAddress(64),
+		createDummyHexAddress(64),
+		"0",
+		0,
+		0,
+		"",
+		[]byte("signature"),
+		"chainID",
+		1,
+		0,
+		0)
+
+	assert.Equal(t, node.ErrNilTxValidator, err)
+	assert.False(t, txSent)
 }
 
 func TestCreateShardedStores_NilShardCoordinatorShouldError(t *testing.T) {
@@ -1591,6 +1679,74 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 	assert.Equal(t, accnt, recovAccnt)
 }
 
+func TestNode_GetTransactionReceiptNilReceiptsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	rcpt, err := n.GetTransactionReceipt("aabb")
+
+	assert.Nil(t, rcpt)
+	assert.Equal(t, node.ErrNilReceiptsHandler, err)
+}
+
+func TestNode_GetTransactionReceiptInvalidHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithReceiptsHandler(&mock.ReceiptsHandlerStub{}),
+	)
+
+	rcpt, err := n.GetTransactionReceipt("not hex")
+
+	assert.Nil(t, rcpt)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetTransactionReceiptShouldReturn(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("tx hash")
+	expectedReceipt := &receipt.Receipt{TxHash: txHash}
+	n, _ := node.NewNode(
+		node.WithReceiptsHandler(&mock.ReceiptsHandlerStub{
+			GetReceiptCalled: func(hash []byte) (*receipt.Receipt, error) {
+				if bytes.Equal(hash, txHash) {
+					return expectedReceipt, nil
+				}
+				return nil, errors.New("not found")
+			},
+		}),
+	)
+
+	rcpt, err := n.GetTransactionReceipt(hex.EncodeToString(txHash))
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedReceipt, rcpt)
+}
+
+func TestNode_GetLogsBloomShouldReturn(t *testing.T) {
+	t.Parallel()
+
+	blockHash := []byte("block hash")
+	expectedBloom := receipt.NewBloom()
+	n, _ := node.NewNode(
+		node.WithReceiptsHandler(&mock.ReceiptsHandlerStub{
+			GetLogsBloomCalled: func(headerHash []byte) (receipt.Bloom, error) {
+				if bytes.Equal(headerHash, blockHash) {
+					return expectedBloom, nil
+				}
+				return nil, errors.New("not found")
+			},
+		}),
+	)
+
+	bloom, err := n.GetLogsBloom(hex.EncodeToString(blockHash))
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBloom, bloom)
+}
+
 func TestNode_AppStatusHandlersShouldIncrement(t *testing.T) {
 	t.Parallel()
 
@@ -1725,6 +1881,8 @@ func TestNode_SendBulkTransactionsMultiShardTxsShouldBeMappedCorrectly(t *testin
 		Data:      "",
 		Signature: []byte("sig0"),
 		Challenge: nil,
+		ChainID:   []byte("chainID"),
+		Version:   1,
 	})
 
 	txsToSend = append(txsToSend, &transaction.Transaction{
@@ -1737,6 +1895,8 @@ func TestNode_SendBulkTransactionsMultiShardTxsShouldBeMappedCorrectly(t *testin
 		Data:      "",
 		Signature: []byte("sig1"),
 		Challenge: nil,
+		ChainID:   []byte("chainID"),
+		Version:   1,
 	})
 
 	txsToSend = append(txsToSend, &transaction.Transaction{
@@ -1749,6 +1909,8 @@ func TestNode_SendBulkTransactionsMultiShardTxsShouldBeMappedCorrectly(t *testin
 		Data:      "",
 		Signature: []byte("sig2"),
 		Challenge: nil,
+		ChainID:   []byte("chainID"),
+		Version:   1,
 	})
 
 	wg := sync.WaitGroup{}
@@ -1827,6 +1989,13 @@ func TestNode_SendBulkTransactionsMultiShardTxsShouldBeMappedCorrectly(t *testin
 		node.WithMessenger(mes),
 		node.WithDataPool(dataPool),
 		node.WithTxFeeHandler(feeHandler),
+		node.WithChainID([]byte("chainID")),
+		node.WithMinTransactionVersion(1),
+		node.WithTxValidator(&mock.TxValidatorStub{
+			CheckTxValidityCalled: func(txValidatorHandler process.TxValidatorHandler) error {
+				return nil
+			},
+		}),
 	)
 
 	numTxs, err := n.SendBulkTransactions(txsToSend)
@@ -1855,3 +2024,109 @@ func TestNode_SendBulkTransactionsMultiShardTxsShouldBeMappedCorrectly(t *testin
 	assert.Equal(t, len(txsToSend), recTxsSize)
 	mutRecoveredTransactions.RUnlock()
 }
+
+func createNodeForDoubleSigningReport(shardCoordinator sharding.Coordinator) *node.Node {
+	n, _ := node.NewNode(
+		node.WithMarshalizer(getMarshalizer()),
+		node.WithHasher(getHasher()),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+		node.WithAccountsAdapter(getAccAdapter(big.NewInt(1000))),
+		node.WithTxSignPrivKey(getPrivateKey()),
+		node.WithTxSignPubKey(&mock.PublicKeyMock{
+			ToByteArrayHandler: func() ([]byte, error) {
+				return []byte("sender address of 32 bytes long."), nil
+			},
+		}),
+		node.WithTxSingleSigner(&mock.SinglesignMock{}),
+		node.WithShardCoordinator(shardCoordinator),
+		node.WithTxFeeHandler(&mock.FeeHandlerStub{
+			ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+				return uint64(len(tx.GetData()))
+			},
+		}),
+		node.WithChainID([]byte("chainID")),
+		node.WithMinTransactionVersion(1),
+	)
+
+	return n
+}
+
+func createDoubleSigningEvidence() *consensus.DoubleSigningEvidence {
+	return &consensus.DoubleSigningEvidence{
+		FirstMessage:  &consensus.Message{PubKey: []byte("validator"), BlockHeaderHash: []byte("hash1")},
+		SecondMessage: &consensus.Message{PubKey: []byte("validator"), BlockHeaderHash: []byte("hash2")},
+	}
+}
+
+func TestNode_CreateDoubleSigningReportShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForDoubleSigningReport(mock.NewOneShardCoordinatorMock())
+
+	tx, err := n.CreateDoubleSigningReport(createDoubleSigningEvidence())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), tx.Nonce)
+	assert.Equal(t, big.NewInt(0), tx.Value)
+	assert.Equal(t, []byte("sender address of 32 bytes long."), tx.SndAddr)
+	assert.Equal(t, vmFactory.StakingSCAddress, tx.RcvAddr)
+	assert.True(t, strings.HasPrefix(tx.Data, "reportDoubleSign@"))
+	assert.Equal(t, uint64(len(tx.Data)), tx.GasLimit)
+	assert.Equal(t, []byte("chainID"), tx.ChainID)
+	assert.Equal(t, uint32(1), tx.Version)
+	assert.Equal(t, []byte("signed"), tx.Signature)
+}
+
+func TestNode_CreateDoubleSigningReportShouldNotReuseTheNonceOfAReportSentBefore(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForDoubleSigningReport(mock.NewOneShardCoordinatorMock())
+	n.SetNextDoubleSigningNonce(5)
+
+	tx, err := n.CreateDoubleSigningReport(createDoubleSigningEvidence())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), tx.Nonce)
+}
+
+func TestNode_MarkDoubleSigningReportedShouldReportOncePerValidatorAndRound(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForDoubleSigningReport(mock.NewOneShardCoordinatorMock())
+
+	assert.True(t, n.MarkDoubleSigningReported(&consensus.Message{PubKey: []byte("validator"), RoundIndex: 10}))
+	assert.False(t, n.MarkDoubleSigningReported(&consensus.Message{PubKey: []byte("validator"), RoundIndex: 10}))
+	assert.True(t, n.MarkDoubleSigningReported(&consensus.Message{PubKey: []byte("validator"), RoundIndex: 11}))
+	assert.True(t, n.MarkDoubleSigningReported(&consensus.Message{PubKey: []byte("other validator"), RoundIndex: 10}))
+
+	// the old reports are forgotten
+	assert.True(t, n.MarkDoubleSigningReported(&consensus.Message{PubKey: []byte("validator"), RoundIndex: 1000}))
+	assert.True(t, n.MarkDoubleSigningReported(&consensus.Message{PubKey: []byte("validator"), RoundIndex: 10}))
+}
+
+func TestNode_CreateDoubleSigningReportSenderInOtherShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+		return 1
+	}
+	n := createNodeForDoubleSigningReport(shardCoordinator)
+
+	tx, err := n.CreateDoubleSigningReport(createDoubleSigningEvidence())
+	assert.Nil(t, tx)
+	assert.Equal(t, node.ErrSenderNotInSelfShard, err)
+}
+
+func TestNode_CreateDoubleSigningReportNilFeeHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithMarshalizer(getMarshalizer()),
+		node.WithTxSignPrivKey(getPrivateKey()),
+		node.WithTxSignPubKey(&mock.PublicKeyMock{}),
+		node.WithTxSingleSigner(&mock.SinglesignMock{}),
+	)
+
+	tx, err := n.CreateDoubleSigningReport(createDoubleSigningEvidence())
+	assert.Nil(t, tx)
+	assert.Equal(t, node.ErrNilTxFeeHandler, err)
+}
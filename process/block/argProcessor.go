@@ -34,22 +34,25 @@ type ArgBaseProcessor struct {
 	ValidatorStatisticsProcessor process.ValidatorStatisticsProcessor
 	Rounder                      consensus.Rounder
 	BootStorer                   process.BootStorer
+	ReceiptsHandler              process.ReceiptsHandler
 }
 
 // ArgShardProcessor holds all dependencies required by the process data factory in order to create
 // new instances of shard processor
 type ArgShardProcessor struct {
 	ArgBaseProcessor
-	DataPool        dataRetriever.PoolsHolder
-	TxsPoolsCleaner process.PoolsCleaner
+	DataPool          dataRetriever.PoolsHolder
+	TxsPoolsCleaner   process.PoolsCleaner
+	GovernanceApplier process.GovernanceChangesApplier
 }
 
 // ArgMetaProcessor holds all dependencies required by the process data factory in order to create
 // new instances of meta processor
 type ArgMetaProcessor struct {
 	ArgBaseProcessor
-	DataPool           dataRetriever.MetaPoolsHolder
-	SCDataGetter       external.SCQueryService
-	PeerChangesHandler process.PeerChangesHandler
-	SCToProtocol       process.SmartContractToProtocolHandler
+	DataPool             dataRetriever.MetaPoolsHolder
+	SCDataGetter         external.SCQueryService
+	PeerChangesHandler   process.PeerChangesHandler
+	SCToProtocol         process.SmartContractToProtocolHandler
+	GovernanceToProtocol process.GovernanceChangesHandler
 }
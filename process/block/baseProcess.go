@@ -68,6 +68,7 @@ type baseProcessor struct {
 	validatorStatisticsProcessor process.ValidatorStatisticsProcessor
 	rounder                      consensus.Rounder
 	bootStorer                   process.BootStorer
+	receiptsHandler              process.ReceiptsHandler
 	requestBlockBodyHandler      process.RequestBlockBodyHandler
 
 	hdrsForCurrBlock hdrForBlock
@@ -660,10 +661,37 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if arguments.TxCoordinator == nil || arguments.TxCoordinator.IsInterfaceNil() {
 		return process.ErrNilTransactionCoordinator
 	}
+	if check.IfNil(arguments.ReceiptsHandler) {
+		return process.ErrNilReceiptsHandler
+	}
 
 	return nil
 }
 
+// removeReceiptsFromStorage removes the receipts saved when the given block was committed, as the block is rolled back
+func (bp *baseProcessor) removeReceiptsFromStorage(header data.HeaderHandler, body block.Body) {
+	headerHash, err := core.CalculateHash(bp.marshalizer, bp.hasher, header)
+	if err != nil {
+		log.Debug("removeReceiptsFromStorage.CalculateHash", "error", err.Error())
+		return
+	}
+
+	err = bp.receiptsHandler.RemoveReceiptsFromStorage(headerHash, getTxHashesFromBody(body))
+	if err != nil {
+		log.Debug("RemoveReceiptsFromStorage", "error", err.Error())
+	}
+}
+
+// getTxHashesFromBody returns the hashes of all the transactions and smart contract results included in the body
+func getTxHashesFromBody(body block.Body) [][]byte {
+	txHashes := make([][]byte, 0)
+	for _, miniBlock := range body {
+		txHashes = append(txHashes, miniBlock.TxHashes...)
+	}
+
+	return txHashes
+}
+
 func (bp *baseProcessor) createBlockStarted() {
 	bp.resetMissingHdrs()
 	bp.hdrsForCurrBlock.mutHdrsForBlock.Lock()
@@ -671,6 +699,7 @@ func (bp *baseProcessor) createBlockStarted() {
 	bp.hdrsForCurrBlock.highestHdrNonce = make(map[uint32]uint64)
 	bp.hdrsForCurrBlock.mutHdrsForBlock.Unlock()
 	bp.txCoordinator.CreateBlockStarted()
+	bp.receiptsHandler.CreateBlockStarted()
 }
 
 func (bp *baseProcessor) resetMissingHdrs() {
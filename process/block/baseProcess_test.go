@@ -379,9 +379,11 @@ func CreateMockArguments() blproc.ArgShardProcessor {
 					return nil
 				},
 			},
+			ReceiptsHandler: &mock.ReceiptsHandlerStub{},
 		},
-		DataPool:        initDataPool([]byte("")),
-		TxsPoolsCleaner: &mock.TxPoolsCleanerMock{},
+		DataPool:          initDataPool([]byte("")),
+		TxsPoolsCleaner:   &mock.TxPoolsCleanerMock{},
+		GovernanceApplier: &mock.GovernanceChangesHandlerStub{},
 	}
 
 	return arguments
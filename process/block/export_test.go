@@ -44,6 +44,10 @@ func (sp *shardProcessor) GetOrderedProcessedMetaBlocksFromHeader(header *block.
 	return sp.getOrderedProcessedMetaBlocksFromHeader(header)
 }
 
+func (sp *shardProcessor) ApplyGovernanceChanges(blockNonce uint64, processedMetaHdrs []data.HeaderHandler) {
+	sp.applyGovernanceChanges(blockNonce, processedMetaHdrs)
+}
+
 func (sp *shardProcessor) RemoveProcessedMetaBlocksFromPool(processedMetaHdrs []data.HeaderHandler) error {
 	return sp.removeProcessedMetaBlocksFromPool(processedMetaHdrs)
 }
@@ -79,9 +83,11 @@ func NewShardProcessorEmptyWith3shards(tdp dataRetriever.PoolsHolder, genesisBlo
 					return nil
 				},
 			},
+			ReceiptsHandler: &mock.ReceiptsHandlerStub{},
 		},
-		DataPool:        tdp,
-		TxsPoolsCleaner: &mock.TxPoolsCleanerMock{},
+		DataPool:          tdp,
+		TxsPoolsCleaner:   &mock.TxPoolsCleanerMock{},
+		GovernanceApplier: &mock.GovernanceChangesHandlerStub{},
 	}
 	shardProcessor, err := NewShardProcessor(arguments)
 	return shardProcessor, err
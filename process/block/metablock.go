@@ -31,6 +31,8 @@ type metaProcessor struct {
 	scToProtocol process.SmartContractToProtocolHandler
 	peerChanges  process.PeerChangesHandler
 
+	governanceToProtocol process.GovernanceChangesHandler
+
 	shardsHeadersNonce *sync.Map
 	shardBlockFinality uint32
 	chRcvAllHdrs       chan bool
@@ -59,6 +61,9 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 	if arguments.SCToProtocol == nil || arguments.SCToProtocol.IsInterfaceNil() {
 		return nil, process.ErrNilSCToProtocol
 	}
+	if arguments.GovernanceToProtocol == nil || arguments.GovernanceToProtocol.IsInterfaceNil() {
+		return nil, process.ErrNilGovernanceToProtocol
+	}
 
 	blockSizeThrottler, err := throttle.NewBlockSizeThrottle()
 	if err != nil {
@@ -84,6 +89,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		validatorStatisticsProcessor:  arguments.ValidatorStatisticsProcessor,
 		rounder:                       arguments.Rounder,
 		bootStorer:                    arguments.BootStorer,
+		receiptsHandler:               arguments.ReceiptsHandler,
 	}
 
 	err = base.setLastNotarizedHeadersSlice(arguments.StartHeaders)
@@ -99,6 +105,8 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		scDataGetter:   arguments.SCDataGetter,
 		peerChanges:    arguments.PeerChangesHandler,
 		scToProtocol:   arguments.SCToProtocol,
+
+		governanceToProtocol: arguments.GovernanceToProtocol,
 	}
 
 	mp.baseProcessor.requestBlockBodyHandler = &mp
@@ -260,7 +268,12 @@ func (mp *metaProcessor) ProcessBlock(
 		return err
 	}
 
-	err = mp.scToProtocol.UpdateProtocol(body, header.Round)
+	err = mp.scToProtocol.UpdateProtocol(body, header.Nonce)
+	if err != nil {
+		return err
+	}
+
+	err = mp.governanceToProtocol.VerifyGovernanceChanges(header.Nonce, header.GovernanceChanges)
 	if err != nil {
 		return err
 	}
@@ -518,11 +531,23 @@ func (mp *metaProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler,
 		log.Debug("RestoreBlockDataFromStorage", "error", errNotCritical.Error())
 	}
 
+	mp.removeReceiptsFromStorage(metaBlock, body)
 	mp.removeLastNotarized()
 
 	return nil
 }
 
+// RevertStateToBlock recreates the state tries to the root hashes indicated by the provided header and sets the
+// governance changed parameters as they were after it
+func (mp *metaProcessor) RevertStateToBlock(header data.HeaderHandler) error {
+	err := mp.baseProcessor.RevertStateToBlock(header)
+	if err != nil {
+		return err
+	}
+
+	return mp.governanceToProtocol.RestoreGovernanceChanges(header.GetNonce())
+}
+
 // CreateBlockBody creates block body of metachain
 func (mp *metaProcessor) CreateBlockBody(initialHdrData data.HeaderHandler, haveTime func() bool) (data.BodyHandler, error) {
 	log.Trace("started creating block body",
@@ -537,7 +562,7 @@ func (mp *metaProcessor) CreateBlockBody(initialHdrData data.HeaderHandler, have
 		return nil, err
 	}
 
-	err = mp.scToProtocol.UpdateProtocol(miniBlocks, initialHdrData.GetRound())
+	err = mp.scToProtocol.UpdateProtocol(miniBlocks, initialHdrData.GetNonce())
 	if err != nil {
 		return nil, err
 	}
@@ -835,6 +860,11 @@ func (mp *metaProcessor) CommitBlock(
 		return err
 	}
 
+	errNotCritical = mp.receiptsHandler.SaveReceiptsToStorage(headerHash, getTxHashesFromBody(body))
+	if errNotCritical != nil {
+		log.Debug("SaveReceiptsToStorage", "error", errNotCritical.Error())
+	}
+
 	for i := 0; i < len(body); i++ {
 		buff, err = mp.marshalizer.Marshal(body[i])
 		if err != nil {
@@ -897,6 +927,8 @@ func (mp *metaProcessor) CommitBlock(
 		return err
 	}
 
+	mp.governanceToProtocol.ApplyGovernanceChanges(header.Nonce, header.GovernanceChanges)
+
 	log.Info("meta block has been committed successfully",
 		"nonce", header.Nonce,
 		"round", header.Round,
@@ -1453,8 +1485,14 @@ func (mp *metaProcessor) ApplyBodyToHeader(hdr data.HeaderHandler, bodyHandler d
 		return err
 	}
 
+	governanceChanges, err := mp.governanceToProtocol.GovernanceChanges(metaHdr.Nonce)
+	if err != nil {
+		return err
+	}
+
 	metaHdr.ShardInfo = shardInfo
 	metaHdr.PeerInfo = peerInfo
+	metaHdr.GovernanceChanges = governanceChanges
 	metaHdr.RootHash = mp.getRootHash()
 	metaHdr.TxCount = getTxCount(shardInfo)
 
@@ -47,11 +47,13 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 					return nil
 				},
 			},
+			ReceiptsHandler: &mock.ReceiptsHandlerStub{},
 		},
-		DataPool:           mdp,
-		SCDataGetter:       &mock.ScQueryMock{},
-		SCToProtocol:       &mock.SCToProtocolStub{},
-		PeerChangesHandler: &mock.PeerChangesHandler{},
+		DataPool:             mdp,
+		SCDataGetter:         &mock.ScQueryMock{},
+		SCToProtocol:         &mock.SCToProtocolStub{},
+		GovernanceToProtocol: &mock.GovernanceChangesHandlerStub{},
+		PeerChangesHandler:   &mock.PeerChangesHandler{},
 	}
 	return arguments
 }
@@ -179,6 +181,17 @@ func TestNewMetaProcessor_NilDataPoolShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilGovernanceToProtocolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.GovernanceToProtocol = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilGovernanceToProtocol, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_NilForkDetectorShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -256,6 +269,17 @@ func TestNewMetaProcessor_NilTxCoordinatorShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilReceiptsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.ReceiptsHandler = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilReceiptsHandler, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -843,6 +867,60 @@ func TestMetaProcessor_ApplyBodyToHeaderShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestMetaProcessor_ApplyBodyToHeaderShouldSetGovernanceChanges(t *testing.T) {
+	t.Parallel()
+
+	changes := []block.GovernanceData{
+		{ProposalID: 1, Parameter: "economics.MinGasPrice", Value: "10", ActivationNonce: 5},
+	}
+	arguments := createMockMetaArguments()
+	arguments.Accounts = &mock.AccountsStub{
+		JournalLenCalled: func() int {
+			return 0
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("root"), nil
+		},
+	}
+	arguments.DataPool = initMetaDataPool()
+	arguments.Store = initStore()
+	arguments.GovernanceToProtocol = &mock.GovernanceChangesHandlerStub{
+		GovernanceChangesCalled: func(nonce uint64) ([]block.GovernanceData, error) {
+			assert.Equal(t, uint64(5), nonce)
+			return changes, nil
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	hdr := &block.MetaBlock{Nonce: 5}
+	err := mp.ApplyBodyToHeader(hdr, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, changes, hdr.GovernanceChanges)
+}
+
+func TestMetaProcessor_RevertStateToBlockShouldRestoreGovernanceChanges(t *testing.T) {
+	t.Parallel()
+
+	restoredNonce := uint64(0)
+	arguments := createMockMetaArguments()
+	arguments.Accounts = &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return nil
+		},
+	}
+	arguments.GovernanceToProtocol = &mock.GovernanceChangesHandlerStub{
+		RestoreGovernanceChangesCalled: func(nonce uint64) error {
+			restoredNonce = nonce
+			return nil
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	err := mp.RevertStateToBlock(&block.MetaBlock{Nonce: 5})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), restoredNonce)
+}
+
 func TestMetaProcessor_CommitBlockShouldRevertAccountStateWhenErr(t *testing.T) {
 	t.Parallel()
 
@@ -4,6 +4,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -17,6 +19,7 @@ type TxPoolsCleaner struct {
 	shardCoordinator sharding.Coordinator
 	dataPool         dataRetriever.PoolsHolder
 	addrConverter    state.AddressConverter
+	rounder          consensus.Rounder
 	numRemovedTxs    uint64
 	canDoClean       chan struct{}
 }
@@ -27,6 +30,7 @@ func NewTxsPoolsCleaner(
 	shardCoordinator sharding.Coordinator,
 	dataPool dataRetriever.PoolsHolder,
 	addrConverter state.AddressConverter,
+	rounder consensus.Rounder,
 ) (*TxPoolsCleaner, error) {
 	if accounts == nil || accounts.IsInterfaceNil() {
 		return nil, process.ErrNilAccountsAdapter
@@ -45,6 +49,9 @@ func NewTxsPoolsCleaner(
 	if addrConverter == nil || addrConverter.IsInterfaceNil() {
 		return nil, process.ErrNilAddressConverter
 	}
+	if check.IfNil(rounder) {
+		return nil, process.ErrNilRounder
+	}
 
 	canDoClean := make(chan struct{}, 1)
 
@@ -53,12 +60,13 @@ func NewTxsPoolsCleaner(
 		shardCoordinator: shardCoordinator,
 		dataPool:         dataPool,
 		addrConverter:    addrConverter,
+		rounder:          rounder,
 		numRemovedTxs:    0,
 		canDoClean:       canDoClean,
 	}, nil
 }
 
-// Clean removes the transactions with lower nonces than the senders' accounts.
+// Clean removes the transactions with lower nonces than the senders' accounts and the expired ones.
 func (tpc *TxPoolsCleaner) Clean(duration time.Duration) (bool, error) {
 	if duration == 0 {
 		return false, process.ErrZeroMaxCleanTime
@@ -86,6 +94,10 @@ func (tpc *TxPoolsCleaner) cleanPools(haveTime func() bool) {
 	shardId := tpc.shardCoordinator.SelfId()
 	transactions := tpc.dataPool.Transactions()
 	numOfShards := tpc.shardCoordinator.NumberOfShards()
+	currentRound := uint64(0)
+	if tpc.rounder.Index() > 0 {
+		currentRound = uint64(tpc.rounder.Index())
+	}
 
 	for destShardId := uint32(0); destShardId < numOfShards; destShardId++ {
 		cacherId := process.ShardCacherIdentifier(shardId, destShardId)
@@ -108,6 +120,12 @@ func (tpc *TxPoolsCleaner) cleanPools(haveTime func() bool) {
 				continue
 			}
 
+			if tx.IsExpiredInRound(currentRound) {
+				txsPool.Remove(key)
+				atomic.AddUint64(&tpc.numRemovedTxs, 1)
+				continue
+			}
+
 			sndAddr := tx.GetSndAddress()
 			addr, err := tpc.addrConverter.CreateAddressFromPublicKeyBytes(sndAddr)
 			if err != nil {
@@ -102,7 +102,7 @@ func TestNewTxsPoolsCleaner_NilAccountsShouldErr(t *testing.T) {
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 	tdp := initDataPool([]byte("test"))
 	addrConverter, _ := addressConverters.NewPlainAddressConverter(32, "0x")
-	txsPoolsCleaner, err := poolsCleaner.NewTxsPoolsCleaner(nil, shardCoordinator, tdp, addrConverter)
+	txsPoolsCleaner, err := poolsCleaner.NewTxsPoolsCleaner(nil, shardCoordinator, tdp, addrConverter, &mock.RounderMock{})
 
 	assert.Nil(t, txsPoolsCleaner)
 	assert.Equal(t, process.ErrNilAccountsAdapter, err)
@@ -116,7 +116,7 @@ func TestNewTxsPoolsCleaner_NilShardCoordinatorShouldErr(t *testing.T) {
 	accounts := getAccAdapter(nonce, balance)
 	tdp := initDataPool([]byte("test"))
 	addrConverter, _ := addressConverters.NewPlainAddressConverter(32, "0x")
-	txsPoolsCleaner, err := poolsCleaner.NewTxsPoolsCleaner(accounts, nil, tdp, addrConverter)
+	txsPoolsCleaner, err := poolsCleaner.NewTxsPoolsCleaner(accounts, nil, tdp, addrConverter, &mock.RounderMock{})
 
 	assert.Nil(t, txsPoolsCleaner)
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
@@ -130,7 +130,7 @@ func TestNewTxsPoolsCleaner_NilDataPoolShouldErr(t *testing.T) {
 	accounts := getAccAdapter(nonce, balance)
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 	addrConverter, _ := addressConverters.NewPlainAddressConverter(32, "0x")
-	txsPoolsCleaner, err := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, nil, addrConverter)
+	txsPoolsCleaner, err := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, nil, addrConverter, &mock.RounderMock{})
 
 	assert.Nil(t, txsPoolsCleaner)
 	assert.Equal(t, process.ErrNilDataPoolHolder, err)
@@ -149,7 +149,7 @@ func TestNewTxsPoolsCleaner_NilTransactionPoolShouldErr(t *testing.T) {
 		},
 	}
 	addrConverter, _ := addressConverters.NewPlainAddressConverter(32, "0x")
-	txsPoolsCleaner, err := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, addrConverter)
+	txsPoolsCleaner, err := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, addrConverter, &mock.RounderMock{})
 
 	assert.Nil(t, txsPoolsCleaner)
 	assert.Equal(t, process.ErrNilTransactionPool, err)
@@ -163,12 +163,27 @@ func TestNewTxsPoolsCleaner_NilAddressConverterShouldErr(t *testing.T) {
 	accounts := getAccAdapter(nonce, balance)
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 	tdp := initDataPool([]byte("test"))
-	txsPoolsCleaner, err := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, nil)
+	txsPoolsCleaner, err := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, nil, &mock.RounderMock{})
 
 	assert.Nil(t, txsPoolsCleaner)
 	assert.Equal(t, process.ErrNilAddressConverter, err)
 }
 
+func TestNewTxsPoolsCleaner_NilRounderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(1)
+	balance := big.NewInt(1)
+	accounts := getAccAdapter(nonce, balance)
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	tdp := initDataPool([]byte("test"))
+	addrConverter, _ := addressConverters.NewPlainAddressConverter(32, "0x")
+	txsPoolsCleaner, err := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, addrConverter, nil)
+
+	assert.Nil(t, txsPoolsCleaner)
+	assert.Equal(t, process.ErrNilRounder, err)
+}
+
 func TestNewTxsPoolsCleaner_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -178,7 +193,7 @@ func TestNewTxsPoolsCleaner_ShouldWork(t *testing.T) {
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 	tdp := initDataPool([]byte("test"))
 	addrConverter, _ := addressConverters.NewPlainAddressConverter(32, "0x")
-	txsPoolsCleaner, err := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, addrConverter)
+	txsPoolsCleaner, err := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, addrConverter, &mock.RounderMock{})
 
 	assert.NotNil(t, txsPoolsCleaner)
 	assert.Nil(t, err)
@@ -194,7 +209,7 @@ func TestTxPoolsCleaner_CleanNilSenderAddrShouldRemoveTx(t *testing.T) {
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 	tdp := initDataPoolWithFourTransactions()
 	addrConverter, _ := addressConverters.NewPlainAddressConverter(32, "0x")
-	txsPoolsCleaner, _ := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, addrConverter)
+	txsPoolsCleaner, _ := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, addrConverter, &mock.RounderMock{})
 
 	itRan, err := txsPoolsCleaner.Clean(maxCleanTime)
 	assert.Nil(t, err)
@@ -217,7 +232,7 @@ func TestTxPoolsCleaner_CleanAccountNotExistsShouldRemoveTx(t *testing.T) {
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 	tdp := initDataPoolWithFourTransactions()
 	addrConverter, _ := addressConverters.NewPlainAddressConverter(32, "0x")
-	txsPoolsCleaner, _ := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, addrConverter)
+	txsPoolsCleaner, _ := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, addrConverter, &mock.RounderMock{})
 
 	itRan, err := txsPoolsCleaner.Clean(cleanDuration)
 	assert.Nil(t, err)
@@ -238,7 +253,7 @@ func TestTxPoolsCleaner_CleanLowerAccountNonceShouldRemoveTx(t *testing.T) {
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 	tdp := initDataPoolWithFourTransactions()
 	addrConverter, _ := addressConverters.NewPlainAddressConverter(32, "0x")
-	txsPoolsCleaner, _ := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, addrConverter)
+	txsPoolsCleaner, _ := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, addrConverter, &mock.RounderMock{})
 
 	itRan, err := txsPoolsCleaner.Clean(cleanDuration)
 	assert.Nil(t, err)
@@ -248,6 +263,49 @@ func TestTxPoolsCleaner_CleanLowerAccountNonceShouldRemoveTx(t *testing.T) {
 	assert.Equal(t, numRemovedTxsExpected, numRemovedTxs)
 }
 
+func TestTxPoolsCleaner_CleanExpiredTxShouldRemoveTx(t *testing.T) {
+	t.Parallel()
+
+	sndAddr := []byte("address_address_address_address_")
+	txsInPool := map[string]*transaction.Transaction{
+		"expired":       {Nonce: 10, SndAddr: sndAddr, ValidUntilRound: 9},
+		"valid":         {Nonce: 10, SndAddr: sndAddr, ValidAfterRound: 5, ValidUntilRound: 10},
+		"not yet valid": {Nonce: 10, SndAddr: sndAddr, ValidAfterRound: 11},
+	}
+	removedKeys := make([]string, 0)
+	tdp := &mock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &mock.ShardedDataStub{
+				ShardDataStoreCalled: func(id string) (c storage.Cacher) {
+					return &mock.CacherStub{
+						PeekCalled: func(key []byte) (value interface{}, ok bool) {
+							tx, ok := txsInPool[string(key)]
+							return tx, ok
+						},
+						KeysCalled: func() [][]byte {
+							return [][]byte{[]byte("expired"), []byte("valid"), []byte("not yet valid")}
+						},
+						RemoveCalled: func(key []byte) {
+							removedKeys = append(removedKeys, string(key))
+						},
+					}
+				},
+			}
+		},
+	}
+	accounts := getAccAdapter(10, big.NewInt(1))
+	addrConverter, _ := addressConverters.NewPlainAddressConverter(32, "0x")
+	rounder := &mock.RounderMock{RoundIndex: 10}
+	txsPoolsCleaner, _ := poolsCleaner.NewTxsPoolsCleaner(accounts, mock.NewOneShardCoordinatorMock(), tdp, addrConverter, rounder)
+
+	itRan, err := txsPoolsCleaner.Clean(time.Second)
+
+	assert.Nil(t, err)
+	assert.True(t, itRan)
+	assert.Equal(t, uint64(1), txsPoolsCleaner.NumRemovedTxs())
+	assert.Equal(t, []string{"expired"}, removedKeys)
+}
+
 func TestTxPoolsCleaner_CleanNilHaveTimeShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -257,7 +315,7 @@ func TestTxPoolsCleaner_CleanNilHaveTimeShouldErr(t *testing.T) {
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 	tdp := initDataPoolWithFourTransactions()
 	addrConverter, _ := addressConverters.NewPlainAddressConverter(32, "0x")
-	txsPoolsCleaner, _ := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, addrConverter)
+	txsPoolsCleaner, _ := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, addrConverter, &mock.RounderMock{})
 
 	itRan, err := txsPoolsCleaner.Clean(0)
 	assert.Equal(t, process.ErrZeroMaxCleanTime, err)
@@ -273,7 +331,7 @@ func TestTxPoolsCleaner_CleanWillDoNothingIfIsCalledMultipleTime(t *testing.T) {
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 	tdp := initDataPoolWithFourTransactions()
 	addrConverter, _ := addressConverters.NewPlainAddressConverter(32, "0x")
-	txsPoolsCleaner, _ := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, addrConverter)
+	txsPoolsCleaner, _ := poolsCleaner.NewTxsPoolsCleaner(accounts, shardCoordinator, tdp, addrConverter, &mock.RounderMock{})
 
 	go func() {
 		_, _ = txsPoolsCleaner.Clean(time.Second)
@@ -0,0 +1,297 @@
+package poolsPersister
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/logger"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("process/block/poolsPersister")
+
+var txPoolKey = []byte("transactions")
+var unsignedTxPoolKey = []byte("unsignedTransactions")
+var rewardTxPoolKey = []byte("rewardTransactions")
+
+// persistedTx is a pool entry, as saved in the storage unit
+type persistedTx struct {
+	CacheId string
+	Hash    []byte
+	TxBuff  []byte
+}
+
+// persistedPool holds all the entries of one pool, saved under a single key
+type persistedPool struct {
+	Txs []persistedTx
+}
+
+// persistedPoolInfo describes how the entries of one pool are saved and restored
+type persistedPoolInfo struct {
+	key       []byte
+	pool      dataRetriever.ShardedDataCacherNotifier
+	committed dataRetriever.UnitType
+	newTx     func() data.TransactionHandler
+}
+
+// ArgTxPoolsPersister holds all the components needed to create a TxPoolsPersister
+type ArgTxPoolsPersister struct {
+	Storer           storage.Storer
+	Store            dataRetriever.StorageService
+	Marshalizer      marshal.Marshalizer
+	Accounts         state.AccountsAdapter
+	AddrConverter    state.AddressConverter
+	ShardCoordinator sharding.Coordinator
+	TxPool           dataRetriever.ShardedDataCacherNotifier
+	UnsignedTxPool   dataRetriever.ShardedDataCacherNotifier
+	// RewardTxPool is optional as the metachain does not hold a reward transactions pool
+	RewardTxPool dataRetriever.ShardedDataCacherNotifier
+}
+
+// TxPoolsPersister saves the pending transactions when the node is stopped and adds them back in pools, if they
+// are still valid, when the node is started again
+type TxPoolsPersister struct {
+	storer           storage.Storer
+	store            dataRetriever.StorageService
+	marshalizer      marshal.Marshalizer
+	accounts         state.AccountsAdapter
+	addrConverter    state.AddressConverter
+	shardCoordinator sharding.Coordinator
+	pools            []persistedPoolInfo
+}
+
+// NewTxPoolsPersister creates a new transaction pools persister
+func NewTxPoolsPersister(arg ArgTxPoolsPersister) (*TxPoolsPersister, error) {
+	if check.IfNil(arg.Storer) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(arg.Store) {
+		return nil, process.ErrNilStore
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(arg.Accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(arg.AddrConverter) {
+		return nil, process.ErrNilAddressConverter
+	}
+	if check.IfNil(arg.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(arg.TxPool) {
+		return nil, process.ErrNilTransactionPool
+	}
+	if check.IfNil(arg.UnsignedTxPool) {
+		return nil, process.ErrNilUTxDataPool
+	}
+
+	pools := []persistedPoolInfo{
+		{
+			key:       txPoolKey,
+			pool:      arg.TxPool,
+			committed: dataRetriever.TransactionUnit,
+			newTx:     func() data.TransactionHandler { return &transaction.Transaction{} },
+		},
+		{
+			key:       unsignedTxPoolKey,
+			pool:      arg.UnsignedTxPool,
+			committed: dataRetriever.UnsignedTransactionUnit,
+			newTx:     func() data.TransactionHandler { return &smartContractResult.SmartContractResult{} },
+		},
+	}
+	if !check.IfNil(arg.RewardTxPool) {
+		pools = append(pools, persistedPoolInfo{
+			key:       rewardTxPoolKey,
+			pool:      arg.RewardTxPool,
+			committed: dataRetriever.RewardTransactionUnit,
+			newTx:     func() data.TransactionHandler { return &rewardTx.RewardTx{} },
+		})
+	}
+
+	return &TxPoolsPersister{
+		storer:           arg.Storer,
+		store:            arg.Store,
+		marshalizer:      arg.Marshalizer,
+		accounts:         arg.Accounts,
+		addrConverter:    arg.AddrConverter,
+		shardCoordinator: arg.ShardCoordinator,
+		pools:            pools,
+	}, nil
+}
+
+// SavePools writes the content of the transaction pools in the storage unit. It should be called when the node
+// is gracefully stopped
+func (tpp *TxPoolsPersister) SavePools() error {
+	for _, info := range tpp.pools {
+		numSaved, err := tpp.savePool(info)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("transactions pool saved", "pool", string(info.key), "num txs", numSaved)
+	}
+
+	return nil
+}
+
+func (tpp *TxPoolsPersister) savePool(info persistedPoolInfo) (int, error) {
+	persisted := &persistedPool{
+		Txs: make([]persistedTx, 0),
+	}
+
+	for _, cacheId := range tpp.cacherIdentifiers() {
+		txStore := info.pool.ShardDataStore(cacheId)
+		if check.IfNil(txStore) {
+			continue
+		}
+
+		for _, key := range txStore.Keys() {
+			value, ok := txStore.Peek(key)
+			if !ok {
+				continue
+			}
+
+			txBuff, err := tpp.marshalizer.Marshal(value)
+			if err != nil {
+				log.Debug("transaction not saved", "hash", key, "error", err.Error())
+				continue
+			}
+
+			persisted.Txs = append(persisted.Txs, persistedTx{
+				CacheId: cacheId,
+				Hash:    key,
+				TxBuff:  txBuff,
+			})
+		}
+	}
+
+	buff, err := tpp.marshalizer.Marshal(persisted)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tpp.storer.Put(info.key, buff)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(persisted.Txs), nil
+}
+
+// LoadPools adds back in pools the transactions saved when the node was stopped. The transactions already
+// committed and the ones having a lower nonce than their sender's account are dropped. The saved content is
+// removed from the storage unit afterwards, so it is loaded only once
+func (tpp *TxPoolsPersister) LoadPools() error {
+	for _, info := range tpp.pools {
+		numLoaded, numDropped, err := tpp.loadPool(info)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("transactions pool loaded", "pool", string(info.key), "num txs", numLoaded, "num dropped", numDropped)
+	}
+
+	return nil
+}
+
+func (tpp *TxPoolsPersister) loadPool(info persistedPoolInfo) (int, int, error) {
+	buff, err := tpp.storer.Get(info.key)
+	if err != nil {
+		// nothing was saved
+		return 0, 0, nil
+	}
+
+	persisted := &persistedPool{}
+	err = tpp.marshalizer.Unmarshal(persisted, buff)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	numLoaded := 0
+	for _, entry := range persisted.Txs {
+		tx := info.newTx()
+		err = tpp.marshalizer.Unmarshal(tx, entry.TxBuff)
+		if err != nil {
+			log.Debug("saved transaction can not be restored", "hash", entry.Hash, "error", err.Error())
+			continue
+		}
+
+		if !tpp.isStillValid(info, entry.Hash, tx) {
+			continue
+		}
+
+		info.pool.AddData(entry.Hash, tx, entry.CacheId)
+		numLoaded++
+	}
+
+	err = tpp.storer.Remove(info.key)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return numLoaded, len(persisted.Txs) - numLoaded, nil
+}
+
+func (tpp *TxPoolsPersister) isStillValid(info persistedPoolInfo, hash []byte, tx data.TransactionHandler) bool {
+	committedStorer := tpp.store.GetStorer(info.committed)
+	if !check.IfNil(committedStorer) && committedStorer.Has(hash) == nil {
+		return false
+	}
+
+	userTx, ok := tx.(*transaction.Transaction)
+	if !ok {
+		return true
+	}
+
+	sndAddr, err := tpp.addrConverter.CreateAddressFromPublicKeyBytes(userTx.SndAddr)
+	if err != nil {
+		return false
+	}
+
+	senderIsInAnotherShard := tpp.shardCoordinator.ComputeId(sndAddr) != tpp.shardCoordinator.SelfId()
+	if senderIsInAnotherShard {
+		return true
+	}
+
+	account, err := tpp.accounts.GetExistingAccount(sndAddr)
+	if err != nil {
+		return false
+	}
+
+	return userTx.Nonce >= account.GetNonce()
+}
+
+// cacherIdentifiers returns the identifiers of all the shard stores a pool can hold
+func (tpp *TxPoolsPersister) cacherIdentifiers() []string {
+	shardIds := make([]uint32, 0, tpp.shardCoordinator.NumberOfShards()+1)
+	for shardId := uint32(0); shardId < tpp.shardCoordinator.NumberOfShards(); shardId++ {
+		shardIds = append(shardIds, shardId)
+	}
+	shardIds = append(shardIds, sharding.MetachainShardId)
+
+	cacherIds := make([]string, 0, len(shardIds)*len(shardIds))
+	for _, senderShardId := range shardIds {
+		for _, receiverShardId := range shardIds {
+			cacherIds = append(cacherIds, process.ShardCacherIdentifier(senderShardId, receiverShardId))
+		}
+	}
+
+	return cacherIds
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tpp *TxPoolsPersister) IsInterfaceNil() bool {
+	if tpp == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,199 @@
+package poolsPersister_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/poolsPersister"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMemUnit() storage.Storer {
+	cache, _ := storageUnit.NewCache(storageUnit.LRUCache, 10, 1)
+	persist, _ := memorydb.NewlruDB(1000)
+	unit, _ := storageUnit.NewStorageUnit(cache, persist)
+
+	return unit
+}
+
+func createPool() dataRetriever.ShardedDataCacherNotifier {
+	pool, _ := shardedData.NewShardedData(storageUnit.CacheConfig{Size: 100, Type: storageUnit.LRUCache})
+	return pool
+}
+
+func createMockArgument() poolsPersister.ArgTxPoolsPersister {
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.TransactionUnit, createMemUnit())
+	store.AddStorer(dataRetriever.UnsignedTransactionUnit, createMemUnit())
+	store.AddStorer(dataRetriever.RewardTransactionUnit, createMemUnit())
+
+	return poolsPersister.ArgTxPoolsPersister{
+		Storer:      createMemUnit(),
+		Store:       store,
+		Marshalizer: &mock.MarshalizerMock{},
+		Accounts: &mock.AccountsStub{
+			GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+				return &state.Account{Nonce: 5, Balance: big.NewInt(0)}, nil
+			},
+		},
+		AddrConverter:    &mock.AddressConverterMock{},
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		TxPool:           createPool(),
+		UnsignedTxPool:   createPool(),
+		RewardTxPool:     createPool(),
+	}
+}
+
+func TestNewTxPoolsPersister_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.Storer = nil
+	tpp, err := poolsPersister.NewTxPoolsPersister(arg)
+
+	assert.Nil(t, tpp)
+	assert.Equal(t, process.ErrNilStorage, err)
+}
+
+func TestNewTxPoolsPersister_NilStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.Store = nil
+	tpp, err := poolsPersister.NewTxPoolsPersister(arg)
+
+	assert.Nil(t, tpp)
+	assert.Equal(t, process.ErrNilStore, err)
+}
+
+func TestNewTxPoolsPersister_NilTxPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.TxPool = nil
+	tpp, err := poolsPersister.NewTxPoolsPersister(arg)
+
+	assert.Nil(t, tpp)
+	assert.Equal(t, process.ErrNilTransactionPool, err)
+}
+
+func TestNewTxPoolsPersister_NilUnsignedTxPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.UnsignedTxPool = nil
+	tpp, err := poolsPersister.NewTxPoolsPersister(arg)
+
+	assert.Nil(t, tpp)
+	assert.Equal(t, process.ErrNilUTxDataPool, err)
+}
+
+func TestNewTxPoolsPersister_NilRewardTxPoolShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.RewardTxPool = nil
+	tpp, err := poolsPersister.NewTxPoolsPersister(arg)
+
+	assert.Nil(t, err)
+	assert.False(t, tpp.IsInterfaceNil())
+}
+
+func TestTxPoolsPersister_LoadWithoutSavedDataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	tpp, _ := poolsPersister.NewTxPoolsPersister(arg)
+
+	err := tpp.LoadPools()
+
+	assert.Nil(t, err)
+	assert.Nil(t, arg.TxPool.ShardDataStore(process.ShardCacherIdentifier(0, 0)))
+}
+
+func TestTxPoolsPersister_SaveAndLoadShouldRestoreThePools(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	tpp, _ := poolsPersister.NewTxPoolsPersister(arg)
+
+	tx := &transaction.Transaction{Nonce: 6, SndAddr: []byte("sender"), Value: big.NewInt(1)}
+	scr := &smartContractResult.SmartContractResult{Nonce: 1, Value: big.NewInt(2)}
+	reward := &rewardTx.RewardTx{Round: 3, Value: big.NewInt(3)}
+	arg.TxPool.AddData([]byte("tx"), tx, process.ShardCacherIdentifier(0, 0))
+	arg.UnsignedTxPool.AddData([]byte("scr"), scr, process.ShardCacherIdentifier(sharding.MetachainShardId, 0))
+	arg.RewardTxPool.AddData([]byte("reward"), reward, process.ShardCacherIdentifier(0, sharding.MetachainShardId))
+
+	err := tpp.SavePools()
+	assert.Nil(t, err)
+
+	arg.TxPool.Clear()
+	arg.UnsignedTxPool.Clear()
+	arg.RewardTxPool.Clear()
+
+	err = tpp.LoadPools()
+	assert.Nil(t, err)
+
+	restoredTx, ok := arg.TxPool.ShardDataStore(process.ShardCacherIdentifier(0, 0)).Peek([]byte("tx"))
+	assert.True(t, ok)
+	assert.Equal(t, tx, restoredTx)
+	restoredScr, ok := arg.UnsignedTxPool.ShardDataStore(process.ShardCacherIdentifier(sharding.MetachainShardId, 0)).Peek([]byte("scr"))
+	assert.True(t, ok)
+	assert.Equal(t, scr, restoredScr)
+	restoredReward, ok := arg.RewardTxPool.ShardDataStore(process.ShardCacherIdentifier(0, sharding.MetachainShardId)).Peek([]byte("reward"))
+	assert.True(t, ok)
+	assert.Equal(t, reward, restoredReward)
+}
+
+func TestTxPoolsPersister_LoadShouldDropInvalidTransactions(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	tpp, _ := poolsPersister.NewTxPoolsPersister(arg)
+
+	cacheId := process.ShardCacherIdentifier(0, 0)
+	arg.TxPool.AddData([]byte("lower nonce"), &transaction.Transaction{Nonce: 4, SndAddr: []byte("sender"), Value: big.NewInt(0)}, cacheId)
+	arg.TxPool.AddData([]byte("committed"), &transaction.Transaction{Nonce: 5, SndAddr: []byte("sender"), Value: big.NewInt(0)}, cacheId)
+	arg.TxPool.AddData([]byte("valid"), &transaction.Transaction{Nonce: 5, SndAddr: []byte("other"), Value: big.NewInt(0)}, cacheId)
+	_ = arg.Store.Put(dataRetriever.TransactionUnit, []byte("committed"), []byte("tx"))
+
+	err := tpp.SavePools()
+	assert.Nil(t, err)
+	arg.TxPool.Clear()
+	err = tpp.LoadPools()
+	assert.Nil(t, err)
+
+	txStore := arg.TxPool.ShardDataStore(cacheId)
+	assert.Equal(t, 1, txStore.Len())
+	assert.True(t, txStore.Has([]byte("valid")))
+}
+
+func TestTxPoolsPersister_LoadShouldRemoveTheSavedData(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	tpp, _ := poolsPersister.NewTxPoolsPersister(arg)
+
+	cacheId := process.ShardCacherIdentifier(0, 0)
+	arg.TxPool.AddData([]byte("tx"), &transaction.Transaction{Nonce: 5, SndAddr: []byte("sender"), Value: big.NewInt(0)}, cacheId)
+	_ = tpp.SavePools()
+	_ = tpp.LoadPools()
+	arg.TxPool.Clear()
+
+	err := tpp.LoadPools()
+
+	assert.Nil(t, err)
+	assert.Nil(t, arg.TxPool.ShardDataStore(cacheId))
+}
@@ -272,34 +272,27 @@ func (rtxh *rewardsHandler) cleanCachedData() {
 	rtxh.mutGenRewardTxs.Unlock()
 }
 
-func getPercentageOfValue(value *big.Int, percentage float64) *big.Int {
-	x := new(big.Float).SetInt(value)
-	y := big.NewFloat(percentage)
-
-	z := new(big.Float).Mul(x, y)
-
-	op := big.NewInt(0)
-	result, _ := z.Int(op)
-
-	return result
-}
-
 func (rtxh *rewardsHandler) createLeaderTx() *rewardTx.RewardTx {
 	currTx := &rewardTx.RewardTx{}
 
-	currTx.Value = getPercentageOfValue(rtxh.accumulatedFees, rtxh.economicsRewards.LeaderPercentage())
+	currTx.Value = core.GetPercentageOfValue(rtxh.accumulatedFees, rtxh.economicsRewards.LeaderPercentage())
 	currTx.RcvAddr = rtxh.address.LeaderAddress()
 	currTx.ShardId = rtxh.shardCoordinator.SelfId()
 	currTx.Epoch = rtxh.address.Epoch()
 	currTx.Round = rtxh.address.Round()
 
+	consensusRewardData := rtxh.address.ConsensusShardRewardData()
+	if consensusRewardData != nil {
+		currTx.PubKey = pubKeyAt(consensusRewardData, 0)
+	}
+
 	return currTx
 }
 
 func (rtxh *rewardsHandler) createBurnTx() *rewardTx.RewardTx {
 	currTx := &rewardTx.RewardTx{}
 
-	currTx.Value = getPercentageOfValue(rtxh.accumulatedFees, rtxh.economicsRewards.BurnPercentage())
+	currTx.Value = core.GetPercentageOfValue(rtxh.accumulatedFees, rtxh.economicsRewards.BurnPercentage())
 	currTx.RcvAddr = rtxh.address.BurnAddress()
 	currTx.ShardId = rtxh.shardCoordinator.SelfId()
 	currTx.Epoch = rtxh.address.Epoch()
@@ -311,7 +304,7 @@ func (rtxh *rewardsHandler) createBurnTx() *rewardTx.RewardTx {
 func (rtxh *rewardsHandler) createCommunityTx() *rewardTx.RewardTx {
 	currTx := &rewardTx.RewardTx{}
 
-	currTx.Value = getPercentageOfValue(rtxh.accumulatedFees, rtxh.economicsRewards.CommunityPercentage())
+	currTx.Value = core.GetPercentageOfValue(rtxh.accumulatedFees, rtxh.economicsRewards.CommunityPercentage())
 	currTx.RcvAddr = rtxh.address.ElrondCommunityAddress()
 	currTx.ShardId = rtxh.shardCoordinator.SelfId()
 	currTx.Epoch = rtxh.address.Epoch()
@@ -352,13 +345,14 @@ func (rtxh *rewardsHandler) createProtocolRewards() []data.TransactionHandler {
 		return consensusRewardTxs
 	}
 
-	for _, address := range consensusRewardData.Addresses {
+	for i, address := range consensusRewardData.Addresses {
 		rTx := &rewardTx.RewardTx{}
 		rTx.Value = rtxh.economicsRewards.RewardsValue()
 		rTx.RcvAddr = []byte(address)
 		rTx.ShardId = rtxh.shardCoordinator.SelfId()
 		rTx.Epoch = consensusRewardData.Epoch
 		rTx.Round = consensusRewardData.Round
+		rTx.PubKey = pubKeyAt(consensusRewardData, i)
 
 		consensusRewardTxs = append(consensusRewardTxs, rTx)
 	}
@@ -366,7 +360,8 @@ func (rtxh *rewardsHandler) createProtocolRewards() []data.TransactionHandler {
 	return consensusRewardTxs
 }
 
-// createProtocolRewardsForMeta creates the protocol reward transactions
+// createProtocolRewardsForMeta creates the protocol reward transactions for the metachain nodes paid to addresses in
+// this shard. The rewards paid to smart contracts on the metachain are created by the first shard
 func (rtxh *rewardsHandler) createProtocolRewardsForMeta() []data.TransactionHandler {
 	metaRewardsData := rtxh.address.ConsensusMetaRewardData()
 	consensusRewardTxs := make([]data.TransactionHandler, 0)
@@ -377,13 +372,16 @@ func (rtxh *rewardsHandler) createProtocolRewardsForMeta() []data.TransactionHan
 	}
 
 	for _, metaConsensusSet := range metaRewardsData {
-		for _, address := range metaConsensusSet.Addresses {
+		for i, address := range metaConsensusSet.Addresses {
 			shardId, err := rtxh.address.ShardIdForAddress([]byte(address))
 			if err != nil {
 				log.Debug("ShardIdForAddress", "error", err.Error())
 				continue
 			}
 
+			if shardId == sharding.MetachainShardId {
+				shardId = 0
+			}
 			if shardId != rtxh.shardCoordinator.SelfId() {
 				continue
 			}
@@ -394,6 +392,7 @@ func (rtxh *rewardsHandler) createProtocolRewardsForMeta() []data.TransactionHan
 			rTx.ShardId = rtxh.shardCoordinator.SelfId()
 			rTx.Epoch = metaConsensusSet.Epoch
 			rTx.Round = metaConsensusSet.Round
+			rTx.PubKey = pubKeyAt(metaConsensusSet, i)
 
 			consensusRewardTxs = append(consensusRewardTxs, rTx)
 		}
@@ -402,6 +401,14 @@ func (rtxh *rewardsHandler) createProtocolRewardsForMeta() []data.TransactionHan
 	return consensusRewardTxs
 }
 
+func pubKeyAt(consensusRewardData *data.ConsensusRewardData, index int) []byte {
+	if index >= len(consensusRewardData.PubKeys) {
+		return nil
+	}
+
+	return []byte(consensusRewardData.PubKeys[index])
+}
+
 // verifyCreatedRewardsTxs verifies if the calculated rewards transactions and the block reward transactions are the same
 func (rtxh *rewardsHandler) verifyCreatedRewardsTxs() error {
 	calculatedRewardTxs := make([]data.TransactionHandler, 0)
@@ -9,9 +9,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -566,6 +568,49 @@ func TestRewardsHandler_CreateBlockStartedShouldCreateProtocolReward(t *testing.
 	assert.NotNil(t, th.protocolRewards)
 }
 
+func TestRewardsHandler_CreateProtocolRewardsForMetaPaidToMetachainSCShouldBeCreatedByFirstShard(t *testing.T) {
+	t.Parallel()
+
+	nodesCoordinator := &mock.NodesCoordinatorMock{
+		GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32) ([]string, error) {
+			return []string{"node"}, nil
+		},
+		GetValidatorsRewardsAddressesCalled: func(randomness []byte, round uint64, shardId uint32) ([]string, error) {
+			return []string{"delegation"}, nil
+		},
+	}
+	createRewardsHandler := func(selfId uint32) *rewardsHandler {
+		shardCoordinator := mock.NewMultiShardsCoordinatorMock(3)
+		shardCoordinator.CurrentShard = selfId
+		shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+			return sharding.MetachainShardId
+		}
+		specialAddress := mock.NewSpecialAddressHandlerMock(&mock.AddressConverterMock{}, shardCoordinator, nodesCoordinator)
+		_ = specialAddress.SetMetaConsensusData([]byte("randomness"), 1, 0)
+
+		th, _ := NewRewardTxHandler(
+			specialAddress,
+			&mock.HasherMock{},
+			&mock.MarshalizerMock{},
+			shardCoordinator,
+			&mock.AddressConverterMock{},
+			&mock.ChainStorerMock{},
+			initDataPool().RewardTransactions(),
+			RewandsHandlerMock(),
+		)
+
+		return th
+	}
+
+	rewards := createRewardsHandler(1).createProtocolRewardsForMeta()
+	assert.Equal(t, 0, len(rewards))
+
+	rewards = createRewardsHandler(0).createProtocolRewardsForMeta()
+	assert.Equal(t, 1, len(rewards))
+	assert.Equal(t, []byte("delegation"), rewards[0].GetRecvAddress())
+	assert.Equal(t, []byte("node"), rewards[0].(*rewardTx.RewardTx).PubKey)
+}
+
 func TestRewardsHandler_SaveCurrentIntermediateTxToStorageShouldWork(t *testing.T) {
 	t.Parallel()
 
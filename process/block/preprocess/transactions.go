@@ -23,12 +23,22 @@ import (
 
 var log = logger.GetOrCreate("process/block/preprocess")
 
+// maxMissingNoncesToRequestPerSender bounds the number of missing nonces requested at once for a sender
+const maxMissingNoncesToRequestPerSender = dataRetriever.MaxNoncesPerSenderRequest
+
+// missingNoncesScanInterval is the minimum time between two scans of the pool for missing nonces
+const missingNoncesScanInterval = 5 * time.Second
+
+// maxSendersCheckedForMissingNonces bounds the number of accounts read on the block creation path for one scan
+const maxSendersCheckedForMissingNonces = 1000
+
 // TODO: increase code coverage with unit tests
 
 type transactions struct {
 	*basePreProcess
 	chRcvAllTxs          chan bool
 	onRequestTransaction func(shardID uint32, txHashes [][]byte)
+	onRequestTxsByNonce  func(shardID uint32, sender []byte, nonces []uint64)
 	txsForCurrBlock      txsForBlock
 	txPool               dataRetriever.ShardedDataCacherNotifier
 	storage              dataRetriever.StorageService
@@ -39,6 +49,12 @@ type transactions struct {
 	mutOrderedTxs        sync.RWMutex
 	economicsFee         process.FeeHandler
 	miniBlocksCompacter  process.MiniBlocksCompacter
+	txSelection          process.TxSelectionStrategy
+
+	mutMissingNoncesScan    sync.Mutex
+	isScanningMissingNonces bool
+	lastMissingNoncesScan   time.Time
+	pooledSenders           [][]byte
 }
 
 // NewTransactionPreprocessor creates a new transaction preprocessor object
@@ -51,9 +67,11 @@ func NewTransactionPreprocessor(
 	shardCoordinator sharding.Coordinator,
 	accounts state.AccountsAdapter,
 	onRequestTransaction func(shardID uint32, txHashes [][]byte),
+	onRequestTxsByNonce func(shardID uint32, sender []byte, nonces []uint64),
 	economicsFee process.FeeHandler,
 	miniBlocksCompacter process.MiniBlocksCompacter,
 	gasHandler process.GasHandler,
+	txSelection process.TxSelectionStrategy,
 ) (*transactions, error) {
 
 	if check.IfNil(hasher) {
@@ -80,6 +98,9 @@ func NewTransactionPreprocessor(
 	if onRequestTransaction == nil {
 		return nil, process.ErrNilRequestHandler
 	}
+	if onRequestTxsByNonce == nil {
+		return nil, process.ErrNilRequestHandler
+	}
 	if check.IfNil(economicsFee) {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
@@ -89,6 +110,9 @@ func NewTransactionPreprocessor(
 	if check.IfNil(gasHandler) {
 		return nil, process.ErrNilGasHandler
 	}
+	if check.IfNil(txSelection) {
+		return nil, process.ErrNilTxSelectionStrategy
+	}
 
 	bpp := basePreProcess{
 		hasher:           hasher,
@@ -102,10 +126,12 @@ func NewTransactionPreprocessor(
 		storage:              store,
 		txPool:               txDataPool,
 		onRequestTransaction: onRequestTransaction,
+		onRequestTxsByNonce:  onRequestTxsByNonce,
 		txProcessor:          txProcessor,
 		accounts:             accounts,
 		economicsFee:         economicsFee,
 		miniBlocksCompacter:  miniBlocksCompacter,
+		txSelection:          txSelection,
 	}
 
 	txs.chRcvAllTxs = make(chan bool)
@@ -372,7 +398,8 @@ func (txs *transactions) processAndRemoveBadTransaction(
 
 	err := txs.txProcessor.ProcessTransaction(transaction, round)
 	if err == process.ErrLowerNonceInTransaction ||
-		err == process.ErrInsufficientFunds {
+		err == process.ErrInsufficientFunds ||
+		err == process.ErrTransactionExpired {
 		strCache := process.ShardCacherIdentifier(sndShardId, dstShardId)
 		txs.txPool.RemoveData(transactionHash, strCache)
 	}
@@ -474,6 +501,8 @@ func (txs *transactions) CreateAndProcessMiniBlocks(
 	newMBAdded := true
 	txSpaceRemained := int(maxTxSpaceRemained)
 
+	txs.startMissingNoncesScan()
+
 	miniBlock, err := txs.CreateAndProcessMiniBlock(
 		txs.shardCoordinator.SelfId(),
 		sharding.MetachainShardId,
@@ -560,6 +589,8 @@ func (txs *transactions) CreateAndProcessMiniBlock(
 		"time [s]", timeAfter.Sub(timeBefore).Seconds(),
 	)
 
+	orderedTxs, orderedTxHashes = txs.selectTxs(senderShardId, receiverShardId, orderedTxs, orderedTxHashes, spaceRemained, round)
+
 	miniBlock := &block.MiniBlock{}
 	miniBlock.SenderShardID = senderShardId
 	miniBlock.ReceiverShardID = receiverShardId
@@ -646,6 +677,191 @@ func (txs *transactions) CreateAndProcessMiniBlock(
 	return miniBlock, nil
 }
 
+// selectTxs lets the selection strategy choose, from the not yet processed transactions, the ones which are tried
+// in the miniblock and their order. The transactions which become valid in a later round stay in pool
+func (txs *transactions) selectTxs(
+	senderShardId uint32,
+	receiverShardId uint32,
+	orderedTxs []*transaction.Transaction,
+	orderedTxHashes [][]byte,
+	spaceRemained int,
+	round uint64,
+) ([]*transaction.Transaction, [][]byte) {
+
+	candidateTxs := make([]*transaction.Transaction, 0, len(orderedTxs))
+	candidateTxHashes := make([][]byte, 0, len(orderedTxHashes))
+	for index := range orderedTxs {
+		if txs.isTxAlreadyProcessed(orderedTxHashes[index], &txs.txsForCurrBlock) {
+			continue
+		}
+		if orderedTxs[index].IsNotYetValidInRound(round) {
+			continue
+		}
+
+		candidateTxs = append(candidateTxs, orderedTxs[index])
+		candidateTxHashes = append(candidateTxHashes, orderedTxHashes[index])
+	}
+
+	computeGas := func(tx *transaction.Transaction) (uint64, error) {
+		gasInSenderShard, gasInReceiverShard, err := txs.gasHandler.ComputeGasConsumedByTx(senderShardId, receiverShardId, tx)
+		if err != nil {
+			return 0, err
+		}
+
+		if txs.shardCoordinator.SelfId() == senderShardId {
+			return gasInSenderShard, nil
+		}
+		return gasInReceiverShard, nil
+	}
+
+	maxGas := uint64(0)
+	totalGasConsumed := txs.gasHandler.TotalGasConsumed()
+	if totalGasConsumed < txs.economicsFee.MaxGasLimitPerBlock() {
+		maxGas = txs.economicsFee.MaxGasLimitPerBlock() - totalGasConsumed
+	}
+
+	return txs.txSelection.SelectTxs(candidateTxs, candidateTxHashes, computeGas, maxGas, spaceRemained)
+}
+
+// startMissingNoncesScan requests the missing nonces on a separate go routine, so the block creation does not wait
+// for the scan of the whole pool. A new scan is started only after the previous one ended and at least
+// missingNoncesScanInterval passed since it was started. The go routine can not read the accounts, which are changed
+// by the block being processed, so it works on the nonces read here, before the processing starts, for the senders the
+// previous scan found in the pool
+func (txs *transactions) startMissingNoncesScan() {
+	txs.mutMissingNoncesScan.Lock()
+	defer txs.mutMissingNoncesScan.Unlock()
+
+	if txs.isScanningMissingNonces || time.Since(txs.lastMissingNoncesScan) < missingNoncesScanInterval {
+		return
+	}
+
+	txs.isScanningMissingNonces = true
+	txs.lastMissingNoncesScan = time.Now()
+	accountNonces := txs.getAccountNonces(txs.pooledSenders)
+
+	go func() {
+		pooledSenders := txs.requestMissingNonces(accountNonces)
+
+		txs.mutMissingNoncesScan.Lock()
+		txs.pooledSenders = pooledSenders
+		txs.isScanningMissingNonces = false
+		txs.mutMissingNoncesScan.Unlock()
+	}()
+}
+
+func (txs *transactions) getAccountNonces(senders [][]byte) map[string]uint64 {
+	accountNonces := make(map[string]uint64, len(senders))
+	for _, sender := range senders {
+		account, err := txs.accounts.GetExistingAccount(state.NewAddress(sender))
+		if err != nil || check.IfNil(account) {
+			continue
+		}
+
+		accountNonces[string(sender)] = account.GetNonce()
+	}
+
+	return accountNonces
+}
+
+// requestMissingNonces asks the peers for the transactions missing between the account nonce of each sender from the
+// self shard and the highest nonce that sender has in the pool, as such a gap blocks all the following transactions.
+// Only the senders having a nonce in the provided snapshot are checked. It returns the senders found in the pool,
+// whose nonces are to be read for the next scan
+func (txs *transactions) requestMissingNonces(accountNonces map[string]uint64) [][]byte {
+	selfShardID := txs.shardCoordinator.SelfId()
+	noncesBySender := make(map[string]map[uint64]struct{})
+
+	dstShardIDs := []uint32{sharding.MetachainShardId}
+	for shardID := uint32(0); shardID < txs.shardCoordinator.NumberOfShards(); shardID++ {
+		dstShardIDs = append(dstShardIDs, shardID)
+	}
+
+	for _, dstShardID := range dstShardIDs {
+		pooledTxs, err := txs.getPooledTxs(selfShardID, dstShardID)
+		if err != nil {
+			continue
+		}
+
+		for _, tx := range pooledTxs {
+			nonces, ok := noncesBySender[string(tx.SndAddr)]
+			if !ok {
+				nonces = make(map[uint64]struct{})
+				noncesBySender[string(tx.SndAddr)] = nonces
+			}
+			nonces[tx.Nonce] = struct{}{}
+		}
+	}
+
+	pooledSenders := make([][]byte, 0, len(noncesBySender))
+	for sender, nonces := range noncesBySender {
+		if len(pooledSenders) < maxSendersCheckedForMissingNonces {
+			pooledSenders = append(pooledSenders, []byte(sender))
+		}
+
+		accountNonce, ok := accountNonces[sender]
+		if !ok {
+			continue
+		}
+
+		missingNonces := computeMissingNonces(accountNonce, nonces)
+		if len(missingNonces) == 0 {
+			continue
+		}
+
+		log.Trace("requesting missing nonces",
+			"sender", []byte(sender),
+			"num nonces", len(missingNonces),
+		)
+		txs.onRequestTxsByNonce(selfShardID, []byte(sender), missingNonces)
+	}
+
+	return pooledSenders
+}
+
+// getPooledTxs reads the transactions from the pool without touching the ordered transactions cached for the block
+// being created
+func (txs *transactions) getPooledTxs(sndShardId uint32, dstShardId uint32) ([]*transaction.Transaction, error) {
+	strCache := process.ShardCacherIdentifier(sndShardId, dstShardId)
+	txShardPool := txs.txPool.ShardDataStore(strCache)
+	if txShardPool == nil {
+		return nil, process.ErrNilTxDataPool
+	}
+
+	txCache, isTxCache := txShardPool.(process.TxCacheSelector)
+	if isTxCache {
+		pooledTxs, _ := selectTxsByGasPrice(txCache, txShardPool.Len())
+		return pooledTxs, nil
+	}
+
+	pooledTxs, _, err := SortTxByNonce(txShardPool)
+
+	return pooledTxs, err
+}
+
+func computeMissingNonces(accountNonce uint64, pooledNonces map[uint64]struct{}) []uint64 {
+	highestNonce := uint64(0)
+	for nonce := range pooledNonces {
+		if nonce > highestNonce {
+			highestNonce = nonce
+		}
+	}
+
+	missingNonces := make([]uint64, 0)
+	for nonce := accountNonce; nonce < highestNonce; nonce++ {
+		if len(missingNonces) >= maxMissingNoncesToRequestPerSender {
+			break
+		}
+
+		_, ok := pooledNonces[nonce]
+		if !ok {
+			missingNonces = append(missingNonces, nonce)
+		}
+	}
+
+	return missingNonces
+}
+
 func (txs *transactions) computeOrderedTxs(
 	sndShardId uint32,
 	dstShardId uint32,
@@ -670,9 +886,14 @@ func (txs *transactions) computeOrderedTxs(
 
 	alreadyOrdered := len(orderedTxs) > 0
 	if !alreadyOrdered {
-		orderedTxs, orderedTxHashes, err = SortTxByNonce(txShardPool)
-		if err != nil {
-			return nil, nil, err
+		txCache, isTxCache := txShardPool.(process.TxCacheSelector)
+		if isTxCache {
+			orderedTxs, orderedTxHashes = selectTxsByGasPrice(txCache, process.MaxItemsInBlock)
+		} else {
+			orderedTxs, orderedTxHashes, err = SortTxByNonce(txShardPool)
+			if err != nil {
+				return nil, nil, err
+			}
 		}
 
 		log.Debug("creating mini blocks has been started",
@@ -762,6 +983,26 @@ func (txs *transactions) ProcessMiniBlock(
 	return nil
 }
 
+// selectTxsByGasPrice returns the transactions chosen by the transactions cache: the best paying senders first,
+// the transactions of each sender in nonce order
+func selectTxsByGasPrice(txCache process.TxCacheSelector, numRequested int) ([]*transaction.Transaction, [][]byte) {
+	selectedTxs, selectedTxHashes := txCache.SelectTransactions(numRequested)
+
+	transactions := make([]*transaction.Transaction, 0, len(selectedTxs))
+	txHashes := make([][]byte, 0, len(selectedTxs))
+	for index, txHandler := range selectedTxs {
+		tx, ok := txHandler.(*transaction.Transaction)
+		if !ok {
+			continue
+		}
+
+		transactions = append(transactions, tx)
+		txHashes = append(txHashes, selectedTxHashes[index])
+	}
+
+	return transactions, txHashes
+}
+
 // SortTxByNonce sort transactions according to nonces
 func SortTxByNonce(txShardPool storage.Cacher) ([]*transaction.Transaction, [][]byte, error) {
 	if txShardPool == nil {
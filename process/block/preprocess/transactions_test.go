@@ -8,6 +8,7 @@ import (
 	"math/rand"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
@@ -25,8 +27,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -214,9 +218,11 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilPool(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, txs)
@@ -237,9 +243,11 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilStore(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, txs)
@@ -260,9 +268,11 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilHasher(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, txs)
@@ -283,9 +293,11 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilMarsalizer(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, txs)
@@ -306,9 +318,11 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilTxProce(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, txs)
@@ -329,9 +343,11 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilShardCoord(t *testing.T) {
 		nil,
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, txs)
@@ -352,9 +368,11 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilAccounts(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(3),
 		nil,
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, txs)
@@ -374,9 +392,36 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilRequestFunc(t *testing.T)
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		nil,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
+	)
+
+	assert.Nil(t, txs)
+	assert.Equal(t, process.ErrNilRequestHandler, err)
+}
+
+func TestTxsPreprocessor_NewTransactionPreprocessorNilRequestTxsByNonceFunc(t *testing.T) {
+	t.Parallel()
+
+	tdp := initDataPool()
+	requestTransaction := func(shardID uint32, txHashes [][]byte) {}
+	txs, err := NewTransactionPreprocessor(
+		tdp.Transactions(),
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		requestTransaction,
+		nil,
+		feeHandlerMock(),
+		miniBlocksCompacterMock(),
+		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, txs)
@@ -397,9 +442,11 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilFeeHandler(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		nil,
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, txs)
@@ -420,9 +467,11 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilMiniBlocksCompacter(t *tes
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		nil,
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, txs)
@@ -443,15 +492,42 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilGasHandler(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		nil,
+		NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, txs)
 	assert.Equal(t, process.ErrNilGasHandler, err)
 }
 
+func TestTxsPreprocessor_NewTransactionPreprocessorNilTxSelectionStrategy(t *testing.T) {
+	t.Parallel()
+
+	tdp := initDataPool()
+	requestTransaction := func(shardID uint32, txHashes [][]byte) {}
+	txs, err := NewTransactionPreprocessor(
+		tdp.Transactions(),
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
+		feeHandlerMock(),
+		miniBlocksCompacterMock(),
+		&mock.GasHandlerMock{},
+		nil,
+	)
+
+	assert.Nil(t, txs)
+	assert.Equal(t, process.ErrNilTxSelectionStrategy, err)
+}
+
 func TestTxsPreProcessor_GetTransactionFromPool(t *testing.T) {
 	t.Parallel()
 	tdp := initDataPool()
@@ -465,9 +541,11 @@ func TestTxsPreProcessor_GetTransactionFromPool(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 	txHash := []byte("tx1_hash")
 	tx, _ := process.GetTransactionHandlerFromPool(1, 1, txHash, tdp.Transactions())
@@ -489,9 +567,11 @@ func TestTransactionPreprocessor_RequestTransactionFromNetwork(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 	shardId := uint32(1)
 	txHash1 := []byte("tx_hash1")
@@ -519,9 +599,11 @@ func TestTransactionPreprocessor_RequestBlockTransactionFromMiniBlockFromNetwork
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 
 	shardId := uint32(1)
@@ -564,9 +646,11 @@ func TestTransactionPreprocessor_ReceivedTransactionShouldEraseRequested(t *test
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 
 	//add 3 tx hashes on requested list
@@ -640,9 +724,11 @@ func TestTransactionPreprocessor_GetAllTxsFromMiniBlockShouldWork(t *testing.T)
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 
 	mb := &block.MiniBlock{
@@ -677,9 +763,11 @@ func TestTransactionPreprocessor_RemoveBlockTxsFromPoolNilBlockShouldErr(t *test
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 	err := txs.RemoveTxBlockFromPools(nil, tdp.MiniBlocks())
 	assert.NotNil(t, err)
@@ -699,9 +787,11 @@ func TestTransactionPreprocessor_RemoveBlockTxsFromPoolOK(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
 	)
 	body := make(block.Body, 0)
 	txHash := []byte("txHash")
@@ -737,6 +827,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddAll(t *testi
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{
@@ -754,6 +845,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddAll(t *testi
 				return 0
 			},
 		},
+		NewPoolOrderSelection(),
 	)
 	assert.NotNil(t, txs)
 
@@ -797,6 +889,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddAllAsNoSCCal
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{
@@ -814,6 +907,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddAllAsNoSCCal
 				return 0
 			},
 		},
+		NewPoolOrderSelection(),
 	)
 	assert.NotNil(t, txs)
 
@@ -862,6 +956,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddOnly5asSCCal
 		mock.NewMultiShardsCoordinatorMock(3),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{
@@ -884,6 +979,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddOnly5asSCCal
 			RemoveGasRefundedCalled: func(hashes [][]byte) {
 			},
 		},
+		NewPoolOrderSelection(),
 	)
 	assert.NotNil(t, txs)
 
@@ -917,6 +1013,83 @@ func init() {
 	r = rand.New(rand.NewSource(time.Now().UnixNano()))
 }
 
+func TestTransactions_CreateAndProcessMiniBlockShouldKeepNotYetValidAndRemoveExpiredTxs(t *testing.T) {
+	t.Parallel()
+
+	txPool, _ := shardedData.NewShardedData(storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache})
+	requestTransaction := func(shardID uint32, txHashes [][]byte) {}
+	hasher := &mock.HasherMock{}
+	marshalizer := &mock.MarshalizerMock{}
+
+	processedTxs := make([]*transaction.Transaction, 0)
+	txs, _ := NewTransactionPreprocessor(
+		txPool,
+		&mock.ChainStorerMock{},
+		hasher,
+		marshalizer,
+		&mock.TxProcessorMock{ProcessTransactionCalled: func(transaction *transaction.Transaction, round uint64) error {
+			processedTxs = append(processedTxs, transaction)
+			if transaction.IsExpiredInRound(round) {
+				return process.ErrTransactionExpired
+			}
+			return nil
+		}},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{
+			JournalLenCalled: func() int {
+				return 0
+			},
+			RevertToSnapshotCalled: func(snapshot int) error {
+				return nil
+			},
+		},
+		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
+		feeHandlerMock(),
+		miniBlocksCompacterMock(),
+		&mock.GasHandlerMock{
+			SetGasConsumedCalled: func(gasConsumed uint64, hash []byte) {},
+			TotalGasConsumedCalled: func() uint64 {
+				return 0
+			},
+			ComputeGasConsumedByTxCalled: func(txSenderShardId uint32, txReceiverShardId uint32, txHandler data.TransactionHandler) (uint64, uint64, error) {
+				return 0, 0, nil
+			},
+			SetGasRefundedCalled:    func(gasRefunded uint64, hash []byte) {},
+			RemoveGasConsumedCalled: func(hashes [][]byte) {},
+			RemoveGasRefundedCalled: func(hashes [][]byte) {},
+			TotalGasRefundedCalled: func() uint64 {
+				return 0
+			},
+		},
+		NewPoolOrderSelection(),
+	)
+
+	sndShardId := uint32(0)
+	dstShardId := uint32(1)
+	strCache := process.ShardCacherIdentifier(sndShardId, dstShardId)
+
+	validTx := &transaction.Transaction{Nonce: 1, ValidAfterRound: 5, ValidUntilRound: 15}
+	notYetValidTx := &transaction.Transaction{Nonce: 2, ValidAfterRound: 11}
+	expiredTx := &transaction.Transaction{Nonce: 3, ValidUntilRound: 9}
+	validTxHash, _ := core.CalculateHash(marshalizer, hasher, validTx)
+	notYetValidTxHash, _ := core.CalculateHash(marshalizer, hasher, notYetValidTx)
+	expiredTxHash, _ := core.CalculateHash(marshalizer, hasher, expiredTx)
+	txPool.AddData(validTxHash, validTx, strCache)
+	txPool.AddData(notYetValidTxHash, notYetValidTx, strCache)
+	txPool.AddData(expiredTxHash, expiredTx, strCache)
+
+	mb, err := txs.CreateAndProcessMiniBlock(sndShardId, dstShardId, process.MaxItemsInBlock, haveTimeTrue, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{validTxHash}, mb.TxHashes)
+	assert.Equal(t, 2, len(processedTxs))
+	_, ok := txPool.SearchFirstData(notYetValidTxHash)
+	assert.True(t, ok)
+	_, ok = txPool.SearchFirstData(expiredTxHash)
+	assert.False(t, ok)
+}
+
 func TestSortTxByNonce_NilTxDataPoolShouldErr(t *testing.T) {
 	t.Parallel()
 	transactions, txHashes, err := SortTxByNonce(nil)
@@ -1057,6 +1230,208 @@ func TestSortTxByNonce_TransactionsWithSameNonceShouldGetSorted(t *testing.T) {
 	}
 }
 
+func TestSelectTxsByGasPrice_ShouldOrderBySenderGasPriceAndNonce(t *testing.T) {
+	t.Parallel()
+
+	transactions := []*transaction.Transaction{
+		{Nonce: 2, SndAddr: []byte("alice"), GasPrice: 10},
+		{Nonce: 1, SndAddr: []byte("alice"), GasPrice: 10},
+		{Nonce: 1, SndAddr: []byte("bob"), GasPrice: 50},
+		{Nonce: 2, SndAddr: []byte("bob"), GasPrice: 50},
+	}
+	cache, _ := txcache.NewTxCache(len(transactions), len(transactions), 10)
+	for i, tx := range transactions {
+		cache.Put([]byte(fmt.Sprintf("hash%d", i)), tx)
+	}
+
+	selectedTxs, selectedHashes := selectTxsByGasPrice(cache, process.MaxItemsInBlock)
+
+	assert.Equal(t, len(transactions), len(selectedTxs))
+	assert.Equal(t, len(transactions), len(selectedHashes))
+	assert.Equal(t, []byte("hash2"), selectedHashes[0])
+	assert.Equal(t, []byte("hash3"), selectedHashes[1])
+	assert.Equal(t, []byte("hash1"), selectedHashes[2])
+	assert.Equal(t, []byte("hash0"), selectedHashes[3])
+	assert.Equal(t, transactions[2], selectedTxs[0])
+}
+
+func TestTransactions_ComputeOrderedTxsShouldSelectFromTxCache(t *testing.T) {
+	t.Parallel()
+
+	txPool, _ := shardedData.NewShardedTxData(100, 100, 10)
+	txs, _ := NewTransactionPreprocessor(
+		txPool,
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		func(shardID uint32, txHashes [][]byte) {},
+		func(shardID uint32, sender []byte, nonces []uint64) {},
+		feeHandlerMock(),
+		miniBlocksCompacterMock(),
+		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
+	)
+
+	strCache := process.ShardCacherIdentifier(0, 1)
+	txPool.AddData([]byte("hash0"), &transaction.Transaction{Nonce: 1, SndAddr: []byte("alice"), GasPrice: 10}, strCache)
+	txPool.AddData([]byte("hash1"), &transaction.Transaction{Nonce: 1, SndAddr: []byte("bob"), GasPrice: 20}, strCache)
+
+	orderedTxs, orderedTxHashes, err := txs.computeOrderedTxs(0, 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(orderedTxs))
+	assert.Equal(t, [][]byte{[]byte("hash1"), []byte("hash0")}, orderedTxHashes)
+}
+
+func TestTransactions_RequestMissingNoncesShouldRequestGapsFromAllDestinations(t *testing.T) {
+	t.Parallel()
+
+	requestedNonces := make(map[string][]uint64)
+	txPool, _ := shardedData.NewShardedTxData(100, 100, 10)
+	txs, _ := NewTransactionPreprocessor(
+		txPool,
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{
+			GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+				assert.Fail(t, "the accounts should not be read while scanning")
+				return nil, state.ErrAccNotFound
+			},
+		},
+		func(shardID uint32, txHashes [][]byte) {},
+		func(shardID uint32, sender []byte, nonces []uint64) {
+			assert.Equal(t, uint32(0), shardID)
+			requestedNonces[string(sender)] = nonces
+		},
+		feeHandlerMock(),
+		miniBlocksCompacterMock(),
+		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
+	)
+
+	toShard1 := process.ShardCacherIdentifier(0, 1)
+	toMeta := process.ShardCacherIdentifier(0, sharding.MetachainShardId)
+	txPool.AddData([]byte("hash0"), &transaction.Transaction{Nonce: 6, SndAddr: []byte("alice")}, toShard1)
+	txPool.AddData([]byte("hash1"), &transaction.Transaction{Nonce: 9, SndAddr: []byte("alice")}, toMeta)
+	txPool.AddData([]byte("hash2"), &transaction.Transaction{Nonce: 3, SndAddr: []byte("bob")}, toShard1)
+	txPool.AddData([]byte("hash3"), &transaction.Transaction{Nonce: 4, SndAddr: []byte("bob")}, toMeta)
+	txPool.AddData([]byte("hash4"), &transaction.Transaction{Nonce: 2, SndAddr: []byte("carol")}, toShard1)
+
+	pooledSenders := txs.requestMissingNonces(map[string]uint64{"alice": 5, "bob": 3})
+
+	assert.Equal(t, map[string][]uint64{"alice": {5, 7, 8}}, requestedNonces)
+	assert.Equal(t, 3, len(pooledSenders))
+	assert.Equal(t, 0, len(txs.orderedTxs))
+}
+
+func TestTransactions_StartMissingNoncesScanShouldReadTheAccountsOfThePreviouslyPooledSenders(t *testing.T) {
+	t.Parallel()
+
+	numAccountReads := int32(0)
+	chRequested := make(chan []uint64, 1)
+	txPool, _ := shardedData.NewShardedTxData(100, 100, 10)
+	txs, _ := NewTransactionPreprocessor(
+		txPool,
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{
+			GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+				atomic.AddInt32(&numAccountReads, 1)
+				account, _ := state.NewAccount(addressContainer, &mock.AccountTrackerStub{})
+				account.Nonce = 5
+				return account, nil
+			},
+		},
+		func(shardID uint32, txHashes [][]byte) {},
+		func(shardID uint32, sender []byte, nonces []uint64) {
+			chRequested <- nonces
+		},
+		feeHandlerMock(),
+		miniBlocksCompacterMock(),
+		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
+	)
+	txPool.AddData([]byte("hash0"), &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice")}, process.ShardCacherIdentifier(0, 1))
+	txs.pooledSenders = [][]byte{[]byte("alice")}
+
+	txs.startMissingNoncesScan()
+	// the nonces are read before the scan starts
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numAccountReads))
+
+	select {
+	case nonces := <-chRequested:
+		assert.Equal(t, []uint64{5, 6}, nonces)
+	case <-time.After(time.Second):
+		assert.Fail(t, "the missing nonces should have been requested")
+	}
+}
+
+func TestTransactions_StartMissingNoncesScanShouldBeThrottled(t *testing.T) {
+	t.Parallel()
+
+	numScans := int32(0)
+	chScanDone := make(chan struct{}, 2)
+	txs, _ := NewTransactionPreprocessor(
+		initDataPool().Transactions(),
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{
+			GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+				return state.NewAccount(addressContainer, &mock.AccountTrackerStub{})
+			},
+		},
+		func(shardID uint32, txHashes [][]byte) {},
+		func(shardID uint32, sender []byte, nonces []uint64) {},
+		feeHandlerMock(),
+		miniBlocksCompacterMock(),
+		&mock.GasHandlerMock{},
+		NewPoolOrderSelection(),
+	)
+	txs.txPool = &mock.ShardedDataStub{
+		ShardDataStoreCalled: func(cacheId string) storage.Cacher {
+			if cacheId == process.ShardCacherIdentifier(0, sharding.MetachainShardId) {
+				atomic.AddInt32(&numScans, 1)
+				chScanDone <- struct{}{}
+			}
+			return nil
+		},
+	}
+
+	txs.startMissingNoncesScan()
+	select {
+	case <-chScanDone:
+	case <-time.After(time.Second):
+		assert.Fail(t, "the scan should have started")
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	txs.startMissingNoncesScan()
+	time.Sleep(10 * time.Millisecond)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numScans))
+}
+
+func TestTransactions_ComputeMissingNoncesShouldBeBounded(t *testing.T) {
+	t.Parallel()
+
+	missingNonces := computeMissingNonces(0, map[uint64]struct{}{1000: {}})
+
+	assert.Equal(t, maxMissingNoncesToRequestPerSender, len(missingNonces))
+	assert.Equal(t, uint64(0), missingNonces[0])
+}
+
 func TestMiniBlocksCompaction_CompactAndExpandMiniBlocksShouldResultTheSameMiniBlocks(t *testing.T) {
 	t.Parallel()
 
@@ -1076,6 +1451,7 @@ func TestMiniBlocksCompaction_CompactAndExpandMiniBlocksShouldResultTheSameMiniB
 		mock.NewMultiShardsCoordinatorMock(2),
 		&mock.AccountsStub{},
 		requestTransaction,
+		func(shardID uint32, sender []byte, nonces []uint64) {},
 		feeHandlerMock(),
 		miniBlocksCompacterMock(),
 		&mock.GasHandlerMock{
@@ -1096,6 +1472,7 @@ func TestMiniBlocksCompaction_CompactAndExpandMiniBlocksShouldResultTheSameMiniB
 				return 0
 			},
 		},
+		NewPoolOrderSelection(),
 	)
 
 	keygen := signing.NewKeyGenerator(kyber.NewBlakeSHA256Ed25519())
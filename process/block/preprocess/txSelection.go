@@ -0,0 +1,347 @@
+package preprocess
+
+import (
+	"container/heap"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// poolOrderSelection tries the transactions in the order they were taken from the pool, leaving the gas and the
+// size limits to be checked while the miniblock is created
+type poolOrderSelection struct {
+}
+
+// NewPoolOrderSelection creates the default transactions selection strategy
+func NewPoolOrderSelection() *poolOrderSelection {
+	return &poolOrderSelection{}
+}
+
+// SelectTxs returns the provided transactions, unchanged
+func (pos *poolOrderSelection) SelectTxs(
+	txs []*transaction.Transaction,
+	txHashes [][]byte,
+	_ func(tx *transaction.Transaction) (uint64, error),
+	_ uint64,
+	_ int,
+) ([]*transaction.Transaction, [][]byte) {
+	return txs, txHashes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pos *poolOrderSelection) IsInterfaceNil() bool {
+	if pos == nil {
+		return true
+	}
+	return false
+}
+
+type txCandidate struct {
+	tx     *transaction.Transaction
+	txHash []byte
+	gas    uint64
+	fee    *big.Int
+}
+
+// txGroup is a run of consecutive candidates of a sender, selected together
+type txGroup struct {
+	length int
+	gas    uint64
+	fee    *big.Int
+}
+
+// senderChain holds the candidates of one sender, in nonce order. Only a prefix of the not yet selected
+// candidates can be added to a block. The not yet selected candidates are split in groups having a decreasing
+// fee per gas unit, so the first group is always the prefix with the best fee per gas unit
+type senderChain struct {
+	candidates []*txCandidate
+	next       int
+	groups     []*txGroup
+	index      int
+	heapIndex  int
+}
+
+// feeComparator compares fees per gas unit without dividing, reusing its buffers between comparisons
+type feeComparator struct {
+	first  *big.Int
+	second *big.Int
+	gas    *big.Int
+}
+
+func newFeeComparator() *feeComparator {
+	return &feeComparator{
+		first:  big.NewInt(0),
+		second: big.NewInt(0),
+		gas:    big.NewInt(0),
+	}
+}
+
+// hasBetterFeePerGas compares firstFee/firstGas with secondFee/secondGas
+func (fc *feeComparator) hasBetterFeePerGas(firstFee *big.Int, firstGas uint64, secondFee *big.Int, secondGas uint64) bool {
+	fc.first.Mul(firstFee, fc.gas.SetUint64(secondGas))
+	fc.second.Mul(secondFee, fc.gas.SetUint64(firstGas))
+
+	return fc.first.Cmp(fc.second) > 0
+}
+
+// chainsHeap is a max heap of the sender chains, ordered by the fee per gas unit of their first group.
+// The chain created first wins in case of equality
+type chainsHeap struct {
+	chains     []*senderChain
+	comparator *feeComparator
+}
+
+// Len returns the number of chains in the heap
+func (ch *chainsHeap) Len() int {
+	return len(ch.chains)
+}
+
+// Less returns true if the i-th chain should be selected from before the j-th one
+func (ch *chainsHeap) Less(i, j int) bool {
+	first := ch.chains[i].groups[0]
+	second := ch.chains[j].groups[0]
+	if ch.comparator.hasBetterFeePerGas(first.fee, first.gas, second.fee, second.gas) {
+		return true
+	}
+	if ch.comparator.hasBetterFeePerGas(second.fee, second.gas, first.fee, first.gas) {
+		return false
+	}
+
+	return ch.chains[i].index < ch.chains[j].index
+}
+
+// Swap swaps two chains, updating their indexes
+func (ch *chainsHeap) Swap(i, j int) {
+	ch.chains[i], ch.chains[j] = ch.chains[j], ch.chains[i]
+	ch.chains[i].heapIndex = i
+	ch.chains[j].heapIndex = j
+}
+
+// Push appends a chain at the end of the heap. It must only be called through heap.Push
+func (ch *chainsHeap) Push(x interface{}) {
+	chain := x.(*senderChain)
+	chain.heapIndex = len(ch.chains)
+	ch.chains = append(ch.chains, chain)
+}
+
+// Pop removes the last chain of the heap. It must only be called through heap.Pop
+func (ch *chainsHeap) Pop() interface{} {
+	n := len(ch.chains)
+	chain := ch.chains[n-1]
+	ch.chains[n-1] = nil
+	ch.chains = ch.chains[:n-1]
+
+	return chain
+}
+
+// feeMaximizingSelection chooses the transactions that maximize the collected fees for the available gas. As the
+// transactions of a sender have to be executed in nonce order, the selection is done by repeatedly taking the
+// group of consecutive transactions of a sender with the best fee per gas unit. The senders are kept in a heap
+// ordered by their best group, so each step only updates the chain it selected from. A percent of the available
+// gas is left unused, so the miniblocks towards the other shards can be filled as well
+type feeMaximizingSelection struct {
+	reservedGasPercent uint32
+}
+
+// NewFeeMaximizingSelection creates a fee maximizing selection strategy that leaves reservedGasPercent of the
+// available gas for the other miniblocks of the block
+func NewFeeMaximizingSelection(reservedGasPercent uint32) (*feeMaximizingSelection, error) {
+	if reservedGasPercent >= 100 {
+		return nil, process.ErrInvalidReservedGasPercent
+	}
+
+	return &feeMaximizingSelection{
+		reservedGasPercent: reservedGasPercent,
+	}, nil
+}
+
+// SelectTxs returns the transactions which should be added in the miniblock, in the order they should be executed
+func (fms *feeMaximizingSelection) SelectTxs(
+	txs []*transaction.Transaction,
+	txHashes [][]byte,
+	computeGas func(tx *transaction.Transaction) (uint64, error),
+	maxGas uint64,
+	maxTxs int,
+) ([]*transaction.Transaction, [][]byte) {
+
+	comparator := newFeeComparator()
+	chains := &chainsHeap{
+		chains:     fms.createSenderChains(txs, txHashes, computeGas, comparator),
+		comparator: comparator,
+	}
+	heap.Init(chains)
+
+	remainingGas := maxGas - maxGas*uint64(fms.reservedGasPercent)/100
+	remainingTxs := maxTxs
+
+	selectedTxs := make([]*transaction.Transaction, 0)
+	selectedTxHashes := make([][]byte, 0)
+
+	for remainingTxs > 0 && chains.Len() > 0 {
+		bestChain := chains.chains[0]
+		group := bestChain.groups[0]
+
+		if group.length > remainingTxs || group.gas > remainingGas {
+			// only a shorter prefix of the group can still be selected, its fee per gas unit might be lower
+			hasFittingPrefix := bestChain.truncateFirstGroup(remainingGas, remainingTxs, comparator)
+			if !hasFittingPrefix {
+				heap.Pop(chains)
+				continue
+			}
+
+			heap.Fix(chains, 0)
+			continue
+		}
+
+		for _, candidate := range bestChain.candidates[bestChain.next : bestChain.next+group.length] {
+			selectedTxs = append(selectedTxs, candidate.tx)
+			selectedTxHashes = append(selectedTxHashes, candidate.txHash)
+		}
+		remainingGas -= group.gas
+		remainingTxs -= group.length
+
+		bestChain.next += group.length
+		bestChain.groups = bestChain.groups[1:]
+		if len(bestChain.groups) == 0 {
+			heap.Pop(chains)
+			continue
+		}
+
+		heap.Fix(chains, 0)
+	}
+
+	return selectedTxs, selectedTxHashes
+}
+
+func (fms *feeMaximizingSelection) createSenderChains(
+	txs []*transaction.Transaction,
+	txHashes [][]byte,
+	computeGas func(tx *transaction.Transaction) (uint64, error),
+	comparator *feeComparator,
+) []*senderChain {
+
+	chains := make([]*senderChain, 0)
+	chainBySender := make(map[string]*senderChain)
+	brokenChains := make(map[string]struct{})
+
+	for index, tx := range txs {
+		sender := string(tx.SndAddr)
+		_, isBroken := brokenChains[sender]
+		if isBroken {
+			continue
+		}
+
+		gas, err := computeGas(tx)
+		if err != nil {
+			// the next transactions of this sender can not be executed without this one
+			brokenChains[sender] = struct{}{}
+			continue
+		}
+
+		chain, ok := chainBySender[sender]
+		if !ok {
+			chain = &senderChain{
+				candidates: make([]*txCandidate, 0),
+				index:      len(chains),
+			}
+			chainBySender[sender] = chain
+			chains = append(chains, chain)
+		}
+
+		fee := big.NewInt(0).SetUint64(tx.GasPrice)
+		fee.Mul(fee, big.NewInt(0).SetUint64(tx.GasLimit))
+
+		chain.candidates = append(chain.candidates, &txCandidate{
+			tx:     tx,
+			txHash: txHashes[index],
+			gas:    gas,
+			fee:    fee,
+		})
+	}
+
+	for _, chain := range chains {
+		chain.groups = createGroups(chain.candidates, comparator)
+	}
+
+	return chains
+}
+
+// createGroups splits the candidates in groups having a decreasing fee per gas unit. A candidate paying more per
+// gas unit than the group before it is merged into that group, as selecting both together is better than selecting
+// the group alone
+func createGroups(candidates []*txCandidate, comparator *feeComparator) []*txGroup {
+	groups := make([]*txGroup, 0, len(candidates))
+	for _, candidate := range candidates {
+		group := &txGroup{
+			length: 1,
+			gas:    candidate.gas,
+			fee:    big.NewInt(0).Set(candidate.fee),
+		}
+
+		for len(groups) > 0 {
+			last := groups[len(groups)-1]
+			if !comparator.hasBetterFeePerGas(group.fee, group.gas, last.fee, last.gas) {
+				break
+			}
+
+			last.length += group.length
+			last.gas += group.gas
+			last.fee.Add(last.fee, group.fee)
+			group = last
+			groups = groups[:len(groups)-1]
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+// truncateFirstGroup replaces the first group with its prefix having the best fee per gas unit among the prefixes
+// that fit in the remaining gas and size, and regroups the candidates that follow it. It returns false if no
+// prefix fits
+func (sc *senderChain) truncateFirstGroup(remainingGas uint64, remainingTxs int, comparator *feeComparator) bool {
+	first := sc.groups[0]
+	maxLen := first.length
+	if maxLen > remainingTxs {
+		maxLen = remainingTxs
+	}
+
+	var best *txGroup
+	prefixFee := big.NewInt(0)
+	prefixGas := uint64(0)
+	for length := 1; length <= maxLen; length++ {
+		candidate := sc.candidates[sc.next+length-1]
+		if prefixGas+candidate.gas > remainingGas {
+			break
+		}
+
+		prefixGas += candidate.gas
+		prefixFee.Add(prefixFee, candidate.fee)
+
+		if best == nil || comparator.hasBetterFeePerGas(prefixFee, prefixGas, best.fee, best.gas) {
+			best = &txGroup{
+				length: length,
+				gas:    prefixGas,
+				fee:    big.NewInt(0).Set(prefixFee),
+			}
+		}
+	}
+
+	if best == nil {
+		return false
+	}
+
+	sc.groups = append([]*txGroup{best}, createGroups(sc.candidates[sc.next+best.length:], comparator)...)
+
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fms *feeMaximizingSelection) IsInterfaceNil() bool {
+	if fms == nil {
+		return true
+	}
+	return false
+}
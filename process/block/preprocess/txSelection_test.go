@@ -0,0 +1,189 @@
+package preprocess_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
+	"github.com/stretchr/testify/assert"
+)
+
+func createSelectionTx(sender string, nonce uint64, gasPrice uint64, gasLimit uint64) *transaction.Transaction {
+	return &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		GasLimit: gasLimit,
+	}
+}
+
+func createSelectionInput(txs ...*transaction.Transaction) ([]*transaction.Transaction, [][]byte) {
+	txHashes := make([][]byte, 0, len(txs))
+	for _, tx := range txs {
+		txHashes = append(txHashes, []byte(fmt.Sprintf("%s-%d", tx.SndAddr, tx.Nonce)))
+	}
+
+	return txs, txHashes
+}
+
+func gasLimitAsGas(tx *transaction.Transaction) (uint64, error) {
+	return tx.GasLimit, nil
+}
+
+func TestPoolOrderSelection_SelectTxsShouldReturnTheInput(t *testing.T) {
+	t.Parallel()
+
+	txs, txHashes := createSelectionInput(
+		createSelectionTx("alice", 1, 10, 10),
+		createSelectionTx("bob", 1, 100, 10),
+	)
+	computeGasCalled := false
+	computeGas := func(tx *transaction.Transaction) (uint64, error) {
+		computeGasCalled = true
+		return 0, nil
+	}
+
+	pos := preprocess.NewPoolOrderSelection()
+	selectedTxs, selectedTxHashes := pos.SelectTxs(txs, txHashes, computeGas, 1, 1)
+
+	assert.False(t, pos.IsInterfaceNil())
+	assert.False(t, computeGasCalled)
+	assert.Equal(t, txs, selectedTxs)
+	assert.Equal(t, txHashes, selectedTxHashes)
+}
+
+func TestNewFeeMaximizingSelection_InvalidReservedGasPercentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fms, err := preprocess.NewFeeMaximizingSelection(100)
+
+	assert.Nil(t, fms)
+	assert.Equal(t, process.ErrInvalidReservedGasPercent, err)
+}
+
+func TestNewFeeMaximizingSelection_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	fms, err := preprocess.NewFeeMaximizingSelection(99)
+
+	assert.Nil(t, err)
+	assert.False(t, fms.IsInterfaceNil())
+}
+
+func TestFeeMaximizingSelection_SelectTxsShouldPreferHigherFeesAndKeepNonceOrder(t *testing.T) {
+	t.Parallel()
+
+	txs, txHashes := createSelectionInput(
+		createSelectionTx("alice", 1, 10, 10),
+		createSelectionTx("alice", 2, 10, 10),
+		createSelectionTx("bob", 1, 5, 10),
+		createSelectionTx("bob", 2, 100, 10),
+		createSelectionTx("carol", 1, 30, 10),
+	)
+
+	fms, _ := preprocess.NewFeeMaximizingSelection(0)
+	_, selectedTxHashes := fms.SelectTxs(txs, txHashes, gasLimitAsGas, 100, 10)
+
+	expected := [][]byte{[]byte("bob-1"), []byte("bob-2"), []byte("carol-1"), []byte("alice-1"), []byte("alice-2")}
+	assert.Equal(t, expected, selectedTxHashes)
+}
+
+func TestFeeMaximizingSelection_SelectTxsShouldRespectGasLimit(t *testing.T) {
+	t.Parallel()
+
+	txs, txHashes := createSelectionInput(
+		createSelectionTx("alice", 1, 10, 10),
+		createSelectionTx("bob", 1, 50, 30),
+		createSelectionTx("carol", 1, 30, 10),
+	)
+
+	fms, _ := preprocess.NewFeeMaximizingSelection(0)
+	_, selectedTxHashes := fms.SelectTxs(txs, txHashes, gasLimitAsGas, 35, 10)
+
+	assert.Equal(t, [][]byte{[]byte("bob-1")}, selectedTxHashes)
+}
+
+func TestFeeMaximizingSelection_SelectTxsShouldRespectMaxTxs(t *testing.T) {
+	t.Parallel()
+
+	txs, txHashes := createSelectionInput(
+		createSelectionTx("alice", 1, 10, 10),
+		createSelectionTx("bob", 1, 50, 10),
+		createSelectionTx("carol", 1, 30, 10),
+	)
+
+	fms, _ := preprocess.NewFeeMaximizingSelection(0)
+	_, selectedTxHashes := fms.SelectTxs(txs, txHashes, gasLimitAsGas, 1000, 2)
+
+	assert.Equal(t, [][]byte{[]byte("bob-1"), []byte("carol-1")}, selectedTxHashes)
+}
+
+func TestFeeMaximizingSelection_SelectTxsShouldLeaveTheReservedGas(t *testing.T) {
+	t.Parallel()
+
+	txs, txHashes := createSelectionInput(
+		createSelectionTx("alice", 1, 10, 10),
+		createSelectionTx("bob", 1, 50, 10),
+		createSelectionTx("carol", 1, 30, 10),
+	)
+
+	fms, _ := preprocess.NewFeeMaximizingSelection(50)
+	_, selectedTxHashes := fms.SelectTxs(txs, txHashes, gasLimitAsGas, 40, 10)
+
+	assert.Equal(t, [][]byte{[]byte("bob-1"), []byte("carol-1")}, selectedTxHashes)
+}
+
+func TestFeeMaximizingSelection_SelectTxsShouldSkipTheSenderAfterAGasError(t *testing.T) {
+	t.Parallel()
+
+	txs, txHashes := createSelectionInput(
+		createSelectionTx("alice", 1, 10, 10),
+		createSelectionTx("alice", 2, 10, 0),
+		createSelectionTx("alice", 3, 10, 10),
+		createSelectionTx("bob", 1, 5, 10),
+	)
+	computeGas := func(tx *transaction.Transaction) (uint64, error) {
+		if tx.GasLimit == 0 {
+			return 0, errors.New("insufficient gas limit")
+		}
+		return tx.GasLimit, nil
+	}
+
+	fms, _ := preprocess.NewFeeMaximizingSelection(0)
+	_, selectedTxHashes := fms.SelectTxs(txs, txHashes, computeGas, 1000, 10)
+
+	assert.Equal(t, [][]byte{[]byte("alice-1"), []byte("bob-1")}, selectedTxHashes)
+}
+
+func TestFeeMaximizingSelection_SelectTxsShouldTakeALowPayingTxToReachAHighPayingOne(t *testing.T) {
+	t.Parallel()
+
+	txs, txHashes := createSelectionInput(
+		createSelectionTx("alice", 1, 1, 10),
+		createSelectionTx("alice", 2, 100, 10),
+		createSelectionTx("bob", 1, 40, 10),
+	)
+
+	fms, _ := preprocess.NewFeeMaximizingSelection(0)
+	_, selectedTxHashes := fms.SelectTxs(txs, txHashes, gasLimitAsGas, 20, 10)
+
+	assert.Equal(t, [][]byte{[]byte("alice-1"), []byte("alice-2")}, selectedTxHashes)
+}
+
+func TestFeeMaximizingSelection_SelectTxsShouldReRankAGroupThatDoesNotFit(t *testing.T) {
+	t.Parallel()
+
+	txs, txHashes := createSelectionInput(
+		createSelectionTx("alice", 1, 1, 10),
+		createSelectionTx("alice", 2, 100, 10),
+		createSelectionTx("bob", 1, 40, 10),
+	)
+
+	fms, _ := preprocess.NewFeeMaximizingSelection(0)
+	_, selectedTxHashes := fms.SelectTxs(txs, txHashes, gasLimitAsGas, 10, 10)
+
+	assert.Equal(t, [][]byte{[]byte("bob-1")}, selectedTxHashes)
+}
@@ -35,6 +35,7 @@ type shardProcessor struct {
 	core                   serviceContainer.Core
 	txCounter              *transactionCounter
 	txsPoolsCleaner        process.PoolsCleaner
+	governanceApplier      process.GovernanceChangesApplier
 }
 
 // NewShardProcessor creates a new shardProcessor object
@@ -70,6 +71,7 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		txCoordinator:                 arguments.TxCoordinator,
 		rounder:                       arguments.Rounder,
 		bootStorer:                    arguments.BootStorer,
+		receiptsHandler:               arguments.ReceiptsHandler,
 		validatorStatisticsProcessor:  arguments.ValidatorStatisticsProcessor,
 	}
 
@@ -81,13 +83,17 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 	if arguments.TxsPoolsCleaner == nil || arguments.TxsPoolsCleaner.IsInterfaceNil() {
 		return nil, process.ErrNilTxsPoolsCleaner
 	}
+	if check.IfNil(arguments.GovernanceApplier) {
+		return nil, process.ErrNilGovernanceApplier
+	}
 
 	sp := shardProcessor{
-		core:            arguments.Core,
-		baseProcessor:   base,
-		dataPool:        arguments.DataPool,
-		txCounter:       NewTransactionCounter(),
-		txsPoolsCleaner: arguments.TxsPoolsCleaner,
+		core:              arguments.Core,
+		baseProcessor:     base,
+		dataPool:          arguments.DataPool,
+		txCounter:         NewTransactionCounter(),
+		txsPoolsCleaner:   arguments.TxsPoolsCleaner,
+		governanceApplier: arguments.GovernanceApplier,
 	}
 
 	sp.baseProcessor.requestBlockBodyHandler = &sp
@@ -492,6 +498,7 @@ func (sp *shardProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler
 
 	go sp.txCounter.subtractRestoredTxs(restoredTxNr)
 
+	sp.removeReceiptsFromStorage(header, body)
 	sp.removeLastNotarized()
 
 	return nil
@@ -662,6 +669,11 @@ func (sp *shardProcessor) CommitBlock(
 		return err
 	}
 
+	errNotCritical = sp.receiptsHandler.SaveReceiptsToStorage(headerHash, getTxHashesFromBody(body))
+	if errNotCritical != nil {
+		log.Debug("SaveReceiptsToStorage", "error", errNotCritical.Error())
+	}
+
 	for i := 0; i < len(body); i++ {
 		buff, err = sp.marshalizer.Marshal(body[i])
 		if err != nil {
@@ -700,6 +712,8 @@ func (sp *shardProcessor) CommitBlock(
 		return err
 	}
 
+	sp.applyGovernanceChanges(header.Nonce, processedMetaHdrs)
+
 	log.Info("shard block has been committed successfully",
 		"nonce", header.Nonce,
 		"round", header.Round,
@@ -1062,6 +1076,30 @@ func (sp *shardProcessor) getOrderedProcessedMetaBlocksFromMiniBlockHashes(
 	return processedMetaHdrs, nil
 }
 
+// applyGovernanceChanges applies the governance changes of the metablocks fully processed by the committed block,
+// in the order of their nonces
+func (sp *shardProcessor) applyGovernanceChanges(blockNonce uint64, processedMetaHdrs []data.HeaderHandler) {
+	for _, hdr := range processedMetaHdrs {
+		metaBlock, ok := hdr.(*block.MetaBlock)
+		if !ok || len(metaBlock.GovernanceChanges) == 0 {
+			continue
+		}
+
+		sp.governanceApplier.ApplyGovernanceChanges(blockNonce, metaBlock.GovernanceChanges)
+	}
+}
+
+// RevertStateToBlock recreates the state tries to the root hashes indicated by the provided header and sets the
+// governance parameters as they were after it
+func (sp *shardProcessor) RevertStateToBlock(header data.HeaderHandler) error {
+	err := sp.baseProcessor.RevertStateToBlock(header)
+	if err != nil {
+		return err
+	}
+
+	return sp.governanceApplier.RestoreGovernanceChanges(header.GetNonce())
+}
+
 func (sp *shardProcessor) removeProcessedMetaBlocksFromPool(processedMetaHdrs []data.HeaderHandler) error {
 	lastNotarizedMetaHdr, err := sp.getLastNotarizedHdr(sharding.MetachainShardId)
 	if err != nil {
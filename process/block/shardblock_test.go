@@ -26,6 +26,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	blproc "github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -256,6 +257,17 @@ func TestNewShardProcessor_NilTxCoordinator(t *testing.T) {
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilReceiptsHandler(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.ReceiptsHandler = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilReceiptsHandler, err)
+	assert.Nil(t, sp)
+}
+
 func TestNewShardProcessor_NilUint64Converter(t *testing.T) {
 	t.Parallel()
 
@@ -267,6 +279,17 @@ func TestNewShardProcessor_NilUint64Converter(t *testing.T) {
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilGovernanceApplierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.GovernanceApplier = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilGovernanceApplier, err)
+	assert.Nil(t, sp)
+}
+
 func TestNewShardProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -492,6 +515,7 @@ func TestShardProcessor_ProcessBlockWithInvalidTransactionShouldErr(t *testing.T
 			},
 			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
 		},
+		preprocess.NewPoolOrderSelection(),
 	)
 	container, _ := factory.Create()
 
@@ -706,6 +730,7 @@ func TestShardProcessor_ProcessBlockWithErrOnProcessBlockTransactionsCallShouldR
 			},
 			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
 		},
+		preprocess.NewPoolOrderSelection(),
 	)
 	container, _ := factory.Create()
 
@@ -1876,6 +1901,7 @@ func TestShardProcessor_CommitBlockNoTxInPoolShouldErr(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 	container, _ := factory.Create()
 
@@ -1979,6 +2005,13 @@ func TestShardProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 	arguments.Hasher = hasher
 	arguments.Accounts = accounts
 	arguments.ForkDetector = fd
+	var savedReceiptsTxHashes [][]byte
+	arguments.ReceiptsHandler = &mock.ReceiptsHandlerStub{
+		SaveReceiptsToStorageCalled: func(headerHash []byte, txHashes [][]byte) error {
+			savedReceiptsTxHashes = txHashes
+			return nil
+		},
+	}
 	sp, _ := blproc.NewShardProcessor(arguments)
 
 	blkc := createTestBlockchain()
@@ -1994,10 +2027,60 @@ func TestShardProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, forkDetectorAddCalled)
 	assert.Equal(t, hdrHash, blkc.GetCurrentBlockHeaderHash())
+	assert.Equal(t, [][]byte{txHash}, savedReceiptsTxHashes)
 	//this should sleep as there is an async call to display current hdr and block in CommitBlock
 	time.Sleep(time.Second)
 }
 
+func TestShardProcessor_ApplyGovernanceChangesShouldApplyTheChangesOfTheProcessedMetaBlocks(t *testing.T) {
+	t.Parallel()
+
+	appliedChanges := make([]block.GovernanceData, 0)
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.GovernanceApplier = &mock.GovernanceChangesHandlerStub{
+		ApplyGovernanceChangesCalled: func(blockNonce uint64, changes []block.GovernanceData) {
+			assert.Equal(t, uint64(7), blockNonce)
+			appliedChanges = append(appliedChanges, changes...)
+		},
+	}
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	change1 := block.GovernanceData{ProposalID: 1, Parameter: "economics.MinGasPrice", Value: "10", ActivationNonce: 3}
+	change2 := block.GovernanceData{ProposalID: 2, Parameter: "economics.MinGasLimit", Value: "20", ActivationNonce: 4}
+	processedMetaHdrs := []data.HeaderHandler{
+		&block.MetaBlock{Nonce: 3, GovernanceChanges: []block.GovernanceData{change1}},
+		&block.MetaBlock{Nonce: 4},
+		&block.MetaBlock{Nonce: 5, GovernanceChanges: []block.GovernanceData{change2}},
+	}
+	sp.ApplyGovernanceChanges(7, processedMetaHdrs)
+
+	assert.Equal(t, []block.GovernanceData{change1, change2}, appliedChanges)
+}
+
+func TestShardProcessor_RevertStateToBlockShouldRestoreGovernanceChanges(t *testing.T) {
+	t.Parallel()
+
+	restoredNonce := uint64(0)
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.Accounts = &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return nil
+		},
+	}
+	arguments.GovernanceApplier = &mock.GovernanceChangesHandlerStub{
+		RestoreGovernanceChangesCalled: func(nonce uint64) error {
+			restoredNonce = nonce
+			return nil
+		},
+	}
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	err := sp.RevertStateToBlock(&block.Header{Nonce: 5})
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), restoredNonce)
+}
+
 func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 	t.Parallel()
 	tdp := initDataPool([]byte("tx_hash1"))
@@ -2429,6 +2512,7 @@ func TestShardProcessor_MarshalizedDataToBroadcastShouldWork(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 	container, _ := factory.Create()
 
@@ -2535,6 +2619,7 @@ func TestShardProcessor_MarshalizedDataMarshalWithoutSuccess(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 	container, _ := factory.Create()
 
@@ -2994,6 +3079,7 @@ func TestShardProcessor_CreateMiniBlocksShouldWorkWithIntraShardTxs(t *testing.T
 				return 0
 			},
 		},
+		preprocess.NewPoolOrderSelection(),
 	)
 	container, _ := factory.Create()
 
@@ -3186,6 +3272,7 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 	container, _ := factory.Create()
 
@@ -3205,6 +3292,13 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 	arguments.Hasher = hasherMock
 	arguments.Marshalizer = marshalizerMock
 	arguments.TxCoordinator = tc
+	var removedReceiptsTxHashes [][]byte
+	arguments.ReceiptsHandler = &mock.ReceiptsHandlerStub{
+		RemoveReceiptsFromStorageCalled: func(headerHash []byte, txHashes [][]byte) error {
+			removedReceiptsTxHashes = txHashes
+			return nil
+		},
+	}
 	sp, _ := blproc.NewShardProcessor(arguments)
 
 	txHashes := make([][]byte, 0)
@@ -3256,6 +3350,7 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 	assert.Equal(t, &miniblock, miniblockFromPool)
 	assert.Equal(t, tx, txFromPool)
 	assert.Equal(t, false, sp.IsMiniBlockProcessed(metablockHash, miniblockHash))
+	assert.Equal(t, txHashes, removedReceiptsTxHashes)
 }
 
 func TestShardProcessor_DecodeBlockBody(t *testing.T) {
@@ -28,6 +28,12 @@ const (
 	SCInvoking
 	// RewardTx defines ID of a reward transaction
 	RewardTx
+	// RelayedTx defines ID of a transaction paid by a relayer on behalf of the user who signed the wrapped transaction
+	RelayedTx
+	// MultiSigRegistration defines ID of a transaction which turns its sender into a multisignature account
+	MultiSigRegistration
+	// BuiltInFunctionCall defines ID of a transaction which calls a fungible token function built into the protocol
+	BuiltInFunctionCall
 	// InvalidTransaction defines unknown transaction type
 	InvalidTransaction
 )
@@ -21,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
@@ -429,6 +430,7 @@ func createPreProcessorContainer() process.PreProcessorsContainer {
 		FeeHandlerMock(),
 		MiniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 	container, _ := preFactory.Create()
 
@@ -521,6 +523,7 @@ func createPreProcessorContainerWithDataPool(
 			RemoveGasRefundedCalled: func(hashes [][]byte) {
 			},
 		},
+		preprocess.NewPoolOrderSelection(),
 	)
 	container, _ := preFactory.Create()
 
@@ -825,6 +828,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessCrossShardTransactions(t *tes
 				return 0
 			},
 		},
+		preprocess.NewPoolOrderSelection(),
 	)
 	container, _ := preFactory.Create()
 
@@ -928,6 +932,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessTransactionsFromMeNothingToPr
 				return totalGasConsumed
 			},
 		},
+		preprocess.NewPoolOrderSelection(),
 	)
 	container, _ := preFactory.Create()
 
@@ -1449,6 +1454,7 @@ func TestTransactionCoordinator_receivedMiniBlockRequestTxs(t *testing.T) {
 		FeeHandlerMock(),
 		MiniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 	container, _ := preFactory.Create()
 
@@ -1618,6 +1624,7 @@ func TestTransactionCoordinator_ProcessBlockTransactionProcessTxError(t *testing
 			},
 			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
 		},
+		preprocess.NewPoolOrderSelection(),
 	)
 	container, _ := preFactory.Create()
 
@@ -1743,6 +1750,7 @@ func TestTransactionCoordinator_RequestMiniblocks(t *testing.T) {
 		FeeHandlerMock(),
 		MiniBlocksCompacterMock(),
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 	container, _ := preFactory.Create()
 
@@ -1879,6 +1887,7 @@ func TestShardProcessor_ProcessMiniBlockCompleteWithOkTxsShouldExecuteThemAndNot
 				return 0
 			},
 		},
+		preprocess.NewPoolOrderSelection(),
 	)
 	container, _ := preFactory.Create()
 
@@ -2009,6 +2018,7 @@ func TestShardProcessor_ProcessMiniBlockCompleteWithErrorWhileProcessShouldCallR
 			RemoveGasConsumedCalled: func(hashes [][]byte) {
 			},
 		},
+		preprocess.NewPoolOrderSelection(),
 	)
 	container, _ := preFactory.Create()
 
@@ -2,11 +2,15 @@ package coordinator
 
 import (
 	"bytes"
+	"strings"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/esdt"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
@@ -53,6 +57,18 @@ func (tth *txTypeHandler) ComputeTransactionType(tx data.TransactionHandler) (pr
 		return process.RewardTx, nil
 	}
 
+	if tth.isRelayedTransaction(tx) {
+		return process.RelayedTx, nil
+	}
+
+	if tth.isMultiSigRegistration(tx) {
+		return process.MultiSigRegistration, nil
+	}
+
+	if tth.isBuiltInFunctionCall(tx) {
+		return process.BuiltInFunctionCall, nil
+	}
+
 	isEmptyAddress := tth.isDestAddressEmpty(tx)
 	if isEmptyAddress {
 		if len(tx.GetData()) > 0 {
@@ -77,6 +93,35 @@ func (tth *txTypeHandler) ComputeTransactionType(tx data.TransactionHandler) (pr
 	return process.MoveBalance, nil
 }
 
+// isRelayedTransaction returns true if the transaction is signed by a relayer and wraps a user transaction. The
+// smart contract results are never relayed, even if their data has the same prefix
+func (tth *txTypeHandler) isRelayedTransaction(tx data.TransactionHandler) bool {
+	_, isTx := tx.(*transaction.Transaction)
+	if !isTx {
+		return false
+	}
+
+	return strings.HasPrefix(tx.GetData(), core.RelayedTransaction+"@")
+}
+
+// isMultiSigRegistration returns true if the transaction is sent by an account to itself to set the public keys and
+// the threshold of its signature sets
+func (tth *txTypeHandler) isMultiSigRegistration(tx data.TransactionHandler) bool {
+	_, isTx := tx.(*transaction.Transaction)
+	if !isTx {
+		return false
+	}
+
+	return strings.HasPrefix(tx.GetData(), core.MultiSigRegistration+"@") &&
+		bytes.Equal(tx.GetSndAddress(), tx.GetRecvAddress())
+}
+
+// isBuiltInFunctionCall returns true if the data calls a function built into the protocol, whatever the receiver. The
+// tokens sent to a smart contract are moved by the protocol before the contract is invoked to handle them
+func (tth *txTypeHandler) isBuiltInFunctionCall(tx data.TransactionHandler) bool {
+	return esdt.IsBuiltInFunctionCall(tx.GetData())
+}
+
 func (tth *txTypeHandler) isDestAddressEmpty(tx data.TransactionHandler) bool {
 	isEmptyAddress := bytes.Equal(tx.GetRecvAddress(), make([]byte, tth.adrConv.AddressLen()))
 	return isEmptyAddress
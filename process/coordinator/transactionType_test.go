@@ -5,7 +5,9 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -266,3 +268,165 @@ func TestTxTypeHandler_ComputeTransactionTypeRewardTx(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, process.RewardTx, txType)
 }
+
+func TestTxTypeHandler_ComputeTransactionTypeRelayedTx(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	tth, err := NewTxTypeHandler(
+		addrConv,
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+	)
+
+	assert.NotNil(t, tth)
+	assert.Nil(t, err)
+
+	tx := &transaction.Transaction{
+		RcvAddr: generateRandomByteSlice(addrConv.AddressLen()),
+		Value:   big.NewInt(0),
+		Data:    core.RelayedTransaction + "@0a0b",
+	}
+	txType, err := tth.ComputeTransactionType(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, process.RelayedTx, txType)
+}
+
+func TestTxTypeHandler_ComputeTransactionTypeMultiSigRegistration(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	tth, err := NewTxTypeHandler(
+		addrConv,
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+	)
+
+	assert.NotNil(t, tth)
+	assert.Nil(t, err)
+
+	addr := generateRandomByteSlice(addrConv.AddressLen())
+	tx := &transaction.Transaction{
+		SndAddr: addr,
+		RcvAddr: addr,
+		Value:   big.NewInt(0),
+		Data:    core.MultiSigRegistration + "@01@0a0b",
+	}
+	txType, err := tth.ComputeTransactionType(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, process.MultiSigRegistration, txType)
+}
+
+func TestTxTypeHandler_ComputeTransactionTypeMultiSigRegistrationToOtherAccountIsMoveBalance(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	tth, err := NewTxTypeHandler(
+		addrConv,
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			return &state.Account{}, nil
+		}},
+	)
+
+	assert.NotNil(t, tth)
+	assert.Nil(t, err)
+
+	tx := &transaction.Transaction{
+		SndAddr: generateRandomByteSlice(addrConv.AddressLen()),
+		RcvAddr: generateRandomByteSlice(addrConv.AddressLen()),
+		Value:   big.NewInt(0),
+		Data:    core.MultiSigRegistration + "@01@0a0b",
+	}
+	txType, err := tth.ComputeTransactionType(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, process.MoveBalance, txType)
+}
+
+func TestTxTypeHandler_ComputeTransactionTypeSmartContractResultIsNeverRelayed(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	tth, err := NewTxTypeHandler(
+		addrConv,
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			return &state.Account{}, nil
+		}},
+	)
+
+	assert.NotNil(t, tth)
+	assert.Nil(t, err)
+
+	scr := &smartContractResult.SmartContractResult{
+		RcvAddr: generateRandomByteSlice(addrConv.AddressLen()),
+		Value:   big.NewInt(0),
+		Data:    core.RelayedTransaction + "@0a0b",
+	}
+	txType, err := tth.ComputeTransactionType(scr)
+	assert.Nil(t, err)
+	assert.Equal(t, process.MoveBalance, txType)
+}
+
+func TestTxTypeHandler_ComputeTransactionTypeBuiltInFunctionCall(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	tth, err := NewTxTypeHandler(
+		addrConv,
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+	)
+
+	assert.NotNil(t, tth)
+	assert.Nil(t, err)
+
+	tx := &transaction.Transaction{
+		SndAddr: generateRandomByteSlice(addrConv.AddressLen()),
+		RcvAddr: generateRandomByteSlice(addrConv.AddressLen()),
+		Value:   big.NewInt(0),
+		Data:    core.BuiltInFunctionESDTTransfer + "@544f4b454e@0a",
+	}
+	txType, err := tth.ComputeTransactionType(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, process.BuiltInFunctionCall, txType)
+
+	scr := &smartContractResult.SmartContractResult{
+		RcvAddr: generateRandomByteSlice(addrConv.AddressLen()),
+		Value:   big.NewInt(0),
+		Data:    core.BuiltInFunctionESDTFreeze + "@544f4b454e",
+	}
+	txType, err = tth.ComputeTransactionType(scr)
+	assert.Nil(t, err)
+	assert.Equal(t, process.BuiltInFunctionCall, txType)
+}
+
+func TestTxTypeHandler_ComputeTransactionTypeBuiltInFunctionCallToSmartContractShouldNotBeSCInvoking(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	scAddress := make([]byte, addrConv.AddressLen())
+	scAddress[len(scAddress)-1] = 1
+
+	tx := &transaction.Transaction{
+		SndAddr: generateRandomByteSlice(addrConv.AddressLen()),
+		RcvAddr: scAddress,
+		Value:   big.NewInt(0),
+		Data:    core.BuiltInFunctionESDTTransfer + "@544f4b454e@0a",
+	}
+
+	_, acntDst := createAccounts(tx)
+	acntDst.SetCode([]byte("code"))
+
+	tth, _ := NewTxTypeHandler(
+		addrConv,
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			return acntDst, nil
+		}},
+	)
+
+	txType, err := tth.ComputeTransactionType(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, process.BuiltInFunctionCall, txType)
+}
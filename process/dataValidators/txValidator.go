@@ -1,11 +1,15 @@
 package dataValidators
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"fmt"
 
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/logger"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -17,15 +21,23 @@ var log = logger.GetOrCreate("process/dataValidators")
 type txValidator struct {
 	accounts             state.AccountsAdapter
 	shardCoordinator     sharding.Coordinator
+	txPool               dataRetriever.ShardedDataCacherNotifier
 	rejectedTxs          uint64
 	maxNonceDeltaAllowed int
+	chainID              []byte
+	minTxVersion         uint32
+	signatureSetHandler  process.SignatureSetHandler
 }
 
 // NewTxValidator creates a new nil tx handler validator instance
 func NewTxValidator(
 	accounts state.AccountsAdapter,
 	shardCoordinator sharding.Coordinator,
+	txPool dataRetriever.ShardedDataCacherNotifier,
 	maxNonceDeltaAllowed int,
+	chainID []byte,
+	minTxVersion uint32,
+	signatureSetHandler process.SignatureSetHandler,
 ) (*txValidator, error) {
 
 	if accounts == nil || accounts.IsInterfaceNil() {
@@ -34,17 +46,40 @@ func NewTxValidator(
 	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
 		return nil, process.ErrNilShardCoordinator
 	}
+	if check.IfNil(txPool) {
+		return nil, process.ErrNilTransactionPool
+	}
+	if len(chainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
+	if check.IfNil(signatureSetHandler) {
+		return nil, process.ErrNilSignatureSetHandler
+	}
 
 	return &txValidator{
 		accounts:             accounts,
 		shardCoordinator:     shardCoordinator,
+		txPool:               txPool,
 		rejectedTxs:          uint64(0),
 		maxNonceDeltaAllowed: maxNonceDeltaAllowed,
+		chainID:              chainID,
+		minTxVersion:         minTxVersion,
+		signatureSetHandler:  signatureSetHandler,
 	}, nil
 }
 
 // CheckTxValidity will filter transactions that needs to be added in pools
 func (txv *txValidator) CheckTxValidity(interceptedTx process.TxValidatorHandler) error {
+	err := txv.checkChainIDAndVersion(interceptedTx)
+	if err != nil {
+		return err
+	}
+
+	err = txv.checkReplacement(interceptedTx)
+	if err != nil {
+		return err
+	}
+
 	shardId := txv.shardCoordinator.SelfId()
 	txShardId := interceptedTx.SenderShardId()
 	senderIsInAnotherShard := shardId != txShardId
@@ -77,6 +112,11 @@ func (txv *txValidator) CheckTxValidity(interceptedTx process.TxValidatorHandler
 		return errors.New(fmt.Sprintf("cannot convert account handler in a state.Account %s", hexSenderAddr))
 	}
 
+	err = txv.checkSignatureSet(interceptedTx, account)
+	if err != nil {
+		return err
+	}
+
 	accountBalance := account.Balance
 	txTotalValue := interceptedTx.TotalValue()
 	if accountBalance.Cmp(txTotalValue) < 0 {
@@ -87,6 +127,60 @@ func (txv *txValidator) CheckTxValidity(interceptedTx process.TxValidatorHandler
 	return nil
 }
 
+// checkChainIDAndVersion rejects a transaction signed for another network or with a version lower than the minimum
+// one accepted by this node
+func (txv *txValidator) checkChainIDAndVersion(interceptedTx process.TxValidatorHandler) error {
+	tx, ok := interceptedTx.Transaction().(*transaction.Transaction)
+	if !ok {
+		return nil
+	}
+
+	if !bytes.Equal(tx.ChainID, txv.chainID) {
+		txv.rejectedTxs++
+		return process.ErrInvalidChainID
+	}
+	if tx.Version < txv.minTxVersion {
+		txv.rejectedTxs++
+		return process.ErrInvalidTransactionVersion
+	}
+
+	return nil
+}
+
+// checkSignatureSet rejects a transaction which is not signed as its sender account requires. The single signatures
+// are already verified by the intercepted transaction, the signature sets need the sender account
+func (txv *txValidator) checkSignatureSet(interceptedTx process.TxValidatorHandler, account *state.Account) error {
+	tx, ok := interceptedTx.Transaction().(*transaction.Transaction)
+	if !ok {
+		return nil
+	}
+
+	err := txv.signatureSetHandler.VerifySignatureSet(tx, account)
+	if err != nil {
+		txv.rejectedTxs++
+		return err
+	}
+
+	return nil
+}
+
+// checkReplacement rejects a transaction that would not be accepted by the pool because a pending transaction with the
+// same sender and nonce pays almost the same gas price
+func (txv *txValidator) checkReplacement(interceptedTx process.TxValidatorHandler) error {
+	cacherIdentifier := process.ShardCacherIdentifier(interceptedTx.SenderShardId(), interceptedTx.ReceiverShardId())
+	replacementChecker, ok := txv.txPool.ShardDataStore(cacherIdentifier).(process.TxReplacementChecker)
+	if !ok {
+		return nil
+	}
+
+	if replacementChecker.CanAddTransaction(interceptedTx.Hash(), interceptedTx.Transaction()) {
+		return nil
+	}
+
+	txv.rejectedTxs++
+	return process.ErrInsufficientGasPriceForReplacement
+}
+
 // NumRejectedTxs will return number of rejected transaction
 func (txv *txValidator) NumRejectedTxs() uint64 {
 	return txv.rejectedTxs
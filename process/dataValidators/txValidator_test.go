@@ -8,13 +8,21 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/stretchr/testify/assert"
 )
 
+var testChainID = []byte("chainID")
+
+const testMinTxVersion = uint32(1)
+
 func getAccAdapter(nonce uint64, balance *big.Int) *mock.AccountsStub {
 	accDB := &mock.AccountsStub{}
 	accDB.GetExistingAccountCalled = func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
@@ -35,6 +43,14 @@ func createMockCoordinator(identifierPrefix string, currentShardID uint32) *mock
 	}
 }
 
+func createTxPool() *mock.ShardedDataStub {
+	return &mock.ShardedDataStub{
+		ShardDataStoreCalled: func(cacheId string) (c storage.Cacher) {
+			return nil
+		},
+	}
+}
+
 func getTxValidatorHandler(
 	sndShardId uint32,
 	nonce uint64,
@@ -54,6 +70,20 @@ func getTxValidatorHandler(
 		TotalValueCalled: func() *big.Int {
 			return totalValue
 		},
+		ReceiverShardIdCalled: func() uint32 {
+			return sndShardId
+		},
+		HashCalled: func() []byte {
+			return []byte("hash")
+		},
+		TransactionCalled: func() data.TransactionHandler {
+			return &transaction.Transaction{
+				Nonce:   nonce,
+				SndAddr: sndAddr.Bytes(),
+				ChainID: testChainID,
+				Version: testMinTxVersion,
+			}
+		},
 	}
 }
 
@@ -62,7 +92,7 @@ func TestTxValidator_NewValidatorNilAccountsShouldErr(t *testing.T) {
 
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, err := dataValidators.NewTxValidator(nil, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(nil, shardCoordinator, createTxPool(), maxNonceDeltaAllowed, testChainID, testMinTxVersion, &mock.SignatureSetHandlerStub{})
 
 	assert.Nil(t, txValidator)
 	assert.Equal(t, process.ErrNilAccountsAdapter, err)
@@ -73,19 +103,55 @@ func TestTxValidator_NewValidatorNilShardCoordinatorShouldErr(t *testing.T) {
 
 	accounts := getAccAdapter(0, big.NewInt(0))
 	maxNonceDeltaAllowed := 100
-	txValidator, err := dataValidators.NewTxValidator(accounts, nil, maxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(accounts, nil, createTxPool(), maxNonceDeltaAllowed, testChainID, testMinTxVersion, &mock.SignatureSetHandlerStub{})
 
 	assert.Nil(t, txValidator)
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
 }
 
+func TestTxValidator_NewValidatorNilTxPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := getAccAdapter(0, big.NewInt(0))
+	shardCoordinator := createMockCoordinator("_", 0)
+	maxNonceDeltaAllowed := 100
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, nil, maxNonceDeltaAllowed, testChainID, testMinTxVersion, &mock.SignatureSetHandlerStub{})
+
+	assert.Nil(t, txValidator)
+	assert.Equal(t, process.ErrNilTransactionPool, err)
+}
+
+func TestTxValidator_NewValidatorEmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := getAccAdapter(0, big.NewInt(0))
+	shardCoordinator := createMockCoordinator("_", 0)
+	maxNonceDeltaAllowed := 100
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, createTxPool(), maxNonceDeltaAllowed, nil, testMinTxVersion, &mock.SignatureSetHandlerStub{})
+
+	assert.Nil(t, txValidator)
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
+func TestTxValidator_NewValidatorNilSignatureSetHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := getAccAdapter(0, big.NewInt(0))
+	shardCoordinator := createMockCoordinator("_", 0)
+	maxNonceDeltaAllowed := 100
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, createTxPool(), maxNonceDeltaAllowed, testChainID, testMinTxVersion, nil)
+
+	assert.Nil(t, txValidator)
+	assert.Equal(t, process.ErrNilSignatureSetHandler, err)
+}
+
 func TestTxValidator_NewValidatorShouldWork(t *testing.T) {
 	t.Parallel()
 
 	accounts := getAccAdapter(0, big.NewInt(0))
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, createTxPool(), maxNonceDeltaAllowed, testChainID, testMinTxVersion, &mock.SignatureSetHandlerStub{})
 
 	assert.Nil(t, err)
 	assert.NotNil(t, txValidator)
@@ -100,7 +166,7 @@ func TestTxValidator_CheckTxValidityTxCrossShardShouldWork(t *testing.T) {
 	accounts := getAccAdapter(1, big.NewInt(0))
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, createTxPool(), maxNonceDeltaAllowed, testChainID, testMinTxVersion, &mock.SignatureSetHandlerStub{})
 	assert.Nil(t, err)
 
 	addressMock := mock.NewAddressMock([]byte("address"))
@@ -119,7 +185,7 @@ func TestTxValidator_CheckTxValidityAccountNonceIsGreaterThanTxNonceShouldReturn
 	accounts := getAccAdapter(accountNonce, big.NewInt(0))
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, createTxPool(), maxNonceDeltaAllowed, testChainID, testMinTxVersion, &mock.SignatureSetHandlerStub{})
 	assert.Nil(t, err)
 
 	addressMock := mock.NewAddressMock([]byte("address"))
@@ -139,7 +205,7 @@ func TestTxValidator_CheckTxValidityTxNonceIsTooHigh(t *testing.T) {
 
 	accounts := getAccAdapter(accountNonce, big.NewInt(0))
 	shardCoordinator := createMockCoordinator("_", 0)
-	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, createTxPool(), maxNonceDeltaAllowed, testChainID, testMinTxVersion, &mock.SignatureSetHandlerStub{})
 	assert.Nil(t, err)
 
 	addressMock := mock.NewAddressMock([]byte("address"))
@@ -161,7 +227,7 @@ func TestTxValidator_CheckTxValidityAccountBalanceIsLessThanTxTotalValueShouldRe
 	accounts := getAccAdapter(accountNonce, accountBalance)
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, createTxPool(), maxNonceDeltaAllowed, testChainID, testMinTxVersion, &mock.SignatureSetHandlerStub{})
 	assert.Nil(t, err)
 
 	addressMock := mock.NewAddressMock([]byte("address"))
@@ -183,7 +249,7 @@ func TestTxValidator_CheckTxValidityNumOfRejectedTxShouldIncreaseShouldReturnFal
 	accounts := getAccAdapter(accountNonce, accountBalance)
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, createTxPool(), maxNonceDeltaAllowed, testChainID, testMinTxVersion, &mock.SignatureSetHandlerStub{})
 	assert.Nil(t, err)
 
 	addressMock := mock.NewAddressMock([]byte("address"))
@@ -205,7 +271,7 @@ func TestTxValidator_CheckTxValidityAccountNotExitsShouldReturnFalse(t *testing.
 	}
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, _ := dataValidators.NewTxValidator(accDB, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, _ := dataValidators.NewTxValidator(accDB, shardCoordinator, createTxPool(), maxNonceDeltaAllowed, testChainID, testMinTxVersion, &mock.SignatureSetHandlerStub{})
 
 	addressMock := mock.NewAddressMock([]byte("address"))
 	txValidatorHandler := getTxValidatorHandler(0, 1, addressMock, big.NewInt(0))
@@ -223,7 +289,7 @@ func TestTxValidator_CheckTxValidityWrongAccountTypeShouldReturnFalse(t *testing
 	}
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, _ := dataValidators.NewTxValidator(accDB, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, _ := dataValidators.NewTxValidator(accDB, shardCoordinator, createTxPool(), maxNonceDeltaAllowed, testChainID, testMinTxVersion, &mock.SignatureSetHandlerStub{})
 
 	addressMock := mock.NewAddressMock([]byte("address"))
 	txValidatorHandler := getTxValidatorHandler(0, 1, addressMock, big.NewInt(0))
@@ -240,7 +306,7 @@ func TestTxValidator_CheckTxValidityTxIsOkShouldReturnTrue(t *testing.T) {
 	accounts := getAccAdapter(accountNonce, accountBalance)
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, _ := dataValidators.NewTxValidator(accounts, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, _ := dataValidators.NewTxValidator(accounts, shardCoordinator, createTxPool(), maxNonceDeltaAllowed, testChainID, testMinTxVersion, &mock.SignatureSetHandlerStub{})
 
 	addressMock := mock.NewAddressMock([]byte("address"))
 	txValidatorHandler := getTxValidatorHandler(0, 1, addressMock, big.NewInt(0))
@@ -249,6 +315,61 @@ func TestTxValidator_CheckTxValidityTxIsOkShouldReturnTrue(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestTxValidator_CheckTxValidityInvalidSignatureSetShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accountNonce := uint64(0)
+	accountBalance := big.NewInt(10)
+	accounts := getAccAdapter(accountNonce, accountBalance)
+	shardCoordinator := createMockCoordinator("_", 0)
+	maxNonceDeltaAllowed := 100
+	sigSetHandler := &mock.SignatureSetHandlerStub{
+		VerifySignatureSetCalled: func(tx *transaction.Transaction, account *state.Account) error {
+			return process.ErrNotEnoughSignatures
+		},
+	}
+	txValidator, _ := dataValidators.NewTxValidator(accounts, shardCoordinator, createTxPool(), maxNonceDeltaAllowed, testChainID, testMinTxVersion, sigSetHandler)
+
+	addressMock := mock.NewAddressMock([]byte("address"))
+	txValidatorHandler := getTxValidatorHandler(0, 1, addressMock, big.NewInt(0))
+
+	result := txValidator.CheckTxValidity(txValidatorHandler)
+	assert.Equal(t, process.ErrNotEnoughSignatures, result)
+	assert.Equal(t, uint64(1), txValidator.NumRejectedTxs())
+}
+
+func TestTxValidator_CheckTxValidityWrongChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := getAccAdapter(0, big.NewInt(10))
+	shardCoordinator := createMockCoordinator("_", 0)
+	maxNonceDeltaAllowed := 100
+	txValidator, _ := dataValidators.NewTxValidator(accounts, shardCoordinator, createTxPool(), maxNonceDeltaAllowed, []byte("other chainID"), testMinTxVersion, &mock.SignatureSetHandlerStub{})
+
+	addressMock := mock.NewAddressMock([]byte("address"))
+	txValidatorHandler := getTxValidatorHandler(0, 1, addressMock, big.NewInt(0))
+
+	result := txValidator.CheckTxValidity(txValidatorHandler)
+	assert.Equal(t, process.ErrInvalidChainID, result)
+	assert.Equal(t, uint64(1), txValidator.NumRejectedTxs())
+}
+
+func TestTxValidator_CheckTxValidityLowerVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := getAccAdapter(0, big.NewInt(10))
+	shardCoordinator := createMockCoordinator("_", 0)
+	maxNonceDeltaAllowed := 100
+	txValidator, _ := dataValidators.NewTxValidator(accounts, shardCoordinator, createTxPool(), maxNonceDeltaAllowed, testChainID, testMinTxVersion+1, &mock.SignatureSetHandlerStub{})
+
+	addressMock := mock.NewAddressMock([]byte("address"))
+	txValidatorHandler := getTxValidatorHandler(0, 1, addressMock, big.NewInt(0))
+
+	result := txValidator.CheckTxValidity(txValidatorHandler)
+	assert.Equal(t, process.ErrInvalidTransactionVersion, result)
+	assert.Equal(t, uint64(1), txValidator.NumRejectedTxs())
+}
+
 //------- IsInterfaceNil
 
 func TestTxValidator_IsInterfaceNil(t *testing.T) {
@@ -256,8 +377,58 @@ func TestTxValidator_IsInterfaceNil(t *testing.T) {
 
 	accounts := getAccAdapter(0, big.NewInt(0))
 	shardCoordinator := createMockCoordinator("_", 0)
-	txValidator, _ := dataValidators.NewTxValidator(accounts, shardCoordinator, 100)
+	txValidator, _ := dataValidators.NewTxValidator(accounts, shardCoordinator, createTxPool(), 100, testChainID, testMinTxVersion, &mock.SignatureSetHandlerStub{})
 	txValidator = nil
 
 	assert.True(t, check.IfNil(txValidator))
 }
+
+func createTxPoolWithPendingTx(sndAddr []byte, nonce uint64, gasPrice uint64) *mock.ShardedDataStub {
+	txCache, _ := txcache.NewTxCache(100, 100, 10)
+	txCache.Put([]byte("pending"), &transaction.Transaction{Nonce: nonce, SndAddr: sndAddr, GasPrice: gasPrice})
+
+	return &mock.ShardedDataStub{
+		ShardDataStoreCalled: func(cacheId string) (c storage.Cacher) {
+			return txCache
+		},
+	}
+}
+
+func TestTxValidator_CheckTxValidityNotEnoughGasPriceForReplacementShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := getAccAdapter(1, big.NewInt(10))
+	shardCoordinator := createMockCoordinator("_", 0)
+	txPool := createTxPoolWithPendingTx([]byte("address"), 1, 100)
+	txValidator, _ := dataValidators.NewTxValidator(accounts, shardCoordinator, txPool, 100, testChainID, testMinTxVersion, &mock.SignatureSetHandlerStub{})
+
+	addressMock := mock.NewAddressMock([]byte("address"))
+	txValidatorHandler := getTxValidatorHandler(0, 1, addressMock, big.NewInt(0))
+	stub := txValidatorHandler.(*mock.TxValidatorHandlerStub)
+	stub.TransactionCalled = func() data.TransactionHandler {
+		return &transaction.Transaction{Nonce: 1, SndAddr: []byte("address"), GasPrice: 105, ChainID: testChainID, Version: testMinTxVersion}
+	}
+
+	result := txValidator.CheckTxValidity(txValidatorHandler)
+	assert.Equal(t, process.ErrInsufficientGasPriceForReplacement, result)
+	assert.Equal(t, uint64(1), txValidator.NumRejectedTxs())
+}
+
+func TestTxValidator_CheckTxValidityEnoughGasPriceForReplacementShouldWork(t *testing.T) {
+	t.Parallel()
+
+	accounts := getAccAdapter(1, big.NewInt(10))
+	shardCoordinator := createMockCoordinator("_", 0)
+	txPool := createTxPoolWithPendingTx([]byte("address"), 1, 100)
+	txValidator, _ := dataValidators.NewTxValidator(accounts, shardCoordinator, txPool, 100, testChainID, testMinTxVersion, &mock.SignatureSetHandlerStub{})
+
+	addressMock := mock.NewAddressMock([]byte("address"))
+	txValidatorHandler := getTxValidatorHandler(0, 1, addressMock, big.NewInt(0))
+	stub := txValidatorHandler.(*mock.TxValidatorHandlerStub)
+	stub.TransactionCalled = func() data.TransactionHandler {
+		return &transaction.Transaction{Nonce: 1, SndAddr: []byte("address"), GasPrice: 110, ChainID: testChainID, Version: testMinTxVersion}
+	}
+
+	result := txValidator.CheckTxValidity(txValidatorHandler)
+	assert.Nil(t, result)
+}
@@ -4,6 +4,7 @@ import (
 	"math"
 	"math/big"
 	"strconv"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -15,6 +16,7 @@ type EconomicsData struct {
 	communityPercentage float64
 	leaderPercentage    float64
 	burnPercentage      float64
+	developerPercentage float64
 	maxGasLimitPerBlock uint64
 	minGasPrice         uint64
 	minGasLimit         uint64
@@ -22,6 +24,10 @@ type EconomicsData struct {
 	burnAddress         string
 	stakeValue          *big.Int
 	unBoundPeriod       uint64
+	baseIssuingCost     *big.Int
+	votingPeriod        uint64
+	minQuorum           *big.Int
+	mutParameters       sync.RWMutex
 }
 
 const float64EqualityThreshold = 1e-9
@@ -53,6 +59,7 @@ func NewEconomicsData(economics *config.ConfigEconomics) (*EconomicsData, error)
 		communityPercentage: economics.RewardsSettings.CommunityPercentage,
 		leaderPercentage:    economics.RewardsSettings.LeaderPercentage,
 		burnPercentage:      economics.RewardsSettings.BurnPercentage,
+		developerPercentage: economics.RewardsSettings.DeveloperPercentage,
 		maxGasLimitPerBlock: data.maxGasLimitPerBlock,
 		minGasPrice:         data.minGasPrice,
 		minGasLimit:         data.minGasLimit,
@@ -60,6 +67,9 @@ func NewEconomicsData(economics *config.ConfigEconomics) (*EconomicsData, error)
 		burnAddress:         economics.EconomicsAddresses.BurnAddress,
 		stakeValue:          data.stakeValue,
 		unBoundPeriod:       data.unBoundPeriod,
+		baseIssuingCost:     data.baseIssuingCost,
+		votingPeriod:        data.votingPeriod,
+		minQuorum:           data.minQuorum,
 	}, nil
 }
 
@@ -94,18 +104,38 @@ func convertValues(economics *config.ConfigEconomics) (*EconomicsData, error) {
 		return nil, process.ErrInvalidUnboundPeriod
 	}
 
+	baseIssuingCost := new(big.Int)
+	baseIssuingCost, ok = baseIssuingCost.SetString(economics.ESDTSettings.BaseIssuingCost, conversionBase)
+	if !ok || baseIssuingCost.Sign() <= 0 {
+		return nil, process.ErrInvalidBaseIssuingCost
+	}
+
 	maxGasLimitPerBlock, err := strconv.ParseUint(economics.FeeSettings.MaxGasLimitPerBlock, conversionBase, bitConversionSize)
 	if err != nil {
 		return nil, process.ErrInvalidMaxGasLimitPerBlock
 	}
 
+	votingPeriod, err := strconv.ParseUint(economics.GovernanceSettings.VotingPeriod, conversionBase, bitConversionSize)
+	if err != nil {
+		return nil, process.ErrInvalidVotingPeriod
+	}
+
+	minQuorum := new(big.Int)
+	minQuorum, ok = minQuorum.SetString(economics.GovernanceSettings.MinQuorum, conversionBase)
+	if !ok || minQuorum.Sign() <= 0 {
+		return nil, process.ErrInvalidMinQuorum
+	}
+
 	return &EconomicsData{
 		rewardsValue:        rewardsValue,
 		minGasPrice:         minGasPrice,
 		minGasLimit:         minGasLimit,
 		stakeValue:          stakeValue,
 		unBoundPeriod:       unBoundPeriod,
+		baseIssuingCost:     baseIssuingCost,
 		maxGasLimitPerBlock: maxGasLimitPerBlock,
+		votingPeriod:        votingPeriod,
+		minQuorum:           minQuorum,
 	}, nil
 }
 
@@ -124,6 +154,10 @@ func checkValues(economics *config.ConfigEconomics) error {
 		return process.ErrInvalidRewardsPercentages
 	}
 
+	if isPercentageInvalid(economics.RewardsSettings.DeveloperPercentage) {
+		return process.ErrInvalidDeveloperPercentage
+	}
+
 	return nil
 }
 
@@ -138,6 +172,9 @@ func isPercentageInvalid(percentage float64) bool {
 
 // RewardsValue will return rewards value
 func (ed *EconomicsData) RewardsValue() *big.Int {
+	ed.mutParameters.RLock()
+	defer ed.mutParameters.RUnlock()
+
 	return ed.rewardsValue
 }
 
@@ -156,6 +193,11 @@ func (ed *EconomicsData) BurnPercentage() float64 {
 	return ed.burnPercentage
 }
 
+// DeveloperPercentage will return the share of a smart contract call fee which goes to the contract owner
+func (ed *EconomicsData) DeveloperPercentage() float64 {
+	return ed.developerPercentage
+}
+
 // ComputeFee computes the provided transaction's fee
 func (ed *EconomicsData) ComputeFee(tx process.TransactionWithFeeHandler) *big.Int {
 	gasPrice := big.NewInt(0).SetUint64(tx.GetGasPrice())
@@ -166,7 +208,12 @@ func (ed *EconomicsData) ComputeFee(tx process.TransactionWithFeeHandler) *big.I
 
 // CheckValidityTxValues checks if the provided transaction is economically correct
 func (ed *EconomicsData) CheckValidityTxValues(tx process.TransactionWithFeeHandler) error {
-	if ed.minGasPrice > tx.GetGasPrice() {
+	ed.mutParameters.RLock()
+	minGasPrice := ed.minGasPrice
+	maxGasLimitPerBlock := ed.maxGasLimitPerBlock
+	ed.mutParameters.RUnlock()
+
+	if minGasPrice > tx.GetGasPrice() {
 		return process.ErrInsufficientGasPriceInTx
 	}
 
@@ -175,7 +222,7 @@ func (ed *EconomicsData) CheckValidityTxValues(tx process.TransactionWithFeeHand
 		return process.ErrInsufficientGasLimitInTx
 	}
 
-	if requiredGasLimit > ed.maxGasLimitPerBlock {
+	if requiredGasLimit > maxGasLimitPerBlock {
 		return process.ErrHigherGasLimitRequiredInTx
 	}
 
@@ -184,12 +231,17 @@ func (ed *EconomicsData) CheckValidityTxValues(tx process.TransactionWithFeeHand
 
 // MaxGasLimitPerBlock will return maximum gas limit allowed per block
 func (ed *EconomicsData) MaxGasLimitPerBlock() uint64 {
+	ed.mutParameters.RLock()
+	defer ed.mutParameters.RUnlock()
+
 	return ed.maxGasLimitPerBlock
 }
 
 // ComputeGasLimit returns the gas limit need by the provided transaction in order to be executed
 func (ed *EconomicsData) ComputeGasLimit(tx process.TransactionWithFeeHandler) uint64 {
+	ed.mutParameters.RLock()
 	gasLimit := ed.minGasLimit
+	ed.mutParameters.RUnlock()
 
 	//TODO: change this method of computing the gas limit of a notarizing tx
 	// it should follow an exponential curve as to disincentivise notarizing large data
@@ -219,6 +271,80 @@ func (ed *EconomicsData) UnBoundPeriod() uint64 {
 	return ed.unBoundPeriod
 }
 
+// BaseIssuingCost will return the value which has to be paid to issue a fungible token
+func (ed *EconomicsData) BaseIssuingCost() *big.Int {
+	return ed.baseIssuingCost
+}
+
+// VotingPeriod will return the number of nonces a governance proposal can be voted for
+func (ed *EconomicsData) VotingPeriod() uint64 {
+	return ed.votingPeriod
+}
+
+// MinQuorum will return the minimum stake which has to vote for a governance proposal in order for it to pass
+func (ed *EconomicsData) MinQuorum() *big.Int {
+	return ed.minQuorum
+}
+
+// ParameterValue returns the current value of one of the economics parameters which can be changed by governance
+func (ed *EconomicsData) ParameterValue(name string) (string, error) {
+	ed.mutParameters.RLock()
+	defer ed.mutParameters.RUnlock()
+
+	switch name {
+	case "RewardsValue":
+		return ed.rewardsValue.String(), nil
+	case "MinGasPrice":
+		return strconv.FormatUint(ed.minGasPrice, 10), nil
+	case "MinGasLimit":
+		return strconv.FormatUint(ed.minGasLimit, 10), nil
+	case "MaxGasLimitPerBlock":
+		return strconv.FormatUint(ed.maxGasLimitPerBlock, 10), nil
+	}
+
+	return "", process.ErrUnknownParameter
+}
+
+// UpdateParameter changes the value of one of the economics parameters which can be changed by governance
+func (ed *EconomicsData) UpdateParameter(name string, value string) error {
+	conversionBase := 10
+	bitConversionSize := 64
+
+	ed.mutParameters.Lock()
+	defer ed.mutParameters.Unlock()
+
+	switch name {
+	case "RewardsValue":
+		rewardsValue, ok := big.NewInt(0).SetString(value, conversionBase)
+		if !ok || rewardsValue.Sign() < 0 {
+			return process.ErrInvalidParameterValue
+		}
+		ed.rewardsValue = rewardsValue
+	case "MinGasPrice":
+		minGasPrice, err := strconv.ParseUint(value, conversionBase, bitConversionSize)
+		if err != nil || minGasPrice == 0 {
+			return process.ErrInvalidParameterValue
+		}
+		ed.minGasPrice = minGasPrice
+	case "MinGasLimit":
+		minGasLimit, err := strconv.ParseUint(value, conversionBase, bitConversionSize)
+		if err != nil || minGasLimit == 0 || minGasLimit > ed.maxGasLimitPerBlock {
+			return process.ErrInvalidParameterValue
+		}
+		ed.minGasLimit = minGasLimit
+	case "MaxGasLimitPerBlock":
+		maxGasLimitPerBlock, err := strconv.ParseUint(value, conversionBase, bitConversionSize)
+		if err != nil || maxGasLimitPerBlock == 0 || maxGasLimitPerBlock < ed.minGasLimit {
+			return process.ErrInvalidParameterValue
+		}
+		ed.maxGasLimitPerBlock = maxGasLimitPerBlock
+	default:
+		return process.ErrUnknownParameter
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ed *EconomicsData) IsInterfaceNil() bool {
 	if ed == nil {
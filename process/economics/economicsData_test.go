@@ -34,6 +34,13 @@ func createDummyEconomicsConfig() *config.ConfigEconomics {
 			StakeValue:    "500000000",
 			UnBoundPeriod: "100000",
 		},
+		ESDTSettings: config.ESDTSettings{
+			BaseIssuingCost: "1000000",
+		},
+		GovernanceSettings: config.GovernanceSettings{
+			VotingPeriod: "1000",
+			MinQuorum:    "1000000",
+		},
 	}
 }
 
@@ -131,6 +138,60 @@ func TestNewEconomicsData_InvalidMinGasLimitShouldErr(t *testing.T) {
 
 }
 
+func TestNewEconomicsData_InvalidBaseIssuingCostShouldErr(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	badBaseIssuingCosts := []string{
+		"-1",
+		"0",
+		"badValue",
+		"",
+		"10ERD",
+	}
+
+	for _, baseIssuingCost := range badBaseIssuingCosts {
+		economicsConfig.ESDTSettings.BaseIssuingCost = baseIssuingCost
+		_, err := economics.NewEconomicsData(economicsConfig)
+		assert.Equal(t, process.ErrInvalidBaseIssuingCost, err)
+	}
+}
+
+func TestNewEconomicsData_InvalidVotingPeriodShouldErr(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	badVotingPeriods := []string{
+		"-1",
+		"badValue",
+		"",
+	}
+
+	for _, votingPeriod := range badVotingPeriods {
+		economicsConfig.GovernanceSettings.VotingPeriod = votingPeriod
+		_, err := economics.NewEconomicsData(economicsConfig)
+		assert.Equal(t, process.ErrInvalidVotingPeriod, err)
+	}
+}
+
+func TestNewEconomicsData_InvalidMinQuorumShouldErr(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	badMinQuorums := []string{
+		"-1",
+		"0",
+		"badValue",
+		"",
+	}
+
+	for _, minQuorum := range badMinQuorums {
+		economicsConfig.GovernanceSettings.MinQuorum = minQuorum
+		_, err := economics.NewEconomicsData(economicsConfig)
+		assert.Equal(t, process.ErrInvalidMinQuorum, err)
+	}
+}
+
 func TestNewEconomicsData_InvalidBurnPercentageShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -182,6 +243,16 @@ func TestNewEconomicsData_InvalidRewardsPercentageSumShouldErr(t *testing.T) {
 
 }
 
+func TestNewEconomicsData_InvalidDeveloperPercentageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.RewardsSettings.DeveloperPercentage = 1.1
+
+	_, err := economics.NewEconomicsData(economicsConfig)
+	assert.Equal(t, process.ErrInvalidDeveloperPercentage, err)
+}
+
 func TestNewEconomicsData_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -244,6 +315,18 @@ func TestEconomicsData_BurnPercentage(t *testing.T) {
 	assert.Equal(t, burnPercentage, value)
 }
 
+func TestEconomicsData_DeveloperPercentage(t *testing.T) {
+	t.Parallel()
+
+	developerPercentage := 0.3
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.RewardsSettings.DeveloperPercentage = developerPercentage
+	economicsData, _ := economics.NewEconomicsData(economicsConfig)
+
+	value := economicsData.DeveloperPercentage()
+	assert.Equal(t, developerPercentage, value)
+}
+
 func TestEconomicsData_ComputeFeeNoTxData(t *testing.T) {
 	t.Parallel()
 
@@ -413,3 +496,115 @@ func TestEconomicsData_BurnAddress(t *testing.T) {
 	value := economicsData.BurnAddress()
 	assert.Equal(t, burnAddress, value)
 }
+
+func TestEconomicsData_BaseIssuingCost(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.ESDTSettings.BaseIssuingCost = "12345"
+	economicsData, _ := economics.NewEconomicsData(economicsConfig)
+
+	value := economicsData.BaseIssuingCost()
+	assert.Equal(t, big.NewInt(12345), value)
+}
+
+func TestEconomicsData_GovernanceSettings(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.GovernanceSettings.VotingPeriod = "100"
+	economicsConfig.GovernanceSettings.MinQuorum = "12345"
+	economicsData, _ := economics.NewEconomicsData(economicsConfig)
+
+	assert.Equal(t, uint64(100), economicsData.VotingPeriod())
+	assert.Equal(t, big.NewInt(12345), economicsData.MinQuorum())
+}
+
+func TestEconomicsData_ParameterValueUnknownShouldErr(t *testing.T) {
+	t.Parallel()
+
+	economicsData, _ := economics.NewEconomicsData(createDummyEconomicsConfig())
+
+	value, err := economicsData.ParameterValue("BurnAddress")
+	assert.Equal(t, "", value)
+	assert.Equal(t, process.ErrUnknownParameter, err)
+}
+
+func TestEconomicsData_ParameterValueShouldReturnTheUpdatedValue(t *testing.T) {
+	t.Parallel()
+
+	economicsData, _ := economics.NewEconomicsData(createDummyEconomicsConfig())
+
+	_ = economicsData.UpdateParameter("MinGasPrice", "7")
+	value, err := economicsData.ParameterValue("MinGasPrice")
+	assert.Nil(t, err)
+	assert.Equal(t, "7", value)
+
+	_ = economicsData.UpdateParameter("RewardsValue", "10")
+	value, err = economicsData.ParameterValue("RewardsValue")
+	assert.Nil(t, err)
+	assert.Equal(t, "10", value)
+}
+
+func TestEconomicsData_UpdateParameterUnknownShouldErr(t *testing.T) {
+	t.Parallel()
+
+	economicsData, _ := economics.NewEconomicsData(createDummyEconomicsConfig())
+
+	err := economicsData.UpdateParameter("BurnAddress", "addr")
+	assert.Equal(t, process.ErrUnknownParameter, err)
+}
+
+func TestEconomicsData_UpdateParameterInvalidValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	economicsData, _ := economics.NewEconomicsData(createDummyEconomicsConfig())
+
+	err := economicsData.UpdateParameter("MinGasPrice", "badValue")
+	assert.Equal(t, process.ErrInvalidParameterValue, err)
+
+	err = economicsData.UpdateParameter("RewardsValue", "-1")
+	assert.Equal(t, process.ErrInvalidParameterValue, err)
+
+	err = economicsData.UpdateParameter("MinGasLimit", "100001")
+	assert.Equal(t, process.ErrInvalidParameterValue, err)
+
+	err = economicsData.UpdateParameter("MaxGasLimitPerBlock", "499")
+	assert.Equal(t, process.ErrInvalidParameterValue, err)
+
+	err = economicsData.UpdateParameter("MinGasPrice", "0")
+	assert.Equal(t, process.ErrInvalidParameterValue, err)
+
+	err = economicsData.UpdateParameter("MinGasLimit", "0")
+	assert.Equal(t, process.ErrInvalidParameterValue, err)
+
+	err = economicsData.UpdateParameter("MaxGasLimitPerBlock", "0")
+	assert.Equal(t, process.ErrInvalidParameterValue, err)
+}
+
+func TestEconomicsData_UpdateParameterShouldWork(t *testing.T) {
+	t.Parallel()
+
+	economicsData, _ := economics.NewEconomicsData(createDummyEconomicsConfig())
+
+	_ = economicsData.UpdateParameter("RewardsValue", "10")
+	_ = economicsData.UpdateParameter("MaxGasLimitPerBlock", "200000")
+	_ = economicsData.UpdateParameter("MinGasLimit", "1000")
+	err := economicsData.UpdateParameter("MinGasPrice", "7")
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), economicsData.RewardsValue())
+	assert.Equal(t, uint64(200000), economicsData.MaxGasLimitPerBlock())
+
+	tx := &transaction.Transaction{GasPrice: 6, GasLimit: 1000}
+	err = economicsData.CheckValidityTxValues(tx)
+	assert.Equal(t, process.ErrInsufficientGasPriceInTx, err)
+
+	tx.GasPrice = 7
+	err = economicsData.CheckValidityTxValues(tx)
+	assert.Nil(t, err)
+
+	tx.GasLimit = 999
+	err = economicsData.CheckValidityTxValues(tx)
+	assert.Equal(t, process.ErrInsufficientGasLimitInTx, err)
+}
@@ -503,6 +503,16 @@ var ErrMaxGasLimitPerMiniBlockInReceiverShardIsReached = errors.New("max gas lim
 // ErrMaxGasLimitPerBlockInSelfShardIsReached signals that max gas limit per block in self shard has been reached
 var ErrMaxGasLimitPerBlockInSelfShardIsReached = errors.New("max gas limit per block in self shard is reached")
 
+// ErrInsufficientGasPriceForReplacement signals that a transaction having the sender and the nonce of a pending one
+// does not increase the gas price enough to replace it
+var ErrInsufficientGasPriceForReplacement = errors.New("insufficient gas price for replacing the pending transaction")
+
+// ErrNilTxSelectionStrategy signals that a nil transactions selection strategy has been provided
+var ErrNilTxSelectionStrategy = errors.New("nil transactions selection strategy")
+
+// ErrInvalidReservedGasPercent signals that the percent of gas reserved for other miniblocks is not lower than 100
+var ErrInvalidReservedGasPercent = errors.New("invalid reserved gas percent")
+
 // ErrInvalidMinimumGasPrice signals that an invalid gas price has been read from config file
 var ErrInvalidMinimumGasPrice = errors.New("invalid minimum gas price")
 
@@ -515,9 +525,40 @@ var ErrInvalidRewardsValue = errors.New("invalid rewards value")
 // ErrInvalidUnboundPeriod signals that an invalid unbound period has been read from config file
 var ErrInvalidUnboundPeriod = errors.New("invalid unbound period")
 
+// ErrInvalidBaseIssuingCost signals that an invalid base issuing cost has been read from config file
+var ErrInvalidBaseIssuingCost = errors.New("invalid base issuing cost")
+
+// ErrInvalidVotingPeriod signals that an invalid governance voting period has been read from config file
+var ErrInvalidVotingPeriod = errors.New("invalid voting period")
+
+// ErrInvalidMinQuorum signals that an invalid governance minimum quorum has been read from config file
+var ErrInvalidMinQuorum = errors.New("invalid min quorum")
+
+// ErrUnknownParameter signals that a parameter which can not be changed by governance has been provided
+var ErrUnknownParameter = errors.New("unknown parameter")
+
+// ErrInvalidParameterValue signals that an invalid value has been provided for a parameter
+var ErrInvalidParameterValue = errors.New("invalid parameter value")
+
+// ErrNilGovernanceToProtocol signals that a nil governance to protocol handler has been provided
+var ErrNilGovernanceToProtocol = errors.New("nil governance to protocol")
+
+// ErrGovernanceChangesMismatch signals that the governance changes of a metablock are not the accepted changes which
+// activate at its nonce
+var ErrGovernanceChangesMismatch = errors.New("governance changes mismatch")
+
+// ErrNilGovernanceApplier signals that a nil governance changes applier has been provided
+var ErrNilGovernanceApplier = errors.New("nil governance applier")
+
+// ErrNilParametersUpdater signals that a nil parameters updater has been provided
+var ErrNilParametersUpdater = errors.New("nil parameters updater")
+
 // ErrInvalidRewardsPercentages signals that rewards percentages are not correct
 var ErrInvalidRewardsPercentages = errors.New("invalid rewards percentages")
 
+// ErrInvalidDeveloperPercentage signals that the share of the fees going to smart contract owners is not correct
+var ErrInvalidDeveloperPercentage = errors.New("invalid developer percentage")
+
 // ErrInvalidNonceRequest signals that invalid nonce was requested
 var ErrInvalidNonceRequest = errors.New("invalid nonce request")
 
@@ -574,3 +615,123 @@ var ErrSCDeployFromSCRIsNotPermitted = errors.New("it is not permitted to deploy
 
 // ErrNotEnoughGas signals that not enough gas has been provided
 var ErrNotEnoughGas = errors.New("not enough gas was sent in the transaction")
+
+// ErrNilInterceptedDataVerifier signals that a nil intercepted data verifier has been provided
+var ErrNilInterceptedDataVerifier = errors.New("nil intercepted data verifier")
+
+// ErrInvalidNumWorkers signals that an invalid number of workers has been provided
+var ErrInvalidNumWorkers = errors.New("invalid number of workers")
+
+// ErrInvalidChunkSize signals that an invalid chunk size has been provided
+var ErrInvalidChunkSize = errors.New("invalid chunk size")
+
+// ErrInvalidRelayedTxData signals that the data of a relayed transaction does not hold a valid user transaction
+var ErrInvalidRelayedTxData = errors.New("invalid relayed transaction data")
+
+// ErrRelayedTxBeneficiaryDoesNotMatchReceiver signals that the sender of the user transaction is not the receiver
+// of the relayed transaction
+var ErrRelayedTxBeneficiaryDoesNotMatchReceiver = errors.New("user transaction sender does not match relayed transaction receiver")
+
+// ErrRelayedTxValueMismatch signals that the value of the user transaction differs from the relayed one
+var ErrRelayedTxValueMismatch = errors.New("user transaction value does not match relayed transaction value")
+
+// ErrRelayedTxGasPriceMismatch signals that the gas price of the user transaction differs from the relayed one
+var ErrRelayedTxGasPriceMismatch = errors.New("user transaction gas price does not match relayed transaction gas price")
+
+// ErrRelayedTxGasLimitTooLow signals that the relayed transaction does not pay for the gas of the user transaction
+var ErrRelayedTxGasLimitTooLow = errors.New("relayed transaction gas limit is lower than the user transaction needs")
+
+// ErrRecursiveRelayedTx signals that a relayed transaction wraps another relayed transaction
+var ErrRecursiveRelayedTx = errors.New("relayed transaction can not wrap another relayed transaction")
+
+// ErrInvalidChainID signals that an invalid chain ID has been provided
+var ErrInvalidChainID = errors.New("invalid chain ID")
+
+// ErrInvalidTransactionVersion signals that an invalid transaction version has been provided
+var ErrInvalidTransactionVersion = errors.New("invalid transaction version")
+
+// ErrInvalidMultiSigRegistration signals that the data of a multisignature registration transaction is invalid
+var ErrInvalidMultiSigRegistration = errors.New("invalid multisignature registration data")
+
+// ErrInvalidMultiSigThreshold signals that the signature threshold of a multisignature account is not between one
+// and the number of public keys
+var ErrInvalidMultiSigThreshold = errors.New("invalid multisignature threshold")
+
+// ErrTooManyMultiSigPubKeys signals that too many public keys have been provided for a multisignature account
+var ErrTooManyMultiSigPubKeys = errors.New("too many multisignature public keys")
+
+// ErrDuplicatedMultiSigPubKey signals that the same public key has been provided twice for a multisignature account
+var ErrDuplicatedMultiSigPubKey = errors.New("duplicated multisignature public key")
+
+// ErrSignatureAndSignatureSet signals that a transaction carries both a signature and a signature set
+var ErrSignatureAndSignatureSet = errors.New("transaction has both a signature and a signature set")
+
+// ErrMissingSignatureSet signals that a transaction of a multisignature account does not carry a signature set
+var ErrMissingSignatureSet = errors.New("missing signature set for multisignature account")
+
+// ErrSignatureSetNotAllowed signals that a transaction carries a signature set but its sender is not a
+// multisignature account
+var ErrSignatureSetNotAllowed = errors.New("signature set not allowed for non multisignature account")
+
+// ErrInvalidSignatureSetLength signals that the signature set does not hold an entry for each registered public key
+var ErrInvalidSignatureSetLength = errors.New("invalid signature set length")
+
+// ErrNotEnoughSignatures signals that the signature set does not reach the threshold of the multisignature account
+var ErrNotEnoughSignatures = errors.New("not enough signatures in signature set")
+
+// ErrNilSignatureSetHandler signals that a nil signature set handler has been provided
+var ErrNilSignatureSetHandler = errors.New("nil signature set handler")
+
+// ErrNoSingleSignature signals that the intercepted data is authorized by a signature set which can not be checked
+// on its own
+var ErrNoSingleSignature = errors.New("no single signature")
+
+// ErrTransactionNotYetValid signals that the transaction can be executed only in a later round
+var ErrTransactionNotYetValid = errors.New("transaction not yet valid")
+
+// ErrTransactionExpired signals that the last round in which the transaction could be executed has passed
+var ErrTransactionExpired = errors.New("transaction expired")
+
+// ErrInvalidRoundWindow signals that the transaction becomes valid only after the round in which it expires
+var ErrInvalidRoundWindow = errors.New("invalid round window")
+
+// ErrInvalidBuiltInFunctionCall signals that the data of a call to a function built into the protocol is malformed
+var ErrInvalidBuiltInFunctionCall = errors.New("invalid built in function call")
+
+// ErrCallerIsNotSCOwner signals that a function reserved to the owner of a smart contract was called by another address
+var ErrCallerIsNotSCOwner = errors.New("caller is not the owner of the smart contract")
+
+// ErrBuiltInFunctionCallNotAllowed signals that the function built into the protocol can not be called by this sender
+// or in this context
+var ErrBuiltInFunctionCallNotAllowed = errors.New("built in function call not allowed")
+
+// ErrESDTIsFrozen signals that the tokens of the account were frozen by the token owner
+var ErrESDTIsFrozen = errors.New("esdt is frozen")
+
+// ErrInsufficientESDTFunds signals that the account does not hold enough tokens for the transfer
+var ErrInsufficientESDTFunds = errors.New("insufficient esdt funds")
+
+// ErrInvalidESDTReceiver signals that the tokens are sent to a metachain address which can not hold them
+var ErrInvalidESDTReceiver = errors.New("invalid esdt receiver")
+
+// ErrStorageKeyIsProtected signals that a storage key reserved for the protocol was about to be written
+var ErrStorageKeyIsProtected = errors.New("storage key is protected")
+
+// ErrNilAccountHandler signals that a nil account handler has been provided
+var ErrNilAccountHandler = errors.New("nil account handler")
+
+// ErrNilDoubleSigningVerifier signals that a nil double signing verifier was provided
+var ErrNilDoubleSigningVerifier = errors.New("nil double signing verifier")
+
+// ErrSCIsNotUpgradable signals that the code of the smart contract was not deployed as upgradable
+var ErrSCIsNotUpgradable = errors.New("smart contract is not upgradable")
+
+// ErrNilHeaderHash signals that a nil header hash has been provided
+var ErrNilHeaderHash = errors.New("nil header hash")
+
+// ErrNilReceiptsHandler signals that a nil receipts handler has been provided
+var ErrNilReceiptsHandler = errors.New("nil receipts handler")
+
+// ErrPastStateQueriesNotSupported signals that a query at a past state was requested from a query service
+// that can only run on the current state
+var ErrPastStateQueriesNotSupported = errors.New("queries at a past state are not supported")
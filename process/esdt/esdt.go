@@ -0,0 +1,216 @@
+package esdt
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// ESDigitalToken holds the balance an account has in a fungible token and whether the token owner froze it
+type ESDigitalToken struct {
+	Value  *big.Int `json:"Value"`
+	Frozen bool     `json:"Frozen"`
+}
+
+// Call holds the parsed data of a call to one of the fungible token functions built into the protocol
+type Call struct {
+	Function  string
+	TokenName []byte
+	Value     *big.Int
+}
+
+// IsBuiltInFunctionCall returns true if the data calls one of the fungible token functions built into the protocol
+func IsBuiltInFunctionCall(data string) bool {
+	function := strings.SplitN(data, "@", 2)[0]
+	if len(function) == len(data) {
+		return false
+	}
+
+	switch function {
+	case core.BuiltInFunctionESDTTransfer, core.BuiltInFunctionESDTFreeze, core.BuiltInFunctionESDTUnFreeze:
+		return true
+	}
+
+	return false
+}
+
+// ParseCall decodes the hex encoded arguments of a call to a fungible token function built into the protocol. A
+// transfer carries the token name and the value, a freeze or an unfreeze carries only the token name
+func ParseCall(data string) (*Call, error) {
+	if !IsBuiltInFunctionCall(data) {
+		return nil, process.ErrInvalidBuiltInFunctionCall
+	}
+
+	tokens := strings.Split(data, "@")
+	call := &Call{
+		Function: tokens[0],
+		Value:    big.NewInt(0),
+	}
+
+	expectedArguments := 1
+	if call.Function == core.BuiltInFunctionESDTTransfer {
+		expectedArguments = 2
+	}
+	if len(tokens) != expectedArguments+1 {
+		return nil, process.ErrInvalidBuiltInFunctionCall
+	}
+
+	tokenName, err := hex.DecodeString(tokens[1])
+	if err != nil || len(tokenName) == 0 {
+		return nil, process.ErrInvalidBuiltInFunctionCall
+	}
+	call.TokenName = tokenName
+
+	if expectedArguments == 2 {
+		value, errDecode := hex.DecodeString(tokens[2])
+		if errDecode != nil {
+			return nil, process.ErrInvalidBuiltInFunctionCall
+		}
+		call.Value.SetBytes(value)
+	}
+
+	return call, nil
+}
+
+// TokenKey returns the protected storage key under which an account keeps its balance of the given token
+func TokenKey(tokenName []byte) []byte {
+	return append([]byte(core.ElrondProtectedKeyPrefix+core.ESDTKeyIdentifier), tokenName...)
+}
+
+// IsProtectedKey returns true if the storage key can be written only by the protocol
+func IsProtectedKey(key []byte) bool {
+	return bytes.HasPrefix(key, []byte(core.ElrondProtectedKeyPrefix))
+}
+
+// GetToken returns the balance the account has in the given token, an empty one if it never held the token
+func GetToken(
+	marshalizer marshal.Marshalizer,
+	account state.AccountHandler,
+	tokenName []byte,
+) (*ESDigitalToken, error) {
+	if check.IfNil(account) {
+		return nil, process.ErrNilAccountHandler
+	}
+
+	token := &ESDigitalToken{Value: big.NewInt(0)}
+	marshaledData, err := account.DataTrieTracker().RetrieveValue(TokenKey(tokenName))
+	if err == state.ErrNilTrie || len(marshaledData) == 0 {
+		return token, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = marshalizer.Unmarshal(token, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+	if token.Value == nil {
+		token.Value = big.NewInt(0)
+	}
+
+	return token, nil
+}
+
+// SaveToken writes the balance the account has in the given token into the account's storage
+func SaveToken(
+	marshalizer marshal.Marshalizer,
+	accounts state.AccountsAdapter,
+	account state.AccountHandler,
+	tokenName []byte,
+	token *ESDigitalToken,
+) error {
+	marshaledData, err := marshalizer.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	account.DataTrieTracker().SaveKeyValue(TokenKey(tokenName), marshaledData)
+	return accounts.SaveDataTrie(account)
+}
+
+// Debit takes the value from the account's balance of the given token. Frozen tokens can not be sent
+func Debit(
+	marshalizer marshal.Marshalizer,
+	accounts state.AccountsAdapter,
+	account state.AccountHandler,
+	tokenName []byte,
+	value *big.Int,
+) error {
+	token, err := GetToken(marshalizer, account, tokenName)
+	if err != nil {
+		return err
+	}
+	if token.Frozen {
+		return process.ErrESDTIsFrozen
+	}
+	if token.Value.Cmp(value) < 0 {
+		return process.ErrInsufficientESDTFunds
+	}
+
+	token.Value.Sub(token.Value, value)
+	return SaveToken(marshalizer, accounts, account, tokenName, token)
+}
+
+// Credit adds the value to the account's balance of the given token. Frozen accounts still receive tokens, so a
+// transfer already debited by the sender's shard is never lost
+func Credit(
+	marshalizer marshal.Marshalizer,
+	accounts state.AccountsAdapter,
+	account state.AccountHandler,
+	tokenName []byte,
+	value *big.Int,
+) error {
+	token, err := GetToken(marshalizer, account, tokenName)
+	if err != nil {
+		return err
+	}
+
+	token.Value.Add(token.Value, value)
+	return SaveToken(marshalizer, accounts, account, tokenName, token)
+}
+
+// RevertCredit takes back the value credited to the account's balance of the given token by a transfer which failed
+// afterwards. The balance is taken even if it is frozen, as the tokens were never available to the account
+func RevertCredit(
+	marshalizer marshal.Marshalizer,
+	accounts state.AccountsAdapter,
+	account state.AccountHandler,
+	tokenName []byte,
+	value *big.Int,
+) error {
+	token, err := GetToken(marshalizer, account, tokenName)
+	if err != nil {
+		return err
+	}
+	if token.Value.Cmp(value) < 0 {
+		return process.ErrInsufficientESDTFunds
+	}
+
+	token.Value.Sub(token.Value, value)
+	return SaveToken(marshalizer, accounts, account, tokenName, token)
+}
+
+// SetFrozen freezes or unfreezes the account's balance of the given token
+func SetFrozen(
+	marshalizer marshal.Marshalizer,
+	accounts state.AccountsAdapter,
+	account state.AccountHandler,
+	tokenName []byte,
+	frozen bool,
+) error {
+	token, err := GetToken(marshalizer, account, tokenName)
+	if err != nil {
+		return err
+	}
+
+	token.Frozen = frozen
+	return SaveToken(marshalizer, accounts, account, tokenName, token)
+}
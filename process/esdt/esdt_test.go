@@ -0,0 +1,158 @@
+package esdt_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/esdt"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createAccount() *state.Account {
+	acnt, _ := state.NewAccount(mock.NewAddressMock([]byte("address")), &mock.AccountTrackerStub{})
+	return acnt
+}
+
+func createAccountsStub() *mock.AccountsStub {
+	return &mock.AccountsStub{
+		SaveDataTrieCalled: func(acountWrapper state.AccountHandler) error {
+			return nil
+		},
+	}
+}
+
+func TestIsBuiltInFunctionCall(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, esdt.IsBuiltInFunctionCall(core.BuiltInFunctionESDTTransfer+"@544f4b454e@0a"))
+	assert.True(t, esdt.IsBuiltInFunctionCall(core.BuiltInFunctionESDTFreeze+"@544f4b454e"))
+	assert.True(t, esdt.IsBuiltInFunctionCall(core.BuiltInFunctionESDTUnFreeze+"@544f4b454e"))
+	assert.False(t, esdt.IsBuiltInFunctionCall(core.BuiltInFunctionESDTTransfer))
+	assert.False(t, esdt.IsBuiltInFunctionCall("transfer@544f4b454e@0a"))
+	assert.False(t, esdt.IsBuiltInFunctionCall(""))
+}
+
+func TestParseCall_TransferShouldWork(t *testing.T) {
+	t.Parallel()
+
+	call, err := esdt.ParseCall(core.BuiltInFunctionESDTTransfer + "@544f4b454e@0a")
+
+	assert.Nil(t, err)
+	assert.Equal(t, core.BuiltInFunctionESDTTransfer, call.Function)
+	assert.Equal(t, []byte("TOKEN"), call.TokenName)
+	assert.Equal(t, big.NewInt(10), call.Value)
+}
+
+func TestParseCall_FreezeShouldWork(t *testing.T) {
+	t.Parallel()
+
+	call, err := esdt.ParseCall(core.BuiltInFunctionESDTFreeze + "@544f4b454e")
+
+	assert.Nil(t, err)
+	assert.Equal(t, core.BuiltInFunctionESDTFreeze, call.Function)
+	assert.Equal(t, []byte("TOKEN"), call.TokenName)
+}
+
+func TestParseCall_InvalidDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	invalidData := []string{
+		"",
+		"transfer@544f4b454e@0a",
+		core.BuiltInFunctionESDTTransfer + "@544f4b454e",
+		core.BuiltInFunctionESDTTransfer + "@@0a",
+		core.BuiltInFunctionESDTTransfer + "@zz@0a",
+		core.BuiltInFunctionESDTTransfer + "@544f4b454e@zz",
+		core.BuiltInFunctionESDTFreeze + "@544f4b454e@0a",
+	}
+
+	for _, data := range invalidData {
+		call, err := esdt.ParseCall(data)
+		assert.Nil(t, call)
+		assert.Equal(t, process.ErrInvalidBuiltInFunctionCall, err)
+	}
+}
+
+func TestIsProtectedKey(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, esdt.IsProtectedKey(esdt.TokenKey([]byte("TOKEN"))))
+	assert.False(t, esdt.IsProtectedKey([]byte("TOKEN")))
+}
+
+func TestGetToken_NilAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	token, err := esdt.GetToken(&mock.MarshalizerMock{}, nil, []byte("TOKEN"))
+
+	assert.Nil(t, token)
+	assert.Equal(t, process.ErrNilAccountHandler, err)
+}
+
+func TestGetToken_NeverHeldShouldReturnEmptyToken(t *testing.T) {
+	t.Parallel()
+
+	token, err := esdt.GetToken(&mock.MarshalizerMock{}, createAccount(), []byte("TOKEN"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), token.Value)
+	assert.False(t, token.Frozen)
+}
+
+func TestCreditAndDebit_ShouldMoveTokens(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	accounts := createAccountsStub()
+	acnt := createAccount()
+	tokenName := []byte("TOKEN")
+
+	err := esdt.Credit(marshalizer, accounts, acnt, tokenName, big.NewInt(100))
+	assert.Nil(t, err)
+
+	err = esdt.Debit(marshalizer, accounts, acnt, tokenName, big.NewInt(40))
+	assert.Nil(t, err)
+
+	token, _ := esdt.GetToken(marshalizer, acnt, tokenName)
+	assert.Equal(t, big.NewInt(60), token.Value)
+}
+
+func TestDebit_InsufficientFundsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	accounts := createAccountsStub()
+	acnt := createAccount()
+	tokenName := []byte("TOKEN")
+
+	_ = esdt.Credit(marshalizer, accounts, acnt, tokenName, big.NewInt(10))
+
+	err := esdt.Debit(marshalizer, accounts, acnt, tokenName, big.NewInt(11))
+	assert.Equal(t, process.ErrInsufficientESDTFunds, err)
+}
+
+func TestDebit_FrozenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	accounts := createAccountsStub()
+	acnt := createAccount()
+	tokenName := []byte("TOKEN")
+
+	_ = esdt.Credit(marshalizer, accounts, acnt, tokenName, big.NewInt(10))
+	_ = esdt.SetFrozen(marshalizer, accounts, acnt, tokenName, true)
+
+	err := esdt.Debit(marshalizer, accounts, acnt, tokenName, big.NewInt(1))
+	assert.Equal(t, process.ErrESDTIsFrozen, err)
+
+	err = esdt.Credit(marshalizer, accounts, acnt, tokenName, big.NewInt(1))
+	assert.Nil(t, err)
+
+	_ = esdt.SetFrozen(marshalizer, accounts, acnt, tokenName, false)
+	err = esdt.Debit(marshalizer, accounts, acnt, tokenName, big.NewInt(11))
+	assert.Nil(t, err)
+}
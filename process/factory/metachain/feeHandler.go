@@ -26,6 +26,11 @@ func (t *TransactionFeeHandler) CheckValidityTxValues(tx process.TransactionWith
 	return nil
 }
 
+// DeveloperPercentage returns 0 as no fee is shared with the smart contract owners on metachain
+func (t *TransactionFeeHandler) DeveloperPercentage() float64 {
+	return 0
+}
+
 // ProcessTransactionFee empty cost processing for metachain
 func (t *TransactionFeeHandler) ProcessTransactionFee(cost *big.Int) {
 }
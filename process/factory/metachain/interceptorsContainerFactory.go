@@ -1,6 +1,8 @@
 package metachain
 
 import (
+	"runtime"
+
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
@@ -18,17 +20,23 @@ import (
 	interceptorFactory "github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 const numGoRoutines = 2000
 
+// sigChunkSize is the number of transaction signatures a worker verifies at a time
+const sigChunkSize = 100
+
 type interceptorsContainerFactory struct {
 	accounts               state.AccountsAdapter
 	addrConverter          state.AddressConverter
 	singleSigner           crypto.SingleSigner
 	keyGen                 crypto.KeyGenerator
 	maxTxNonceDeltaAllowed int
+	chainID                []byte
+	minTxVersion           uint32
 	txFeeHandler           process.FeeHandler
 	txInterceptorThrottler process.InterceptorThrottler
 	marshalizer            marshal.Marshalizer
@@ -43,6 +51,7 @@ type interceptorsContainerFactory struct {
 	tpsBenchmark           *statistics.TpsBenchmark
 	argInterceptorFactory  *interceptorFactory.ArgInterceptedDataFactory
 	globalThrottler        process.InterceptorThrottler
+	txSigVerifier          process.InterceptedDataVerifier
 }
 
 // NewInterceptorsContainerFactory is responsible for creating a new interceptors factory object
@@ -64,6 +73,8 @@ func NewInterceptorsContainerFactory(
 	maxTxNonceDeltaAllowed int,
 	txFeeHandler process.FeeHandler,
 	blackList process.BlackListHandler,
+	chainID []byte,
+	minTxVersion uint32,
 ) (*interceptorsContainerFactory, error) {
 
 	if check.IfNil(shardCoordinator) {
@@ -108,6 +119,9 @@ func NewInterceptorsContainerFactory(
 	if check.IfNil(blackList) {
 		return nil, process.ErrNilBlackListHandler
 	}
+	if len(chainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
 	if check.IfNil(blockKeyGen) {
 		return nil, process.ErrNilKeyGen
 	}
@@ -127,6 +141,8 @@ func NewInterceptorsContainerFactory(
 		BlockSigner:      blockSingleSigner,
 		AddrConv:         addrConverter,
 		FeeHandler:       txFeeHandler,
+		ChainID:          chainID,
+		MinTxVersion:     minTxVersion,
 	}
 
 	icf := &interceptorsContainerFactory{
@@ -135,12 +151,16 @@ func NewInterceptorsContainerFactory(
 		store:                  store,
 		marshalizer:            marshalizer,
 		hasher:                 hasher,
+		singleSigner:           singleSigner,
+		keyGen:                 keyGen,
 		multiSigner:            multiSigner,
 		dataPool:               dataPool,
 		nodesCoordinator:       nodesCoordinator,
 		blackList:              blackList,
 		argInterceptorFactory:  argInterceptorFactory,
 		maxTxNonceDeltaAllowed: maxTxNonceDeltaAllowed,
+		chainID:                chainID,
+		minTxVersion:           minTxVersion,
 		accounts:               accounts,
 	}
 
@@ -150,6 +170,12 @@ func NewInterceptorsContainerFactory(
 		return nil, err
 	}
 
+	// all the transaction interceptors share the same workers
+	icf.txSigVerifier, err = interceptors.NewParallelSigVerifier(singleSigner, runtime.NumCPU(), sigChunkSize)
+	if err != nil {
+		return nil, err
+	}
+
 	return icf, nil
 }
 
@@ -361,7 +387,20 @@ func (icf *interceptorsContainerFactory) generateTxInterceptors() ([]string, []p
 }
 
 func (icf *interceptorsContainerFactory) createOneTxInterceptor(topic string) (process.Interceptor, error) {
-	txValidator, err := dataValidators.NewTxValidator(icf.accounts, icf.shardCoordinator, icf.maxTxNonceDeltaAllowed)
+	signatureSetHandler, err := transaction.NewSignatureSetHandler(icf.marshalizer, icf.keyGen, icf.singleSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	txValidator, err := dataValidators.NewTxValidator(
+		icf.accounts,
+		icf.shardCoordinator,
+		icf.dataPool.Transactions(),
+		icf.maxTxNonceDeltaAllowed,
+		icf.chainID,
+		icf.minTxVersion,
+		signatureSetHandler,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -385,6 +424,7 @@ func (icf *interceptorsContainerFactory) createOneTxInterceptor(topic string) (p
 		txFactory,
 		txProcessor,
 		icf.globalThrottler,
+		icf.txSigVerifier,
 	)
 	if err != nil {
 		return nil, err
@@ -444,6 +484,7 @@ func (icf *interceptorsContainerFactory) createOneUnsignedTxInterceptor(topic st
 		txFactory,
 		txProcessor,
 		icf.globalThrottler,
+		interceptors.NewSequentialVerifier(),
 	)
 	if err != nil {
 		return nil, err
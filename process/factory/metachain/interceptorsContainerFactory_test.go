@@ -102,6 +102,8 @@ func TestNewInterceptorsContainerFactory_NilShardCoordinatorShouldErr(t *testing
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -129,6 +131,8 @@ func TestNewInterceptorsContainerFactory_NilNodesCoordinatorShouldErr(t *testing
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -156,6 +160,8 @@ func TestNewInterceptorsContainerFactory_NilTopicHandlerShouldErr(t *testing.T)
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -183,6 +189,8 @@ func TestNewInterceptorsContainerFactory_NilBlockchainShouldErr(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -210,6 +218,8 @@ func TestNewInterceptorsContainerFactory_NilMarshalizerShouldErr(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -237,6 +247,8 @@ func TestNewInterceptorsContainerFactory_NilHasherShouldErr(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -264,6 +276,8 @@ func TestNewInterceptorsContainerFactory_NilMultiSignerShouldErr(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -291,6 +305,8 @@ func TestNewInterceptorsContainerFactory_NilDataPoolShouldErr(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -318,6 +334,8 @@ func TestNewInterceptorsContainerFactory_NilAccountsShouldErr(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -345,6 +363,8 @@ func TestNewInterceptorsContainerFactory_NilAddrConvShouldErr(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -372,6 +392,8 @@ func TestNewInterceptorsContainerFactory_NilSingleSignerShouldErr(t *testing.T)
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -399,6 +421,8 @@ func TestNewInterceptorsContainerFactory_NilKeyGenShouldErr(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -426,6 +450,8 @@ func TestNewInterceptorsContainerFactory_NilFeeHandlerShouldErr(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		nil,
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -453,12 +479,43 @@ func TestNewInterceptorsContainerFactory_NilBlackListHandlerShouldErr(t *testing
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		nil,
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
 	assert.Equal(t, process.ErrNilBlackListHandler, err)
 }
 
+func TestNewInterceptorsContainerFactory_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := metachain.NewInterceptorsContainerFactory(
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		mock.NewMultiSigner(),
+		createDataPools(),
+		&mock.AccountsStub{},
+		&mock.AddressConverterMock{},
+		&mock.SignerMock{},
+		&mock.SignerMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SingleSignKeyGenMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		&mock.BlackListHandlerStub{},
+		nil,
+		uint32(1),
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
 func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -480,6 +537,8 @@ func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.NotNil(t, icf)
@@ -509,6 +568,8 @@ func TestInterceptorsContainerFactory_CreateTopicMetablocksFailsShouldErr(t *tes
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	container, err := icf.Create()
@@ -538,6 +599,8 @@ func TestInterceptorsContainerFactory_CreateTopicShardHeadersForMetachainFailsSh
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	container, err := icf.Create()
@@ -567,6 +630,8 @@ func TestInterceptorsContainerFactory_CreateRegisterForMetablocksFailsShouldErr(
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	container, err := icf.Create()
@@ -596,6 +661,8 @@ func TestInterceptorsContainerFactory_CreateRegisterShardHeadersForMetachainFail
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	container, err := icf.Create()
@@ -632,6 +699,8 @@ func TestInterceptorsContainerFactory_CreateShouldWork(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	container, err := icf.Create()
@@ -681,6 +750,8 @@ func TestInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	container, err := icf.Create()
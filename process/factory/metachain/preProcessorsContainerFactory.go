@@ -26,6 +26,7 @@ type preProcessorsContainerFactory struct {
 	economicsFee        process.FeeHandler
 	miniBlocksCompacter process.MiniBlocksCompacter
 	gasHandler          process.GasHandler
+	txSelection         process.TxSelectionStrategy
 }
 
 // NewPreProcessorsContainerFactory is responsible for creating a new preProcessors factory object
@@ -42,6 +43,7 @@ func NewPreProcessorsContainerFactory(
 	economicsFee process.FeeHandler,
 	miniBlocksCompacter process.MiniBlocksCompacter,
 	gasHandler process.GasHandler,
+	txSelection process.TxSelectionStrategy,
 ) (*preProcessorsContainerFactory, error) {
 
 	if check.IfNil(shardCoordinator) {
@@ -80,6 +82,9 @@ func NewPreProcessorsContainerFactory(
 	if check.IfNil(gasHandler) {
 		return nil, process.ErrNilGasHandler
 	}
+	if check.IfNil(txSelection) {
+		return nil, process.ErrNilTxSelectionStrategy
+	}
 
 	return &preProcessorsContainerFactory{
 		shardCoordinator:    shardCoordinator,
@@ -94,6 +99,7 @@ func NewPreProcessorsContainerFactory(
 		miniBlocksCompacter: miniBlocksCompacter,
 		scResultProcessor:   scResultProcessor,
 		gasHandler:          gasHandler,
+		txSelection:         txSelection,
 	}, nil
 }
 
@@ -134,9 +140,11 @@ func (ppcm *preProcessorsContainerFactory) createTxPreProcessor() (process.PrePr
 		ppcm.shardCoordinator,
 		ppcm.accounts,
 		ppcm.requestHandler.RequestTransaction,
+		ppcm.requestHandler.RequestTransactionsBySenderNonces,
 		ppcm.economicsFee,
 		ppcm.miniBlocksCompacter,
 		ppcm.gasHandler,
+		ppcm.txSelection,
 	)
 
 	return txPreprocessor, err
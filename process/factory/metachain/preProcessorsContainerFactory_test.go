@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/stretchr/testify/assert"
@@ -26,6 +27,7 @@ func TestNewPreProcessorsContainerFactory_NilShardCoordinator(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
@@ -48,6 +50,7 @@ func TestNewPreProcessorsContainerFactory_NilStore(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilStore, err)
@@ -70,6 +73,7 @@ func TestNewPreProcessorsContainerFactory_NilMarshalizer(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilMarshalizer, err)
@@ -92,6 +96,7 @@ func TestNewPreProcessorsContainerFactory_NilHasher(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilHasher, err)
@@ -114,6 +119,7 @@ func TestNewPreProcessorsContainerFactory_NilDataPool(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilDataPoolHolder, err)
@@ -136,6 +142,7 @@ func TestNewPreProcessorsContainerFactory_NilAccounts(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilAccountsAdapter, err)
@@ -158,6 +165,7 @@ func TestNewPreProcessorsContainerFactory_NilFeeHandler(t *testing.T) {
 		nil,
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
@@ -180,6 +188,7 @@ func TestNewPreProcessorsContainerFactory_NilTxProcessor(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilTxProcessor, err)
@@ -202,6 +211,7 @@ func TestNewPreProcessorsContainerFactory_NilRequestHandler(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 	assert.Equal(t, process.ErrNilRequestHandler, err)
 	assert.Nil(t, ppcm)
@@ -223,6 +233,7 @@ func TestNewPreProcessorsContainerFactory_NilMiniBlocksCompacter(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		nil,
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 	assert.Equal(t, process.ErrNilMiniBlocksCompacter, err)
 	assert.Nil(t, ppcm)
@@ -244,11 +255,34 @@ func TestNewPreProcessorsContainerFactory_NilGasHandler(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		nil,
+		preprocess.NewPoolOrderSelection(),
 	)
 	assert.Equal(t, process.ErrNilGasHandler, err)
 	assert.Nil(t, ppcm)
 }
 
+func TestNewPreProcessorsContainerFactory_NilTxSelectionStrategy(t *testing.T) {
+	t.Parallel()
+
+	ppcm, err := metachain.NewPreProcessorsContainerFactory(
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.ChainStorerMock{},
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		mock.NewMetaPoolsHolderFake(),
+		&mock.AccountsStub{},
+		&mock.RequestHandlerMock{},
+		&mock.TxProcessorMock{},
+		&mock.SmartContractResultsProcessorMock{},
+		&mock.FeeHandlerStub{},
+		&mock.MiniBlocksCompacterMock{},
+		&mock.GasHandlerMock{},
+		nil,
+	)
+	assert.Equal(t, process.ErrNilTxSelectionStrategy, err)
+	assert.Nil(t, ppcm)
+}
+
 func TestNewPreProcessorsContainerFactory(t *testing.T) {
 	t.Parallel()
 
@@ -265,6 +299,7 @@ func TestNewPreProcessorsContainerFactory(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, err)
@@ -292,6 +327,7 @@ func TestPreProcessorsContainerFactory_CreateErrTxPreproc(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, err)
@@ -318,6 +354,7 @@ func TestPreProcessorsContainerFactory_Create(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, err)
@@ -14,20 +14,25 @@ import (
 )
 
 type vmContainerFactory struct {
-	blockChainHookImpl *hooks.BlockChainHookImpl
-	cryptoHook         vmcommon.CryptoHook
-	systemContracts    vm.SystemSCContainer
-	economics          *economics.EconomicsData
+	blockChainHookImpl    *hooks.BlockChainHookImpl
+	cryptoHook            vmcommon.CryptoHook
+	systemContracts       vm.SystemSCContainer
+	economics             *economics.EconomicsData
+	doubleSigningVerifier vm.DoubleSigningVerifier
 }
 
 // NewVMContainerFactory is responsible for creating a new virtual machine factory object
 func NewVMContainerFactory(
 	argBlockChainHook hooks.ArgBlockChainHook,
 	economics *economics.EconomicsData,
+	doubleSigningVerifier vm.DoubleSigningVerifier,
 ) (*vmContainerFactory, error) {
 	if economics == nil {
 		return nil, process.ErrNilEconomicsData
 	}
+	if doubleSigningVerifier == nil || doubleSigningVerifier.IsInterfaceNil() {
+		return nil, process.ErrNilDoubleSigningVerifier
+	}
 
 	blockChainHookImpl, err := hooks.NewBlockChainHookImpl(argBlockChainHook)
 	if err != nil {
@@ -36,9 +41,10 @@ func NewVMContainerFactory(
 	cryptoHook := hooks.NewVMCryptoHook()
 
 	return &vmContainerFactory{
-		blockChainHookImpl: blockChainHookImpl,
-		cryptoHook:         cryptoHook,
-		economics:          economics,
+		blockChainHookImpl:    blockChainHookImpl,
+		cryptoHook:            cryptoHook,
+		economics:             economics,
+		doubleSigningVerifier: doubleSigningVerifier,
 	}, nil
 }
 
@@ -65,7 +71,13 @@ func (vmf *vmContainerFactory) createSystemVM() (vmcommon.VMExecutionHandler, er
 		return nil, err
 	}
 
-	scFactory, err := systemVMFactory.NewSystemSCFactory(systemEI, vmf.economics)
+	scFactory, err := systemVMFactory.NewSystemSCFactory(
+		systemEI,
+		vmf.economics,
+		vmf.economics,
+		vmf.economics,
+		vmf.doubleSigningVerifier,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
@@ -35,12 +36,26 @@ func TestNewVMContainerFactory_OkValues(t *testing.T) {
 	vmf, err := NewVMContainerFactory(
 		createMockVMAccountsArguments(),
 		&economics.EconomicsData{},
+		&mock.DoubleSigningVerifierStub{},
 	)
 
 	assert.NotNil(t, vmf)
 	assert.Nil(t, err)
 }
 
+func TestNewVMContainerFactory_NilDoubleSigningVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vmf, err := NewVMContainerFactory(
+		createMockVMAccountsArguments(),
+		&economics.EconomicsData{},
+		nil,
+	)
+
+	assert.Nil(t, vmf)
+	assert.Equal(t, process.ErrNilDoubleSigningVerifier, err)
+}
+
 func TestVmContainerFactory_Create(t *testing.T) {
 	t.Parallel()
 
@@ -48,7 +63,7 @@ func TestVmContainerFactory_Create(t *testing.T) {
 		&config.ConfigEconomics{
 			EconomicsAddresses: config.EconomicsAddresses{
 				CommunityAddress: "addr1",
-				BurnAddress:      "addr2",
+				BurnAddress:      "deadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef",
 			},
 			RewardsSettings: config.RewardsSettings{
 				RewardsValue:        "1000",
@@ -65,12 +80,20 @@ func TestVmContainerFactory_Create(t *testing.T) {
 				StakeValue:    "500",
 				UnBoundPeriod: "1000",
 			},
+			ESDTSettings: config.ESDTSettings{
+				BaseIssuingCost: "1000",
+			},
+			GovernanceSettings: config.GovernanceSettings{
+				VotingPeriod: "1000",
+				MinQuorum:    "1000",
+			},
 		},
 	)
 
 	vmf, err := NewVMContainerFactory(
 		createMockVMAccountsArguments(),
 		economicsData,
+		&mock.DoubleSigningVerifierStub{},
 	)
 	assert.NotNil(t, vmf)
 	assert.Nil(t, err)
@@ -1,6 +1,8 @@
 package shard
 
 import (
+	"runtime"
+
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -16,11 +18,15 @@ import (
 	interceptorFactory "github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 const numGoRoutines = 2000
 
+// sigChunkSize is the number of transaction signatures a worker verifies at a time
+const sigChunkSize = 100
+
 type interceptorsContainerFactory struct {
 	accounts               state.AccountsAdapter
 	shardCoordinator       sharding.Coordinator
@@ -37,7 +43,10 @@ type interceptorsContainerFactory struct {
 	blackList              process.BlackListHandler
 	argInterceptorFactory  *interceptorFactory.ArgInterceptedDataFactory
 	globalTxThrottler      process.InterceptorThrottler
+	txSigVerifier          process.InterceptedDataVerifier
 	maxTxNonceDeltaAllowed int
+	chainID                []byte
+	minTxVersion           uint32
 }
 
 // NewInterceptorsContainerFactory is responsible for creating a new interceptors factory object
@@ -59,6 +68,8 @@ func NewInterceptorsContainerFactory(
 	maxTxNonceDeltaAllowed int,
 	txFeeHandler process.FeeHandler,
 	blackList process.BlackListHandler,
+	chainID []byte,
+	minTxVersion uint32,
 ) (*interceptorsContainerFactory, error) {
 	if check.IfNil(accounts) {
 		return nil, process.ErrNilAccountsAdapter
@@ -102,6 +113,9 @@ func NewInterceptorsContainerFactory(
 	if check.IfNil(blackList) {
 		return nil, process.ErrNilBlackListHandler
 	}
+	if len(chainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
 	if check.IfNil(blockSignKeyGen) {
 		return nil, process.ErrNilKeyGen
 	}
@@ -121,6 +135,8 @@ func NewInterceptorsContainerFactory(
 		BlockSigner:      blockSingleSigner,
 		AddrConv:         addrConverter,
 		FeeHandler:       txFeeHandler,
+		ChainID:          chainID,
+		MinTxVersion:     minTxVersion,
 	}
 
 	icf := &interceptorsContainerFactory{
@@ -139,6 +155,8 @@ func NewInterceptorsContainerFactory(
 		argInterceptorFactory:  argInterceptorFactory,
 		blackList:              blackList,
 		maxTxNonceDeltaAllowed: maxTxNonceDeltaAllowed,
+		chainID:                chainID,
+		minTxVersion:           minTxVersion,
 	}
 
 	var err error
@@ -147,6 +165,12 @@ func NewInterceptorsContainerFactory(
 		return nil, err
 	}
 
+	// all the transaction interceptors share the same workers
+	icf.txSigVerifier, err = interceptors.NewParallelSigVerifier(singleSigner, runtime.NumCPU(), sigChunkSize)
+	if err != nil {
+		return nil, err
+	}
+
 	return icf, nil
 }
 
@@ -267,7 +291,20 @@ func (icf *interceptorsContainerFactory) generateTxInterceptors() ([]string, []p
 }
 
 func (icf *interceptorsContainerFactory) createOneTxInterceptor(topic string) (process.Interceptor, error) {
-	txValidator, err := dataValidators.NewTxValidator(icf.accounts, icf.shardCoordinator, icf.maxTxNonceDeltaAllowed)
+	signatureSetHandler, err := transaction.NewSignatureSetHandler(icf.marshalizer, icf.keyGen, icf.singleSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	txValidator, err := dataValidators.NewTxValidator(
+		icf.accounts,
+		icf.shardCoordinator,
+		icf.dataPool.Transactions(),
+		icf.maxTxNonceDeltaAllowed,
+		icf.chainID,
+		icf.minTxVersion,
+		signatureSetHandler,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -291,6 +328,7 @@ func (icf *interceptorsContainerFactory) createOneTxInterceptor(topic string) (p
 		txFactory,
 		txProcessor,
 		icf.globalTxThrottler,
+		icf.txSigVerifier,
 	)
 	if err != nil {
 		return nil, err
@@ -359,6 +397,7 @@ func (icf *interceptorsContainerFactory) createOneUnsignedTxInterceptor(topic st
 		txFactory,
 		txProcessor,
 		icf.globalTxThrottler,
+		interceptors.NewSequentialVerifier(),
 	)
 	if err != nil {
 		return nil, err
@@ -428,6 +467,7 @@ func (icf *interceptorsContainerFactory) createOneRewardTxInterceptor(topic stri
 		txFactory,
 		txProcessor,
 		icf.globalTxThrottler,
+		interceptors.NewSequentialVerifier(),
 	)
 	if err != nil {
 		return nil, err
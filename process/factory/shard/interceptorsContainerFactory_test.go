@@ -106,6 +106,8 @@ func TestNewInterceptorsContainerFactory_NilAccountsAdapter(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -133,6 +135,8 @@ func TestNewInterceptorsContainerFactory_NilShardCoordinatorShouldErr(t *testing
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -160,6 +164,8 @@ func TestNewInterceptorsContainerFactory_NilNodesCoordinatorShouldErr(t *testing
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -187,6 +193,8 @@ func TestNewInterceptorsContainerFactory_NilTopicHandlerShouldErr(t *testing.T)
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -214,6 +222,8 @@ func TestNewInterceptorsContainerFactory_NilBlockchainShouldErr(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -241,6 +251,8 @@ func TestNewInterceptorsContainerFactory_NilMarshalizerShouldErr(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -268,6 +280,8 @@ func TestNewInterceptorsContainerFactory_NilHasherShouldErr(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -295,6 +309,8 @@ func TestNewInterceptorsContainerFactory_NilKeyGenShouldErr(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -322,6 +338,8 @@ func TestNewInterceptorsContainerFactory_NilSingleSignerShouldErr(t *testing.T)
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -349,6 +367,8 @@ func TestNewInterceptorsContainerFactory_NilMultiSignerShouldErr(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -376,6 +396,8 @@ func TestNewInterceptorsContainerFactory_NilDataPoolShouldErr(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -403,6 +425,8 @@ func TestNewInterceptorsContainerFactory_NilAddrConverterShouldErr(t *testing.T)
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -430,6 +454,8 @@ func TestNewInterceptorsContainerFactory_NilTxFeeHandlerShouldErr(t *testing.T)
 		maxTxNonceDeltaAllowed,
 		nil,
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
@@ -457,12 +483,43 @@ func TestNewInterceptorsContainerFactory_NilBlackListHandlerShouldErr(t *testing
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		nil,
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.Nil(t, icf)
 	assert.Equal(t, process.ErrNilBlackListHandler, err)
 }
 
+func TestNewInterceptorsContainerFactory_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := shard.NewInterceptorsContainerFactory(
+		&mock.AccountsStub{},
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		&mock.SignerMock{},
+		mock.NewMultiSigner(),
+		createDataPools(),
+		&mock.AddressConverterMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		&mock.BlackListHandlerStub{},
+		nil,
+		uint32(1),
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
 func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -484,6 +541,8 @@ func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	assert.NotNil(t, icf)
@@ -513,6 +572,8 @@ func TestInterceptorsContainerFactory_CreateTopicCreationTxFailsShouldErr(t *tes
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	container, err := icf.Create()
@@ -542,6 +603,8 @@ func TestInterceptorsContainerFactory_CreateTopicCreationHdrFailsShouldErr(t *te
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	container, err := icf.Create()
@@ -571,6 +634,8 @@ func TestInterceptorsContainerFactory_CreateTopicCreationMiniBlocksFailsShouldEr
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	container, err := icf.Create()
@@ -600,6 +665,8 @@ func TestInterceptorsContainerFactory_CreateTopicCreationMetachainHeadersFailsSh
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	container, err := icf.Create()
@@ -629,6 +696,8 @@ func TestInterceptorsContainerFactory_CreateRegisterTxFailsShouldErr(t *testing.
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	container, err := icf.Create()
@@ -658,6 +727,8 @@ func TestInterceptorsContainerFactory_CreateRegisterHdrFailsShouldErr(t *testing
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	container, err := icf.Create()
@@ -687,6 +758,8 @@ func TestInterceptorsContainerFactory_CreateRegisterMiniBlocksFailsShouldErr(t *
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	container, err := icf.Create()
@@ -716,6 +789,8 @@ func TestInterceptorsContainerFactory_CreateRegisterMetachainHeadersShouldErr(t
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	container, err := icf.Create()
@@ -752,6 +827,8 @@ func TestInterceptorsContainerFactory_CreateShouldWork(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	container, err := icf.Create()
@@ -801,6 +878,8 @@ func TestInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
 		&mock.BlackListHandlerStub{},
+		[]byte("chainID"),
+		uint32(1),
 	)
 
 	container, err := icf.Create()
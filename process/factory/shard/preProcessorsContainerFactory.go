@@ -30,6 +30,7 @@ type preProcessorsContainerFactory struct {
 	economicsFee        process.FeeHandler
 	miniBlocksCompacter process.MiniBlocksCompacter
 	gasHandler          process.GasHandler
+	txSelection         process.TxSelectionStrategy
 }
 
 // NewPreProcessorsContainerFactory is responsible for creating a new preProcessors factory object
@@ -50,6 +51,7 @@ func NewPreProcessorsContainerFactory(
 	economicsFee process.FeeHandler,
 	miniBlocksCompacter process.MiniBlocksCompacter,
 	gasHandler process.GasHandler,
+	txSelection process.TxSelectionStrategy,
 ) (*preProcessorsContainerFactory, error) {
 
 	if check.IfNil(shardCoordinator) {
@@ -100,6 +102,9 @@ func NewPreProcessorsContainerFactory(
 	if check.IfNil(gasHandler) {
 		return nil, process.ErrNilGasHandler
 	}
+	if check.IfNil(txSelection) {
+		return nil, process.ErrNilTxSelectionStrategy
+	}
 
 	return &preProcessorsContainerFactory{
 		shardCoordinator:    shardCoordinator,
@@ -118,6 +123,7 @@ func NewPreProcessorsContainerFactory(
 		economicsFee:        economicsFee,
 		miniBlocksCompacter: miniBlocksCompacter,
 		gasHandler:          gasHandler,
+		txSelection:         txSelection,
 	}, nil
 }
 
@@ -168,9 +174,11 @@ func (ppcm *preProcessorsContainerFactory) createTxPreProcessor() (process.PrePr
 		ppcm.shardCoordinator,
 		ppcm.accounts,
 		ppcm.requestHandler.RequestTransaction,
+		ppcm.requestHandler.RequestTransactionsBySenderNonces,
 		ppcm.economicsFee,
 		ppcm.miniBlocksCompacter,
 		ppcm.gasHandler,
+		ppcm.txSelection,
 	)
 
 	return txPreprocessor, err
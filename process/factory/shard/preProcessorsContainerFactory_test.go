@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/stretchr/testify/assert"
 )
@@ -29,6 +30,7 @@ func TestNewPreProcessorsContainerFactory_NilShardCoordinator(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
@@ -55,6 +57,7 @@ func TestNewPreProcessorsContainerFactory_NilStore(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilStore, err)
@@ -81,6 +84,7 @@ func TestNewPreProcessorsContainerFactory_NilMarshalizer(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilMarshalizer, err)
@@ -107,6 +111,7 @@ func TestNewPreProcessorsContainerFactory_NilHasher(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilHasher, err)
@@ -133,6 +138,7 @@ func TestNewPreProcessorsContainerFactory_NilDataPool(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilDataPoolHolder, err)
@@ -159,6 +165,7 @@ func TestNewPreProcessorsContainerFactory_NilAddrConv(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilAddressConverter, err)
@@ -185,6 +192,7 @@ func TestNewPreProcessorsContainerFactory_NilAccounts(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilAccountsAdapter, err)
@@ -211,6 +219,7 @@ func TestNewPreProcessorsContainerFactory_NilTxProcessor(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilTxProcessor, err)
@@ -237,6 +246,7 @@ func TestNewPreProcessorsContainerFactory_NilSCProcessor(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilSmartContractProcessor, err)
@@ -263,6 +273,7 @@ func TestNewPreProcessorsContainerFactory_NilSCR(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilSmartContractResultProcessor, err)
@@ -289,6 +300,7 @@ func TestNewPreProcessorsContainerFactory_NilRewardTxProcessor(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilRewardsTxProcessor, err)
@@ -315,6 +327,7 @@ func TestNewPreProcessorsContainerFactory_NilRequestHandler(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilRequestHandler, err)
@@ -341,6 +354,7 @@ func TestNewPreProcessorsContainerFactory_NilInternalTransactionProducer(t *test
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilInternalTransactionProducer, err)
@@ -367,6 +381,7 @@ func TestNewPreProcessorsContainerFactory_NilFeeHandler(t *testing.T) {
 		nil,
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
@@ -393,6 +408,7 @@ func TestNewPreProcessorsContainerFactory_NilMiniBlocksCompacter(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		nil,
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilMiniBlocksCompacter, err)
@@ -419,12 +435,40 @@ func TestNewPreProcessorsContainerFactory_NilGasHandler(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		nil,
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Equal(t, process.ErrNilGasHandler, err)
 	assert.Nil(t, ppcm)
 }
 
+func TestNewPreProcessorsContainerFactory_NilTxSelectionStrategy(t *testing.T) {
+	t.Parallel()
+
+	ppcm, err := NewPreProcessorsContainerFactory(
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.ChainStorerMock{},
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		mock.NewPoolsHolderMock(),
+		&mock.AddressConverterMock{},
+		&mock.AccountsStub{},
+		&mock.RequestHandlerMock{},
+		&mock.TxProcessorMock{},
+		&mock.SCProcessorMock{},
+		&mock.SmartContractResultsProcessorMock{},
+		&mock.RewardTxProcessorMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.FeeHandlerStub{},
+		&mock.MiniBlocksCompacterMock{},
+		&mock.GasHandlerMock{},
+		nil,
+	)
+
+	assert.Equal(t, process.ErrNilTxSelectionStrategy, err)
+	assert.Nil(t, ppcm)
+}
+
 func TestNewPreProcessorsContainerFactory(t *testing.T) {
 	t.Parallel()
 
@@ -445,6 +489,7 @@ func TestNewPreProcessorsContainerFactory(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, err)
@@ -475,6 +520,7 @@ func TestPreProcessorsContainerFactory_CreateErrTxPreproc(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, err)
@@ -515,6 +561,7 @@ func TestPreProcessorsContainerFactory_CreateErrScrPreproc(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, err)
@@ -564,6 +611,7 @@ func TestPreProcessorsContainerFactory_Create(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.MiniBlocksCompacterMock{},
 		&mock.GasHandlerMock{},
+		preprocess.NewPoolOrderSelection(),
 	)
 
 	assert.Nil(t, err)
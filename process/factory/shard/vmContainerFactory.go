@@ -1,7 +1,12 @@
 package shard
 
 import (
+	"strconv"
+	"strings"
+	"sync"
+
 	arwen "github.com/ElrondNetwork/arwen-wasm-vm/arwen/context"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/containers"
@@ -13,7 +18,10 @@ type vmContainerFactory struct {
 	blockChainHookImpl *hooks.BlockChainHookImpl
 	cryptoHook         vmcommon.CryptoHook
 	blockGasLimit      uint64
-	gasSchedule        map[string]map[string]uint64
+
+	mutGasSchedule sync.RWMutex
+	gasSchedule    map[string]map[string]uint64
+	container      process.VirtualMachinesContainer
 }
 
 // NewVMContainerFactory is responsible for creating a new virtual machine factory object
@@ -54,14 +62,98 @@ func (vmf *vmContainerFactory) Create() (process.VirtualMachinesContainer, error
 		return nil, err
 	}
 
+	vmf.mutGasSchedule.Lock()
+	vmf.container = container
+	vmf.mutGasSchedule.Unlock()
+
 	return container, nil
 }
 
 func (vmf *vmContainerFactory) createArwenVM() (vmcommon.VMExecutionHandler, error) {
-	arwenVM, err := arwen.NewArwenVM(vmf.blockChainHookImpl, vmf.cryptoHook, factory.ArwenVirtualMachine, vmf.blockGasLimit, vmf.gasSchedule)
+	vmf.mutGasSchedule.RLock()
+	defer vmf.mutGasSchedule.RUnlock()
+
+	return vmf.createArwenVMWithGasSchedule(vmf.gasSchedule)
+}
+
+func (vmf *vmContainerFactory) createArwenVMWithGasSchedule(gasSchedule map[string]map[string]uint64) (vmcommon.VMExecutionHandler, error) {
+	arwenVM, err := arwen.NewArwenVM(vmf.blockChainHookImpl, vmf.cryptoHook, factory.ArwenVirtualMachine, vmf.blockGasLimit, gasSchedule)
 	return arwenVM, err
 }
 
+// ParameterValue returns the gas cost named as section.name in the gas schedule
+func (vmf *vmContainerFactory) ParameterValue(name string) (string, error) {
+	section, costName, err := splitGasCostName(name)
+	if err != nil {
+		return "", err
+	}
+
+	vmf.mutGasSchedule.RLock()
+	defer vmf.mutGasSchedule.RUnlock()
+
+	cost, ok := vmf.gasSchedule[section][costName]
+	if !ok {
+		return "", process.ErrUnknownParameter
+	}
+
+	return strconv.FormatUint(cost, 10), nil
+}
+
+// UpdateParameter changes the gas cost named as section.name in the gas schedule. The Arwen VM of the created
+// container is replaced by one using the new gas schedule
+func (vmf *vmContainerFactory) UpdateParameter(name string, value string) error {
+	section, costName, err := splitGasCostName(name)
+	if err != nil {
+		return err
+	}
+
+	cost, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || cost == 0 {
+		return process.ErrInvalidParameterValue
+	}
+
+	vmf.mutGasSchedule.Lock()
+	defer vmf.mutGasSchedule.Unlock()
+
+	if _, ok := vmf.gasSchedule[section][costName]; !ok {
+		return process.ErrUnknownParameter
+	}
+
+	gasSchedule := make(map[string]map[string]uint64, len(vmf.gasSchedule))
+	for sectionName, costs := range vmf.gasSchedule {
+		gasSchedule[sectionName] = make(map[string]uint64, len(costs))
+		for key, val := range costs {
+			gasSchedule[sectionName][key] = val
+		}
+	}
+	gasSchedule[section][costName] = cost
+
+	if vmf.container != nil {
+		arwenVM, errCreate := vmf.createArwenVMWithGasSchedule(gasSchedule)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		errCreate = vmf.container.Replace(factory.ArwenVirtualMachine, arwenVM)
+		if errCreate != nil {
+			return errCreate
+		}
+	}
+
+	vmf.gasSchedule = gasSchedule
+
+	return nil
+}
+
+func splitGasCostName(name string) (string, string, error) {
+	tokens := strings.Split(name, core.GovernanceNamespaceSeparator)
+	if len(tokens) != 2 {
+		return "", "", process.ErrUnknownParameter
+	}
+
+	return tokens[0], tokens[1], nil
+}
+
 // BlockChainHookImpl returns the created blockChainHookImpl
 func (vmf *vmContainerFactory) BlockChainHookImpl() process.BlockChainHookHandler {
 	return vmf.blockChainHookImpl
@@ -77,3 +77,64 @@ func TestVmContainerFactory_Create(t *testing.T) {
 	acc := vmf.BlockChainHookImpl()
 	assert.NotNil(t, acc)
 }
+
+func TestVmContainerFactory_ParameterValue(t *testing.T) {
+	t.Parallel()
+
+	vmf, _ := NewVMContainerFactory(
+		10000,
+		arwenConfig.MakeGasMap(1),
+		createMockVMAccountsArguments(),
+	)
+
+	value, err := vmf.ParameterValue("BaseOperationCost.StorePerByte")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", value)
+
+	_, err = vmf.ParameterValue("BaseOperationCost")
+	assert.Equal(t, process.ErrUnknownParameter, err)
+
+	_, err = vmf.ParameterValue("BaseOperationCost.Unknown")
+	assert.Equal(t, process.ErrUnknownParameter, err)
+}
+
+func TestVmContainerFactory_UpdateParameterInvalidValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vmf, _ := NewVMContainerFactory(
+		10000,
+		arwenConfig.MakeGasMap(1),
+		createMockVMAccountsArguments(),
+	)
+
+	err := vmf.UpdateParameter("BaseOperationCost.StorePerByte", "0")
+	assert.Equal(t, process.ErrInvalidParameterValue, err)
+
+	err = vmf.UpdateParameter("BaseOperationCost.StorePerByte", "ten")
+	assert.Equal(t, process.ErrInvalidParameterValue, err)
+
+	err = vmf.UpdateParameter("UnknownCost.StorePerByte", "10")
+	assert.Equal(t, process.ErrUnknownParameter, err)
+}
+
+func TestVmContainerFactory_UpdateParameterShouldReplaceTheArwenVM(t *testing.T) {
+	t.Parallel()
+
+	vmf, _ := NewVMContainerFactory(
+		10000,
+		arwenConfig.MakeGasMap(1),
+		createMockVMAccountsArguments(),
+	)
+	container, _ := vmf.Create()
+	oldVM, _ := container.Get(factory.ArwenVirtualMachine)
+
+	err := vmf.UpdateParameter("BaseOperationCost.StorePerByte", "10")
+	assert.Nil(t, err)
+
+	value, _ := vmf.ParameterValue("BaseOperationCost.StorePerByte")
+	assert.Equal(t, "10", value)
+
+	newVM, _ := container.Get(factory.ArwenVirtualMachine)
+	assert.NotNil(t, newVM)
+	assert.True(t, oldVM != newVM)
+}
@@ -23,4 +23,6 @@ type ArgInterceptedDataFactory struct {
 	BlockSigner      crypto.SingleSigner
 	AddrConv         state.AddressConverter
 	FeeHandler       process.FeeHandler
+	ChainID          []byte
+	MinTxVersion     uint32
 }
@@ -66,6 +66,8 @@ func createMockArgument() *ArgInterceptedDataFactory {
 		BlockSigner:      createMockSigner(),
 		AddrConv:         createMockAddressConverter(),
 		FeeHandler:       createMockFeeHandler(),
+		ChainID:          []byte("chainID"),
+		MinTxVersion:     1,
 	}
 }
 
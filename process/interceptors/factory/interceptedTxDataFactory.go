@@ -19,6 +19,8 @@ type interceptedTxDataFactory struct {
 	addrConverter    state.AddressConverter
 	shardCoordinator sharding.Coordinator
 	feeHandler       process.FeeHandler
+	chainID          []byte
+	minTxVersion     uint32
 }
 
 // NewInterceptedTxDataFactory creates an instance of interceptedTxDataFactory
@@ -47,6 +49,9 @@ func NewInterceptedTxDataFactory(argument *ArgInterceptedDataFactory) (*intercep
 	if check.IfNil(argument.FeeHandler) {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if len(argument.ChainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
 
 	return &interceptedTxDataFactory{
 		marshalizer:      argument.Marshalizer,
@@ -56,6 +61,8 @@ func NewInterceptedTxDataFactory(argument *ArgInterceptedDataFactory) (*intercep
 		addrConverter:    argument.AddrConv,
 		shardCoordinator: argument.ShardCoordinator,
 		feeHandler:       argument.FeeHandler,
+		chainID:          argument.ChainID,
+		minTxVersion:     argument.MinTxVersion,
 	}, nil
 }
 
@@ -70,6 +77,8 @@ func (itdf *interceptedTxDataFactory) Create(buff []byte) (process.InterceptedDa
 		itdf.addrConverter,
 		itdf.shardCoordinator,
 		itdf.feeHandler,
+		itdf.chainID,
+		itdf.minTxVersion,
 	)
 }
 
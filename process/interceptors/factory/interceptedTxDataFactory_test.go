@@ -97,6 +97,17 @@ func TestNewInterceptedTxDataFactory_NilEconomicsFeeHandlerShouldErr(t *testing.
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
+func TestNewInterceptedTxDataFactory_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.ChainID = nil
+
+	imh, err := NewInterceptedTxDataFactory(arg)
+	assert.Nil(t, imh)
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
 func TestInterceptedTxDataFactory_ShouldWorkAndCreate(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,134 @@
+package interceptors
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// sigJob is a signature waiting to be verified, along with the position of its intercepted data in the bulk
+type sigJob struct {
+	index     int
+	publicKey crypto.PublicKey
+	message   []byte
+	signature []byte
+}
+
+// parallelSigVerifier checks the fields of the intercepted data sequentially and then verifies their signatures
+// one by one, split in chunks on a bounded set of workers. The workers are shared by all the bulks checked at the
+// same time, so a single verifier should be used by all the interceptors. The intercepted data which can not
+// provide its signature apart from its other fields is checked individually
+type parallelSigVerifier struct {
+	singleSigner crypto.SingleSigner
+	chunkSize    int
+	workerSlots  chan struct{}
+}
+
+// NewParallelSigVerifier creates a verifier which uses at most numWorkers go routines, overall, to verify the
+// signatures of the received bulks, each of them verifying chunkSize signatures at a time
+func NewParallelSigVerifier(singleSigner crypto.SingleSigner, numWorkers int, chunkSize int) (*parallelSigVerifier, error) {
+	if check.IfNil(singleSigner) {
+		return nil, process.ErrNilSingleSigner
+	}
+	if numWorkers < 1 {
+		return nil, process.ErrInvalidNumWorkers
+	}
+	if chunkSize < 1 {
+		return nil, process.ErrInvalidChunkSize
+	}
+
+	return &parallelSigVerifier{
+		singleSigner: singleSigner,
+		chunkSize:    chunkSize,
+		workerSlots:  make(chan struct{}, numWorkers),
+	}, nil
+}
+
+// CheckValidity returns the validity check result of each of the provided intercepted data
+func (psv *parallelSigVerifier) CheckValidity(interceptedData []process.InterceptedData) []error {
+	errs := make([]error, len(interceptedData))
+	jobs := make([]*sigJob, 0, len(interceptedData))
+
+	for idx, data := range interceptedData {
+		job, err := createSigJob(idx, data)
+		if err != nil {
+			errs[idx] = err
+			continue
+		}
+		if job == nil {
+			// fallback for data which can not be verified apart
+			errs[idx] = data.CheckValidity()
+			continue
+		}
+
+		jobs = append(jobs, job)
+	}
+
+	psv.verifySignatures(jobs, errs)
+
+	return errs
+}
+
+func createSigJob(index int, data process.InterceptedData) (*sigJob, error) {
+	signedData, ok := data.(process.InterceptedSignedData)
+	if !ok {
+		return nil, nil
+	}
+
+	err := signedData.CheckValidityWithoutSignature()
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, message, signature, err := signedData.SignedMessage()
+	if err == process.ErrNoSingleSignature {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &sigJob{
+		index:     index,
+		publicKey: publicKey,
+		message:   message,
+		signature: signature,
+	}, nil
+}
+
+// verifySignatures splits the jobs in chunks and starts a worker for each of them as soon as a worker slot is free.
+// Each job writes its result on its own position in errs so no synchronization is needed on the slice
+func (psv *parallelSigVerifier) verifySignatures(jobs []*sigJob, errs []error) {
+	wg := &sync.WaitGroup{}
+	for start := 0; start < len(jobs); start += psv.chunkSize {
+		end := start + psv.chunkSize
+		if end > len(jobs) {
+			end = len(jobs)
+		}
+
+		psv.workerSlots <- struct{}{}
+		wg.Add(1)
+		go func(chunk []*sigJob) {
+			psv.verifyChunk(chunk, errs)
+			<-psv.workerSlots
+			wg.Done()
+		}(jobs[start:end])
+	}
+	wg.Wait()
+}
+
+func (psv *parallelSigVerifier) verifyChunk(chunk []*sigJob, errs []error) {
+	for _, job := range chunk {
+		errs[job.index] = psv.singleSigner.Verify(job.publicKey, job.message, job.signature)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (psv *parallelSigVerifier) IsInterfaceNil() bool {
+	if psv == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,278 @@
+package interceptors_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+var errInvalidSig = errors.New("invalid signature")
+
+func createSignedData(message []byte) *mock.InterceptedSignedDataStub {
+	return &mock.InterceptedSignedDataStub{
+		InterceptedDataStub: mock.InterceptedDataStub{
+			CheckValidityCalled: func() error {
+				return errors.New("should have not been called")
+			},
+		},
+		CheckValidityWithoutSignatureCalled: func() error {
+			return nil
+		},
+		SignedMessageCalled: func() (crypto.PublicKey, []byte, []byte, error) {
+			return nil, message, message, nil
+		},
+	}
+}
+
+func createSignerMock(numVerified *int32) *mock.SignerMock {
+	return &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			atomic.AddInt32(numVerified, 1)
+			if bytes.Equal(msg, []byte("bad")) {
+				return errInvalidSig
+			}
+			return nil
+		},
+	}
+}
+
+func TestNewParallelSigVerifier_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	psv, err := interceptors.NewParallelSigVerifier(nil, 1, 1)
+
+	assert.True(t, check.IfNil(psv))
+	assert.Equal(t, process.ErrNilSingleSigner, err)
+}
+
+func TestNewParallelSigVerifier_InvalidNumWorkersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	psv, err := interceptors.NewParallelSigVerifier(&mock.SignerMock{}, 0, 1)
+
+	assert.True(t, check.IfNil(psv))
+	assert.Equal(t, process.ErrInvalidNumWorkers, err)
+}
+
+func TestNewParallelSigVerifier_InvalidChunkSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	psv, err := interceptors.NewParallelSigVerifier(&mock.SignerMock{}, 1, 0)
+
+	assert.True(t, check.IfNil(psv))
+	assert.Equal(t, process.ErrInvalidChunkSize, err)
+}
+
+func TestParallelSigVerifier_CheckValidityShouldVerifyAllSignatures(t *testing.T) {
+	t.Parallel()
+
+	numVerified := int32(0)
+	psv, _ := interceptors.NewParallelSigVerifier(createSignerMock(&numVerified), 3, 2)
+
+	interceptedData := make([]process.InterceptedData, 0)
+	for i := 0; i < 11; i++ {
+		message := []byte(fmt.Sprintf("good %d", i))
+		if i == 4 || i == 9 {
+			message = []byte("bad")
+		}
+		interceptedData = append(interceptedData, createSignedData(message))
+	}
+
+	errs := psv.CheckValidity(interceptedData)
+
+	assert.Equal(t, int32(11), atomic.LoadInt32(&numVerified))
+	assert.Equal(t, 11, len(errs))
+	for i, err := range errs {
+		if i == 4 || i == 9 {
+			assert.Equal(t, errInvalidSig, err)
+			continue
+		}
+		assert.Nil(t, err)
+	}
+}
+
+func TestParallelSigVerifier_CheckValidityShouldNotVerifySignatureOfInvalidData(t *testing.T) {
+	t.Parallel()
+
+	numVerified := int32(0)
+	psv, _ := interceptors.NewParallelSigVerifier(createSignerMock(&numVerified), 2, 2)
+
+	errIntegrity := errors.New("integrity error")
+	errSignedMessage := errors.New("signed message error")
+	invalidData := createSignedData([]byte("good"))
+	invalidData.CheckValidityWithoutSignatureCalled = func() error {
+		return errIntegrity
+	}
+	noSignedMessageData := createSignedData([]byte("good"))
+	noSignedMessageData.SignedMessageCalled = func() (crypto.PublicKey, []byte, []byte, error) {
+		return nil, nil, nil, errSignedMessage
+	}
+
+	errs := psv.CheckValidity([]process.InterceptedData{invalidData, noSignedMessageData, createSignedData([]byte("good"))})
+
+	assert.Equal(t, []error{errIntegrity, errSignedMessage, nil}, errs)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numVerified))
+}
+
+func TestParallelSigVerifier_CheckValidityShouldFallbackForNotSignedData(t *testing.T) {
+	t.Parallel()
+
+	numVerified := int32(0)
+	psv, _ := interceptors.NewParallelSigVerifier(createSignerMock(&numVerified), 2, 2)
+
+	errValidity := errors.New("validity error")
+	notSignedData := &mock.InterceptedDataStub{
+		CheckValidityCalled: func() error {
+			return errValidity
+		},
+	}
+
+	errs := psv.CheckValidity([]process.InterceptedData{notSignedData, createSignedData([]byte("good"))})
+
+	assert.Equal(t, []error{errValidity, nil}, errs)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numVerified))
+}
+
+func TestParallelSigVerifier_CheckValidityShouldFallbackForSignatureSets(t *testing.T) {
+	t.Parallel()
+
+	numVerified := int32(0)
+	psv, _ := interceptors.NewParallelSigVerifier(createSignerMock(&numVerified), 2, 2)
+
+	errValidity := errors.New("validity error")
+	signatureSetData := createSignedData(nil)
+	signatureSetData.CheckValidityCalled = func() error {
+		return errValidity
+	}
+	signatureSetData.SignedMessageCalled = func() (crypto.PublicKey, []byte, []byte, error) {
+		return nil, nil, nil, process.ErrNoSingleSignature
+	}
+
+	errs := psv.CheckValidity([]process.InterceptedData{signatureSetData, createSignedData([]byte("good"))})
+
+	assert.Equal(t, []error{errValidity, nil}, errs)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numVerified))
+}
+
+func TestParallelSigVerifier_CheckValidityShouldShareTheWorkersBetweenBulks(t *testing.T) {
+	t.Parallel()
+
+	numWorkers := 2
+	activeWorkers := int32(0)
+	maxActiveWorkers := int32(0)
+	signer := &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			active := atomic.AddInt32(&activeWorkers, 1)
+			for {
+				maxActive := atomic.LoadInt32(&maxActiveWorkers)
+				if active <= maxActive || atomic.CompareAndSwapInt32(&maxActiveWorkers, maxActive, active) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&activeWorkers, -1)
+			return nil
+		},
+	}
+	psv, _ := interceptors.NewParallelSigVerifier(signer, numWorkers, 1)
+
+	wg := &sync.WaitGroup{}
+	numBulks := 4
+	wg.Add(numBulks)
+	for i := 0; i < numBulks; i++ {
+		go func() {
+			interceptedData := make([]process.InterceptedData, 0)
+			for j := 0; j < 10; j++ {
+				interceptedData = append(interceptedData, createSignedData([]byte("good")))
+			}
+			_ = psv.CheckValidity(interceptedData)
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+
+	assert.True(t, atomic.LoadInt32(&maxActiveWorkers) <= int32(numWorkers))
+}
+
+func TestSequentialVerifier_CheckValidityShouldCallEachData(t *testing.T) {
+	t.Parallel()
+
+	errValidity := errors.New("validity error")
+	sv := interceptors.NewSequentialVerifier()
+
+	errs := sv.CheckValidity([]process.InterceptedData{
+		&mock.InterceptedDataStub{
+			CheckValidityCalled: func() error {
+				return errValidity
+			},
+		},
+		&mock.InterceptedDataStub{
+			CheckValidityCalled: func() error {
+				return nil
+			},
+		},
+	})
+
+	assert.False(t, check.IfNil(sv))
+	assert.Equal(t, []error{errValidity, nil}, errs)
+}
+
+func createSchnorrSignedBulk(numTxs int) []process.InterceptedData {
+	keyGen := signing.NewKeyGenerator(kyber.NewBlakeSHA256Ed25519())
+	signer := &singlesig.SchnorrSigner{}
+
+	interceptedData := make([]process.InterceptedData, 0, numTxs)
+	for i := 0; i < numTxs; i++ {
+		sk, pk := keyGen.GeneratePair()
+		message := []byte(fmt.Sprintf("transaction %d", i))
+		signature, _ := signer.Sign(sk, message)
+
+		interceptedData = append(interceptedData, &mock.InterceptedSignedDataStub{
+			CheckValidityWithoutSignatureCalled: func() error {
+				return nil
+			},
+			SignedMessageCalled: func() (crypto.PublicKey, []byte, []byte, error) {
+				return pk, message, signature, nil
+			},
+		})
+	}
+
+	return interceptedData
+}
+
+func BenchmarkParallelSigVerifier_CheckValidity(b *testing.B) {
+	interceptedData := createSchnorrSignedBulk(1000)
+	psv, _ := interceptors.NewParallelSigVerifier(&singlesig.SchnorrSigner{}, 8, 100)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = psv.CheckValidity(interceptedData)
+	}
+}
+
+func BenchmarkSequentialSigVerification(b *testing.B) {
+	interceptedData := createSchnorrSignedBulk(1000)
+	signer := &singlesig.SchnorrSigner{}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, data := range interceptedData {
+			pk, message, signature, _ := data.(process.InterceptedSignedData).SignedMessage()
+			_ = signer.Verify(pk, message, signature)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package interceptors
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// sequentialVerifier checks the validity of the intercepted data one by one
+type sequentialVerifier struct {
+}
+
+// NewSequentialVerifier creates a verifier which calls CheckValidity on each of the intercepted data
+func NewSequentialVerifier() *sequentialVerifier {
+	return &sequentialVerifier{}
+}
+
+// CheckValidity returns the validity check result of each of the provided intercepted data
+func (sv *sequentialVerifier) CheckValidity(interceptedData []process.InterceptedData) []error {
+	errs := make([]error, len(interceptedData))
+	for idx, data := range interceptedData {
+		errs[idx] = data.CheckValidity()
+	}
+
+	return errs
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sv *sequentialVerifier) IsInterfaceNil() bool {
+	if sv == nil {
+		return true
+	}
+	return false
+}
@@ -5,8 +5,10 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -57,9 +59,12 @@ type TxValidator interface {
 // TxValidatorHandler defines the functionality that is needed for a TxValidator to validate a transaction
 type TxValidatorHandler interface {
 	SenderShardId() uint32
+	ReceiverShardId() uint32
 	Nonce() uint64
 	SenderAddress() state.AddressContainer
 	TotalValue() *big.Int
+	Hash() []byte
+	Transaction() data.TransactionHandler
 }
 
 // HdrValidatorHandler defines the functionality that is needed for a HdrValidator to validate a header
@@ -88,6 +93,30 @@ type InterceptedData interface {
 	Hash() []byte
 }
 
+// InterceptedSignedData is intercepted data which allows its signature to be verified apart from its other fields,
+// so the signatures of more intercepted data can be verified together
+type InterceptedSignedData interface {
+	InterceptedData
+	CheckValidityWithoutSignature() error
+	SignedMessage() (crypto.PublicKey, []byte, []byte, error)
+}
+
+// SignatureSetHandler checks that a transaction is signed as required by its sender account, as the transactions of
+// a multisignature account must carry a signature set reaching the account's threshold, and decodes the
+// registrations of the multisignature accounts
+type SignatureSetHandler interface {
+	VerifySignatureSet(tx *transaction.Transaction, account *state.Account) error
+	ParseMultiSigRegistration(data string) (uint32, [][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// InterceptedDataVerifier checks the validity of all the intercepted data received in a bulk. The returned slice
+// holds the validity check result for each of the provided intercepted data, in the same order
+type InterceptedDataVerifier interface {
+	CheckValidity(interceptedData []InterceptedData) []error
+	IsInterfaceNil() bool
+}
+
 // InterceptorProcessor further validates and saves received data
 type InterceptorProcessor interface {
 	Validate(data InterceptedData) error
@@ -369,6 +398,7 @@ type DataPacker interface {
 type RequestHandler interface {
 	RequestHeaderByNonce(shardId uint32, nonce uint64)
 	RequestTransaction(shardId uint32, txHashes [][]byte)
+	RequestTransactionsBySenderNonces(shardId uint32, sender []byte, nonces []uint64)
 	RequestUnsignedTransactions(destShardID uint32, scrHashes [][]byte)
 	RequestRewardTransactions(destShardID uint32, txHashes [][]byte)
 	RequestMiniBlock(shardId uint32, miniblockHash []byte)
@@ -395,6 +425,7 @@ type TemporaryAccountsHandler interface {
 	AddTempAccount(address []byte, balance *big.Int, nonce uint64)
 	CleanTempAccounts()
 	TempAccount(address []byte) state.AccountHandler
+	SetDeployAddress(address []byte)
 	IsInterfaceNil() bool
 }
 
@@ -428,15 +459,53 @@ type RewardsHandler interface {
 type ValidatorSettingsHandler interface {
 	UnBoundPeriod() uint64
 	StakeValue() *big.Int
+	BurnAddress() string
+	IsInterfaceNil() bool
+}
+
+// ESDTSettingsHandler defines the functionality which is needed for the fungible tokens' settings
+type ESDTSettingsHandler interface {
+	BaseIssuingCost() *big.Int
+	IsInterfaceNil() bool
+}
+
+// GovernanceSettingsHandler defines the functionality which is needed for the on-chain governance settings
+type GovernanceSettingsHandler interface {
+	VotingPeriod() uint64
+	MinQuorum() *big.Int
+	IsInterfaceNil() bool
+}
+
+// ParametersUpdater is able to change, at runtime, the value of the protocol parameters it holds
+type ParametersUpdater interface {
+	ParameterValue(name string) (string, error)
+	UpdateParameter(name string, value string) error
 	IsInterfaceNil() bool
 }
 
+// GovernanceChangesApplier applies the governance changes carried by the metablocks once the block notarizing them
+// is committed, and restores the parameters as they were after a given block when the state is reverted to it
+type GovernanceChangesApplier interface {
+	ApplyGovernanceChanges(blockNonce uint64, changes []block.GovernanceData)
+	RestoreGovernanceChanges(nonce uint64) error
+	IsInterfaceNil() bool
+}
+
+// GovernanceChangesHandler creates and verifies the governance changes a metablock carries, from the changes
+// accepted in the governance smart contract state
+type GovernanceChangesHandler interface {
+	GovernanceChangesApplier
+	GovernanceChanges(nonce uint64) ([]block.GovernanceData, error)
+	VerifyGovernanceChanges(nonce uint64, changes []block.GovernanceData) error
+}
+
 // FeeHandler is able to perform some economics calculation on a provided transaction
 type FeeHandler interface {
 	MaxGasLimitPerBlock() uint64
 	ComputeGasLimit(tx TransactionWithFeeHandler) uint64
 	ComputeFee(tx TransactionWithFeeHandler) *big.Int
 	CheckValidityTxValues(tx TransactionWithFeeHandler) error
+	DeveloperPercentage() float64
 	IsInterfaceNil() bool
 }
 
@@ -474,6 +543,32 @@ type MiniBlocksCompacter interface {
 	IsInterfaceNil() bool
 }
 
+// TxCacheSelector defines a transactions cache able to select, without sorting all its content, the transactions
+// that should be included in a block
+type TxCacheSelector interface {
+	SelectTransactions(numRequested int) ([]data.TransactionHandler, [][]byte)
+}
+
+// TxSelectionStrategy decides which of the pending transactions are tried, and in which order, when a miniblock is
+// created. The transactions of a sender must be returned in the relative order they were provided, which is the
+// nonce order. computeGas returns the gas a transaction consumes in the self shard
+type TxSelectionStrategy interface {
+	SelectTxs(
+		txs []*transaction.Transaction,
+		txHashes [][]byte,
+		computeGas func(tx *transaction.Transaction) (uint64, error),
+		maxGas uint64,
+		maxTxs int,
+	) ([]*transaction.Transaction, [][]byte)
+	IsInterfaceNil() bool
+}
+
+// TxReplacementChecker defines a transactions cache that accepts a transaction having the sender and the nonce of a
+// pending one only if it pays enough to replace it
+type TxReplacementChecker interface {
+	CanAddTransaction(key []byte, tx data.TransactionHandler) bool
+}
+
 // BlackListHandler can determine if a certain key is or not blacklisted
 type BlackListHandler interface {
 	Add(key string) error
@@ -491,9 +586,15 @@ type NetworkConnectionWatcher interface {
 
 // SCQuery represents a prepared query for executing a function of the smart contract
 type SCQuery struct {
-	ScAddress []byte
-	FuncName  string
-	Arguments [][]byte
+	ScAddress  []byte
+	FuncName   string
+	CallerAddr []byte
+	CallValue  *big.Int
+	Arguments  [][]byte
+	// BlockNonce and BlockRootHash optionally select a past state the query is executed against.
+	// When both are provided, BlockRootHash takes precedence
+	BlockNonce    *uint64
+	BlockRootHash []byte
 }
 
 // GasHandler is able to perform some gas calculation
@@ -512,6 +613,25 @@ type GasHandler interface {
 	IsInterfaceNil() bool
 }
 
+// ReceiptsHandler collects the outcome of the transactions processed in the current block and saves it, along with
+// a bloom of the logs emitted in the block, when the block is committed
+type ReceiptsHandler interface {
+	AddReceipt(rcpt *receipt.Receipt)
+	CreateBlockStarted()
+	SaveReceiptsToStorage(headerHash []byte, txHashes [][]byte) error
+	RemoveReceiptsFromStorage(headerHash []byte, txHashes [][]byte) error
+	GetReceipt(txHash []byte) (*receipt.Receipt, error)
+	GetLogsBloom(headerHash []byte) (receipt.Bloom, error)
+	IsInterfaceNil() bool
+}
+
+// TxPoolsPersister saves the pending transactions when the node is stopped and restores them when it starts again
+type TxPoolsPersister interface {
+	SavePools() error
+	LoadPools() error
+	IsInterfaceNil() bool
+}
+
 // BootStorer is the interface needed by bootstrapper to read/write data in storage
 type BootStorer interface {
 	SaveLastRound(round int64) error
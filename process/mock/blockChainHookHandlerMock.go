@@ -11,6 +11,7 @@ type BlockChainHookHandlerMock struct {
 	CleanTempAccountsCalled func()
 	TempAccountCalled       func(address []byte) state.AccountHandler
 	SetCurrentHeaderCalled  func(hdr data.HeaderHandler)
+	SetDeployAddressCalled  func(address []byte)
 }
 
 func (e *BlockChainHookHandlerMock) AddTempAccount(address []byte, balance *big.Int, nonce uint64) {
@@ -44,3 +45,9 @@ func (e *BlockChainHookHandlerMock) SetCurrentHeader(hdr data.HeaderHandler) {
 		e.SetCurrentHeaderCalled(hdr)
 	}
 }
+
+func (e *BlockChainHookHandlerMock) SetDeployAddress(address []byte) {
+	if e.SetDeployAddressCalled != nil {
+		e.SetDeployAddressCalled(address)
+	}
+}
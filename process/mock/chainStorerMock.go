@@ -72,6 +72,11 @@ func (bc *ChainStorerMock) GetAll(unitType dataRetriever.UnitType, keys [][]byte
 	return nil, nil
 }
 
+// CloseAll closes all the storage units of the storage service
+func (bc *ChainStorerMock) CloseAll() error {
+	return nil
+}
+
 // Destroy removes the underlying files/resources used by the storage service
 func (bc *ChainStorerMock) Destroy() error {
 	if bc.DestroyCalled != nil {
@@ -0,0 +1,16 @@
+package mock
+
+type DoubleSigningVerifierStub struct {
+	VerifyDoubleSigningCalled func(firstMessage []byte, secondMessage []byte) ([]byte, uint64, error)
+}
+
+func (d *DoubleSigningVerifierStub) VerifyDoubleSigning(firstMessage []byte, secondMessage []byte) ([]byte, uint64, error) {
+	if d.VerifyDoubleSigningCalled != nil {
+		return d.VerifyDoubleSigningCalled(firstMessage, secondMessage)
+	}
+	return nil, 0, nil
+}
+
+func (d *DoubleSigningVerifierStub) IsInterfaceNil() bool {
+	return d == nil
+}
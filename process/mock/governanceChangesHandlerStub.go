@@ -0,0 +1,44 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/block"
+
+type GovernanceChangesHandlerStub struct {
+	ApplyGovernanceChangesCalled   func(blockNonce uint64, changes []block.GovernanceData)
+	GovernanceChangesCalled        func(nonce uint64) ([]block.GovernanceData, error)
+	VerifyGovernanceChangesCalled  func(nonce uint64, changes []block.GovernanceData) error
+	RestoreGovernanceChangesCalled func(nonce uint64) error
+}
+
+func (g *GovernanceChangesHandlerStub) ApplyGovernanceChanges(blockNonce uint64, changes []block.GovernanceData) {
+	if g.ApplyGovernanceChangesCalled != nil {
+		g.ApplyGovernanceChangesCalled(blockNonce, changes)
+	}
+}
+
+func (g *GovernanceChangesHandlerStub) GovernanceChanges(nonce uint64) ([]block.GovernanceData, error) {
+	if g.GovernanceChangesCalled != nil {
+		return g.GovernanceChangesCalled(nonce)
+	}
+	return make([]block.GovernanceData, 0), nil
+}
+
+func (g *GovernanceChangesHandlerStub) VerifyGovernanceChanges(nonce uint64, changes []block.GovernanceData) error {
+	if g.VerifyGovernanceChangesCalled != nil {
+		return g.VerifyGovernanceChangesCalled(nonce, changes)
+	}
+	return nil
+}
+
+func (g *GovernanceChangesHandlerStub) RestoreGovernanceChanges(nonce uint64) error {
+	if g.RestoreGovernanceChangesCalled != nil {
+		return g.RestoreGovernanceChangesCalled(nonce)
+	}
+	return nil
+}
+
+func (g *GovernanceChangesHandlerStub) IsInterfaceNil() bool {
+	if g == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+type InterceptedSignedDataStub struct {
+	InterceptedDataStub
+	CheckValidityWithoutSignatureCalled func() error
+	SignedMessageCalled                 func() (crypto.PublicKey, []byte, []byte, error)
+}
+
+func (isds *InterceptedSignedDataStub) CheckValidityWithoutSignature() error {
+	return isds.CheckValidityWithoutSignatureCalled()
+}
+
+func (isds *InterceptedSignedDataStub) SignedMessage() (crypto.PublicKey, []byte, []byte, error) {
+	return isds.SignedMessageCalled()
+}
+
+func (isds *InterceptedSignedDataStub) IsInterfaceNil() bool {
+	if isds == nil {
+		return true
+	}
+	return false
+}
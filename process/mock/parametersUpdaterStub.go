@@ -0,0 +1,24 @@
+package mock
+
+type ParametersUpdaterStub struct {
+	ParameterValueCalled  func(name string) (string, error)
+	UpdateParameterCalled func(name string, value string) error
+}
+
+func (pus *ParametersUpdaterStub) ParameterValue(name string) (string, error) {
+	if pus.ParameterValueCalled != nil {
+		return pus.ParameterValueCalled(name)
+	}
+	return "", nil
+}
+
+func (pus *ParametersUpdaterStub) UpdateParameter(name string, value string) error {
+	if pus.UpdateParameterCalled != nil {
+		return pus.UpdateParameterCalled(name, value)
+	}
+	return nil
+}
+
+func (pus *ParametersUpdaterStub) IsInterfaceNil() bool {
+	return pus == nil
+}
@@ -0,0 +1,62 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+)
+
+type ReceiptsHandlerStub struct {
+	AddReceiptCalled            func(rcpt *receipt.Receipt)
+	CreateBlockStartedCalled    func()
+	SaveReceiptsToStorageCalled     func(headerHash []byte, txHashes [][]byte) error
+	RemoveReceiptsFromStorageCalled func(headerHash []byte, txHashes [][]byte) error
+	GetReceiptCalled                func(txHash []byte) (*receipt.Receipt, error)
+	GetLogsBloomCalled              func(headerHash []byte) (receipt.Bloom, error)
+}
+
+func (rhs *ReceiptsHandlerStub) AddReceipt(rcpt *receipt.Receipt) {
+	if rhs.AddReceiptCalled != nil {
+		rhs.AddReceiptCalled(rcpt)
+	}
+}
+
+func (rhs *ReceiptsHandlerStub) CreateBlockStarted() {
+	if rhs.CreateBlockStartedCalled != nil {
+		rhs.CreateBlockStartedCalled()
+	}
+}
+
+func (rhs *ReceiptsHandlerStub) SaveReceiptsToStorage(headerHash []byte, txHashes [][]byte) error {
+	if rhs.SaveReceiptsToStorageCalled != nil {
+		return rhs.SaveReceiptsToStorageCalled(headerHash, txHashes)
+	}
+	return nil
+}
+
+func (rhs *ReceiptsHandlerStub) RemoveReceiptsFromStorage(headerHash []byte, txHashes [][]byte) error {
+	if rhs.RemoveReceiptsFromStorageCalled != nil {
+		return rhs.RemoveReceiptsFromStorageCalled(headerHash, txHashes)
+	}
+	return nil
+}
+
+func (rhs *ReceiptsHandlerStub) GetReceipt(txHash []byte) (*receipt.Receipt, error) {
+	if rhs.GetReceiptCalled != nil {
+		return rhs.GetReceiptCalled(txHash)
+	}
+	return nil, nil
+}
+
+func (rhs *ReceiptsHandlerStub) GetLogsBloom(headerHash []byte) (receipt.Bloom, error) {
+	if rhs.GetLogsBloomCalled != nil {
+		return rhs.GetLogsBloomCalled(headerHash)
+	}
+	return nil, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rhs *ReceiptsHandlerStub) IsInterfaceNil() bool {
+	if rhs == nil {
+		return true
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package mock
 
 type RequestHandlerMock struct {
 	RequestTransactionHandlerCalled   func(destShardID uint32, txHashes [][]byte)
+	RequestTxsBySenderNoncesCalled    func(destShardID uint32, sender []byte, nonces []uint64)
 	RequestScrHandlerCalled           func(destShardID uint32, txHashes [][]byte)
 	RequestRewardTxHandlerCalled      func(destShardID uint32, txHashes [][]byte)
 	RequestMiniBlockHandlerCalled     func(destShardID uint32, miniblockHash []byte)
@@ -16,6 +17,13 @@ func (rrh *RequestHandlerMock) RequestTransaction(destShardID uint32, txHashes [
 	rrh.RequestTransactionHandlerCalled(destShardID, txHashes)
 }
 
+func (rrh *RequestHandlerMock) RequestTransactionsBySenderNonces(destShardID uint32, sender []byte, nonces []uint64) {
+	if rrh.RequestTxsBySenderNoncesCalled == nil {
+		return
+	}
+	rrh.RequestTxsBySenderNoncesCalled(destShardID, sender, nonces)
+}
+
 func (rrh *RequestHandlerMock) RequestUnsignedTransactions(destShardID uint32, txHashes [][]byte) {
 	if rrh.RequestScrHandlerCalled == nil {
 		return
@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+type SignatureSetHandlerStub struct {
+	VerifySignatureSetCalled        func(tx *transaction.Transaction, account *state.Account) error
+	ParseMultiSigRegistrationCalled func(data string) (uint32, [][]byte, error)
+}
+
+func (sshs *SignatureSetHandlerStub) VerifySignatureSet(tx *transaction.Transaction, account *state.Account) error {
+	if sshs.VerifySignatureSetCalled == nil {
+		return nil
+	}
+
+	return sshs.VerifySignatureSetCalled(tx, account)
+}
+
+func (sshs *SignatureSetHandlerStub) ParseMultiSigRegistration(data string) (uint32, [][]byte, error) {
+	if sshs.ParseMultiSigRegistrationCalled == nil {
+		return 0, nil, nil
+	}
+
+	return sshs.ParseMultiSigRegistrationCalled(data)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sshs *SignatureSetHandlerStub) IsInterfaceNil() bool {
+	if sshs == nil {
+		return true
+	}
+	return false
+}
@@ -10,8 +10,9 @@ type SingleSignKeyGenMock struct {
 }
 
 type SingleSignPublicKey struct {
-	SuiteCalled func() crypto.Suite
-	PointCalled func() crypto.Point
+	ToByteArrayCalled func() ([]byte, error)
+	SuiteCalled       func() crypto.Suite
+	PointCalled       func() crypto.Point
 }
 
 //------- SingleSignKeyGenMock
@@ -43,6 +44,9 @@ func (sskgm *SingleSignKeyGenMock) IsInterfaceNil() bool {
 //------- SingleSignPublicKey
 
 func (sspk *SingleSignPublicKey) ToByteArray() ([]byte, error) {
+	if sspk.ToByteArrayCalled != nil {
+		return sspk.ToByteArrayCalled()
+	}
 	panic("implement me")
 }
 
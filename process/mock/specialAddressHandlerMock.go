@@ -72,11 +72,16 @@ func (sh *SpecialAddressHandlerMock) SetMetaConsensusData(randomness []byte, rou
 	if err != nil {
 		return err
 	}
+	pubKeys, err := sh.NodesCoordinator.GetValidatorsPublicKeys(randomness, round, sharding.MetachainShardId)
+	if err != nil {
+		return err
+	}
 
 	sh.metaConsensusData = append(sh.metaConsensusData, &data.ConsensusRewardData{
 		Round:     round,
 		Epoch:     epoch,
 		Addresses: addresses,
+		PubKeys:   pubKeys,
 	})
 
 	return nil
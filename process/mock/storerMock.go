@@ -49,6 +49,10 @@ func (sm *StorerMock) Remove(key []byte) error {
 func (sm *StorerMock) ClearCache() {
 }
 
+func (sm *StorerMock) Close() error {
+	return nil
+}
+
 func (sm *StorerMock) DestroyUnit() error {
 	return nil
 }
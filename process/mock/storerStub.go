@@ -29,6 +29,10 @@ func (ss *StorerStub) ClearCache() {
 	ss.ClearCacheCalled()
 }
 
+func (ss *StorerStub) Close() error {
+	return nil
+}
+
 func (ss *StorerStub) DestroyUnit() error {
 	return ss.DestroyUnitCalled()
 }
@@ -10,6 +10,7 @@ type TemporaryAccountsHandlerMock struct {
 	AddTempAccountCalled    func(address []byte, balance *big.Int, nonce uint64)
 	CleanTempAccountsCalled func()
 	TempAccountCalled       func(address []byte) state.AccountHandler
+	SetDeployAddressCalled  func(address []byte)
 }
 
 func (tahm *TemporaryAccountsHandlerMock) AddTempAccount(address []byte, balance *big.Int, nonce uint64) {
@@ -36,6 +37,14 @@ func (tahm *TemporaryAccountsHandlerMock) TempAccount(address []byte) state.Acco
 	return tahm.TempAccountCalled(address)
 }
 
+func (tahm *TemporaryAccountsHandlerMock) SetDeployAddress(address []byte) {
+	if tahm.SetDeployAddressCalled == nil {
+		return
+	}
+
+	tahm.SetDeployAddressCalled(address)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tahm *TemporaryAccountsHandlerMock) IsInterfaceNil() bool {
 	if tahm == nil {
@@ -3,20 +3,28 @@ package mock
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 )
 
 type TxValidatorHandlerStub struct {
-	SenderShardIdCalled func() uint32
-	NonceCalled         func() uint64
-	SenderAddressCalled func() state.AddressContainer
-	TotalValueCalled    func() *big.Int
+	SenderShardIdCalled   func() uint32
+	ReceiverShardIdCalled func() uint32
+	NonceCalled           func() uint64
+	SenderAddressCalled   func() state.AddressContainer
+	TotalValueCalled      func() *big.Int
+	HashCalled            func() []byte
+	TransactionCalled     func() data.TransactionHandler
 }
 
 func (tvhs *TxValidatorHandlerStub) SenderShardId() uint32 {
 	return tvhs.SenderShardIdCalled()
 }
 
+func (tvhs *TxValidatorHandlerStub) ReceiverShardId() uint32 {
+	return tvhs.ReceiverShardIdCalled()
+}
+
 func (tvhs *TxValidatorHandlerStub) Nonce() uint64 {
 	return tvhs.NonceCalled()
 }
@@ -28,3 +36,11 @@ func (tvhs *TxValidatorHandlerStub) SenderAddress() state.AddressContainer {
 func (tvhs *TxValidatorHandlerStub) TotalValue() *big.Int {
 	return tvhs.TotalValueCalled()
 }
+
+func (tvhs *TxValidatorHandlerStub) Hash() []byte {
+	return tvhs.HashCalled()
+}
+
+func (tvhs *TxValidatorHandlerStub) Transaction() data.TransactionHandler {
+	return tvhs.TransactionCalled()
+}
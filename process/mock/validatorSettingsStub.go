@@ -13,6 +13,10 @@ func (v *ValidatorSettingsStub) StakeValue() *big.Int {
 	return big.NewInt(10)
 }
 
+func (v *ValidatorSettingsStub) BurnAddress() string {
+	return "deadbeef"
+}
+
 func (v *ValidatorSettingsStub) IsInterfaceNil() bool {
 	return v == nil
 }
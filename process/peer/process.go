@@ -2,6 +2,7 @@ package peer
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"sync"
@@ -280,7 +281,7 @@ func (p *validatorStatistics) checkForMissedBlocks(
 			return err
 		}
 
-		leaderPeerAcc, err := p.getPeerAccount(consensusGroup[0].Address())
+		leaderPeerAcc, err := p.getValidatorPeerAccount(consensusGroup[0])
 		if err != nil {
 			return err
 		}
@@ -377,7 +378,12 @@ func (p *validatorStatistics) initializeNode(node *sharding.InitialNode, stakeVa
 }
 
 func (p *validatorStatistics) generatePeerAccount(node *sharding.InitialNode) (*state.PeerAccount, error) {
-	address, err := p.adrConv.CreateAddressFromHex(node.Address)
+	pubKey, err := hex.DecodeString(node.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := p.adrConv.CreateAddressFromPublicKeyBytes(pubKey)
 	if err != nil {
 		return nil, err
 	}
@@ -426,7 +432,7 @@ func (p *validatorStatistics) savePeerAccountData(
 func (p *validatorStatistics) updateValidatorInfo(validatorList []sharding.Validator, shardId uint32) error {
 	lenValidators := len(validatorList)
 	for i := 0; i < lenValidators; i++ {
-		peerAcc, err := p.getPeerAccount(validatorList[i].Address())
+		peerAcc, err := p.getValidatorPeerAccount(validatorList[i])
 		if err != nil {
 			return err
 		}
@@ -446,6 +452,81 @@ func (p *validatorStatistics) updateValidatorInfo(validatorList []sharding.Valid
 	return nil
 }
 
+// getValidatorPeerAccount returns the peer account of the validator, which is kept under its BLS public key
+func (p *validatorStatistics) getValidatorPeerAccount(validator sharding.Validator) (state.PeerAccountHandler, error) {
+	err := p.migrateLegacyPeerAccount(validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.getPeerAccount(validator.PubKey())
+}
+
+// migrateLegacyPeerAccount moves the data of a validator from the account under its wallet address, where it was
+// kept before the peer accounts were keyed by the BLS public key, to a new account under its BLS public key. The new
+// account is created through the journal, so reverting the block drops the migration as well. The legacy account
+// is left in the trie, as it can not be removed through the journal, and is not read once the migration is done
+func (p *validatorStatistics) migrateLegacyPeerAccount(validator sharding.Validator) error {
+	if len(validator.Address()) == 0 || bytes.Equal(validator.Address(), validator.PubKey()) {
+		return nil
+	}
+
+	address, err := p.adrConv.CreateAddressFromPublicKeyBytes(validator.PubKey())
+	if err != nil {
+		return err
+	}
+
+	exists, err := p.peerAdapter.HasAccount(address)
+	if err != nil || exists {
+		return err
+	}
+
+	legacyAddress, err := p.adrConv.CreateAddressFromPublicKeyBytes(validator.Address())
+	if err != nil {
+		return err
+	}
+
+	legacyAccount, err := p.peerAdapter.GetExistingAccount(legacyAddress)
+	if err == state.ErrAccNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	legacyPeerAccount, ok := legacyAccount.(*state.PeerAccount)
+	if !ok {
+		return process.ErrInvalidPeerAccount
+	}
+
+	account, err := p.peerAdapter.GetAccountWithJournal(address)
+	if err != nil {
+		return err
+	}
+
+	peerAccount, ok := account.(*state.PeerAccount)
+	if !ok {
+		return process.ErrInvalidPeerAccount
+	}
+
+	peerAccount.BLSPublicKey = legacyPeerAccount.BLSPublicKey
+	peerAccount.SchnorrPublicKey = legacyPeerAccount.SchnorrPublicKey
+	peerAccount.Address = legacyPeerAccount.Address
+	peerAccount.Stake = legacyPeerAccount.Stake
+	peerAccount.JailTime = legacyPeerAccount.JailTime
+	peerAccount.PastJailTimes = legacyPeerAccount.PastJailTimes
+	peerAccount.CurrentShardId = legacyPeerAccount.CurrentShardId
+	peerAccount.NextShardId = legacyPeerAccount.NextShardId
+	peerAccount.NodeInWaitingList = legacyPeerAccount.NodeInWaitingList
+	peerAccount.UnStakedNonce = legacyPeerAccount.UnStakedNonce
+	peerAccount.ValidatorSuccessRate = legacyPeerAccount.ValidatorSuccessRate
+	peerAccount.LeaderSuccessRate = legacyPeerAccount.LeaderSuccessRate
+	peerAccount.Nonce = legacyPeerAccount.Nonce
+
+	// the copied fields are saved together with the rating
+	return peerAccount.SetRatingWithJournal(legacyPeerAccount.Rating)
+}
+
 func (p *validatorStatistics) getPeerAccount(address []byte) (state.PeerAccountHandler, error) {
 	addressContainer, err := p.adrConv.CreateAddressFromPublicKeyBytes(address)
 	if err != nil {
@@ -41,6 +41,13 @@ func CreateMockArguments() peer.ArgValidatorStatisticsProcessor {
 				StakeValue:    "500",
 				UnBoundPeriod: "5",
 			},
+			ESDTSettings: config.ESDTSettings{
+				BaseIssuingCost: "1000",
+			},
+			GovernanceSettings: config.GovernanceSettings{
+				VotingPeriod: "1000",
+				MinQuorum:    "1000",
+			},
 		},
 	)
 
@@ -160,9 +167,9 @@ func TestValidatorStatisticsProcessor_SaveInitialStateErrOnInvalidNode(t *testin
 func TestValidatorStatisticsProcessor_SaveInitialStateErrOnWrongAddressConverter(t *testing.T) {
 	t.Parallel()
 
-	addressErr := errors.New("hex address error")
+	addressErr := errors.New("address error")
 	addressConverter := &mock.AddressConverterStub{
-		CreateAddressFromHexCalled: func(hexAddress string) (container state.AddressContainer, e error) {
+		CreateAddressFromPublicKeyBytesCalled: func(pubKey []byte) (container state.AddressContainer, e error) {
 			return nil, addressErr
 		},
 	}
@@ -187,7 +194,7 @@ func TestValidatorStatisticsProcessor_SaveInitialStateErrOnGetAccountFail(t *tes
 	}
 
 	addressConverter := &mock.AddressConverterStub{
-		CreateAddressFromHexCalled: func(hexAddress string) (container state.AddressContainer, e error) {
+		CreateAddressFromPublicKeyBytesCalled: func(pubKey []byte) (container state.AddressContainer, e error) {
 			return &mock.AddressMock{}, nil
 		},
 	}
@@ -211,7 +218,7 @@ func TestValidatorStatisticsProcessor_SaveInitialStateGetAccountReturnsInvalid(t
 	}
 
 	addressConverter := &mock.AddressConverterStub{
-		CreateAddressFromHexCalled: func(hexAddress string) (container state.AddressContainer, e error) {
+		CreateAddressFromPublicKeyBytesCalled: func(pubKey []byte) (container state.AddressContainer, e error) {
 			return &mock.AddressMock{}, nil
 		},
 	}
@@ -244,7 +251,7 @@ func TestValidatorStatisticsProcessor_SaveInitialStateSetAddressErrors(t *testin
 	}
 
 	addressConverter := &mock.AddressConverterStub{
-		CreateAddressFromHexCalled: func(hexAddress string) (container state.AddressContainer, e error) {
+		CreateAddressFromPublicKeyBytesCalled: func(pubKey []byte) (container state.AddressContainer, e error) {
 			return &mock.AddressMock{}, nil
 		},
 	}
@@ -280,7 +287,7 @@ func TestValidatorStatisticsProcessor_SaveInitialStateCommitErrors(t *testing.T)
 	}
 
 	addressConverter := &mock.AddressConverterStub{
-		CreateAddressFromHexCalled: func(hexAddress string) (container state.AddressContainer, e error) {
+		CreateAddressFromPublicKeyBytesCalled: func(pubKey []byte) (container state.AddressContainer, e error) {
 			return &mock.AddressMock{}, nil
 		},
 	}
@@ -314,7 +321,7 @@ func TestValidatorStatisticsProcessor_SaveInitialStateCommit(t *testing.T) {
 	}
 
 	addressConverter := &mock.AddressConverterStub{
-		CreateAddressFromHexCalled: func(hexAddress string) (container state.AddressContainer, e error) {
+		CreateAddressFromPublicKeyBytesCalled: func(pubKey []byte) (container state.AddressContainer, e error) {
 			return &mock.AddressMock{}, nil
 		},
 	}
@@ -858,6 +865,75 @@ func TestValidatorStatisticsProcessor_CheckForMissedBlocksErrOnGetPeerAcc(t *tes
 	assert.Equal(t, peerAccErr, err)
 }
 
+func TestValidatorStatisticsProcessor_CheckForMissedBlocksShouldMigrateLegacyPeerAccount(t *testing.T) {
+	t.Parallel()
+
+	blsKey := []byte("bls key")
+	walletAddress := []byte("wallet address")
+	tracker := &mock.AccountTrackerStub{
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			return nil
+		},
+		JournalizeCalled: func(entry state.JournalEntry) {
+		},
+	}
+	legacyAccount, _ := state.NewPeerAccount(mock.NewAddressMock(walletAddress), tracker)
+	legacyAccount.Address = walletAddress
+	legacyAccount.Stake = big.NewInt(500)
+	legacyAccount.LeaderSuccessRate = state.SignRate{NrSuccess: 7, NrFailure: 2}
+	legacyAccount.ValidatorSuccessRate = state.SignRate{NrSuccess: 11, NrFailure: 3}
+	legacyAccount.Rating = 42
+
+	accounts := map[string]state.AccountHandler{string(walletAddress): legacyAccount}
+	peerAdapter := getAccountsMock()
+	peerAdapter.HasAccountStateCalled = func(addressContainer state.AddressContainer) (bool, error) {
+		_, ok := accounts[string(addressContainer.Bytes())]
+		return ok, nil
+	}
+	peerAdapter.GetExistingAccountCalled = func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+		account, ok := accounts[string(addressContainer.Bytes())]
+		if !ok {
+			return nil, state.ErrAccNotFound
+		}
+		return account, nil
+	}
+	peerAdapter.GetAccountWithJournalCalled = func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+		account, ok := accounts[string(addressContainer.Bytes())]
+		if !ok {
+			account, _ = state.NewPeerAccount(addressContainer, tracker)
+			accounts[string(addressContainer.Bytes())] = account
+		}
+		return account, nil
+	}
+
+	arguments := CreateMockArguments()
+	arguments.NodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32) (validatorsGroup []sharding.Validator, err error) {
+			return []sharding.Validator{
+				mock.NewValidatorMock(big.NewInt(0), 0, blsKey, walletAddress),
+			}, nil
+		},
+	}
+	arguments.AdrConv = &mock.AddressConverterStub{
+		CreateAddressFromPublicKeyBytesCalled: func(pubKey []byte) (addressContainer state.AddressContainer, e error) {
+			return mock.NewAddressMock(pubKey), nil
+		},
+	}
+	arguments.PeerAdapter = peerAdapter
+
+	validatorStatistics, _ := peer.NewValidatorStatisticsProcessor(arguments)
+	err := validatorStatistics.CheckForMissedBlocks(3, 0, []byte("prev"), 0)
+	assert.Nil(t, err)
+
+	migratedAccount := accounts[string(blsKey)].(*state.PeerAccount)
+	assert.Equal(t, walletAddress, migratedAccount.Address)
+	assert.Equal(t, big.NewInt(500), migratedAccount.Stake)
+	assert.Equal(t, state.SignRate{NrSuccess: 7, NrFailure: 4}, migratedAccount.LeaderSuccessRate)
+	assert.Equal(t, state.SignRate{NrSuccess: 11, NrFailure: 3}, migratedAccount.ValidatorSuccessRate)
+	assert.Equal(t, uint32(42), migratedAccount.Rating)
+	assert.Equal(t, state.SignRate{NrSuccess: 7, NrFailure: 2}, legacyAccount.LeaderSuccessRate)
+}
+
 func TestValidatorStatisticsProcessor_CheckForMissedBlocksErrOnDecrease(t *testing.T) {
 	t.Parallel()
 
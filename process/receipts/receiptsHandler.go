@@ -0,0 +1,169 @@
+package receipts
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/logger"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("process/receipts")
+
+// logsBloomKeyPrefix separates the keys of the blocks logs blooms from the transaction hashes keying the receipts
+const logsBloomKeyPrefix = "logsBloom_"
+
+// ReceiptsHandler keeps the receipts of the transactions processed in the current block and writes them in the
+// receipts storage unit, keyed by transaction hash, when the block is committed. A bloom of the logs emitted in the
+// block is saved alongside, keyed by the block header hash
+type ReceiptsHandler struct {
+	storer      storage.Storer
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+
+	mutReceipts sync.Mutex
+	receipts    map[string]*receipt.Receipt
+}
+
+// NewReceiptsHandler creates a new receipts handler
+func NewReceiptsHandler(
+	storer storage.Storer,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (*ReceiptsHandler, error) {
+	if check.IfNil(storer) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, process.ErrNilHasher
+	}
+
+	return &ReceiptsHandler{
+		storer:      storer,
+		marshalizer: marshalizer,
+		hasher:      hasher,
+		receipts:    make(map[string]*receipt.Receipt),
+	}, nil
+}
+
+// AddReceipt keeps the receipt of a transaction processed in the current block. A transaction processed again in
+// the same block replaces its previous receipt
+func (rh *ReceiptsHandler) AddReceipt(rcpt *receipt.Receipt) {
+	if rcpt == nil {
+		return
+	}
+
+	rh.mutReceipts.Lock()
+	rh.receipts[string(rcpt.TxHash)] = rcpt
+	rh.mutReceipts.Unlock()
+}
+
+// CreateBlockStarted drops the receipts kept for a block which was not committed
+func (rh *ReceiptsHandler) CreateBlockStarted() {
+	rh.mutReceipts.Lock()
+	rh.receipts = make(map[string]*receipt.Receipt)
+	rh.mutReceipts.Unlock()
+}
+
+// SaveReceiptsToStorage writes the receipts of the transactions included in the committed block and its logs bloom in
+// the storage unit. The receipts of the transactions processed but left out of the block are dropped
+func (rh *ReceiptsHandler) SaveReceiptsToStorage(headerHash []byte, txHashes [][]byte) error {
+	if len(headerHash) == 0 {
+		return process.ErrNilHeaderHash
+	}
+
+	rh.mutReceipts.Lock()
+	receipts := rh.receipts
+	rh.receipts = make(map[string]*receipt.Receipt)
+	rh.mutReceipts.Unlock()
+
+	bloom := receipt.NewBloom()
+	numSaved := 0
+	for _, txHash := range txHashes {
+		rcpt, ok := receipts[string(txHash)]
+		if !ok {
+			continue
+		}
+
+		rcpt.BlockHash = headerHash
+		for _, rcptLog := range rcpt.Logs {
+			bloom.AddLog(rh.hasher, rcptLog)
+		}
+
+		buff, err := rh.marshalizer.Marshal(rcpt)
+		if err != nil {
+			return err
+		}
+
+		err = rh.storer.Put(txHash, buff)
+		if err != nil {
+			return err
+		}
+		numSaved++
+	}
+
+	log.Trace("receipts saved", "num receipts", numSaved)
+
+	return rh.storer.Put(logsBloomKey(headerHash), bloom)
+}
+
+// RemoveReceiptsFromStorage removes the receipts and the logs bloom saved for a committed block which is rolled back
+func (rh *ReceiptsHandler) RemoveReceiptsFromStorage(headerHash []byte, txHashes [][]byte) error {
+	if len(headerHash) == 0 {
+		return process.ErrNilHeaderHash
+	}
+
+	for _, txHash := range txHashes {
+		err := rh.storer.Remove(txHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return rh.storer.Remove(logsBloomKey(headerHash))
+}
+
+// GetReceipt returns the receipt of a committed transaction
+func (rh *ReceiptsHandler) GetReceipt(txHash []byte) (*receipt.Receipt, error) {
+	buff, err := rh.storer.Get(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	rcpt := &receipt.Receipt{}
+	err = rh.marshalizer.Unmarshal(rcpt, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return rcpt, nil
+}
+
+// GetLogsBloom returns the bloom of the logs emitted in a committed block
+func (rh *ReceiptsHandler) GetLogsBloom(headerHash []byte) (receipt.Bloom, error) {
+	buff, err := rh.storer.Get(logsBloomKey(headerHash))
+	if err != nil {
+		return nil, err
+	}
+
+	return buff, nil
+}
+
+func logsBloomKey(headerHash []byte) []byte {
+	return append([]byte(logsBloomKeyPrefix), headerHash...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rh *ReceiptsHandler) IsInterfaceNil() bool {
+	if rh == nil {
+		return true
+	}
+	return false
+}
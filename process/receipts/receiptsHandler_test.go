@@ -0,0 +1,169 @@
+package receipts_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/receipts"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMemUnit() storage.Storer {
+	cache, _ := storageUnit.NewCache(storageUnit.LRUCache, 10, 1)
+	persist, _ := memorydb.NewlruDB(1000)
+	unit, _ := storageUnit.NewStorageUnit(cache, persist)
+
+	return unit
+}
+
+func createReceipt(txHash string, topic string) *receipt.Receipt {
+	return &receipt.Receipt{
+		TxHash:      []byte(txHash),
+		Fee:         big.NewInt(100),
+		GasRefunded: 10,
+		ReturnCode:  "ok",
+		ReturnData:  [][]byte{[]byte("data")},
+		Logs: []*receipt.Log{
+			{Address: []byte("address"), Topics: [][]byte{[]byte(topic)}},
+		},
+	}
+}
+
+func TestNewReceiptsHandler_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rh, err := receipts.NewReceiptsHandler(nil, &mock.MarshalizerMock{}, &mock.HasherMock{})
+
+	assert.Nil(t, rh)
+	assert.Equal(t, process.ErrNilStorage, err)
+}
+
+func TestNewReceiptsHandler_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rh, err := receipts.NewReceiptsHandler(createMemUnit(), nil, &mock.HasherMock{})
+
+	assert.Nil(t, rh)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewReceiptsHandler_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rh, err := receipts.NewReceiptsHandler(createMemUnit(), &mock.MarshalizerMock{}, nil)
+
+	assert.Nil(t, rh)
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestReceiptsHandler_SaveReceiptsToStorageShouldSaveReceiptsAndBloom(t *testing.T) {
+	t.Parallel()
+
+	hasher := &mock.HasherMock{}
+	rh, _ := receipts.NewReceiptsHandler(createMemUnit(), &mock.MarshalizerMock{}, hasher)
+	rh.AddReceipt(createReceipt("tx1", "topic1"))
+	rh.AddReceipt(createReceipt("tx2", "topic2"))
+
+	err := rh.SaveReceiptsToStorage([]byte("header hash"), [][]byte{[]byte("tx1"), []byte("tx2")})
+	assert.Nil(t, err)
+
+	rcpt, err := rh.GetReceipt([]byte("tx1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("header hash"), rcpt.BlockHash)
+	assert.Equal(t, big.NewInt(100), rcpt.Fee)
+	assert.Equal(t, uint64(10), rcpt.GasRefunded)
+	assert.Equal(t, "ok", rcpt.ReturnCode)
+	assert.Equal(t, [][]byte{[]byte("data")}, rcpt.ReturnData)
+
+	bloom, err := rh.GetLogsBloom([]byte("header hash"))
+	assert.Nil(t, err)
+	assert.True(t, bloom.Test(hasher, []byte("address")))
+	assert.True(t, bloom.Test(hasher, []byte("topic1")))
+	assert.True(t, bloom.Test(hasher, []byte("topic2")))
+	assert.False(t, bloom.Test(hasher, []byte("topic3")))
+}
+
+func TestReceiptsHandler_CreateBlockStartedShouldDropReceipts(t *testing.T) {
+	t.Parallel()
+
+	rh, _ := receipts.NewReceiptsHandler(createMemUnit(), &mock.MarshalizerMock{}, &mock.HasherMock{})
+	rh.AddReceipt(createReceipt("tx1", "topic1"))
+	rh.CreateBlockStarted()
+
+	err := rh.SaveReceiptsToStorage([]byte("header hash"), [][]byte{[]byte("tx1")})
+	assert.Nil(t, err)
+
+	rcpt, err := rh.GetReceipt([]byte("tx1"))
+	assert.NotNil(t, err)
+	assert.Nil(t, rcpt)
+}
+
+func TestReceiptsHandler_SaveReceiptsToStorageNilHeaderHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rh, _ := receipts.NewReceiptsHandler(createMemUnit(), &mock.MarshalizerMock{}, &mock.HasherMock{})
+
+	err := rh.SaveReceiptsToStorage(nil, nil)
+
+	assert.Equal(t, process.ErrNilHeaderHash, err)
+}
+
+func TestReceiptsHandler_SaveReceiptsToStorageShouldSaveOnlyTheBlockTransactions(t *testing.T) {
+	t.Parallel()
+
+	hasher := &mock.HasherMock{}
+	rh, _ := receipts.NewReceiptsHandler(createMemUnit(), &mock.MarshalizerMock{}, hasher)
+	rh.AddReceipt(createReceipt("tx1", "topic1"))
+	rh.AddReceipt(createReceipt("reverted tx", "topic2"))
+
+	err := rh.SaveReceiptsToStorage([]byte("header hash"), [][]byte{[]byte("tx1"), []byte("tx without receipt")})
+	assert.Nil(t, err)
+
+	rcpt, err := rh.GetReceipt([]byte("tx1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("header hash"), rcpt.BlockHash)
+
+	rcpt, err = rh.GetReceipt([]byte("reverted tx"))
+	assert.NotNil(t, err)
+	assert.Nil(t, rcpt)
+
+	bloom, _ := rh.GetLogsBloom([]byte("header hash"))
+	assert.True(t, bloom.Test(hasher, []byte("topic1")))
+	assert.False(t, bloom.Test(hasher, []byte("topic2")))
+}
+
+func TestReceiptsHandler_RemoveReceiptsFromStorageShouldRemoveReceiptsAndBloom(t *testing.T) {
+	t.Parallel()
+
+	rh, _ := receipts.NewReceiptsHandler(createMemUnit(), &mock.MarshalizerMock{}, &mock.HasherMock{})
+	rh.AddReceipt(createReceipt("tx1", "topic1"))
+	txHashes := [][]byte{[]byte("tx1")}
+	_ = rh.SaveReceiptsToStorage([]byte("header hash"), txHashes)
+
+	err := rh.RemoveReceiptsFromStorage([]byte("header hash"), txHashes)
+	assert.Nil(t, err)
+
+	rcpt, err := rh.GetReceipt([]byte("tx1"))
+	assert.NotNil(t, err)
+	assert.Nil(t, rcpt)
+
+	bloom, err := rh.GetLogsBloom([]byte("header hash"))
+	assert.NotNil(t, err)
+	assert.Nil(t, bloom)
+}
+
+func TestReceiptsHandler_RemoveReceiptsFromStorageNilHeaderHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rh, _ := receipts.NewReceiptsHandler(createMemUnit(), &mock.MarshalizerMock{}, &mock.HasherMock{})
+
+	err := rh.RemoveReceiptsFromStorage(nil, nil)
+
+	assert.Equal(t, process.ErrNilHeaderHash, err)
+}
@@ -1,12 +1,18 @@
 package rewardTransaction
 
 import (
+	"encoding/binary"
+	"encoding/hex"
 	"math/big"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
@@ -15,9 +21,13 @@ type rewardTxProcessor struct {
 	accounts         state.AccountsAdapter
 	adrConv          state.AddressConverter
 	shardCoordinator sharding.Coordinator
+	hasher           hashing.Hasher
+	marshalizer      marshal.Marshalizer
+	economicsFee     process.FeeHandler
 
 	mutRewardsForwarder sync.Mutex
 	rewardTxForwarder   process.IntermediateTransactionHandler
+	scrForwarder        process.IntermediateTransactionHandler
 }
 
 // NewRewardTxProcessor creates a rewardTxProcessor instance
@@ -26,6 +36,10 @@ func NewRewardTxProcessor(
 	adrConv state.AddressConverter,
 	coordinator sharding.Coordinator,
 	rewardTxForwarder process.IntermediateTransactionHandler,
+	scrForwarder process.IntermediateTransactionHandler,
+	hasher hashing.Hasher,
+	marshalizer marshal.Marshalizer,
+	economicsFee process.FeeHandler,
 ) (*rewardTxProcessor, error) {
 	if accountsDB == nil {
 		return nil, process.ErrNilAccountsAdapter
@@ -39,12 +53,28 @@ func NewRewardTxProcessor(
 	if rewardTxForwarder == nil {
 		return nil, process.ErrNilIntermediateTransactionHandler
 	}
+	if scrForwarder == nil || scrForwarder.IsInterfaceNil() {
+		return nil, process.ErrNilIntermediateTransactionHandler
+	}
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, process.ErrNilHasher
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+	if economicsFee == nil || economicsFee.IsInterfaceNil() {
+		return nil, process.ErrNilEconomicsFeeHandler
+	}
 
 	return &rewardTxProcessor{
 		accounts:          accountsDB,
 		adrConv:           adrConv,
 		shardCoordinator:  coordinator,
+		hasher:            hasher,
+		marshalizer:       marshalizer,
+		economicsFee:      economicsFee,
 		rewardTxForwarder: rewardTxForwarder,
+		scrForwarder:      scrForwarder,
 	}, nil
 }
 
@@ -84,6 +114,14 @@ func (rtp *rewardTxProcessor) ProcessRewardTransaction(rTx *rewardTx.RewardTx) e
 		return err
 	}
 
+	isPaidToMetachainSC, err := rtp.isPaidToMetachainSC(rTx)
+	if err != nil {
+		return err
+	}
+	if isPaidToMetachainSC {
+		return rtp.forwardRewardToMetachainSC(rTx)
+	}
+
 	accHandler, err := rtp.getAccountFromAddress(rTx.RcvAddr)
 	if err != nil {
 		return err
@@ -106,6 +144,51 @@ func (rtp *rewardTxProcessor) ProcessRewardTransaction(rTx *rewardTx.RewardTx) e
 	return err
 }
 
+func (rtp *rewardTxProcessor) isPaidToMetachainSC(rTx *rewardTx.RewardTx) (bool, error) {
+	if rTx.ShardId != rtp.shardCoordinator.SelfId() || !core.IsSmartContractAddress(rTx.RcvAddr) {
+		return false, nil
+	}
+
+	addr, err := rtp.adrConv.CreateAddressFromPublicKeyBytes(rTx.RcvAddr)
+	if err != nil {
+		return false, err
+	}
+
+	return rtp.shardCoordinator.ComputeId(addr) == sharding.MetachainShardId, nil
+}
+
+// forwardRewardToMetachainSC pays the reward of a node owned by a metachain smart contract, as a delegation, through
+// the contract, so it can split the reward between the ones the node belongs to. The metachain does not process the
+// reward transactions, so the reward is sent as a smart contract result from an address of this shard nobody owns,
+// which also keeps the rewards the contract refuses
+func (rtp *rewardTxProcessor) forwardRewardToMetachainSC(rTx *rewardTx.RewardTx) error {
+	rTxHash, err := core.CalculateHash(rtp.marshalizer, rtp.hasher, rTx)
+	if err != nil {
+		return err
+	}
+
+	scr := &smartContractResult.SmartContractResult{
+		Value:   rTx.Value,
+		RcvAddr: rTx.RcvAddr,
+		SndAddr: rtp.rewardsSenderAddress(),
+		Data:    core.DepositNodeRewards + "@" + hex.EncodeToString(rTx.PubKey),
+		TxHash:  rTxHash,
+	}
+	scr.GasLimit = rtp.economicsFee.ComputeGasLimit(scr)
+
+	return rtp.scrForwarder.AddIntermediateTransactions([]data.TransactionHandler{scr})
+}
+
+// rewardsSenderAddress returns the address of this shard made only of zeros and of the shard id in the last bytes
+func (rtp *rewardTxProcessor) rewardsSenderAddress() []byte {
+	address := make([]byte, rtp.adrConv.AddressLen())
+	shardId := make([]byte, 4)
+	binary.BigEndian.PutUint32(shardId, rtp.shardCoordinator.SelfId())
+	copy(address[len(address)-len(shardId):], shardId)
+
+	return address
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rtp *rewardTxProcessor) IsInterfaceNil() bool {
 	if rtp == nil {
@@ -1,16 +1,20 @@
 package rewardTransaction_test
 
 import (
+	"encoding/hex"
 	"errors"
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,7 +25,11 @@ func TestNewRewardTxProcessor_NilAccountsDbShouldErr(t *testing.T) {
 		nil,
 		&mock.AddressConverterMock{},
 		mock.NewMultiShardsCoordinatorMock(3),
-		&mock.IntermediateTransactionHandlerMock{})
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.FeeHandlerStub{})
 
 	assert.Nil(t, rtp)
 	assert.Equal(t, process.ErrNilAccountsAdapter, err)
@@ -34,7 +42,11 @@ func TestNewRewardTxProcessor_NilAddressConverterShouldErr(t *testing.T) {
 		&mock.AccountsStub{},
 		nil,
 		mock.NewMultiShardsCoordinatorMock(3),
-		&mock.IntermediateTransactionHandlerMock{})
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.FeeHandlerStub{})
 
 	assert.Nil(t, rtp)
 	assert.Equal(t, process.ErrNilAddressConverter, err)
@@ -47,7 +59,11 @@ func TestNewRewardTxProcessor_NilShardCoordinatorShouldErr(t *testing.T) {
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
 		nil,
-		&mock.IntermediateTransactionHandlerMock{})
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.FeeHandlerStub{})
 
 	assert.Nil(t, rtp)
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
@@ -60,12 +76,84 @@ func TestNewRewardTxProcessor_NilRewardTxForwarderShouldErr(t *testing.T) {
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
 		mock.NewMultiShardsCoordinatorMock(3),
-		nil)
+		nil,
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.FeeHandlerStub{})
 
 	assert.Nil(t, rtp)
 	assert.Equal(t, process.ErrNilIntermediateTransactionHandler, err)
 }
 
+func TestNewRewardTxProcessor_NilScrForwarderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rtp, err := rewardTransaction.NewRewardTxProcessor(
+		&mock.AccountsStub{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.IntermediateTransactionHandlerMock{},
+		nil,
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.FeeHandlerStub{})
+
+	assert.Nil(t, rtp)
+	assert.Equal(t, process.ErrNilIntermediateTransactionHandler, err)
+}
+
+func TestNewRewardTxProcessor_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rtp, err := rewardTransaction.NewRewardTxProcessor(
+		&mock.AccountsStub{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		nil,
+		&mock.MarshalizerMock{},
+		&mock.FeeHandlerStub{})
+
+	assert.Nil(t, rtp)
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestNewRewardTxProcessor_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rtp, err := rewardTransaction.NewRewardTxProcessor(
+		&mock.AccountsStub{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.HasherMock{},
+		nil,
+		&mock.FeeHandlerStub{})
+
+	assert.Nil(t, rtp)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewRewardTxProcessor_NilEconomicsFeeHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rtp, err := rewardTransaction.NewRewardTxProcessor(
+		&mock.AccountsStub{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		nil)
+
+	assert.Nil(t, rtp)
+	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
+}
+
 func TestNewRewardTxProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -73,7 +161,11 @@ func TestNewRewardTxProcessor_OkValsShouldWork(t *testing.T) {
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
 		mock.NewMultiShardsCoordinatorMock(3),
-		&mock.IntermediateTransactionHandlerMock{})
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.FeeHandlerStub{})
 
 	assert.NotNil(t, rtp)
 	assert.Nil(t, err)
@@ -87,7 +179,11 @@ func TestRewardTxProcessor_ProcessRewardTransactionNilTxShouldErr(t *testing.T)
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
 		mock.NewMultiShardsCoordinatorMock(3),
-		&mock.IntermediateTransactionHandlerMock{})
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.FeeHandlerStub{})
 
 	err := rtp.ProcessRewardTransaction(nil)
 	assert.Equal(t, process.ErrNilRewardTransaction, err)
@@ -100,7 +196,11 @@ func TestRewardTxProcessor_ProcessRewardTransactionNilTxValueShouldErr(t *testin
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
 		mock.NewMultiShardsCoordinatorMock(3),
-		&mock.IntermediateTransactionHandlerMock{})
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.FeeHandlerStub{})
 
 	rwdTx := rewardTx.RewardTx{Value: nil}
 	err := rtp.ProcessRewardTransaction(&rwdTx)
@@ -119,7 +219,11 @@ func TestRewardTxProcessor_ProcessRewardTransactionCannotCreateAddressShouldErr(
 			},
 		},
 		mock.NewMultiShardsCoordinatorMock(3),
-		&mock.IntermediateTransactionHandlerMock{})
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.FeeHandlerStub{})
 
 	rwdTx := rewardTx.RewardTx{
 		Round:   0,
@@ -150,7 +254,11 @@ func TestRewardTxProcessor_ProcessRewardTransactionAddressNotInNodesShardShouldN
 		},
 		&mock.AddressConverterMock{},
 		shardCoord,
-		&mock.IntermediateTransactionHandlerMock{})
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.FeeHandlerStub{})
 
 	rwdTx := rewardTx.RewardTx{
 		Round:   0,
@@ -178,7 +286,11 @@ func TestRewardTxProcessor_ProcessRewardTransactionCannotGetAccountShouldErr(t *
 		},
 		&mock.AddressConverterMock{},
 		mock.NewMultiShardsCoordinatorMock(3),
-		&mock.IntermediateTransactionHandlerMock{})
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.FeeHandlerStub{})
 
 	rwdTx := rewardTx.RewardTx{
 		Round:   0,
@@ -204,7 +316,11 @@ func TestRewardTxProcessor_ProcessRewardTransactionCannotAddIntermediateTxsShoul
 			AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
 				return expectedErr
 			},
-		})
+		},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.FeeHandlerStub{})
 
 	rwdTx := rewardTx.RewardTx{
 		Round:   0,
@@ -231,7 +347,11 @@ func TestRewardTxProcessor_ProcessRewardTransactionWrongTypeAssertionAccountHold
 		accountsDb,
 		&mock.AddressConverterMock{},
 		mock.NewMultiShardsCoordinatorMock(3),
-		&mock.IntermediateTransactionHandlerMock{})
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.FeeHandlerStub{})
 
 	rwdTx := rewardTx.RewardTx{
 		Round:   0,
@@ -270,7 +390,11 @@ func TestRewardTxProcessor_ProcessRewardTransactionShouldWork(t *testing.T) {
 		accountsDb,
 		&mock.AddressConverterMock{},
 		mock.NewMultiShardsCoordinatorMock(3),
-		&mock.IntermediateTransactionHandlerMock{})
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.FeeHandlerStub{})
 
 	rwdTx := rewardTx.RewardTx{
 		Round:   0,
@@ -285,3 +409,63 @@ func TestRewardTxProcessor_ProcessRewardTransactionShouldWork(t *testing.T) {
 	assert.True(t, journalizeWasCalled)
 	assert.True(t, saveAccountWasCalled)
 }
+
+func TestRewardTxProcessor_ProcessRewardTransactionToMetachainSCShouldDepositRewards(t *testing.T) {
+	t.Parallel()
+
+	getAccountWithJournalWasCalled := false
+	shardCoord := mock.NewMultiShardsCoordinatorMock(3)
+	shardCoord.CurrentShard = 1
+	shardCoord.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+		return sharding.MetachainShardId
+	}
+	var forwardedScrs []data.TransactionHandler
+	rtp, _ := rewardTransaction.NewRewardTxProcessor(
+		&mock.AccountsStub{
+			GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+				getAccountWithJournalWasCalled = true
+				return nil, nil
+			},
+		},
+		&mock.AddressConverterMock{},
+		shardCoord,
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{
+			AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+				forwardedScrs = append(forwardedScrs, txs...)
+				return nil
+			},
+		},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.FeeHandlerStub{
+			ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+				return 10
+			},
+		})
+
+	rcvAddr := make([]byte, 32)
+	rcvAddr[len(rcvAddr)-1] = 255
+	rwdTx := rewardTx.RewardTx{
+		Round:   0,
+		Epoch:   0,
+		Value:   new(big.Int).SetInt64(100),
+		RcvAddr: rcvAddr,
+		ShardId: 1,
+		PubKey:  []byte("node"),
+	}
+
+	err := rtp.ProcessRewardTransaction(&rwdTx)
+	assert.Nil(t, err)
+	assert.False(t, getAccountWithJournalWasCalled)
+	assert.Equal(t, 1, len(forwardedScrs))
+
+	scr := forwardedScrs[0].(*smartContractResult.SmartContractResult)
+	expectedSndAddr := make([]byte, 32)
+	expectedSndAddr[len(expectedSndAddr)-1] = 1
+	assert.Equal(t, rcvAddr, scr.RcvAddr)
+	assert.Equal(t, expectedSndAddr, scr.SndAddr)
+	assert.Equal(t, rwdTx.Value, scr.Value)
+	assert.Equal(t, core.DepositNodeRewards+"@"+hex.EncodeToString([]byte("node")), scr.Data)
+	assert.Equal(t, uint64(10), scr.GasLimit)
+}
@@ -0,0 +1,388 @@
+package scToProtocol
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/logger"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+var log = logger.GetOrCreate("process/sctoprotocol")
+
+// governanceChangesKey is the key under which the applied governance changes are saved in the bootstrap storer
+const governanceChangesKey = "governanceChanges"
+
+// ArgGovernanceApplier is struct that contain all components that are needed to create a new governanceApplier object
+type ArgGovernanceApplier struct {
+	Marshalizer marshal.Marshalizer
+	// Storer keeps the applied changes, so a restarted node sets them again on top of its configuration
+	Storer storage.Storer
+	// Updaters holds, for each parameter namespace, the component which owns the parameters under it
+	Updaters map[string]process.ParametersUpdater
+}
+
+// ArgGovernanceToProtocol is struct that contain all components that are needed to create a new governanceToProtocol object
+type ArgGovernanceToProtocol struct {
+	ArgGovernanceApplier
+	ScQuery external.SCQueryService
+}
+
+// savedChange is the persisted form of a change applied by the block with the given nonce
+type savedChange struct {
+	BlockNonce uint64               `json:"blockNonce"`
+	Change     block.GovernanceData `json:"change"`
+}
+
+// appliedChange remembers the value a parameter had before a committed block changed it
+type appliedChange struct {
+	blockNonce    uint64
+	change        block.GovernanceData
+	previousValue string
+}
+
+// governanceApplier applies the parameter changes carried by the committed metablocks and undoes them when the
+// blocks which applied them are reverted
+type governanceApplier struct {
+	updaters    map[string]process.ParametersUpdater
+	marshalizer marshal.Marshalizer
+	storer      storage.Storer
+
+	mutChanges     sync.Mutex
+	appliedChanges []*appliedChange
+}
+
+// governanceToProtocol defines the metachain component which reads the parameter changes accepted in the
+// governance SC state, so the metablock with their activation nonce carries them
+type governanceToProtocol struct {
+	*governanceApplier
+	marshalizer marshal.Marshalizer
+	scQuery     external.SCQueryService
+}
+
+// NewGovernanceApplier creates the component which applies the governance changes carried by the committed
+// metablocks on the given updaters
+func NewGovernanceApplier(args ArgGovernanceApplier) (*governanceApplier, error) {
+	if args.Marshalizer == nil || args.Marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+	if args.Storer == nil || args.Storer.IsInterfaceNil() {
+		return nil, process.ErrNilStorage
+	}
+	for _, updater := range args.Updaters {
+		if updater == nil || updater.IsInterfaceNil() {
+			return nil, process.ErrNilParametersUpdater
+		}
+	}
+
+	updatersCopy := make(map[string]process.ParametersUpdater, len(args.Updaters))
+	for namespace, updater := range args.Updaters {
+		updatersCopy[namespace] = updater
+	}
+
+	return &governanceApplier{
+		updaters:       updatersCopy,
+		marshalizer:    args.Marshalizer,
+		storer:         args.Storer,
+		appliedChanges: make([]*appliedChange, 0),
+	}, nil
+}
+
+// NewGovernanceToProtocol creates the component which moves from governance sc state to protocol parameters
+func NewGovernanceToProtocol(args ArgGovernanceToProtocol) (*governanceToProtocol, error) {
+	if args.ScQuery == nil || args.ScQuery.IsInterfaceNil() {
+		return nil, process.ErrNilSCDataGetter
+	}
+
+	applier, err := NewGovernanceApplier(args.ArgGovernanceApplier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &governanceToProtocol{
+		governanceApplier: applier,
+		marshalizer:       args.Marshalizer,
+		scQuery:           args.ScQuery,
+	}, nil
+}
+
+// ApplyGovernanceChanges applies the changes carried by the metablocks committed in the block with the given nonce.
+// A change which no registered updater can apply is skipped, so a bad value can not stop the chain
+func (ga *governanceApplier) ApplyGovernanceChanges(blockNonce uint64, changes []block.GovernanceData) {
+	ga.mutChanges.Lock()
+	defer ga.mutChanges.Unlock()
+
+	for i := range changes {
+		ga.applyChange(blockNonce, &changes[i])
+	}
+
+	ga.saveChanges()
+}
+
+// RestoreGovernanceChanges sets the parameters as they are after the block with the given nonce, from the changes
+// saved when the blocks up to it were committed. It is used once the state was recreated for that block, also when
+// the node starts from storage
+func (ga *governanceApplier) RestoreGovernanceChanges(nonce uint64) error {
+	savedChanges, err := ga.loadChanges()
+	if err != nil {
+		return err
+	}
+
+	ga.mutChanges.Lock()
+	defer ga.mutChanges.Unlock()
+
+	ga.revertChanges(0)
+	for i := range savedChanges {
+		if savedChanges[i].BlockNonce > nonce {
+			break
+		}
+
+		ga.applyChange(savedChanges[i].BlockNonce, &savedChanges[i].Change)
+	}
+
+	ga.saveChanges()
+
+	return nil
+}
+
+func (ga *governanceApplier) loadChanges() ([]savedChange, error) {
+	savedChanges := make([]savedChange, 0)
+
+	buff, err := ga.storer.Get([]byte(governanceChangesKey))
+	if err != nil {
+		// no change was applied yet
+		return savedChanges, nil
+	}
+
+	err = ga.marshalizer.Unmarshal(&savedChanges, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return savedChanges, nil
+}
+
+func (ga *governanceApplier) saveChanges() {
+	savedChanges := make([]savedChange, 0, len(ga.appliedChanges))
+	for _, applied := range ga.appliedChanges {
+		savedChanges = append(savedChanges, savedChange{
+			BlockNonce: applied.blockNonce,
+			Change:     applied.change,
+		})
+	}
+
+	buff, err := ga.marshalizer.Marshal(savedChanges)
+	if err == nil {
+		err = ga.storer.Put([]byte(governanceChangesKey), buff)
+	}
+	if err != nil {
+		log.Error("governance parameter changes could not be saved", "error", err.Error())
+	}
+}
+
+func (ga *governanceApplier) revertChanges(blockNonce uint64) {
+	for len(ga.appliedChanges) > 0 {
+		lastChange := ga.appliedChanges[len(ga.appliedChanges)-1]
+		if lastChange.blockNonce <= blockNonce {
+			return
+		}
+
+		updater, name, err := ga.getUpdater(lastChange.change.Parameter)
+		if err == nil {
+			err = updater.UpdateParameter(name, lastChange.previousValue)
+		}
+		if err != nil {
+			log.Error("governance parameter change could not be reverted",
+				"parameter", lastChange.change.Parameter,
+				"value", lastChange.previousValue,
+				"error", err.Error(),
+			)
+		}
+
+		ga.appliedChanges = ga.appliedChanges[:len(ga.appliedChanges)-1]
+	}
+}
+
+func (ga *governanceApplier) applyChange(blockNonce uint64, change *block.GovernanceData) {
+	updater, name, err := ga.getUpdater(change.Parameter)
+	if err != nil {
+		log.Warn("governance parameter change skipped",
+			"proposal", change.ProposalID,
+			"parameter", change.Parameter,
+			"error", err.Error(),
+		)
+		return
+	}
+
+	previousValue, err := updater.ParameterValue(name)
+	if err == nil {
+		err = updater.UpdateParameter(name, change.Value)
+	}
+	if err != nil {
+		log.Warn("governance parameter change could not be applied",
+			"proposal", change.ProposalID,
+			"parameter", change.Parameter,
+			"value", change.Value,
+			"error", err.Error(),
+		)
+		return
+	}
+
+	ga.appliedChanges = append(ga.appliedChanges, &appliedChange{
+		blockNonce:    blockNonce,
+		change:        *change,
+		previousValue: previousValue,
+	})
+
+	log.Info("governance parameter changed",
+		"proposal", change.ProposalID,
+		"parameter", change.Parameter,
+		"value", change.Value,
+	)
+}
+
+func (ga *governanceApplier) getUpdater(parameter string) (process.ParametersUpdater, string, error) {
+	tokens := strings.SplitN(parameter, core.GovernanceNamespaceSeparator, 2)
+	if len(tokens) != 2 {
+		return nil, "", process.ErrUnknownParameter
+	}
+
+	updater, ok := ga.updaters[tokens[0]]
+	if !ok {
+		return nil, "", process.ErrUnknownParameter
+	}
+
+	return updater, tokens[1], nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ga *governanceApplier) IsInterfaceNil() bool {
+	if ga == nil {
+		return true
+	}
+	return false
+}
+
+// GovernanceChanges returns the accepted changes which activate at the given metablock nonce, ordered by proposal
+func (gtp *governanceToProtocol) GovernanceChanges(nonce uint64) ([]block.GovernanceData, error) {
+	acceptedChanges, err := gtp.getAcceptedChanges()
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]block.GovernanceData, 0)
+	for _, change := range acceptedChanges {
+		if change.ActivationNonce != nonce {
+			continue
+		}
+
+		changes = append(changes, block.GovernanceData{
+			ProposalID:      change.ProposalID,
+			Parameter:       change.Parameter,
+			Value:           change.Value,
+			ActivationNonce: change.ActivationNonce,
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].ProposalID < changes[j].ProposalID
+	})
+
+	return changes, nil
+}
+
+// VerifyGovernanceChanges checks the metablock with the given nonce carries exactly the accepted changes which
+// activate at it
+func (gtp *governanceToProtocol) VerifyGovernanceChanges(nonce uint64, changes []block.GovernanceData) error {
+	expectedChanges, err := gtp.GovernanceChanges(nonce)
+	if err != nil {
+		return err
+	}
+
+	if len(expectedChanges) != len(changes) {
+		return process.ErrGovernanceChangesMismatch
+	}
+	for i := range changes {
+		if changes[i] != expectedChanges[i] {
+			return process.ErrGovernanceChangesMismatch
+		}
+	}
+
+	return nil
+}
+
+// RestoreGovernanceChanges sets the parameters as they are after the metablock with the given nonce, from the
+// changes accepted in the current governance SC state. It is used once the state was recreated for that metablock
+func (gtp *governanceToProtocol) RestoreGovernanceChanges(nonce uint64) error {
+	acceptedChanges, err := gtp.getAcceptedChanges()
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(acceptedChanges, func(i, j int) bool {
+		if acceptedChanges[i].ActivationNonce == acceptedChanges[j].ActivationNonce {
+			return acceptedChanges[i].ProposalID < acceptedChanges[j].ProposalID
+		}
+		return acceptedChanges[i].ActivationNonce < acceptedChanges[j].ActivationNonce
+	})
+
+	gtp.mutChanges.Lock()
+	defer gtp.mutChanges.Unlock()
+
+	gtp.revertChanges(0)
+	for _, change := range acceptedChanges {
+		if change.ActivationNonce > nonce {
+			break
+		}
+
+		gtp.applyChange(change.ActivationNonce, &block.GovernanceData{
+			ProposalID:      change.ProposalID,
+			Parameter:       change.Parameter,
+			Value:           change.Value,
+			ActivationNonce: change.ActivationNonce,
+		})
+	}
+
+	gtp.saveChanges()
+
+	return nil
+}
+
+func (gtp *governanceToProtocol) getAcceptedChanges() ([]*systemSmartContracts.GovernanceChange, error) {
+	query := process.SCQuery{
+		ScAddress: factory.GovernanceSCAddress,
+		FuncName:  "getAcceptedChanges",
+	}
+	vmOutput, err := gtp.scQuery.ExecuteQuery(&query)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptedChanges := make([]*systemSmartContracts.GovernanceChange, 0)
+	if len(vmOutput.ReturnData) == 0 || len(vmOutput.ReturnData[0]) == 0 {
+		return acceptedChanges, nil
+	}
+
+	err = gtp.marshalizer.Unmarshal(&acceptedChanges, vmOutput.ReturnData[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return acceptedChanges, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gtp *governanceToProtocol) IsInterfaceNil() bool {
+	if gtp == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,328 @@
+package scToProtocol
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgumentsNewGovernanceApplier() ArgGovernanceApplier {
+	return ArgGovernanceApplier{
+		Marshalizer: &mock.MarshalizerMock{},
+		Storer:      mock.NewStorerMock(),
+		Updaters: map[string]process.ParametersUpdater{
+			core.GovernanceEconomicsNamespace: &mock.ParametersUpdaterStub{},
+		},
+	}
+}
+
+func createMockArgumentsNewGovernanceToProtocol() ArgGovernanceToProtocol {
+	return ArgGovernanceToProtocol{
+		ArgGovernanceApplier: createMockArgumentsNewGovernanceApplier(),
+		ScQuery:              &mock.ScQueryMock{},
+	}
+}
+
+func createScQueryWithAcceptedChanges(changes []*systemSmartContracts.GovernanceChange) *mock.ScQueryMock {
+	return &mock.ScQueryMock{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			if string(query.ScAddress) != string(factory.GovernanceSCAddress) || query.FuncName != "getAcceptedChanges" {
+				return nil, errors.New("unexpected query")
+			}
+
+			marshaledData, _ := json.Marshal(changes)
+			return &vmcommon.VMOutput{ReturnData: [][]byte{marshaledData}}, nil
+		},
+	}
+}
+
+func TestNewGovernanceToProtocol_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsNewGovernanceToProtocol()
+	arguments.Marshalizer = nil
+
+	gtp, err := NewGovernanceToProtocol(arguments)
+	assert.Nil(t, gtp)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewGovernanceToProtocol_NilScQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsNewGovernanceToProtocol()
+	arguments.ScQuery = nil
+
+	gtp, err := NewGovernanceToProtocol(arguments)
+	assert.Nil(t, gtp)
+	assert.Equal(t, process.ErrNilSCDataGetter, err)
+}
+
+func TestNewGovernanceToProtocol_NilUpdaterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsNewGovernanceToProtocol()
+	arguments.Updaters[core.GovernanceConsensusNamespace] = nil
+
+	gtp, err := NewGovernanceToProtocol(arguments)
+	assert.Nil(t, gtp)
+	assert.Equal(t, process.ErrNilParametersUpdater, err)
+}
+
+func TestNewGovernanceToProtocol_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	gtp, err := NewGovernanceToProtocol(createMockArgumentsNewGovernanceToProtocol())
+	assert.Nil(t, err)
+	assert.False(t, gtp.IsInterfaceNil())
+}
+
+func createParametersUpdaterWithValues(values map[string]string) *mock.ParametersUpdaterStub {
+	return &mock.ParametersUpdaterStub{
+		ParameterValueCalled: func(name string) (string, error) {
+			value, ok := values[name]
+			if !ok {
+				return "", process.ErrUnknownParameter
+			}
+			return value, nil
+		},
+		UpdateParameterCalled: func(name string, value string) error {
+			if _, ok := values[name]; !ok {
+				return process.ErrUnknownParameter
+			}
+			values[name] = value
+			return nil
+		},
+	}
+}
+
+func TestNewGovernanceApplier_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsNewGovernanceApplier()
+	arguments.Marshalizer = nil
+
+	ga, err := NewGovernanceApplier(arguments)
+	assert.Nil(t, ga)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewGovernanceApplier_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsNewGovernanceApplier()
+	arguments.Storer = nil
+
+	ga, err := NewGovernanceApplier(arguments)
+	assert.Nil(t, ga)
+	assert.Equal(t, process.ErrNilStorage, err)
+}
+
+func TestNewGovernanceApplier_NilUpdaterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsNewGovernanceApplier()
+	arguments.Updaters[core.GovernanceEconomicsNamespace] = nil
+
+	ga, err := NewGovernanceApplier(arguments)
+	assert.Nil(t, ga)
+	assert.Equal(t, process.ErrNilParametersUpdater, err)
+}
+
+func TestGovernanceApplier_ApplyAndRestoreGovernanceChanges(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]string{"MinGasPrice": "1", "MinGasLimit": "2"}
+	arguments := createMockArgumentsNewGovernanceApplier()
+	arguments.Updaters[core.GovernanceEconomicsNamespace] = createParametersUpdaterWithValues(values)
+	ga, _ := NewGovernanceApplier(arguments)
+
+	ga.ApplyGovernanceChanges(10, []block.GovernanceData{
+		{ProposalID: 1, Parameter: "economics.MinGasPrice", Value: "10", ActivationNonce: 10},
+		{ProposalID: 2, Parameter: "economics.MinGasLimit", Value: "20", ActivationNonce: 10},
+	})
+	ga.ApplyGovernanceChanges(12, []block.GovernanceData{
+		{ProposalID: 3, Parameter: "economics.MinGasPrice", Value: "30", ActivationNonce: 12},
+	})
+	assert.Equal(t, map[string]string{"MinGasPrice": "30", "MinGasLimit": "20"}, values)
+
+	err := ga.RestoreGovernanceChanges(11)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"MinGasPrice": "10", "MinGasLimit": "20"}, values)
+
+	err = ga.RestoreGovernanceChanges(9)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"MinGasPrice": "1", "MinGasLimit": "2"}, values)
+}
+
+func TestGovernanceApplier_RestoreGovernanceChangesAfterRestartShouldApplyTheSavedChanges(t *testing.T) {
+	t.Parallel()
+
+	storer := mock.NewStorerMock()
+	values := map[string]string{"MinGasPrice": "1", "MinGasLimit": "2"}
+	arguments := createMockArgumentsNewGovernanceApplier()
+	arguments.Storer = storer
+	arguments.Updaters[core.GovernanceEconomicsNamespace] = createParametersUpdaterWithValues(values)
+	ga, _ := NewGovernanceApplier(arguments)
+
+	ga.ApplyGovernanceChanges(10, []block.GovernanceData{
+		{ProposalID: 1, Parameter: "economics.MinGasPrice", Value: "10", ActivationNonce: 10},
+	})
+	ga.ApplyGovernanceChanges(12, []block.GovernanceData{
+		{ProposalID: 2, Parameter: "economics.MinGasLimit", Value: "20", ActivationNonce: 12},
+	})
+
+	restartedValues := map[string]string{"MinGasPrice": "1", "MinGasLimit": "2"}
+	arguments = createMockArgumentsNewGovernanceApplier()
+	arguments.Storer = storer
+	arguments.Updaters[core.GovernanceEconomicsNamespace] = createParametersUpdaterWithValues(restartedValues)
+	restartedGa, _ := NewGovernanceApplier(arguments)
+
+	err := restartedGa.RestoreGovernanceChanges(12)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"MinGasPrice": "10", "MinGasLimit": "20"}, restartedValues)
+
+	err = restartedGa.RestoreGovernanceChanges(11)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"MinGasPrice": "10", "MinGasLimit": "2"}, restartedValues)
+
+	err = restartedGa.RestoreGovernanceChanges(12)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"MinGasPrice": "10", "MinGasLimit": "2"}, restartedValues)
+}
+
+func TestGovernanceApplier_RestoreGovernanceChangesWithNothingSavedShouldKeepTheValues(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]string{"MinGasPrice": "1"}
+	arguments := createMockArgumentsNewGovernanceApplier()
+	arguments.Updaters[core.GovernanceEconomicsNamespace] = createParametersUpdaterWithValues(values)
+	ga, _ := NewGovernanceApplier(arguments)
+
+	err := ga.RestoreGovernanceChanges(10)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"MinGasPrice": "1"}, values)
+}
+
+func TestGovernanceApplier_ApplyGovernanceChangesShouldSkipChangesWhichCanNotBeApplied(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]string{"MinGasPrice": "1"}
+	arguments := createMockArgumentsNewGovernanceApplier()
+	arguments.Updaters[core.GovernanceEconomicsNamespace] = createParametersUpdaterWithValues(values)
+	ga, _ := NewGovernanceApplier(arguments)
+
+	ga.ApplyGovernanceChanges(10, []block.GovernanceData{
+		{ProposalID: 1, Parameter: "economics.Unknown", Value: "10", ActivationNonce: 10},
+		{ProposalID: 2, Parameter: "gasSchedule.BaseOperationCost.StorePerByte", Value: "63", ActivationNonce: 10},
+		{ProposalID: 3, Parameter: "economics.MinGasPrice", Value: "10", ActivationNonce: 10},
+	})
+	assert.Equal(t, map[string]string{"MinGasPrice": "10"}, values)
+
+	err := ga.RestoreGovernanceChanges(9)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"MinGasPrice": "1"}, values)
+}
+
+func TestGovernanceToProtocol_GovernanceChangesQueryErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arguments := createMockArgumentsNewGovernanceToProtocol()
+	arguments.ScQuery = &mock.ScQueryMock{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return nil, expectedErr
+		},
+	}
+	gtp, _ := NewGovernanceToProtocol(arguments)
+
+	changes, err := gtp.GovernanceChanges(10)
+	assert.Nil(t, changes)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestGovernanceToProtocol_GovernanceChangesShouldReturnTheChangesActivatedAtNonce(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsNewGovernanceToProtocol()
+	arguments.ScQuery = createScQueryWithAcceptedChanges([]*systemSmartContracts.GovernanceChange{
+		{ProposalID: 3, Parameter: "economics.MinGasPrice", Value: "30", ActivationNonce: 20},
+		{ProposalID: 2, Parameter: "economics.MinGasLimit", Value: "20", ActivationNonce: 10},
+		{ProposalID: 1, Parameter: "economics.MinGasPrice", Value: "10", ActivationNonce: 10},
+	})
+	gtp, _ := NewGovernanceToProtocol(arguments)
+
+	changes, err := gtp.GovernanceChanges(9)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(changes))
+
+	changes, err = gtp.GovernanceChanges(10)
+	assert.Nil(t, err)
+	expectedChanges := []block.GovernanceData{
+		{ProposalID: 1, Parameter: "economics.MinGasPrice", Value: "10", ActivationNonce: 10},
+		{ProposalID: 2, Parameter: "economics.MinGasLimit", Value: "20", ActivationNonce: 10},
+	}
+	assert.Equal(t, expectedChanges, changes)
+}
+
+func TestGovernanceToProtocol_VerifyGovernanceChanges(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsNewGovernanceToProtocol()
+	arguments.ScQuery = createScQueryWithAcceptedChanges([]*systemSmartContracts.GovernanceChange{
+		{ProposalID: 1, Parameter: "economics.MinGasPrice", Value: "10", ActivationNonce: 10},
+	})
+	gtp, _ := NewGovernanceToProtocol(arguments)
+
+	err := gtp.VerifyGovernanceChanges(10, []block.GovernanceData{
+		{ProposalID: 1, Parameter: "economics.MinGasPrice", Value: "10", ActivationNonce: 10},
+	})
+	assert.Nil(t, err)
+
+	err = gtp.VerifyGovernanceChanges(10, []block.GovernanceData{
+		{ProposalID: 1, Parameter: "economics.MinGasPrice", Value: "11", ActivationNonce: 10},
+	})
+	assert.Equal(t, process.ErrGovernanceChangesMismatch, err)
+
+	err = gtp.VerifyGovernanceChanges(10, nil)
+	assert.Equal(t, process.ErrGovernanceChangesMismatch, err)
+
+	err = gtp.VerifyGovernanceChanges(11, nil)
+	assert.Nil(t, err)
+}
+
+func TestGovernanceToProtocol_RestoreGovernanceChangesShouldApplyTheChangesActivatedUpToNonce(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]string{"MinGasPrice": "1", "MinGasLimit": "2"}
+	arguments := createMockArgumentsNewGovernanceToProtocol()
+	arguments.Updaters[core.GovernanceEconomicsNamespace] = createParametersUpdaterWithValues(values)
+	arguments.ScQuery = createScQueryWithAcceptedChanges([]*systemSmartContracts.GovernanceChange{
+		{ProposalID: 3, Parameter: "economics.MinGasPrice", Value: "30", ActivationNonce: 20},
+		{ProposalID: 1, Parameter: "economics.MinGasPrice", Value: "10", ActivationNonce: 10},
+		{ProposalID: 2, Parameter: "economics.MinGasLimit", Value: "20", ActivationNonce: 15},
+	})
+	gtp, _ := NewGovernanceToProtocol(arguments)
+
+	gtp.ApplyGovernanceChanges(20, []block.GovernanceData{
+		{ProposalID: 3, Parameter: "economics.MinGasPrice", Value: "30", ActivationNonce: 20},
+	})
+	assert.Equal(t, "30", values["MinGasPrice"])
+
+	err := gtp.RestoreGovernanceChanges(15)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"MinGasPrice": "10", "MinGasLimit": "20"}, values)
+
+	err = gtp.RestoreGovernanceChanges(5)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"MinGasPrice": "1", "MinGasLimit": "2"}, values)
+}
@@ -102,12 +102,7 @@ func checkIfNil(args ArgStakingToPeer) error {
 	return nil
 }
 
-func (stp *stakingToPeer) getPeerAccount(key []byte) (*state.PeerAccount, error) {
-	adrSrc, err := stp.adrConv.CreateAddressFromPublicKeyBytes(key)
-	if err != nil {
-		return nil, err
-	}
-
+func (stp *stakingToPeer) getPeerAccount(adrSrc state.AddressContainer) (*state.PeerAccount, error) {
 	account, err := stp.peerState.GetAccountWithJournal(adrSrc)
 	if err != nil {
 		return nil, err
@@ -133,38 +128,47 @@ func (stp *stakingToPeer) UpdateProtocol(body block.Body, nonce uint64) error {
 	}
 
 	for key := range affectedStates {
-		peerAcc, err := stp.getPeerAccount([]byte(key))
+		if systemSmartContracts.IsStakerKey([]byte(key)) {
+			err = stp.updateTopUps([]byte(key), nonce)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		// the staking SC keeps each node under its BLS public key, next to the owner records and its own settings
+		if !systemSmartContracts.IsNodeKey([]byte(key)) {
+			continue
+		}
+
+		adrSrc, err := stp.adrConv.CreateAddressFromPublicKeyBytes([]byte(key))
 		if err != nil {
 			return err
 		}
 
-		query := process.SCQuery{
-			ScAddress: factory.StakingSCAddress,
-			FuncName:  "get",
-			Arguments: [][]byte{[]byte(key)},
-		}
-		vmOutput, err := stp.scQuery.ExecuteQuery(&query)
+		peerAcc, err := stp.getPeerAccount(adrSrc)
 		if err != nil {
 			return err
 		}
 
-		data := make([]byte, 0)
-		if len(vmOutput.ReturnData) > 0 {
-			data = vmOutput.ReturnData[0]
+		data, err := stp.getStorageData([]byte(key))
+		if err != nil {
+			return err
 		}
 		// no data under key -> peer can be deleted from trie
 		if len(data) == 0 {
-			err = stp.peerUnregistered(peerAcc, nonce)
+			err = stp.peerUnregistered(adrSrc, peerAcc, nonce)
 			if err != nil {
 				return err
 			}
 
-			adrSrc, err := stp.adrConv.CreateAddressFromPublicKeyBytes([]byte(key))
+			err = stp.peerState.RemoveAccount(adrSrc)
 			if err != nil {
 				return err
 			}
 
-			return stp.peerState.RemoveAccount(adrSrc)
+			continue
 		}
 
 		var stakingData systemSmartContracts.StakingData
@@ -173,7 +177,7 @@ func (stp *stakingToPeer) UpdateProtocol(body block.Body, nonce uint64) error {
 			return err
 		}
 
-		err = stp.createPeerChangeData(stakingData, peerAcc, nonce)
+		err = stp.createPeerChangeData(stakingData, adrSrc, peerAcc, nonce)
 		if err != nil {
 			return err
 		}
@@ -187,12 +191,111 @@ func (stp *stakingToPeer) UpdateProtocol(body block.Body, nonce uint64) error {
 	return nil
 }
 
-func (stp *stakingToPeer) peerUnregistered(account *state.PeerAccount, nonce uint64) error {
+// updateTopUps splits the stake an owner added above the minimum evenly between its nodes, the first node getting
+// the remainder, and records the change of each node's share
+func (stp *stakingToPeer) updateTopUps(stakerKey []byte, nonce uint64) error {
+	data, err := stp.getStorageData(stakerKey)
+	if err != nil {
+		return err
+	}
+	// a removed staker has no nodes left, each of them being unregistered under its own key
+	if len(data) == 0 {
+		return nil
+	}
+
+	var stakerData systemSmartContracts.StakerData
+	err = stp.marshalizer.Unmarshal(&stakerData, data)
+	if err != nil {
+		return err
+	}
+	if len(stakerData.BlsPubKeys) == 0 || stakerData.TopUpValue == nil {
+		return nil
+	}
+
+	numNodes := big.NewInt(int64(len(stakerData.BlsPubKeys)))
+	topUpPerNode, remainder := big.NewInt(0).QuoRem(stakerData.TopUpValue, numNodes, big.NewInt(0))
+	for i, blsPubKey := range stakerData.BlsPubKeys {
+		topUpValue := big.NewInt(0).Set(topUpPerNode)
+		if i == 0 {
+			topUpValue.Add(topUpValue, remainder)
+		}
+
+		err = stp.updatePeerTopUp(blsPubKey, topUpValue, nonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (stp *stakingToPeer) updatePeerTopUp(blsPubKey []byte, topUpValue *big.Int, nonce uint64) error {
+	adrSrc, err := stp.adrConv.CreateAddressFromPublicKeyBytes(blsPubKey)
+	if err != nil {
+		return err
+	}
+
+	peerAcc, err := stp.getPeerAccount(adrSrc)
+	if err != nil {
+		return err
+	}
+
+	oldTopUpValue := big.NewInt(0)
+	if peerAcc.TopUpValue != nil {
+		oldTopUpValue.Set(peerAcc.TopUpValue)
+	}
+	if oldTopUpValue.Cmp(topUpValue) == 0 {
+		return nil
+	}
+
+	actualPeerChange := block.PeerData{
+		Address:     adrSrc.Bytes(),
+		PublicKey:   blsPubKey,
+		Action:      block.PeerTopUp,
+		TimeStamp:   nonce,
+		ValueChange: big.NewInt(0).Sub(topUpValue, oldTopUpValue),
+	}
+
+	peerHash, err := core.CalculateHash(stp.marshalizer, stp.hasher, actualPeerChange)
+	if err != nil {
+		return err
+	}
+
+	stp.mutPeerChanges.Lock()
+	stp.peerChanges[string(peerHash)] = actualPeerChange
+	stp.mutPeerChanges.Unlock()
+
+	return peerAcc.SetTopUpValueWithJournal(topUpValue)
+}
+
+func (stp *stakingToPeer) getStorageData(key []byte) ([]byte, error) {
+	query := process.SCQuery{
+		ScAddress: factory.StakingSCAddress,
+		FuncName:  "get",
+		Arguments: [][]byte{key},
+	}
+	vmOutput, err := stp.scQuery.ExecuteQuery(&query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vmOutput.ReturnData) == 0 {
+		return make([]byte, 0), nil
+	}
+
+	return vmOutput.ReturnData[0], nil
+}
+
+func (stp *stakingToPeer) peerUnregistered(
+	adrSrc state.AddressContainer,
+	account *state.PeerAccount,
+	nonce uint64,
+) error {
 	stp.mutPeerChanges.Lock()
 	defer stp.mutPeerChanges.Unlock()
 
 	actualPeerChange := block.PeerData{
-		Address:     account.Address,
+		Address:     adrSrc.Bytes(),
 		PublicKey:   account.BLSPublicKey,
 		Action:      block.PeerDeregistration,
 		TimeStamp:   nonce,
@@ -219,6 +322,13 @@ func (stp *stakingToPeer) updatePeerState(
 		}
 	}
 
+	if len(stakingData.RewardAddress) > 0 && !bytes.Equal(stakingData.RewardAddress, account.Address) {
+		err := account.SetAddressWithJournal(stakingData.RewardAddress)
+		if err != nil {
+			return err
+		}
+	}
+
 	if stakingData.StakeValue.Cmp(account.Stake) != 0 {
 		err := account.SetStakeWithJournal(stakingData.StakeValue)
 		if err != nil {
@@ -250,6 +360,7 @@ func (stp *stakingToPeer) updatePeerState(
 
 func (stp *stakingToPeer) createPeerChangeData(
 	stakingData systemSmartContracts.StakingData,
+	adrSrc state.AddressContainer,
 	account *state.PeerAccount,
 	nonce uint64,
 ) error {
@@ -257,7 +368,7 @@ func (stp *stakingToPeer) createPeerChangeData(
 	defer stp.mutPeerChanges.Unlock()
 
 	actualPeerChange := block.PeerData{
-		Address:     account.Address,
+		Address:     adrSrc.Bytes(),
 		PublicKey:   account.BLSPublicKey,
 		Action:      0,
 		TimeStamp:   nonce,
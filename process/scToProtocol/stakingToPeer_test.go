@@ -705,3 +705,194 @@ func TestStakingToPeer_VerifyPeerChangesShouldErr(t *testing.T) {
 	err = stakingToPeer.VerifyPeerChanges(peersData)
 	assert.Equal(t, process.ErrPeerChangesHashDoesNotMatch, err)
 }
+
+func TestStakingToPeer_UpdateProtocolSkipsNonNodeKeys(t *testing.T) {
+	t.Parallel()
+
+	currTx := &mock.TxForCurrentBlockStub{}
+	currTx.GetTxCalled = func(txHash []byte) (handler data.TransactionHandler, e error) {
+		return &smartContractResult.SmartContractResult{
+			RcvAddr: factory.StakingSCAddress,
+		}, nil
+	}
+
+	argParser := &mock.ArgumentParserMock{}
+	argParser.GetStorageUpdatesCalled = func(data string) (updates []*vmcommon.StorageUpdate, e error) {
+		return []*vmcommon.StorageUpdate{
+			{Offset: []byte("owner"), Data: []byte("data1")},
+			{Offset: []byte("initialStake"), Data: []byte("data2")},
+		}, nil
+	}
+
+	peerState := &mock.AccountsStub{}
+	peerState.GetAccountWithJournalCalled = func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+		assert.Fail(t, "peer account should not have been requested")
+		return nil, nil
+	}
+
+	arguments := createMockArgumentsNewStakingToPeer()
+	arguments.ArgParser = argParser
+	arguments.CurrTxs = currTx
+	arguments.PeerState = peerState
+	stakingToPeer, _ := NewStakingToPeer(arguments)
+
+	blockBody := createBlockBody()
+	err := stakingToPeer.UpdateProtocol(blockBody, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(stakingToPeer.PeerChanges()))
+}
+
+func TestStakingToPeer_UpdateProtocolMultipleNodesShouldUpdateEachPeer(t *testing.T) {
+	t.Parallel()
+
+	currTx := &mock.TxForCurrentBlockStub{}
+	currTx.GetTxCalled = func(txHash []byte) (handler data.TransactionHandler, e error) {
+		return &smartContractResult.SmartContractResult{
+			RcvAddr: factory.StakingSCAddress,
+		}, nil
+	}
+
+	argParser := &mock.ArgumentParserMock{}
+	argParser.GetStorageUpdatesCalled = func(data string) (updates []*vmcommon.StorageUpdate, e error) {
+		return []*vmcommon.StorageUpdate{
+			{Offset: []byte("removedBlsPublicKey"), Data: nil},
+			{Offset: []byte("stakedBlsPublicKey"), Data: []byte("data")},
+		}, nil
+	}
+
+	peerAccounts := make(map[string]*state.PeerAccount)
+	removedAccounts := make(map[string]struct{})
+	peerState := &mock.AccountsStub{}
+	peerState.GetAccountWithJournalCalled = func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+		peerAccount, _ := state.NewPeerAccount(addressContainer, &mock.AccountTrackerStub{
+			JournalizeCalled: func(entry state.JournalEntry) {
+			},
+			SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+				return nil
+			},
+		})
+		peerAccount.Stake = big.NewInt(100)
+		peerAccounts[string(addressContainer.Bytes())] = peerAccount
+		return peerAccount, nil
+	}
+	peerState.RemoveAccountCalled = func(addressContainer state.AddressContainer) error {
+		removedAccounts[string(addressContainer.Bytes())] = struct{}{}
+		return nil
+	}
+
+	stakingData := systemSmartContracts.StakingData{
+		StartNonce:    5,
+		Staked:        true,
+		StakeValue:    big.NewInt(100),
+		BlsPubKey:     []byte("stakedBlsPublicKey"),
+		OwnerAddress:  []byte("owner"),
+		RewardAddress: []byte("reward"),
+	}
+	scDataGetter := &mock.ScQueryMock{}
+	scDataGetter.ExecuteQueryCalled = func(query *process.SCQuery) (output *vmcommon.VMOutput, e error) {
+		if string(query.Arguments[0]) == "removedBlsPublicKey" {
+			return &vmcommon.VMOutput{}, nil
+		}
+
+		retData, _ := json.Marshal(&stakingData)
+		return &vmcommon.VMOutput{ReturnData: [][]byte{retData}}, nil
+	}
+
+	arguments := createMockArgumentsNewStakingToPeer()
+	arguments.ArgParser = argParser
+	arguments.CurrTxs = currTx
+	arguments.PeerState = peerState
+	arguments.Marshalizer = &mock.MarshalizerMock{}
+	arguments.ScQuery = scDataGetter
+	stakingToPeer, _ := NewStakingToPeer(arguments)
+
+	blockBody := createBlockBody()
+	err := stakingToPeer.UpdateProtocol(blockBody, 5)
+	assert.Nil(t, err)
+
+	_, removed := removedAccounts["removedBlsPublicKey"]
+	assert.True(t, removed)
+
+	stakedAccount := peerAccounts["stakedBlsPublicKey"]
+	assert.Equal(t, []byte("stakedBlsPublicKey"), stakedAccount.BLSPublicKey)
+	assert.Equal(t, []byte("reward"), stakedAccount.Address)
+	assert.Equal(t, uint64(5), stakedAccount.Nonce)
+
+	peerChanges := stakingToPeer.PeerChanges()
+	assert.Equal(t, 2, len(peerChanges))
+	for _, peerChange := range peerChanges {
+		assert.True(t, string(peerChange.Address) == "removedBlsPublicKey" || string(peerChange.Address) == "stakedBlsPublicKey")
+	}
+}
+
+func TestStakingToPeer_UpdateProtocolStakerShouldSplitTopUpBetweenItsNodes(t *testing.T) {
+	t.Parallel()
+
+	currTx := &mock.TxForCurrentBlockStub{}
+	currTx.GetTxCalled = func(txHash []byte) (handler data.TransactionHandler, e error) {
+		return &smartContractResult.SmartContractResult{
+			RcvAddr: factory.StakingSCAddress,
+		}, nil
+	}
+
+	argParser := &mock.ArgumentParserMock{}
+	argParser.GetStorageUpdatesCalled = func(data string) (updates []*vmcommon.StorageUpdate, e error) {
+		return []*vmcommon.StorageUpdate{
+			{Offset: []byte("staker_owner"), Data: []byte("data")},
+		}, nil
+	}
+
+	peerAccounts := make(map[string]*state.PeerAccount)
+	peerState := &mock.AccountsStub{}
+	peerState.GetAccountWithJournalCalled = func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+		peerAccount, ok := peerAccounts[string(addressContainer.Bytes())]
+		if ok {
+			return peerAccount, nil
+		}
+
+		peerAccount, _ = state.NewPeerAccount(addressContainer, &mock.AccountTrackerStub{
+			JournalizeCalled: func(entry state.JournalEntry) {
+			},
+			SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+				return nil
+			},
+		})
+		peerAccount.TopUpValue = big.NewInt(30)
+		peerAccounts[string(addressContainer.Bytes())] = peerAccount
+		return peerAccount, nil
+	}
+
+	stakerData := systemSmartContracts.StakerData{
+		RewardAddress: []byte("owner"),
+		BlsPubKeys:    [][]byte{[]byte("firstBlsPublicKey"), []byte("secondBlsPublicKey")},
+		TopUpValue:    big.NewInt(61),
+	}
+	scDataGetter := &mock.ScQueryMock{}
+	scDataGetter.ExecuteQueryCalled = func(query *process.SCQuery) (output *vmcommon.VMOutput, e error) {
+		assert.Equal(t, "staker_owner", string(query.Arguments[0]))
+
+		retData, _ := json.Marshal(&stakerData)
+		return &vmcommon.VMOutput{ReturnData: [][]byte{retData}}, nil
+	}
+
+	arguments := createMockArgumentsNewStakingToPeer()
+	arguments.ArgParser = argParser
+	arguments.CurrTxs = currTx
+	arguments.PeerState = peerState
+	arguments.Marshalizer = &mock.MarshalizerMock{}
+	arguments.ScQuery = scDataGetter
+	stakingToPeer, _ := NewStakingToPeer(arguments)
+
+	blockBody := createBlockBody()
+	err := stakingToPeer.UpdateProtocol(blockBody, 5)
+	assert.Nil(t, err)
+
+	assert.Equal(t, big.NewInt(31), peerAccounts["firstBlsPublicKey"].TopUpValue)
+	assert.Equal(t, big.NewInt(30), peerAccounts["secondBlsPublicKey"].TopUpValue)
+
+	peerChanges := stakingToPeer.PeerChanges()
+	assert.Equal(t, 1, len(peerChanges))
+	assert.Equal(t, block.PeerTopUp, peerChanges[0].Action)
+	assert.Equal(t, []byte("firstBlsPublicKey"), peerChanges[0].PublicKey)
+	assert.Equal(t, big.NewInt(1), peerChanges[0].ValueChange)
+}
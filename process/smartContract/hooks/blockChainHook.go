@@ -33,6 +33,7 @@ type BlockChainHookImpl struct {
 
 	mutTempAccounts sync.Mutex
 	tempAccounts    map[string]state.AccountHandler
+	deployAddress   []byte
 }
 
 // NewBlockChainHookImpl creates a new BlockChainHookImpl instance
@@ -303,6 +304,7 @@ func (bh *BlockChainHookImpl) CurrentEpoch() uint32 {
 // The address is created by applied keccak256 on the appended value off creator address and nonce
 // Prefix mask is applied for first 8 bytes 0, and for bytes 9-10 - VM type
 // Suffix mask is applied - last 2 bytes are for the shard ID - mask is applied as suffix mask
+// If a deploy address was set, as in the case of a contract upgrade, that address is returned instead, only once
 func (bh *BlockChainHookImpl) NewAddress(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error) {
 	addressLength := bh.addrConv.AddressLen()
 	if len(creatorAddress) != addressLength {
@@ -313,6 +315,14 @@ func (bh *BlockChainHookImpl) NewAddress(creatorAddress []byte, creatorNonce uin
 		return nil, ErrVMTypeLengthIsNotCorrect
 	}
 
+	bh.mutTempAccounts.Lock()
+	deployAddress := bh.deployAddress
+	bh.deployAddress = nil
+	bh.mutTempAccounts.Unlock()
+	if len(deployAddress) > 0 {
+		return deployAddress, nil
+	}
+
 	base := hashFromAddressAndNonce(creatorAddress, creatorNonce)
 	prefixMask := createPrefixMask(vmType)
 	suffixMask := createSuffixMask(creatorAddress)
@@ -404,6 +414,15 @@ func (bh *BlockChainHookImpl) AddTempAccount(address []byte, balance *big.Int, n
 func (bh *BlockChainHookImpl) CleanTempAccounts() {
 	bh.mutTempAccounts.Lock()
 	bh.tempAccounts = make(map[string]state.AccountHandler, 0)
+	bh.deployAddress = nil
+	bh.mutTempAccounts.Unlock()
+}
+
+// SetDeployAddress sets the address at which the next contract create will put the code, used when upgrading
+// an existing contract. The address is reset once it is used or when the temporary accounts are cleaned
+func (bh *BlockChainHookImpl) SetDeployAddress(address []byte) {
+	bh.mutTempAccounts.Lock()
+	bh.deployAddress = address
 	bh.mutTempAccounts.Unlock()
 }
 
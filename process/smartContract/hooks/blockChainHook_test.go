@@ -531,3 +531,40 @@ func TestBlockChainHookImpl_NewAddress(t *testing.T) {
 
 	fmt.Printf("%s \n%s \n", hex.EncodeToString(scAddress1), hex.EncodeToString(scAddress2))
 }
+
+func TestBlockChainHookImpl_NewAddressWithDeployAddressShouldReturnItOnce(t *testing.T) {
+	t.Parallel()
+
+	args := createMockVMAccountsArguments()
+	args.AddrConv = mock.NewAddressConverterFake(32, "")
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+
+	address := []byte("01234567890123456789012345678900")
+	deployAddress := []byte("00000000000000000000000000000001")
+	bh.SetDeployAddress(deployAddress)
+
+	scAddress, err := bh.NewAddress(address, 10, []byte("05"))
+	assert.Nil(t, err)
+	assert.Equal(t, deployAddress, scAddress)
+
+	scAddress, err = bh.NewAddress(address, 10, []byte("05"))
+	assert.Nil(t, err)
+	assert.NotEqual(t, deployAddress, scAddress)
+}
+
+func TestBlockChainHookImpl_NewAddressAfterCleanTempAccountsShouldNotReturnDeployAddress(t *testing.T) {
+	t.Parallel()
+
+	args := createMockVMAccountsArguments()
+	args.AddrConv = mock.NewAddressConverterFake(32, "")
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+
+	address := []byte("01234567890123456789012345678900")
+	deployAddress := []byte("00000000000000000000000000000001")
+	bh.SetDeployAddress(deployAddress)
+	bh.CleanTempAccounts()
+
+	scAddress, err := bh.NewAddress(address, 10, []byte("05"))
+	assert.Nil(t, err)
+	assert.NotEqual(t, deployAddress, scAddress)
+}
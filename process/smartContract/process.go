@@ -4,30 +4,25 @@ import (
 	"bytes"
 	"encoding/hex"
 	"math/big"
-	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/logger"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/esdt"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
 	"github.com/ElrondNetwork/elrond-vm-common"
 )
 
 var log = logger.GetOrCreate("process/smartcontract")
 
-type scExecutionState struct {
-	allLogs       map[string][]*vmcommon.LogEntry
-	allReturnData map[string][]*big.Int
-	returnCodes   map[string]vmcommon.ReturnCode
-	rootHash      []byte
-}
-
 type scProcessor struct {
 	accounts         state.AccountsAdapter
 	tempAccounts     process.TemporaryAccountsHandler
@@ -39,14 +34,12 @@ type scProcessor struct {
 	argsParser       process.ArgumentsParser
 	isCallBack       bool
 
-	mutSCState   sync.Mutex
-	mapExecState map[uint64]scExecutionState
-
-	scrForwarder  process.IntermediateTransactionHandler
-	txFeeHandler  process.TransactionFeeHandler
-	economicsFee  process.FeeHandler
-	txTypeHandler process.TxTypeHandler
-	gasHandler    process.GasHandler
+	scrForwarder    process.IntermediateTransactionHandler
+	txFeeHandler    process.TransactionFeeHandler
+	economicsFee    process.FeeHandler
+	txTypeHandler   process.TxTypeHandler
+	gasHandler      process.GasHandler
+	receiptsHandler process.ReceiptsHandler
 }
 
 // NewSmartContractProcessor create a smart contract processor creates and interprets VM data
@@ -64,6 +57,7 @@ func NewSmartContractProcessor(
 	economicsFee process.FeeHandler,
 	txTypeHandler process.TxTypeHandler,
 	gasHandler process.GasHandler,
+	receiptsHandler process.ReceiptsHandler,
 ) (*scProcessor, error) {
 
 	if check.IfNil(vmContainer) {
@@ -105,6 +99,9 @@ func NewSmartContractProcessor(
 	if check.IfNil(gasHandler) {
 		return nil, process.ErrNilGasHandler
 	}
+	if check.IfNil(receiptsHandler) {
+		return nil, process.ErrNilReceiptsHandler
+	}
 
 	return &scProcessor{
 		vmContainer:      vmContainer,
@@ -120,7 +117,8 @@ func NewSmartContractProcessor(
 		economicsFee:     economicsFee,
 		txTypeHandler:    txTypeHandler,
 		gasHandler:       gasHandler,
-		mapExecState:     make(map[uint64]scExecutionState)}, nil
+		receiptsHandler:  receiptsHandler,
+	}, nil
 }
 
 func (sc *scProcessor) checkTxValidity(tx data.TransactionHandler) error {
@@ -183,21 +181,38 @@ func (sc *scProcessor) ExecuteSmartContractTransaction(
 		return nil
 	}
 
+	if isSCOwnerFunction(vmInput.Function) {
+		err = sc.executeSCOwnerFunction(vmInput, tx, acntSnd, acntDst)
+		return nil
+	}
+
 	vm, err := sc.getVMFromRecvAddress(tx)
 	if err != nil {
 		return nil
 	}
 
-	vmOutput, err := vm.RunSmartContractCall(vmInput)
+	var vmOutput *vmcommon.VMOutput
+	if vmInput.Function == core.BuiltInFunctionUpgradeContract {
+		vmOutput, err = sc.runSmartContractUpgrade(vm, vmInput, tx, acntDst)
+	} else {
+		vmOutput, err = vm.RunSmartContractCall(vmInput)
+	}
 	if err != nil {
 		return nil
 	}
 
-	results, consumedFee, err := sc.processVMOutput(vmOutput, tx, acntSnd, round)
+	results, consumedFee, err := sc.processVMOutput(vmOutput, tx, acntSnd)
 	if err != nil {
 		return nil
 	}
 
+	if vmOutput.ReturnCode == vmcommon.Ok {
+		consumedFee, err = sc.addDeveloperReward(tx.GetRecvAddress(), consumedFee)
+		if err != nil {
+			return nil
+		}
+	}
+
 	err = sc.scrForwarder.AddIntermediateTransactions(results)
 	if err != nil {
 		return nil
@@ -208,17 +223,229 @@ func (sc *scProcessor) ExecuteSmartContractTransaction(
 	return nil
 }
 
+// runSmartContractUpgrade replaces the code of an upgradable smart contract by running the new code through the same
+// VM create path as a deploy, at the address of the existing contract, so its data trie is kept
+func (sc *scProcessor) runSmartContractUpgrade(
+	vm vmcommon.VMExecutionHandler,
+	vmInput *vmcommon.ContractCallInput,
+	tx data.TransactionHandler,
+	acntDst state.AccountHandler,
+) (*vmcommon.VMOutput, error) {
+	stAcc, ok := acntDst.(*state.Account)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+	if !bytes.Equal(stAcc.OwnerAddress, tx.GetSndAddress()) {
+		return nil, process.ErrCallerIsNotSCOwner
+	}
+	if !stAcc.IsUpgradable() {
+		return nil, process.ErrSCIsNotUpgradable
+	}
+	if len(vmInput.Arguments) < 1 {
+		return nil, process.ErrNotEnoughArgumentsToDeploy
+	}
+
+	vmCreateInput := &vmcommon.ContractCreateInput{
+		VMInput:      vmInput.VMInput,
+		ContractCode: vmInput.Arguments[0],
+	}
+	// delete the first argument as it is the new code
+	vmCreateInput.Arguments = vmInput.Arguments[1:]
+
+	sc.tempAccounts.SetDeployAddress(tx.GetRecvAddress())
+
+	return vm.RunSmartContractCreate(vmCreateInput)
+}
+
+func isSCOwnerFunction(function string) bool {
+	return function == core.BuiltInFunctionClaimDeveloperRewards || function == core.BuiltInFunctionChangeOwnerAddress
+}
+
+// executeSCOwnerFunction applies the functions through which the owner manages a smart contract, without invoking the
+// VM. Only the gas needed by a move balance is consumed, the rest being refunded to the caller
+func (sc *scProcessor) executeSCOwnerFunction(
+	vmInput *vmcommon.ContractCallInput,
+	tx data.TransactionHandler,
+	acntSnd, acntDst state.AccountHandler,
+) error {
+	if tx.GetValue().Sign() != 0 {
+		return process.ErrInvalidBuiltInFunctionCall
+	}
+
+	stAcc, ok := acntDst.(*state.Account)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+	if !bytes.Equal(stAcc.OwnerAddress, tx.GetSndAddress()) {
+		return process.ErrCallerIsNotSCOwner
+	}
+
+	txHash, err := sc.computeTransactionHash(tx)
+	if err != nil {
+		return err
+	}
+
+	scrTxs := make([]data.TransactionHandler, 0)
+	switch vmInput.Function {
+	case core.BuiltInFunctionClaimDeveloperRewards:
+		scrReward, err := sc.claimDeveloperRewards(stAcc, tx, txHash)
+		if err != nil {
+			return err
+		}
+		if scrReward != nil {
+			scrTxs = append(scrTxs, scrReward)
+		}
+	case core.BuiltInFunctionChangeOwnerAddress:
+		isNewOwnerValid := len(vmInput.Arguments) == 1 && len(vmInput.Arguments[0]) == sc.adrConv.AddressLen()
+		if !isNewOwnerValid {
+			return process.ErrInvalidBuiltInFunctionCall
+		}
+		err = stAcc.SetOwnerAddressWithJournal(vmInput.Arguments[0])
+		if err != nil {
+			return err
+		}
+	}
+
+	acntSnd, err = sc.reloadLocalSndAccount(acntSnd)
+	if err != nil {
+		return err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided,
+		GasRefund:    big.NewInt(0),
+	}
+	sc.gasHandler.SetGasRefunded(vmOutput.GasRemaining, txHash)
+	scrRefund, consumedFee, err := sc.createSCRForSender(vmOutput, tx, txHash, acntSnd)
+	if err != nil {
+		return err
+	}
+	scrTxs = append(scrTxs, scrRefund)
+	sc.saveReceipt(txHash, consumedFee, vmOutput.GasRemaining, vmOutput.ReturnCode, nil, nil)
+
+	err = sc.scrForwarder.AddIntermediateTransactions(scrTxs)
+	if err != nil {
+		return err
+	}
+
+	sc.txFeeHandler.ProcessTransactionFee(consumedFee)
+
+	return nil
+}
+
+// claimDeveloperRewards moves the reward accumulated by the smart contract to its owner, crediting the owner directly
+// if it is in the current shard
+func (sc *scProcessor) claimDeveloperRewards(
+	scAcc *state.Account,
+	tx data.TransactionHandler,
+	txHash []byte,
+) (*smartContractResult.SmartContractResult, error) {
+	reward := scAcc.DeveloperReward
+	if reward == nil || reward.Sign() == 0 {
+		return nil, nil
+	}
+
+	err := scAcc.SetDeveloperRewardWithJournal(big.NewInt(0))
+	if err != nil {
+		return nil, err
+	}
+
+	ownerAcc, err := sc.getAccountFromAddress(scAcc.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+	if !check.IfNil(ownerAcc) {
+		stOwnerAcc, ok := ownerAcc.(*state.Account)
+		if !ok {
+			return nil, process.ErrWrongTypeAssertion
+		}
+
+		err = stOwnerAcc.SetBalanceWithJournal(big.NewInt(0).Add(stOwnerAcc.Balance, reward))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	scrReward := &smartContractResult.SmartContractResult{
+		Nonce:    tx.GetNonce(),
+		Value:    reward,
+		RcvAddr:  scAcc.OwnerAddress,
+		SndAddr:  tx.GetRecvAddress(),
+		GasPrice: tx.GetGasPrice(),
+		TxHash:   txHash,
+	}
+
+	return scrReward, nil
+}
+
+// addDeveloperReward keeps the configured share of the fee paid for a smart contract call as reward for the owner of
+// the contract and returns the rest of the fee
+func (sc *scProcessor) addDeveloperReward(scAddress []byte, consumedFee *big.Int) (*big.Int, error) {
+	developerPercentage := sc.economicsFee.DeveloperPercentage()
+	if consumedFee == nil || developerPercentage <= 0 {
+		return consumedFee, nil
+	}
+
+	// the account is reloaded as the output of the call might have changed it
+	acc, err := sc.getAccountFromAddress(scAddress)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(acc) {
+		return consumedFee, nil
+	}
+
+	stAcc, ok := acc.(*state.Account)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+	if len(stAcc.OwnerAddress) == 0 {
+		return consumedFee, nil
+	}
+
+	developerFee := core.GetPercentageOfValue(consumedFee, developerPercentage)
+	developerReward := big.NewInt(0).Set(developerFee)
+	if stAcc.DeveloperReward != nil {
+		developerReward.Add(developerReward, stAcc.DeveloperReward)
+	}
+
+	err = stAcc.SetDeveloperRewardWithJournal(developerReward)
+	if err != nil {
+		return nil, err
+	}
+
+	return big.NewInt(0).Sub(consumedFee, developerFee), nil
+}
+
 func (sc *scProcessor) processIfError(
 	acntSnd state.AccountHandler,
 	tx data.TransactionHandler,
 	returnCode vmcommon.ReturnCode,
 ) error {
-	consumedFee := big.NewInt(0).SetUint64(tx.GetGasLimit() * tx.GetGasPrice())
-	scrIfError, err := sc.createSCRsWhenError(tx, returnCode)
+	txHash, err := sc.computeTransactionHash(tx)
+	if err != nil {
+		return err
+	}
+
+	scrTokens, err := sc.returnTokensOfFailedTransfer(acntSnd, tx, txHash)
 	if err != nil {
 		return err
 	}
 
+	consumedFee := big.NewInt(0).SetUint64(tx.GetGasLimit() * tx.GetGasPrice())
+	scrIfError := sc.createSCRsWhenError(tx, txHash, returnCode)
+	scrIfError = append(scrIfError, scrTokens...)
+	sc.saveReceipt(txHash, consumedFee, 0, returnCode, nil, nil)
+
+	if check.IfNil(acntSnd) && sc.isCallBack {
+		// the value of a failed callback stays with the calling smart contract
+		acntSnd, err = sc.getAccountFromAddress(tx.GetRecvAddress())
+		if err != nil {
+			return err
+		}
+	}
+
 	if !check.IfNil(acntSnd) {
 		stAcc, ok := acntSnd.(*state.Account)
 		if !ok {
@@ -242,12 +469,60 @@ func (sc *scProcessor) processIfError(
 	return nil
 }
 
+// returnTokensOfFailedTransfer gives back the tokens a user sent to a smart contract whose call failed. The contract
+// loses the tokens credited before the call, except the esdt smart contract which is never credited, and the sender is
+// credited directly or, if it is in another shard, through a smart contract result
+func (sc *scProcessor) returnTokensOfFailedTransfer(
+	acntSnd state.AccountHandler,
+	tx data.TransactionHandler,
+	txHash []byte,
+) ([]data.TransactionHandler, error) {
+	isTransferToSC := core.IsSmartContractAddress(tx.GetRecvAddress()) && !core.IsSmartContractAddress(tx.GetSndAddress())
+	if sc.isCallBack || !isTransferToSC {
+		return nil, nil
+	}
+
+	call, err := esdt.ParseCall(tx.GetData())
+	if err != nil || call.Function != core.BuiltInFunctionESDTTransfer {
+		return nil, nil
+	}
+
+	if !bytes.Equal(tx.GetRecvAddress(), factory.ESDTSCAddress) {
+		acntDst, err := sc.getAccountFromAddress(tx.GetRecvAddress())
+		if err != nil {
+			return nil, err
+		}
+
+		err = esdt.RevertCredit(sc.marshalizer, sc.accounts, acntDst, call.TokenName, call.Value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !check.IfNil(acntSnd) {
+		err = esdt.Credit(sc.marshalizer, sc.accounts, acntSnd, call.TokenName, call.Value)
+		return nil, err
+	}
+
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:    tx.GetNonce(),
+		Value:    big.NewInt(0),
+		RcvAddr:  tx.GetSndAddress(),
+		SndAddr:  tx.GetRecvAddress(),
+		Data:     tx.GetData(),
+		TxHash:   txHash,
+		GasPrice: tx.GetGasPrice(),
+	}
+
+	return []data.TransactionHandler{scr}, nil
+}
+
 func (sc *scProcessor) prepareSmartContractCall(tx data.TransactionHandler, acntSnd state.AccountHandler) error {
 	sc.isCallBack = false
 	dataToParse := tx.GetData()
 
 	scr, ok := tx.(*smartContractResult.SmartContractResult)
-	isSCRResultFromCrossShardCall := ok && len(scr.Data) > 0 && scr.Data[0] == '@'
+	isSCRResultFromCrossShardCall := ok && scr.CallType == smartContractResult.AsynchronousCallBack
 	if isSCRResultFromCrossShardCall {
 		dataToParse = "callBack" + tx.GetData()
 		sc.isCallBack = true
@@ -270,7 +545,12 @@ func (sc *scProcessor) prepareSmartContractCall(tx data.TransactionHandler, acnt
 }
 
 func (sc *scProcessor) getVMTypeFromArguments(vmType []byte) ([]byte, error) {
-	// first parsed argument after the code in case of vmDeploy is the actual vmType
+	// first parsed argument after the code in case of vmDeploy is the actual vmType, optionally followed by the
+	// code metadata
+	if len(vmType) == core.VMTypeLen+core.CodeMetadataLen {
+		vmType = vmType[:core.VMTypeLen]
+	}
+
 	vmAppendedType := make([]byte, core.VMTypeLen)
 	vmArgLen := len(vmType)
 	if vmArgLen > core.VMTypeLen {
@@ -281,6 +561,48 @@ func (sc *scProcessor) getVMTypeFromArguments(vmType []byte) ([]byte, error) {
 	return vmAppendedType, nil
 }
 
+// getCodeMetadataFromArguments returns the code metadata appended to the vmType argument of a deploy, if any
+func (sc *scProcessor) getCodeMetadataFromArguments() ([]byte, error) {
+	arguments, err := sc.argsParser.GetArguments()
+	if err != nil {
+		return nil, err
+	}
+	if len(arguments) < 1 || len(arguments[0]) != core.VMTypeLen+core.CodeMetadataLen {
+		return nil, nil
+	}
+
+	return arguments[0][core.VMTypeLen:], nil
+}
+
+// setCodeMetadata saves the code metadata on the accounts which received code from the VM
+func (sc *scProcessor) setCodeMetadata(outputAccounts []*vmcommon.OutputAccount, codeMetadata []byte) error {
+	for _, outAcc := range outputAccounts {
+		if len(outAcc.Code) == 0 {
+			continue
+		}
+
+		acc, err := sc.getAccountFromAddress(outAcc.Address)
+		if err != nil {
+			return err
+		}
+		if acc == nil || acc.IsInterfaceNil() {
+			continue
+		}
+
+		stAcc, ok := acc.(*state.Account)
+		if !ok {
+			return process.ErrWrongTypeAssertion
+		}
+
+		err = stAcc.SetCodeMetadataWithJournal(codeMetadata)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (sc *scProcessor) getVMFromRecvAddress(tx data.TransactionHandler) (vmcommon.VMExecutionHandler, error) {
 	vmType := core.GetVMType(tx.GetRecvAddress())
 	vm, err := sc.vmContainer.Get(vmType)
@@ -336,6 +658,12 @@ func (sc *scProcessor) DeploySmartContract(
 		return nil
 	}
 
+	codeMetadata, err := sc.getCodeMetadataFromArguments()
+	if err != nil {
+		log.Debug("Transaction error", "error", err.Error())
+		return nil
+	}
+
 	vm, err := sc.vmContainer.Get(vmType)
 	if err != nil {
 		log.Debug("VM error", "error", err.Error())
@@ -348,12 +676,20 @@ func (sc *scProcessor) DeploySmartContract(
 		return nil
 	}
 
-	results, consumedFee, err := sc.processVMOutput(vmOutput, tx, acntSnd, round)
+	results, consumedFee, err := sc.processVMOutput(vmOutput, tx, acntSnd)
 	if err != nil {
 		log.Debug("Processing error", "error", err.Error())
 		return nil
 	}
 
+	if vmOutput.ReturnCode == vmcommon.Ok && len(codeMetadata) > 0 {
+		err = sc.setCodeMetadata(vmOutput.OutputAccounts, codeMetadata)
+		if err != nil {
+			log.Debug("Processing error", "error", err.Error())
+			return nil
+		}
+	}
+
 	err = sc.scrForwarder.AddIntermediateTransactions(results)
 	if err != nil {
 		log.Debug("Processing error", "error", err.Error())
@@ -498,7 +834,6 @@ func (sc *scProcessor) processVMOutput(
 	vmOutput *vmcommon.VMOutput,
 	tx data.TransactionHandler,
 	acntSnd state.AccountHandler,
-	round uint64,
 ) ([]data.TransactionHandler, *big.Int, error) {
 	if vmOutput == nil {
 		return nil, nil, process.ErrNilVMOutput
@@ -512,11 +847,6 @@ func (sc *scProcessor) processVMOutput(
 		return nil, nil, err
 	}
 
-	err = sc.saveSCOutputToCurrentState(vmOutput, round, txHash)
-	if err != nil {
-		return nil, nil, err
-	}
-
 	if vmOutput.ReturnCode != vmcommon.Ok {
 		log.Debug("error processing tx VM",
 			"hash", txHash,
@@ -527,6 +857,11 @@ func (sc *scProcessor) processVMOutput(
 		return nil, nil, err
 	}
 
+	err = checkBuiltInFunctionCalls(vmOutput.OutputAccounts, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	err = sc.processSCOutputAccounts(vmOutput.OutputAccounts, tx)
 	if err != nil {
 		return nil, nil, err
@@ -555,6 +890,9 @@ func (sc *scProcessor) processVMOutput(
 		return nil, nil, err
 	}
 
+	gasRefunded := vmOutput.GasRemaining + vmOutput.GasRefund.Uint64()
+	sc.saveReceipt(txHash, consumedFee, gasRefunded, vmOutput.ReturnCode, vmOutput.ReturnData, vmOutput.Logs)
+
 	if scrRefund != nil {
 		scrTxs = append(scrTxs, scrRefund)
 	}
@@ -575,15 +913,27 @@ func (sc *scProcessor) processVMOutput(
 	return scrTxs, consumedFee, nil
 }
 
+// checkBuiltInFunctionCalls rejects the output of a smart contract which calls the fungible token functions built into
+// the protocol. Only the esdt smart contract can send tokens, the other contracts keep the tokens they receive
+func checkBuiltInFunctionCalls(outputAccounts []*vmcommon.OutputAccount, tx data.TransactionHandler) error {
+	if bytes.Equal(tx.GetRecvAddress(), factory.ESDTSCAddress) {
+		return nil
+	}
+
+	for _, outAcc := range outputAccounts {
+		if esdt.IsBuiltInFunctionCall(string(outAcc.Data)) {
+			return process.ErrBuiltInFunctionCallNotAllowed
+		}
+	}
+
+	return nil
+}
+
 func (sc *scProcessor) createSCRsWhenError(
 	tx data.TransactionHandler,
+	txHash []byte,
 	returnCode vmcommon.ReturnCode,
-) ([]data.TransactionHandler, error) {
-	txHash, err := core.CalculateHash(sc.marshalizer, sc.hasher, tx)
-	if err != nil {
-		return nil, err
-	}
-
+) []data.TransactionHandler {
 	rcvAddress := tx.GetSndAddress()
 	if sc.isCallBack {
 		rcvAddress = tx.GetRecvAddress()
@@ -598,16 +948,17 @@ func (sc *scProcessor) createSCRsWhenError(
 		Data:    "@" + hex.EncodeToString([]byte(returnCode.String())) + "@" + hex.EncodeToString(txHash),
 		TxHash:  txHash,
 	}
+	setCallBackFields(scr, tx)
 
 	resultedScrs := make([]data.TransactionHandler, 0)
 	resultedScrs = append(resultedScrs, scr)
 
-	return resultedScrs, nil
+	return resultedScrs
 }
 
 // reloadLocalSndAccount will reload from current account state the sender account
 // this requirement is needed because in the case of refunding the exact account that was previously
-// modified in saveSCOutputToCurrentState, the modifications done there should be visible here
+// modified in processSCOutputAccounts, the modifications done there should be visible here
 func (sc *scProcessor) reloadLocalSndAccount(acntSnd state.AccountHandler) (state.AccountHandler, error) {
 	if acntSnd == nil || acntSnd.IsInterfaceNil() {
 		return acntSnd, nil
@@ -638,9 +989,105 @@ func (sc *scProcessor) createSmartContractResult(
 	result.GasPrice = tx.GetGasPrice()
 	result.TxHash = txHash
 
+	if sc.isCrossShardSCCall(outAcc) {
+		result.CallType = smartContractResult.AsynchronousCall
+		result.OriginalSender = getOriginalSender(tx)
+	}
+
 	return result
 }
 
+// isCrossShardSCCall returns true if the output account is a call to a smart contract from another shard, which
+// is executed asynchronously, its result being returned to the caller in a later block
+func (sc *scProcessor) isCrossShardSCCall(outAcc *vmcommon.OutputAccount) bool {
+	if len(outAcc.Data) == 0 || !core.IsSmartContractAddress(outAcc.Address) {
+		return false
+	}
+
+	adrDst, err := sc.adrConv.CreateAddressFromPublicKeyBytes(outAcc.Address)
+	if err != nil {
+		return false
+	}
+
+	return sc.shardCoordinator.ComputeId(adrDst) != sc.shardCoordinator.SelfId()
+}
+
+// getOriginalSender returns the account which started the call chain the transaction is part of
+func getOriginalSender(tx data.TransactionHandler) []byte {
+	scr, ok := tx.(*smartContractResult.SmartContractResult)
+	if ok && len(scr.OriginalSender) > 0 {
+		return scr.OriginalSender
+	}
+
+	return tx.GetSndAddress()
+}
+
+// getRefundAddress returns the account which receives the gas left after processing the transaction. The callback of
+// an asynchronous call refunds the account which started the call chain
+func (sc *scProcessor) getRefundAddress(tx data.TransactionHandler) []byte {
+	if !sc.isCallBack {
+		return tx.GetSndAddress()
+	}
+
+	scr, ok := tx.(*smartContractResult.SmartContractResult)
+	if ok && len(scr.OriginalSender) > 0 {
+		return scr.OriginalSender
+	}
+
+	return tx.GetRecvAddress()
+}
+
+// setCallBackFields marks the result of an asynchronous call as a callback to the calling smart contract
+func setCallBackFields(result *smartContractResult.SmartContractResult, tx data.TransactionHandler) {
+	if !isAsynchronousCall(tx) {
+		return
+	}
+
+	result.CallType = smartContractResult.AsynchronousCallBack
+	result.OriginalSender = tx.(*smartContractResult.SmartContractResult).OriginalSender
+}
+
+func isAsynchronousCall(tx data.TransactionHandler) bool {
+	scr, ok := tx.(*smartContractResult.SmartContractResult)
+	return ok && scr.CallType == smartContractResult.AsynchronousCall
+}
+
+// isValueReturnedToCaller returns true if the output account only moves value back to the smart contract which made
+// the asynchronous call, value which is carried by the callback
+func isValueReturnedToCaller(outAcc *vmcommon.OutputAccount, tx data.TransactionHandler) bool {
+	if !isAsynchronousCall(tx) {
+		return false
+	}
+
+	return bytes.Equal(outAcc.Address, tx.GetSndAddress()) && len(outAcc.Data) == 0 && len(outAcc.Code) == 0
+}
+
+// getValueReturnedToCaller sums the value the asynchronous call moves back to the smart contract which made it
+func getValueReturnedToCaller(outAccs []*vmcommon.OutputAccount, tx data.TransactionHandler) *big.Int {
+	returnedValue := big.NewInt(0)
+	for _, outAcc := range outAccs {
+		if outAcc.BalanceDelta == nil || !isValueReturnedToCaller(outAcc, tx) {
+			continue
+		}
+		returnedValue.Add(returnedValue, outAcc.BalanceDelta)
+	}
+
+	return returnedValue
+}
+
+// getGasForwardedToAsynchronousCalls sums the gas given to the calls to smart contracts from other shards. This gas
+// is paid for in the shards which execute the calls and their callbacks, from the gas limit of their results
+func (sc *scProcessor) getGasForwardedToAsynchronousCalls(outAccs []*vmcommon.OutputAccount) uint64 {
+	gasForwarded := uint64(0)
+	for _, outAcc := range outAccs {
+		if sc.isCrossShardSCCall(outAcc) {
+			gasForwarded += outAcc.GasLimit
+		}
+	}
+
+	return gasForwarded
+}
+
 func (sc *scProcessor) createSCRTransactions(
 	outAccs []*vmcommon.OutputAccount,
 	tx data.TransactionHandler,
@@ -649,6 +1096,10 @@ func (sc *scProcessor) createSCRTransactions(
 	scResults := make([]data.TransactionHandler, 0)
 
 	for i := 0; i < len(outAccs); i++ {
+		if isValueReturnedToCaller(outAccs[i], tx) {
+			continue
+		}
+
 		scTx := sc.createSmartContractResult(outAccs[i], tx, txHash)
 		scResults = append(scResults, scTx)
 	}
@@ -673,10 +1124,13 @@ func (sc *scProcessor) createSCRForSender(
 	refundErd = refundErd.Mul(gasRefund, big.NewInt(int64(tx.GetGasPrice())))
 	consumedFee = consumedFee.Sub(consumedFee, refundErd)
 
-	rcvAddress := tx.GetSndAddress()
-	if sc.isCallBack {
-		rcvAddress = tx.GetRecvAddress()
-	}
+	gasForwarded := sc.getGasForwardedToAsynchronousCalls(vmOutput.OutputAccounts)
+	consumedFee = consumedFee.Sub(consumedFee, big.NewInt(0).Mul(
+		big.NewInt(0).SetUint64(gasForwarded),
+		big.NewInt(0).SetUint64(tx.GetGasPrice()),
+	))
+
+	rcvAddress := sc.getRefundAddress(tx)
 
 	scTx := &smartContractResult.SmartContractResult{}
 	scTx.Value = refundErd
@@ -691,6 +1145,23 @@ func (sc *scProcessor) createSCRForSender(
 	for _, retData := range vmOutput.ReturnData {
 		scTx.Data += "@" + hex.EncodeToString(retData)
 	}
+	setCallBackFields(scTx, tx)
+
+	if isAsynchronousCall(tx) {
+		// the gas left is not paid back as value: it is carried to the callback, whose own gas left is refunded to
+		// the original sender
+		scTx.Value = getValueReturnedToCaller(vmOutput.OutputAccounts, tx)
+		scTx.GasLimit = gasRefund.Uint64()
+		return scTx, consumedFee, nil
+	}
+
+	if check.IfNil(acntSnd) && sc.isCallBack {
+		var err error
+		acntSnd, err = sc.getAccountFromAddress(rcvAddress)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
 
 	if acntSnd == nil || acntSnd.IsInterfaceNil() {
 		return scTx, consumedFee, nil
@@ -732,6 +1203,9 @@ func (sc *scProcessor) processSCOutputAccounts(outputAccounts []*vmcommon.Output
 
 		for j := 0; j < len(outAcc.StorageUpdates); j++ {
 			storeUpdate := outAcc.StorageUpdates[j]
+			if esdt.IsProtectedKey(storeUpdate.Offset) {
+				return process.ErrStorageKeyIsProtected
+			}
 			acc.DataTrieTracker().SaveKeyValue(storeUpdate.Offset, storeUpdate.Data)
 		}
 
@@ -750,6 +1224,11 @@ func (sc *scProcessor) processSCOutputAccounts(outputAccounts []*vmcommon.Output
 				return err
 			}
 
+			err = sc.setOwnerIfMissing(acc, tx.GetSndAddress())
+			if err != nil {
+				return err
+			}
+
 			log.Debug("created SC address", "address", hex.EncodeToString(outAcc.Address))
 		}
 
@@ -797,6 +1276,19 @@ func (sc *scProcessor) processSCOutputAccounts(outputAccounts []*vmcommon.Output
 	return nil
 }
 
+// setOwnerIfMissing makes the sender of the deploy transaction the owner of a newly created smart contract
+func (sc *scProcessor) setOwnerIfMissing(acc state.AccountHandler, owner []byte) error {
+	stAcc, ok := acc.(*state.Account)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+	if len(stAcc.OwnerAddress) > 0 {
+		return nil
+	}
+
+	return stAcc.SetOwnerAddressWithJournal(owner)
+}
+
 // delete accounts - only suicide by current SC or another SC called by current SC - protected by VM
 func (sc *scProcessor) deleteAccounts(deletedAccounts [][]byte) error {
 	for _, value := range deletedAccounts {
@@ -843,62 +1335,32 @@ func (sc *scProcessor) getAccountFromAddress(address []byte) (state.AccountHandl
 	return acnt, nil
 }
 
-// saves VM output into state
-func (sc *scProcessor) saveSCOutputToCurrentState(output *vmcommon.VMOutput, round uint64, txHash []byte) error {
-	var err error
-
-	sc.mutSCState.Lock()
-	defer sc.mutSCState.Unlock()
-
-	/*
-		if _, ok := sc.mapExecState[round]; !ok {
-			sc.mapExecState[round] = scExecutionState{
-				allLogs:       make(map[string][]*vmcommon.LogEntry),
-				allReturnData: make(map[string][]*big.Int),
-				returnCodes:   make(map[string]vmcommon.ReturnCode)}
-		}*/
-
-	//tmpCurrScState := sc.mapExecState[round]
-	defer func() {
-		if err != nil {
-			//sc.mapExecState[round] = tmpCurrScState
-		}
-	}()
-
-	err = sc.saveReturnData(output.ReturnData, round, txHash)
-	if err != nil {
-		return err
-	}
-
-	err = sc.saveReturnCode(output.ReturnCode, round, txHash)
-	if err != nil {
-		return err
-	}
-
-	err = sc.saveLogsIntoState(output.Logs, round, txHash)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// saves return data into account state
-func (sc *scProcessor) saveReturnData(returnData [][]byte, round uint64, txHash []byte) error {
-	//sc.mapExecState[round].allReturnData[string(txHash)] = returnData
-	return nil
-}
-
-// saves smart contract return code into account state
-func (sc *scProcessor) saveReturnCode(returnCode vmcommon.ReturnCode, round uint64, txHash []byte) error {
-	//sc.mapExecState[round].returnCodes[string(txHash)] = returnCode
-	return nil
-}
-
-// save vm output logs into accounts
-func (sc *scProcessor) saveLogsIntoState(logs []*vmcommon.LogEntry, round uint64, txHash []byte) error {
-	//sc.mapExecState[round].allLogs[string(txHash)] = logs
-	return nil
+// saveReceipt keeps the outcome of the transaction, to be saved in the receipts storage unit when the block is
+// committed
+func (sc *scProcessor) saveReceipt(
+	txHash []byte,
+	fee *big.Int,
+	gasRefunded uint64,
+	returnCode vmcommon.ReturnCode,
+	returnData [][]byte,
+	logs []*vmcommon.LogEntry,
+) {
+	rcpt := &receipt.Receipt{
+		TxHash:      txHash,
+		Fee:         big.NewInt(0).Set(fee),
+		GasRefunded: gasRefunded,
+		ReturnCode:  returnCode.String(),
+		ReturnData:  returnData,
+	}
+	for _, logEntry := range logs {
+		rcpt.Logs = append(rcpt.Logs, &receipt.Log{
+			Address: logEntry.Address,
+			Topics:  logEntry.Topics,
+			Data:    logEntry.Data,
+		})
+	}
+
+	sc.receiptsHandler.AddReceipt(rcpt)
 }
 
 // ProcessSmartContractResult updates the account state from the smart contract result
@@ -907,6 +1369,7 @@ func (sc *scProcessor) ProcessSmartContractResult(scr *smartContractResult.Smart
 		return process.ErrNilSmartContractResult
 	}
 
+	sc.isCallBack = false
 	var err error
 	defer func() {
 		if err != nil {
@@ -941,12 +1404,78 @@ func (sc *scProcessor) ProcessSmartContractResult(scr *smartContractResult.Smart
 	case process.SCInvoking:
 		err = sc.ExecuteSmartContractTransaction(scr, nil, dstAcc, scr.Nonce)
 		return nil
+	case process.BuiltInFunctionCall:
+		err = sc.processBuiltInFunctionSCR(scr, dstAcc)
+		return nil
 	}
 
 	err = process.ErrWrongTransaction
 	return nil
 }
 
+// processBuiltInFunctionSCR credits the tokens transferred from another shard and applies the freezes decided by the
+// token owners. Tokens can be forwarded by the sender's shard on behalf of a user, sent by the esdt smart contract or
+// returned by a failed call to a smart contract, as the other contracts can not call the built in functions. A smart
+// contract receiver is invoked to handle the tokens sent by a user, the esdt smart contract burning them. The freezes
+// can only come from the esdt smart contract
+func (sc *scProcessor) processBuiltInFunctionSCR(
+	scr *smartContractResult.SmartContractResult,
+	dstAcc state.AccountHandler,
+) error {
+	call, err := esdt.ParseCall(scr.Data)
+	if err != nil {
+		return err
+	}
+
+	isSentByESDTSC := bytes.Equal(scr.SndAddr, factory.ESDTSCAddress)
+	switch call.Function {
+	case core.BuiltInFunctionESDTTransfer:
+		isCallToSC := core.IsSmartContractAddress(scr.RcvAddr) && !core.IsSmartContractAddress(scr.SndAddr)
+		if isCallToSC {
+			return sc.processESDTTransferToSC(scr, dstAcc, call)
+		}
+		err = esdt.Credit(sc.marshalizer, sc.accounts, dstAcc, call.TokenName, call.Value)
+	case core.BuiltInFunctionESDTFreeze, core.BuiltInFunctionESDTUnFreeze:
+		if !isSentByESDTSC {
+			return process.ErrBuiltInFunctionCallNotAllowed
+		}
+		isFreeze := call.Function == core.BuiltInFunctionESDTFreeze
+		err = esdt.SetFrozen(sc.marshalizer, sc.accounts, dstAcc, call.TokenName, isFreeze)
+	}
+	if err != nil {
+		return err
+	}
+
+	if scr.Value == nil {
+		return nil
+	}
+
+	stAcc, ok := dstAcc.(*state.Account)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	operation := big.NewInt(0).Add(scr.Value, stAcc.Balance)
+	return stAcc.SetBalanceWithJournal(operation)
+}
+
+// processESDTTransferToSC credits the tokens sent to a smart contract and invokes it with the value and the gas of the
+// transfer. The esdt smart contract is not credited, as it burns the tokens it receives
+func (sc *scProcessor) processESDTTransferToSC(
+	scr *smartContractResult.SmartContractResult,
+	dstAcc state.AccountHandler,
+	call *esdt.Call,
+) error {
+	if !bytes.Equal(scr.RcvAddr, factory.ESDTSCAddress) {
+		err := esdt.Credit(sc.marshalizer, sc.accounts, dstAcc, call.TokenName, call.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.ExecuteSmartContractTransaction(scr, nil, dstAcc, scr.Nonce)
+}
+
 func (sc *scProcessor) processSimpleSCR(
 	scr *smartContractResult.SmartContractResult,
 	dstAcc state.AccountHandler,
@@ -963,6 +1492,10 @@ func (sc *scProcessor) processSimpleSCR(
 		}
 
 		for i := 0; i < len(storageUpdates); i++ {
+			if esdt.IsProtectedKey(storageUpdates[i].Offset) {
+				log.Debug("protected storage key in smart contract result was skipped")
+				continue
+			}
 			stAcc.DataTrieTracker().SaveKeyValue(storageUpdates[i].Offset, storageUpdates[i].Data)
 		}
 
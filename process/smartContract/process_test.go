@@ -2,15 +2,20 @@ package smartContract
 
 import (
 	"bytes"
+	"encoding/hex"
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/esdt"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
 	"github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -63,6 +68,7 @@ func TestNewSmartContractProcessorNilVM(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -86,6 +92,7 @@ func TestNewSmartContractProcessorNilArgsParser(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -109,6 +116,7 @@ func TestNewSmartContractProcessorNilHasher(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -132,6 +140,7 @@ func TestNewSmartContractProcessorNilMarshalizer(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -155,6 +164,7 @@ func TestNewSmartContractProcessorNilAccountsDB(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -178,6 +188,7 @@ func TestNewSmartContractProcessorNilAdrConv(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -201,6 +212,7 @@ func TestNewSmartContractProcessorNilShardCoordinator(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -224,6 +236,7 @@ func TestNewSmartContractProcessorNilFakeAccountsHandler(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -247,6 +260,7 @@ func TestNewSmartContractProcessor_NilIntermediateMock(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -270,6 +284,7 @@ func TestNewSmartContractProcessor_ErrNilUnsignedTxHandlerMock(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -293,12 +308,37 @@ func TestNewSmartContractProcessor_ErrErrNilGasHandlerMock(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		nil,
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.Nil(t, sc)
 	assert.Equal(t, process.ErrNilGasHandler, err)
 }
 
+func TestNewSmartContractProcessor_ErrNilReceiptsHandler(t *testing.T) {
+	t.Parallel()
+
+	sc, err := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.AccountsStub{},
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.FeeHandlerStub{},
+		&mock.TxTypeHandlerMock{},
+		&mock.GasHandlerMock{},
+		nil,
+	)
+
+	assert.Nil(t, sc)
+	assert.Equal(t, process.ErrNilReceiptsHandler, err)
+}
+
 func TestNewSmartContractProcessor(t *testing.T) {
 	t.Parallel()
 
@@ -316,6 +356,7 @@ func TestNewSmartContractProcessor(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -342,6 +383,7 @@ func TestScProcessor_DeploySmartContractBadParse(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -384,6 +426,7 @@ func TestScProcessor_DeploySmartContractRunError(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -436,6 +479,7 @@ func TestScProcessor_DeploySmartContractWrongTx(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -475,6 +519,7 @@ func TestScProcessor_DeploySmartContract(t *testing.T) {
 		&mock.GasHandlerMock{
 			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
 		},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -519,6 +564,7 @@ func TestScProcessor_ExecuteSmartContractTransactionNilTx(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -554,6 +600,7 @@ func TestScProcessor_ExecuteSmartContractTransactionNilAccount(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -597,6 +644,7 @@ func TestScProcessor_ExecuteSmartContractTransactionBadParser(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -639,6 +687,7 @@ func TestScProcessor_ExecuteSmartContractTransactionVMRunError(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -689,6 +738,7 @@ func TestScProcessor_ExecuteSmartContractTransaction(t *testing.T) {
 		&mock.GasHandlerMock{
 			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
 		},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -710,6 +760,70 @@ func TestScProcessor_ExecuteSmartContractTransaction(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestScProcessor_ExecuteSmartContractTransactionUserErrorShouldAddReceiptWithFullFee(t *testing.T) {
+	t.Parallel()
+
+	vmContainer := &mock.VMContainerMock{}
+	accntState := &mock.AccountsStub{}
+	var savedReceipt *receipt.Receipt
+	sc, _ := NewSmartContractProcessor(
+		vmContainer,
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accntState,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.FeeHandlerStub{},
+		&mock.TxTypeHandlerMock{},
+		&mock.GasHandlerMock{
+			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
+		},
+		&mock.ReceiptsHandlerStub{
+			AddReceiptCalled: func(rcpt *receipt.Receipt) {
+				savedReceipt = rcpt
+			},
+		},
+	)
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST0000000")
+	tx.Data = "data"
+	tx.Value = big.NewInt(0)
+	tx.GasLimit = 10
+	tx.GasPrice = 2
+	acntSrc, acntDst := createAccounts(tx)
+
+	accntState.GetAccountWithJournalCalled = func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+		return acntSrc, nil
+	}
+
+	vm := &mock.VMExecutionHandlerStub{}
+	vm.RunSmartContractCallCalled = func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+		return &vmcommon.VMOutput{
+			ReturnCode: vmcommon.UserError,
+			GasRefund:  big.NewInt(0),
+		}, nil
+	}
+	vmContainer.GetCalled = func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+		return vm, nil
+	}
+
+	acntDst.SetCode([]byte("code"))
+	err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst, 10)
+	assert.Nil(t, err)
+
+	assert.NotNil(t, savedReceipt)
+	assert.Equal(t, vmcommon.UserError.String(), savedReceipt.ReturnCode)
+	assert.Equal(t, big.NewInt(20), savedReceipt.Fee)
+	assert.Equal(t, uint64(0), savedReceipt.GasRefunded)
+}
+
 func TestScProcessor_CreateVMCallInputWrongCode(t *testing.T) {
 	t.Parallel()
 
@@ -729,6 +843,7 @@ func TestScProcessor_CreateVMCallInputWrongCode(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -768,6 +883,7 @@ func TestScProcessor_CreateVMCallInput(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -803,6 +919,7 @@ func TestScProcessor_CreateVMDeployInputBadFunction(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -848,6 +965,7 @@ func TestScProcessor_CreateVMDeployInput(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -889,6 +1007,7 @@ func TestScProcessor_CreateVMDeployInputNotEnoughArguments(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -925,6 +1044,7 @@ func TestScProcessor_CreateVMInputWrongArgument(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -968,6 +1088,7 @@ func TestScProcessor_CreateVMInputNotEnoughGas(t *testing.T) {
 		},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1004,6 +1125,7 @@ func TestScProcessor_CreateVMInput(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1052,13 +1174,14 @@ func TestScProcessor_processVMOutputNilVMOutput(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
 
 	acntSrc, _, tx := createAccountsAndTransaction()
 
-	_, _, err = sc.processVMOutput(nil, tx, acntSrc, 10)
+	_, _, err = sc.processVMOutput(nil, tx, acntSrc)
 	assert.Equal(t, process.ErrNilVMOutput, err)
 }
 
@@ -1081,6 +1204,7 @@ func TestScProcessor_processVMOutputNilTx(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1088,7 +1212,7 @@ func TestScProcessor_processVMOutputNilTx(t *testing.T) {
 	acntSrc, _, _ := createAccountsAndTransaction()
 
 	vmOutput := &vmcommon.VMOutput{}
-	_, _, err = sc.processVMOutput(vmOutput, nil, acntSrc, 10)
+	_, _, err = sc.processVMOutput(vmOutput, nil, acntSrc)
 	assert.Equal(t, process.ErrNilTransaction, err)
 }
 
@@ -1113,6 +1237,7 @@ func TestScProcessor_processVMOutputNilSndAcc(t *testing.T) {
 		&mock.GasHandlerMock{
 			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
 		},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1123,7 +1248,7 @@ func TestScProcessor_processVMOutputNilSndAcc(t *testing.T) {
 		GasRefund:    big.NewInt(0),
 		GasRemaining: 0,
 	}
-	_, _, err = sc.processVMOutput(vmOutput, tx, nil, 10)
+	_, _, err = sc.processVMOutput(vmOutput, tx, nil)
 	assert.Nil(t, err)
 }
 
@@ -1149,6 +1274,7 @@ func TestScProcessor_processVMOutputNilDstAcc(t *testing.T) {
 		&mock.GasHandlerMock{
 			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
 		},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1165,7 +1291,7 @@ func TestScProcessor_processVMOutputNilDstAcc(t *testing.T) {
 	}
 
 	tx.Value = big.NewInt(0)
-	_, _, err = sc.processVMOutput(vmOutput, tx, acntSnd, 10)
+	_, _, err = sc.processVMOutput(vmOutput, tx, acntSnd)
 	assert.Nil(t, err)
 }
 
@@ -1199,6 +1325,7 @@ func TestScProcessor_GetAccountFromAddressAccNotFound(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1242,6 +1369,7 @@ func TestScProcessor_GetAccountFromAddrFaildAddressConv(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1285,6 +1413,7 @@ func TestScProcessor_GetAccountFromAddrFailedGetExistingAccount(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1328,6 +1457,7 @@ func TestScProcessor_GetAccountFromAddrAccNotInShard(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1372,6 +1502,7 @@ func TestScProcessor_GetAccountFromAddr(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1417,6 +1548,7 @@ func TestScProcessor_DeleteAccountsFailedAtRemove(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1462,6 +1594,7 @@ func TestScProcessor_DeleteAccountsNotInShard(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1512,6 +1645,7 @@ func TestScProcessor_DeleteAccountsInShard(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1541,6 +1675,7 @@ func TestScProcessor_ProcessSCPaymentAccNotInShardShouldNotReturnError(t *testin
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1576,6 +1711,7 @@ func TestScProcessor_ProcessSCPaymentWrongTypeAssertion(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1615,6 +1751,7 @@ func TestScProcessor_ProcessSCPaymentNotEnoughBalance(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1657,6 +1794,7 @@ func TestScProcessor_ProcessSCPayment(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1697,6 +1835,7 @@ func TestScProcessor_RefundGasToSenderNilAndZeroRefund(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1738,6 +1877,7 @@ func TestScProcessor_RefundGasToSenderAccNotInShard(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1790,6 +1930,7 @@ func TestScProcessor_RefundGasToSender(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1819,7 +1960,6 @@ func TestScProcessor_RefundGasToSender(t *testing.T) {
 func TestScProcessor_processVMOutputNilOutput(t *testing.T) {
 	t.Parallel()
 
-	round := uint64(10)
 	acntSrc, _, tx := createAccountsAndTransaction()
 
 	sc, err := NewSmartContractProcessor(
@@ -1836,11 +1976,12 @@ func TestScProcessor_processVMOutputNilOutput(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
 
-	_, _, err = sc.ProcessVMOutput(nil, tx, acntSrc, round)
+	_, _, err = sc.ProcessVMOutput(nil, tx, acntSrc)
 
 	assert.Equal(t, process.ErrNilVMOutput, err)
 }
@@ -1848,7 +1989,6 @@ func TestScProcessor_processVMOutputNilOutput(t *testing.T) {
 func TestScProcessor_processVMOutputNilTransaction(t *testing.T) {
 	t.Parallel()
 
-	round := uint64(10)
 	acntSrc, _, _ := createAccountsAndTransaction()
 
 	sc, err := NewSmartContractProcessor(
@@ -1865,12 +2005,13 @@ func TestScProcessor_processVMOutputNilTransaction(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
 
 	vmOutput := &vmcommon.VMOutput{}
-	_, _, err = sc.ProcessVMOutput(vmOutput, nil, acntSrc, round)
+	_, _, err = sc.ProcessVMOutput(vmOutput, nil, acntSrc)
 
 	assert.Equal(t, process.ErrNilTransaction, err)
 }
@@ -1878,7 +2019,6 @@ func TestScProcessor_processVMOutputNilTransaction(t *testing.T) {
 func TestScProcessor_processVMOutput(t *testing.T) {
 	t.Parallel()
 
-	round := uint64(10)
 	acntSrc, _, tx := createAccountsAndTransaction()
 
 	accntState := &mock.AccountsStub{}
@@ -1898,6 +2038,7 @@ func TestScProcessor_processVMOutput(t *testing.T) {
 		&mock.GasHandlerMock{
 			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
 		},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1912,10 +2053,71 @@ func TestScProcessor_processVMOutput(t *testing.T) {
 	}
 
 	tx.Value = big.NewInt(0)
-	_, _, err = sc.ProcessVMOutput(vmOutput, tx, acntSrc, round)
+	_, _, err = sc.ProcessVMOutput(vmOutput, tx, acntSrc)
 	assert.Nil(t, err)
 }
 
+func TestScProcessor_processVMOutputShouldAddReceiptWithLogs(t *testing.T) {
+	t.Parallel()
+
+	acntSrc, _, tx := createAccountsAndTransaction()
+
+	var savedReceipt *receipt.Receipt
+	accntState := &mock.AccountsStub{}
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accntState,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.FeeHandlerStub{},
+		&mock.TxTypeHandlerMock{},
+		&mock.GasHandlerMock{
+			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
+		},
+		&mock.ReceiptsHandlerStub{
+			AddReceiptCalled: func(rcpt *receipt.Receipt) {
+				savedReceipt = rcpt
+			},
+		},
+	)
+
+	logEntry := &vmcommon.LogEntry{
+		Address: []byte("address"),
+		Topics:  [][]byte{[]byte("topic")},
+		Data:    []byte("data"),
+	}
+	vmOutput := &vmcommon.VMOutput{
+		GasRefund:    big.NewInt(0),
+		GasRemaining: 0,
+		ReturnData:   [][]byte{[]byte("result")},
+		Logs:         []*vmcommon.LogEntry{logEntry},
+	}
+
+	accntState.GetAccountWithJournalCalled = func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+		return acntSrc, nil
+	}
+
+	tx.Value = big.NewInt(0)
+	_, _, err := sc.ProcessVMOutput(vmOutput, tx, acntSrc)
+	assert.Nil(t, err)
+
+	txHash, _ := core.CalculateHash(&mock.MarshalizerMock{}, &mock.HasherMock{}, tx)
+	assert.NotNil(t, savedReceipt)
+	assert.Equal(t, txHash, savedReceipt.TxHash)
+	assert.Equal(t, vmcommon.Ok.String(), savedReceipt.ReturnCode)
+	assert.Equal(t, vmOutput.ReturnData, savedReceipt.ReturnData)
+	assert.Equal(t, 1, len(savedReceipt.Logs))
+	assert.Equal(t, logEntry.Address, savedReceipt.Logs[0].Address)
+	assert.Equal(t, logEntry.Topics, savedReceipt.Logs[0].Topics)
+	assert.Equal(t, logEntry.Data, savedReceipt.Logs[0].Data)
+}
+
 func TestScProcessor_processSCOutputAccounts(t *testing.T) {
 	t.Parallel()
 
@@ -1937,6 +2139,7 @@ func TestScProcessor_processSCOutputAccounts(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2021,6 +2224,7 @@ func TestScProcessor_processSCOutputAccountsNotInShard(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2065,6 +2269,7 @@ func TestScProcessor_CreateCrossShardTransactions(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2113,6 +2318,7 @@ func TestScProcessor_ProcessSmartContractResultNilScr(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2146,6 +2352,7 @@ func TestScProcessor_ProcessSmartContractResultErrGetAccount(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2175,6 +2382,7 @@ func TestScProcessor_ProcessSmartContractResultAccNotInShard(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2209,6 +2417,7 @@ func TestScProcessor_ProcessSmartContractResultBadAccType(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2246,6 +2455,7 @@ func TestScProcessor_ProcessSmartContractResultOutputBalanceNil(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2289,6 +2499,7 @@ func TestScProcessor_ProcessSmartContractResultWithCode(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2336,6 +2547,7 @@ func TestScProcessor_ProcessSmartContractResultWithData(t *testing.T) {
 		&mock.FeeHandlerStub{},
 		&mock.TxTypeHandlerMock{},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2400,6 +2612,7 @@ func TestScProcessor_ProcessSmartContractResultDeploySCShouldError(t *testing.T)
 			},
 		},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2465,6 +2678,7 @@ func TestScProcessor_ProcessSmartContractResultExecuteSC(t *testing.T) {
 			},
 		},
 		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2478,3 +2692,982 @@ func TestScProcessor_ProcessSmartContractResultExecuteSC(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, executeCalled)
 }
+
+func createBuiltInFunctionSCProcessor(dstAcc *state.Account) *scProcessor {
+	accountsDB := &mock.AccountsStub{
+		GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			return dstAcc, nil
+		},
+		SaveDataTrieCalled: func(acountWrapper state.AccountHandler) error {
+			return nil
+		},
+		JournalLenCalled: func() int {
+			return 0
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			return nil
+		},
+	}
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accountsDB,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.FeeHandlerStub{},
+		&mock.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, error) {
+				return process.BuiltInFunctionCall, nil
+			},
+		},
+		&mock.GasHandlerMock{},
+		&mock.ReceiptsHandlerStub{},
+	)
+
+	return sc
+}
+
+func createBuiltInFunctionDstAccount() *state.Account {
+	dstAcc, _ := state.NewAccount(mock.NewAddressMock([]byte("recv address")),
+		&mock.AccountTrackerStub{JournalizeCalled: func(entry state.JournalEntry) {},
+			SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+				return nil
+			}})
+
+	return dstAcc
+}
+
+func TestScProcessor_ProcessSmartContractResultBuiltInTransferShouldCreditTokens(t *testing.T) {
+	t.Parallel()
+
+	dstAcc := createBuiltInFunctionDstAccount()
+	sc := createBuiltInFunctionSCProcessor(dstAcc)
+
+	scr := smartContractResult.SmartContractResult{
+		SndAddr: []byte("user address"),
+		RcvAddr: []byte("recv address"),
+		Value:   big.NewInt(5),
+		Data:    core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString([]byte("TOKEN")) + "@0a",
+	}
+	err := sc.ProcessSmartContractResult(&scr)
+	assert.Nil(t, err)
+
+	token, _ := esdt.GetToken(&mock.MarshalizerMock{}, dstAcc, []byte("TOKEN"))
+	assert.Equal(t, big.NewInt(10), token.Value)
+	assert.Equal(t, big.NewInt(5), dstAcc.Balance)
+}
+
+func TestScProcessor_ProcessSmartContractResultBuiltInTransferReturnedBySCShouldCredit(t *testing.T) {
+	t.Parallel()
+
+	dstAcc := createBuiltInFunctionDstAccount()
+	sc := createBuiltInFunctionSCProcessor(dstAcc)
+
+	scr := smartContractResult.SmartContractResult{
+		SndAddr: make([]byte, 32),
+		RcvAddr: []byte("recv address"),
+		Value:   big.NewInt(0),
+		Data:    core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString([]byte("TOKEN")) + "@0a",
+	}
+	err := sc.ProcessSmartContractResult(&scr)
+	assert.Nil(t, err)
+
+	token, _ := esdt.GetToken(&mock.MarshalizerMock{}, dstAcc, []byte("TOKEN"))
+	assert.Equal(t, big.NewInt(10), token.Value)
+}
+
+func createBuiltInFunctionSCCallProcessor(
+	dstAcc *state.Account,
+	returnCode vmcommon.ReturnCode,
+	scrForwarder process.IntermediateTransactionHandler,
+	tokensWhenInvoked *big.Int,
+) *scProcessor {
+	dstAcc.SetCode([]byte("code"))
+	accountsDB := &mock.AccountsStub{
+		GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			return dstAcc, nil
+		},
+		SaveDataTrieCalled: func(acountWrapper state.AccountHandler) error {
+			return nil
+		},
+	}
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			token, _ := esdt.GetToken(&mock.MarshalizerMock{}, dstAcc, []byte("TOKEN"))
+			tokensWhenInvoked.Set(token.Value)
+			return &vmcommon.VMOutput{ReturnCode: returnCode, GasRefund: big.NewInt(0)}, nil
+		},
+	}
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return vm, nil
+			},
+		},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accountsDB,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		scrForwarder,
+		&mock.UnsignedTxHandlerMock{},
+		&mock.FeeHandlerStub{},
+		&mock.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, error) {
+				return process.BuiltInFunctionCall, nil
+			},
+		},
+		&mock.GasHandlerMock{
+			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
+		},
+		&mock.ReceiptsHandlerStub{},
+	)
+
+	return sc
+}
+
+func createBuiltInFunctionSCCallSCR() *smartContractResult.SmartContractResult {
+	scAddress := make([]byte, 32)
+	scAddress[31] = 1
+
+	return &smartContractResult.SmartContractResult{
+		SndAddr: []byte("user address"),
+		RcvAddr: scAddress,
+		Value:   big.NewInt(0),
+		Data:    core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString([]byte("TOKEN")) + "@0a",
+	}
+}
+
+func TestScProcessor_ProcessSmartContractResultBuiltInTransferToSCShouldCreditAndInvokeIt(t *testing.T) {
+	t.Parallel()
+
+	dstAcc := createBuiltInFunctionDstAccount()
+	tokensWhenInvoked := big.NewInt(0)
+	sc := createBuiltInFunctionSCCallProcessor(
+		dstAcc,
+		vmcommon.Ok,
+		&mock.IntermediateTransactionHandlerMock{},
+		tokensWhenInvoked,
+	)
+
+	err := sc.ProcessSmartContractResult(createBuiltInFunctionSCCallSCR())
+	assert.Nil(t, err)
+
+	token, _ := esdt.GetToken(&mock.MarshalizerMock{}, dstAcc, []byte("TOKEN"))
+	assert.Equal(t, big.NewInt(10), token.Value)
+	assert.Equal(t, big.NewInt(10), tokensWhenInvoked)
+}
+
+func TestScProcessor_ProcessSmartContractResultBuiltInTransferToSCFailedShouldReturnTokens(t *testing.T) {
+	t.Parallel()
+
+	dstAcc := createBuiltInFunctionDstAccount()
+	var returnedTokens *smartContractResult.SmartContractResult
+	scrForwarder := &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			for _, tx := range txs {
+				scr := tx.(*smartContractResult.SmartContractResult)
+				if esdt.IsBuiltInFunctionCall(scr.Data) {
+					returnedTokens = scr
+				}
+			}
+			return nil
+		},
+	}
+	tokensWhenInvoked := big.NewInt(0)
+	sc := createBuiltInFunctionSCCallProcessor(dstAcc, vmcommon.UserError, scrForwarder, tokensWhenInvoked)
+
+	scr := createBuiltInFunctionSCCallSCR()
+	err := sc.ProcessSmartContractResult(scr)
+	assert.Nil(t, err)
+
+	token, _ := esdt.GetToken(&mock.MarshalizerMock{}, dstAcc, []byte("TOKEN"))
+	assert.Equal(t, big.NewInt(0), token.Value)
+	assert.Equal(t, big.NewInt(10), tokensWhenInvoked)
+	assert.NotNil(t, returnedTokens)
+	assert.Equal(t, scr.SndAddr, returnedTokens.RcvAddr)
+	assert.Equal(t, scr.RcvAddr, returnedTokens.SndAddr)
+	assert.Equal(t, scr.Data, returnedTokens.Data)
+}
+
+func TestScProcessor_ProcessSmartContractResultBuiltInTransferToESDTSCShouldNotCredit(t *testing.T) {
+	t.Parallel()
+
+	dstAcc := createBuiltInFunctionDstAccount()
+	tokensWhenInvoked := big.NewInt(0)
+	sc := createBuiltInFunctionSCCallProcessor(
+		dstAcc,
+		vmcommon.Ok,
+		&mock.IntermediateTransactionHandlerMock{},
+		tokensWhenInvoked,
+	)
+
+	scr := createBuiltInFunctionSCCallSCR()
+	scr.RcvAddr = factory.ESDTSCAddress
+	err := sc.ProcessSmartContractResult(scr)
+	assert.Nil(t, err)
+
+	token, _ := esdt.GetToken(&mock.MarshalizerMock{}, dstAcc, []byte("TOKEN"))
+	assert.Equal(t, big.NewInt(0), token.Value)
+}
+
+func TestCheckBuiltInFunctionCalls(t *testing.T) {
+	t.Parallel()
+
+	transfer := []byte(core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString([]byte("TOKEN")) + "@0a")
+	outputAccounts := []*vmcommon.OutputAccount{{Data: []byte("func@01")}, {Data: transfer}}
+	tx := &transaction.Transaction{RcvAddr: []byte("sc address")}
+
+	err := checkBuiltInFunctionCalls(outputAccounts, tx)
+	assert.Equal(t, process.ErrBuiltInFunctionCallNotAllowed, err)
+
+	tx.RcvAddr = factory.ESDTSCAddress
+	err = checkBuiltInFunctionCalls(outputAccounts, tx)
+	assert.Nil(t, err)
+
+	tx.RcvAddr = []byte("sc address")
+	err = checkBuiltInFunctionCalls(outputAccounts[:1], tx)
+	assert.Nil(t, err)
+}
+
+func TestScProcessor_ProcessSmartContractResultBuiltInFreezeOnlyFromESDTSC(t *testing.T) {
+	t.Parallel()
+
+	dstAcc := createBuiltInFunctionDstAccount()
+	sc := createBuiltInFunctionSCProcessor(dstAcc)
+
+	scr := smartContractResult.SmartContractResult{
+		SndAddr: []byte("user address"),
+		RcvAddr: []byte("recv address"),
+		Value:   big.NewInt(0),
+		Data:    core.BuiltInFunctionESDTFreeze + "@" + hex.EncodeToString([]byte("TOKEN")),
+	}
+	err := sc.ProcessSmartContractResult(&scr)
+	assert.Nil(t, err)
+
+	token, _ := esdt.GetToken(&mock.MarshalizerMock{}, dstAcc, []byte("TOKEN"))
+	assert.False(t, token.Frozen)
+
+	scr.SndAddr = factory.ESDTSCAddress
+	err = sc.ProcessSmartContractResult(&scr)
+	assert.Nil(t, err)
+
+	token, _ = esdt.GetToken(&mock.MarshalizerMock{}, dstAcc, []byte("TOKEN"))
+	assert.True(t, token.Frozen)
+}
+
+func TestScProcessor_ProcessSCOutputAccountsProtectedKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dstAcc := createBuiltInFunctionDstAccount()
+	sc := createBuiltInFunctionSCProcessor(dstAcc)
+
+	outputAccounts := []*vmcommon.OutputAccount{{
+		Address: []byte("recv address"),
+		StorageUpdates: []*vmcommon.StorageUpdate{{
+			Offset: esdt.TokenKey([]byte("TOKEN")),
+			Data:   []byte("value"),
+		}},
+	}}
+	tx := &transaction.Transaction{Value: big.NewInt(0)}
+
+	err := sc.processSCOutputAccounts(outputAccounts, tx)
+	assert.Equal(t, process.ErrStorageKeyIsProtected, err)
+}
+
+func createSCOwnerFunctionsProcessor(
+	accounts map[string]*state.Account,
+	vm vmcommon.VMExecutionHandler,
+	developerPercentage float64,
+	processedFee *big.Int,
+) *scProcessor {
+	accountsDB := &mock.AccountsStub{
+		GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			return accounts[string(addressContainer.Bytes())], nil
+		},
+		SaveDataTrieCalled: func(acountWrapper state.AccountHandler) error {
+			return nil
+		},
+	}
+	argParser, _ := NewAtArgumentParser()
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return vm, nil
+			},
+		},
+		argParser,
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accountsDB,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{
+			ProcessTransactionFeeCalled: func(cost *big.Int) {
+				processedFee.Set(cost)
+			},
+		},
+		&mock.FeeHandlerStub{
+			DeveloperPercentageCalled: func() float64 {
+				return developerPercentage
+			},
+		},
+		&mock.TxTypeHandlerMock{},
+		&mock.GasHandlerMock{
+			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
+		},
+		&mock.ReceiptsHandlerStub{},
+	)
+
+	return sc
+}
+
+func createSCOwnerFunctionsAccounts() (*state.Account, *state.Account, map[string]*state.Account) {
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			return nil
+		},
+	}
+
+	ownerAddress := bytes.Repeat([]byte{1}, 32)
+	scAddress := bytes.Repeat([]byte{2}, 32)
+	ownerAcc, _ := state.NewAccount(mock.NewAddressMock(ownerAddress), tracker)
+	ownerAcc.Balance = big.NewInt(1000)
+	scAcc, _ := state.NewAccount(mock.NewAddressMock(scAddress), tracker)
+	scAcc.SetCode([]byte("code"))
+	scAcc.OwnerAddress = ownerAddress
+
+	accounts := map[string]*state.Account{
+		string(ownerAddress): ownerAcc,
+		string(scAddress):    scAcc,
+	}
+
+	return ownerAcc, scAcc, accounts
+}
+
+func TestScProcessor_ProcessSCOutputAccountsNewCodeShouldSetOwner(t *testing.T) {
+	t.Parallel()
+
+	ownerAcc, scAcc, accounts := createSCOwnerFunctionsAccounts()
+	scAcc.OwnerAddress = nil
+	sc := createSCOwnerFunctionsProcessor(accounts, &mock.VMExecutionHandlerStub{}, 0, big.NewInt(0))
+	sc.accounts.(*mock.AccountsStub).PutCodeCalled = func(accountHandler state.AccountHandler, code []byte) error {
+		return nil
+	}
+
+	tx := &transaction.Transaction{SndAddr: ownerAcc.AddressContainer().Bytes(), Value: big.NewInt(0)}
+	outputAccounts := []*vmcommon.OutputAccount{{Address: scAcc.AddressContainer().Bytes(), Code: []byte("code")}}
+	err := sc.ProcessSCOutputAccounts(outputAccounts, tx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, ownerAcc.AddressContainer().Bytes(), scAcc.OwnerAddress)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionShouldAddDeveloperReward(t *testing.T) {
+	t.Parallel()
+
+	ownerAcc, scAcc, accounts := createSCOwnerFunctionsAccounts()
+	processedFee := big.NewInt(0)
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 0, GasRefund: big.NewInt(0)}, nil
+		},
+	}
+	sc := createSCOwnerFunctionsProcessor(accounts, vm, 0.25, processedFee)
+
+	tx := &transaction.Transaction{
+		SndAddr:  ownerAcc.AddressContainer().Bytes(),
+		RcvAddr:  scAcc.AddressContainer().Bytes(),
+		Value:    big.NewInt(0),
+		GasPrice: 1,
+		GasLimit: 100,
+		Data:     "doSomething",
+	}
+	err := sc.ExecuteSmartContractTransaction(tx, ownerAcc, scAcc, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(25), scAcc.DeveloperReward)
+	assert.Equal(t, big.NewInt(75), processedFee)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionClaimDeveloperRewardsNotOwnerShouldNotClaim(t *testing.T) {
+	t.Parallel()
+
+	_, scAcc, accounts := createSCOwnerFunctionsAccounts()
+	scAcc.DeveloperReward = big.NewInt(50)
+	sc := createSCOwnerFunctionsProcessor(accounts, &mock.VMExecutionHandlerStub{}, 0, big.NewInt(0))
+
+	otherAcc, _ := state.NewAccount(mock.NewAddressMock(bytes.Repeat([]byte{3}, 32)), &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			return nil
+		},
+	})
+	otherAcc.Balance = big.NewInt(1000)
+	tx := &transaction.Transaction{
+		SndAddr:  otherAcc.AddressContainer().Bytes(),
+		RcvAddr:  scAcc.AddressContainer().Bytes(),
+		Value:    big.NewInt(0),
+		GasPrice: 1,
+		GasLimit: 100,
+		Data:     core.BuiltInFunctionClaimDeveloperRewards,
+	}
+	err := sc.ExecuteSmartContractTransaction(tx, otherAcc, scAcc, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(50), scAcc.DeveloperReward)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionClaimDeveloperRewardsShouldCreditOwner(t *testing.T) {
+	t.Parallel()
+
+	ownerAcc, scAcc, accounts := createSCOwnerFunctionsAccounts()
+	scAcc.DeveloperReward = big.NewInt(50)
+	vmCalled := false
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			vmCalled = true
+			return nil, nil
+		},
+	}
+	processedFee := big.NewInt(0)
+	sc := createSCOwnerFunctionsProcessor(accounts, vm, 0.3, processedFee)
+
+	tx := &transaction.Transaction{
+		SndAddr:  ownerAcc.AddressContainer().Bytes(),
+		RcvAddr:  scAcc.AddressContainer().Bytes(),
+		Value:    big.NewInt(0),
+		GasPrice: 1,
+		GasLimit: 100,
+		Data:     core.BuiltInFunctionClaimDeveloperRewards,
+	}
+	err := sc.ExecuteSmartContractTransaction(tx, ownerAcc, scAcc, 10)
+
+	assert.Nil(t, err)
+	assert.False(t, vmCalled)
+	assert.Equal(t, big.NewInt(0), scAcc.DeveloperReward)
+	// 1000 initial balance - 100 gas paid + 100 gas refunded, as the fee handler stub requires no gas + 50 reward
+	assert.Equal(t, big.NewInt(1050), ownerAcc.Balance)
+	assert.Equal(t, big.NewInt(0), processedFee)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionChangeOwnerAddressShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ownerAcc, scAcc, accounts := createSCOwnerFunctionsAccounts()
+	sc := createSCOwnerFunctionsProcessor(accounts, &mock.VMExecutionHandlerStub{}, 0, big.NewInt(0))
+
+	newOwner := bytes.Repeat([]byte{4}, 32)
+	tx := &transaction.Transaction{
+		SndAddr:  ownerAcc.AddressContainer().Bytes(),
+		RcvAddr:  scAcc.AddressContainer().Bytes(),
+		Value:    big.NewInt(0),
+		GasPrice: 1,
+		GasLimit: 100,
+		Data:     core.BuiltInFunctionChangeOwnerAddress + "@" + hex.EncodeToString(newOwner),
+	}
+	err := sc.ExecuteSmartContractTransaction(tx, ownerAcc, scAcc, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, newOwner, scAcc.OwnerAddress)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionChangeOwnerAddressInvalidAddressShouldNotChange(t *testing.T) {
+	t.Parallel()
+
+	ownerAcc, scAcc, accounts := createSCOwnerFunctionsAccounts()
+	sc := createSCOwnerFunctionsProcessor(accounts, &mock.VMExecutionHandlerStub{}, 0, big.NewInt(0))
+
+	tx := &transaction.Transaction{
+		SndAddr:  ownerAcc.AddressContainer().Bytes(),
+		RcvAddr:  scAcc.AddressContainer().Bytes(),
+		Value:    big.NewInt(0),
+		GasPrice: 1,
+		GasLimit: 100,
+		Data:     core.BuiltInFunctionChangeOwnerAddress + "@0102",
+	}
+	err := sc.ExecuteSmartContractTransaction(tx, ownerAcc, scAcc, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, ownerAcc.AddressContainer().Bytes(), scAcc.OwnerAddress)
+}
+
+func TestScProcessor_DeploySmartContractWithCodeMetadataShouldSetIt(t *testing.T) {
+	t.Parallel()
+
+	ownerAcc, scAcc, accounts := createSCOwnerFunctionsAccounts()
+	scAcc.OwnerAddress = nil
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCreateCalled: func(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{
+				ReturnCode:     vmcommon.Ok,
+				GasRefund:      big.NewInt(0),
+				OutputAccounts: []*vmcommon.OutputAccount{{Address: scAcc.AddressContainer().Bytes(), Code: input.ContractCode}},
+			}, nil
+		},
+	}
+	sc := createSCOwnerFunctionsProcessor(accounts, vm, 0, big.NewInt(0))
+	sc.accounts.(*mock.AccountsStub).PutCodeCalled = func(accountHandler state.AccountHandler, code []byte) error {
+		return nil
+	}
+
+	tx := &transaction.Transaction{
+		SndAddr:  ownerAcc.AddressContainer().Bytes(),
+		RcvAddr:  generateEmptyByteSlice(sc.adrConv.AddressLen()),
+		Value:    big.NewInt(0),
+		GasPrice: 1,
+		GasLimit: 100,
+		Data:     "abcd@050001",
+	}
+	err := sc.DeploySmartContract(tx, ownerAcc, 10)
+
+	assert.Nil(t, err)
+	assert.True(t, scAcc.IsUpgradable())
+	assert.Equal(t, ownerAcc.AddressContainer().Bytes(), scAcc.OwnerAddress)
+}
+
+func createUpgradeContractTx(ownerAcc *state.Account, scAcc *state.Account) *transaction.Transaction {
+	return &transaction.Transaction{
+		SndAddr:  ownerAcc.AddressContainer().Bytes(),
+		RcvAddr:  scAcc.AddressContainer().Bytes(),
+		Value:    big.NewInt(0),
+		GasPrice: 1,
+		GasLimit: 100,
+		Data:     core.BuiltInFunctionUpgradeContract + "@" + hex.EncodeToString([]byte("new code")) + "@0a",
+	}
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionUpgradeContractShouldReplaceCode(t *testing.T) {
+	t.Parallel()
+
+	ownerAcc, scAcc, accounts := createSCOwnerFunctionsAccounts()
+	scAcc.CodeMetadata = []byte{core.CodeMetadataUpgradable}
+	var createInput *vmcommon.ContractCreateInput
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCreateCalled: func(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+			createInput = input
+			return &vmcommon.VMOutput{
+				ReturnCode:     vmcommon.Ok,
+				GasRefund:      big.NewInt(0),
+				OutputAccounts: []*vmcommon.OutputAccount{{Address: scAcc.AddressContainer().Bytes(), Code: input.ContractCode}},
+			}, nil
+		},
+	}
+	sc := createSCOwnerFunctionsProcessor(accounts, vm, 0, big.NewInt(0))
+	var putCode []byte
+	sc.accounts.(*mock.AccountsStub).PutCodeCalled = func(accountHandler state.AccountHandler, code []byte) error {
+		putCode = code
+		return nil
+	}
+	var deployAddress []byte
+	sc.tempAccounts.(*mock.TemporaryAccountsHandlerMock).SetDeployAddressCalled = func(address []byte) {
+		deployAddress = address
+	}
+
+	err := sc.ExecuteSmartContractTransaction(createUpgradeContractTx(ownerAcc, scAcc), ownerAcc, scAcc, 10)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, createInput)
+	assert.Equal(t, [][]byte{{10}}, createInput.Arguments)
+	assert.Equal(t, scAcc.AddressContainer().Bytes(), deployAddress)
+	assert.Equal(t, []byte("new code"), putCode)
+	assert.True(t, scAcc.IsUpgradable())
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionUpgradeContractNotUpgradableShouldNotRunVM(t *testing.T) {
+	t.Parallel()
+
+	ownerAcc, scAcc, accounts := createSCOwnerFunctionsAccounts()
+	vmCalled := false
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCreateCalled: func(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+			vmCalled = true
+			return nil, nil
+		},
+	}
+	sc := createSCOwnerFunctionsProcessor(accounts, vm, 0, big.NewInt(0))
+
+	err := sc.ExecuteSmartContractTransaction(createUpgradeContractTx(ownerAcc, scAcc), ownerAcc, scAcc, 10)
+
+	assert.Nil(t, err)
+	assert.False(t, vmCalled)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionUpgradeContractNotOwnerShouldNotRunVM(t *testing.T) {
+	t.Parallel()
+
+	ownerAcc, scAcc, accounts := createSCOwnerFunctionsAccounts()
+	scAcc.CodeMetadata = []byte{core.CodeMetadataUpgradable}
+	scAcc.OwnerAddress = bytes.Repeat([]byte{3}, 32)
+	vmCalled := false
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCreateCalled: func(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+			vmCalled = true
+			return nil, nil
+		},
+	}
+	sc := createSCOwnerFunctionsProcessor(accounts, vm, 0, big.NewInt(0))
+
+	err := sc.ExecuteSmartContractTransaction(createUpgradeContractTx(ownerAcc, scAcc), ownerAcc, scAcc, 10)
+
+	assert.Nil(t, err)
+	assert.False(t, vmCalled)
+}
+
+func createAsynchronousCallTestData() (*state.Account, *state.Account, *scProcessor, *[]data.TransactionHandler) {
+	ownerAcc, scAcc, accounts := createSCOwnerFunctionsAccounts()
+	vm := &mock.VMExecutionHandlerStub{}
+	sc := createSCOwnerFunctionsProcessor(accounts, vm, 0, big.NewInt(0))
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+		if _, ok := accounts[string(address.Bytes())]; ok {
+			return 0
+		}
+		return 1
+	}
+	sc.shardCoordinator = shardCoordinator
+
+	forwardedTxs := make([]data.TransactionHandler, 0)
+	sc.scrForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			forwardedTxs = append(forwardedTxs, txs...)
+			return nil
+		},
+	}
+
+	return ownerAcc, scAcc, sc, &forwardedTxs
+}
+
+func TestScProcessor_CreateSmartContractResultCrossShardSCCallShouldBeAsynchronous(t *testing.T) {
+	t.Parallel()
+
+	ownerAcc, scAcc, sc, _ := createAsynchronousCallTestData()
+	crossShardSCAddress := append(make([]byte, core.NumInitCharactersForScAddress), bytes.Repeat([]byte{3}, 24)...)
+	tx := &transaction.Transaction{
+		SndAddr: ownerAcc.AddressContainer().Bytes(),
+		RcvAddr: scAcc.AddressContainer().Bytes(),
+		Value:   big.NewInt(0),
+	}
+
+	outAcc := &vmcommon.OutputAccount{Address: crossShardSCAddress, Data: []byte("callMe@01"), BalanceDelta: big.NewInt(0)}
+	scr := sc.createSmartContractResult(outAcc, tx, []byte("txHash"))
+	assert.Equal(t, smartContractResult.AsynchronousCall, scr.CallType)
+	assert.Equal(t, ownerAcc.AddressContainer().Bytes(), scr.OriginalSender)
+
+	outAcc = &vmcommon.OutputAccount{Address: bytes.Repeat([]byte{3}, 32), Data: []byte("callMe@01"), BalanceDelta: big.NewInt(0)}
+	scr = sc.createSmartContractResult(outAcc, tx, []byte("txHash"))
+	assert.Equal(t, smartContractResult.DirectCall, scr.CallType)
+	assert.Nil(t, scr.OriginalSender)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionAsynchronousCallShouldReturnCallBack(t *testing.T) {
+	t.Parallel()
+
+	ownerAcc, scAcc, sc, forwardedTxs := createAsynchronousCallTestData()
+	sc.vmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+			return &mock.VMExecutionHandlerStub{
+				RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+					return &vmcommon.VMOutput{
+						ReturnCode:   vmcommon.Ok,
+						ReturnData:   [][]byte{{7}},
+						GasRemaining: 10,
+						GasRefund:    big.NewInt(0),
+					}, nil
+				},
+			}, nil
+		},
+	}
+
+	callerSCAddress := bytes.Repeat([]byte{3}, 32)
+	scr := &smartContractResult.SmartContractResult{
+		SndAddr:        callerSCAddress,
+		RcvAddr:        scAcc.AddressContainer().Bytes(),
+		Value:          big.NewInt(0),
+		GasPrice:       1,
+		GasLimit:       100,
+		Data:           "callMe@01",
+		CallType:       smartContractResult.AsynchronousCall,
+		OriginalSender: ownerAcc.AddressContainer().Bytes(),
+	}
+	err := sc.ExecuteSmartContractTransaction(scr, nil, scAcc, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(*forwardedTxs))
+	callBack := (*forwardedTxs)[0].(*smartContractResult.SmartContractResult)
+	assert.Equal(t, callerSCAddress, callBack.RcvAddr)
+	assert.Equal(t, smartContractResult.AsynchronousCallBack, callBack.CallType)
+	assert.Equal(t, ownerAcc.AddressContainer().Bytes(), callBack.OriginalSender)
+	assert.Equal(t, "@"+hex.EncodeToString([]byte(vmcommon.Ok.String()))+"@07", callBack.Data)
+	assert.Equal(t, uint64(10), callBack.GasLimit)
+	assert.Equal(t, big.NewInt(0), callBack.Value)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionCallBackShouldRefundOriginalSender(t *testing.T) {
+	t.Parallel()
+
+	ownerAcc, scAcc, sc, forwardedTxs := createAsynchronousCallTestData()
+	var callInput *vmcommon.ContractCallInput
+	sc.vmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+			return &mock.VMExecutionHandlerStub{
+				RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+					callInput = input
+					return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 40, GasRefund: big.NewInt(0)}, nil
+				},
+			}, nil
+		},
+	}
+
+	scr := &smartContractResult.SmartContractResult{
+		SndAddr:        bytes.Repeat([]byte{3}, 32),
+		RcvAddr:        scAcc.AddressContainer().Bytes(),
+		Value:          big.NewInt(0),
+		GasPrice:       1,
+		GasLimit:       100,
+		Data:           "@" + hex.EncodeToString([]byte(vmcommon.Ok.String())) + "@07",
+		CallType:       smartContractResult.AsynchronousCallBack,
+		OriginalSender: ownerAcc.AddressContainer().Bytes(),
+	}
+	err := sc.ExecuteSmartContractTransaction(scr, nil, scAcc, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "callBack", callInput.Function)
+	assert.Equal(t, [][]byte{[]byte(vmcommon.Ok.String()), {7}}, callInput.Arguments)
+	assert.Equal(t, big.NewInt(1040), ownerAcc.Balance)
+	assert.Equal(t, 1, len(*forwardedTxs))
+	refund := (*forwardedTxs)[0].(*smartContractResult.SmartContractResult)
+	assert.Equal(t, ownerAcc.AddressContainer().Bytes(), refund.RcvAddr)
+	assert.Equal(t, smartContractResult.DirectCall, refund.CallType)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionResultWithoutCallBackTypeShouldNotRunCallBack(t *testing.T) {
+	t.Parallel()
+
+	_, scAcc, sc, _ := createAsynchronousCallTestData()
+	vmCalled := false
+	sc.vmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+			return &mock.VMExecutionHandlerStub{
+				RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+					vmCalled = true
+					return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRefund: big.NewInt(0)}, nil
+				},
+			}, nil
+		},
+	}
+
+	scr := &smartContractResult.SmartContractResult{
+		SndAddr:  bytes.Repeat([]byte{3}, 32),
+		RcvAddr:  scAcc.AddressContainer().Bytes(),
+		Value:    big.NewInt(0),
+		GasPrice: 1,
+		GasLimit: 100,
+		Data:     "@" + hex.EncodeToString([]byte(vmcommon.Ok.String())),
+	}
+	err := sc.ExecuteSmartContractTransaction(scr, nil, scAcc, 10)
+
+	assert.Nil(t, err)
+	assert.False(t, vmCalled)
+}
+
+func createAsynchronousCallShardProcessor(
+	accounts map[string]*state.Account,
+	selfId uint32,
+	fees *big.Int,
+	forwardedTxs *[]data.TransactionHandler,
+) *scProcessor {
+	sc := createSCOwnerFunctionsProcessor(accounts, &mock.VMExecutionHandlerStub{}, 0, big.NewInt(0))
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.CurrentShard = selfId
+	shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+		if _, ok := accounts[string(address.Bytes())]; ok {
+			return selfId
+		}
+		return 1 - selfId
+	}
+	sc.shardCoordinator = shardCoordinator
+	sc.scrForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			*forwardedTxs = append(*forwardedTxs, txs...)
+			return nil
+		},
+	}
+	sc.txFeeHandler = &mock.UnsignedTxHandlerMock{
+		ProcessTransactionFeeCalled: func(cost *big.Int) {
+			fees.Add(fees, cost)
+		},
+	}
+
+	return sc
+}
+
+func setVMOutput(sc *scProcessor, vmOutput *vmcommon.VMOutput) {
+	sc.vmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+			return &mock.VMExecutionHandlerStub{
+				RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+					return vmOutput, nil
+				},
+			}, nil
+		},
+	}
+}
+
+func getAsynchronousCallResult(txs []data.TransactionHandler, callType smartContractResult.CallType) *smartContractResult.SmartContractResult {
+	for _, tx := range txs {
+		scr, ok := tx.(*smartContractResult.SmartContractResult)
+		if ok && scr.CallType == callType {
+			return scr
+		}
+	}
+
+	return nil
+}
+
+func sumBalances(accounts ...*state.Account) *big.Int {
+	sum := big.NewInt(0)
+	for _, acc := range accounts {
+		sum.Add(sum, acc.Balance)
+	}
+
+	return sum
+}
+
+func TestScProcessor_AsynchronousCallAcrossShardsShouldKeepTotalBalancesAndFees(t *testing.T) {
+	t.Parallel()
+
+	ownerAcc, callerSCAcc, accounts0 := createSCOwnerFunctionsAccounts()
+	callerSCAcc.Balance = big.NewInt(100)
+
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			return nil
+		},
+	}
+	calledSCAddress := append(make([]byte, core.NumInitCharactersForScAddress), bytes.Repeat([]byte{3}, 22)...)
+	calledSCAcc, _ := state.NewAccount(mock.NewAddressMock(calledSCAddress), tracker)
+	calledSCAcc.SetCode([]byte("code"))
+	accounts1 := map[string]*state.Account{string(calledSCAddress): calledSCAcc}
+
+	fees0, fees1 := big.NewInt(0), big.NewInt(0)
+	forwardedTxs0, forwardedTxs1 := make([]data.TransactionHandler, 0), make([]data.TransactionHandler, 0)
+	sc0 := createAsynchronousCallShardProcessor(accounts0, 0, fees0, &forwardedTxs0)
+	sc1 := createAsynchronousCallShardProcessor(accounts1, 1, fees1, &forwardedTxs1)
+
+	total := sumBalances(ownerAcc, callerSCAcc, calledSCAcc)
+	callerSCAddress := callerSCAcc.AddressContainer().Bytes()
+
+	// the caller smart contract sends 20 and 50 gas to the smart contract from the other shard
+	setVMOutput(sc0, &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 30,
+		GasRefund:    big.NewInt(0),
+		OutputAccounts: []*vmcommon.OutputAccount{
+			{Address: callerSCAddress, BalanceDelta: big.NewInt(-20)},
+			{Address: calledSCAddress, BalanceDelta: big.NewInt(20), Data: []byte("callMe"), GasLimit: 50},
+		},
+	})
+	tx := &transaction.Transaction{
+		SndAddr:  ownerAcc.AddressContainer().Bytes(),
+		RcvAddr:  callerSCAddress,
+		Value:    big.NewInt(0),
+		GasPrice: 1,
+		GasLimit: 100,
+		Data:     "callOtherShard",
+	}
+	err := sc0.ExecuteSmartContractTransaction(tx, ownerAcc, callerSCAcc, 10)
+	assert.Nil(t, err)
+
+	asyncCall := getAsynchronousCallResult(forwardedTxs0, smartContractResult.AsynchronousCall)
+	assert.NotNil(t, asyncCall)
+	assert.Equal(t, uint64(50), asyncCall.GasLimit)
+
+	// the called smart contract uses 40 gas and returns 5 to the caller
+	setVMOutput(sc1, &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 10,
+		GasRefund:    big.NewInt(0),
+		OutputAccounts: []*vmcommon.OutputAccount{
+			{Address: calledSCAddress, BalanceDelta: big.NewInt(15)},
+			{Address: callerSCAddress, BalanceDelta: big.NewInt(5)},
+		},
+	})
+	err = sc1.ExecuteSmartContractTransaction(asyncCall, nil, calledSCAcc, 10)
+	assert.Nil(t, err)
+
+	numResultsForCaller := 0
+	for _, forwardedTx := range forwardedTxs1 {
+		if bytes.Equal(forwardedTx.GetRecvAddress(), callerSCAddress) {
+			numResultsForCaller++
+		}
+	}
+	assert.Equal(t, 1, numResultsForCaller)
+	callBack := getAsynchronousCallResult(forwardedTxs1, smartContractResult.AsynchronousCallBack)
+	assert.NotNil(t, callBack)
+	assert.Equal(t, big.NewInt(5), callBack.Value)
+	assert.Equal(t, uint64(10), callBack.GasLimit)
+
+	// the callback uses 6 gas, the gas left is refunded to the original sender
+	setVMOutput(sc0, &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 4,
+		GasRefund:    big.NewInt(0),
+		OutputAccounts: []*vmcommon.OutputAccount{
+			{Address: callerSCAddress, BalanceDelta: big.NewInt(5)},
+		},
+	})
+	err = sc0.ExecuteSmartContractTransaction(callBack, nil, callerSCAcc, 10)
+	assert.Nil(t, err)
+
+	assert.Equal(t, big.NewInt(934), ownerAcc.Balance)
+	assert.Equal(t, big.NewInt(85), callerSCAcc.Balance)
+	assert.Equal(t, big.NewInt(15), calledSCAcc.Balance)
+	assert.Equal(t, big.NewInt(26), fees0)
+	assert.Equal(t, big.NewInt(40), fees1)
+
+	totalAfter := sumBalances(ownerAcc, callerSCAcc, calledSCAcc)
+	totalAfter.Add(totalAfter, fees0)
+	totalAfter.Add(totalAfter, fees1)
+	assert.Equal(t, total, totalAfter)
+}
+
+func TestScProcessor_ProcessIfErrorFailedCallBackShouldReturnValueToCallingContract(t *testing.T) {
+	t.Parallel()
+
+	ownerAcc, scAcc, sc, forwardedTxs := createAsynchronousCallTestData()
+	scAcc.Balance = big.NewInt(0)
+	sc.txFeeHandler = &mock.UnsignedTxHandlerMock{}
+	setVMOutput(sc, &vmcommon.VMOutput{ReturnCode: vmcommon.UserError, GasRefund: big.NewInt(0)})
+
+	scr := &smartContractResult.SmartContractResult{
+		SndAddr:        bytes.Repeat([]byte{3}, 32),
+		RcvAddr:        scAcc.AddressContainer().Bytes(),
+		Value:          big.NewInt(7),
+		GasPrice:       1,
+		GasLimit:       10,
+		Data:           "@" + hex.EncodeToString([]byte(vmcommon.Ok.String())),
+		CallType:       smartContractResult.AsynchronousCallBack,
+		OriginalSender: ownerAcc.AddressContainer().Bytes(),
+	}
+	err := sc.ExecuteSmartContractTransaction(scr, nil, scAcc, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(7), scAcc.Balance)
+	assert.Equal(t, big.NewInt(1000), ownerAcc.Balance)
+	assert.Equal(t, 1, len(*forwardedTxs))
+	assert.Equal(t, scAcc.AddressContainer().Bytes(), (*forwardedTxs)[0].GetRecvAddress())
+}
@@ -6,34 +6,90 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/pkg/errors"
 )
 
+// ArgsNewSCQueryService defines the arguments needed to create a SCQueryService
+// Accounts is optional: when it is nil the queries run against the state the VMs already read from and
+// past state queries are rejected. When it is set, it must be an accounts adapter dedicated to the queries
+// as its trie is recreated before each query at the requested block or at the last committed one.
+type ArgsNewSCQueryService struct {
+	VmContainer      process.VirtualMachinesContainer
+	GasLimitPerBlock uint64
+	Accounts         state.AccountsAdapter
+	BlockChain       data.ChainHandler
+	StorageService   dataRetriever.StorageService
+	Marshalizer      marshal.Marshalizer
+	Uint64Converter  typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator sharding.Coordinator
+}
+
 // SCQueryService can execute Get functions over SC to fetch stored values
 type SCQueryService struct {
 	vmContainer      process.VirtualMachinesContainer
 	gasLimitPerBlock uint64
+	accounts         state.AccountsAdapter
+	blockChain       data.ChainHandler
+	storageService   dataRetriever.StorageService
+	marshalizer      marshal.Marshalizer
+	uint64Converter  typeConverters.Uint64ByteSliceConverter
+	shardCoordinator sharding.Coordinator
 	mutRunSc         sync.Mutex
 }
 
 // NewSCQueryService returns a new instance of SCQueryService
-func NewSCQueryService(
-	vmContainer process.VirtualMachinesContainer,
-	gasLimitPerBlock uint64,
-) (*SCQueryService, error) {
-
-	if vmContainer == nil || vmContainer.IsInterfaceNil() {
+func NewSCQueryService(args ArgsNewSCQueryService) (*SCQueryService, error) {
+	if check.IfNil(args.VmContainer) {
 		return nil, process.ErrNoVM
 	}
+	if !check.IfNil(args.Accounts) {
+		err := checkPastStateArguments(args)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return &SCQueryService{
-		vmContainer:      vmContainer,
-		gasLimitPerBlock: gasLimitPerBlock,
+		vmContainer:      args.VmContainer,
+		gasLimitPerBlock: args.GasLimitPerBlock,
+		accounts:         args.Accounts,
+		blockChain:       args.BlockChain,
+		storageService:   args.StorageService,
+		marshalizer:      args.Marshalizer,
+		uint64Converter:  args.Uint64Converter,
+		shardCoordinator: args.ShardCoordinator,
 	}, nil
 }
 
+func checkPastStateArguments(args ArgsNewSCQueryService) error {
+	if check.IfNil(args.BlockChain) {
+		return process.ErrNilBlockChain
+	}
+	if check.IfNil(args.StorageService) {
+		return process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return process.ErrNilUint64Converter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return process.ErrNilShardCoordinator
+	}
+
+	return nil
+}
+
 func (service *SCQueryService) getVMFromAddress(scAddress []byte) (vmcommon.VMExecutionHandler, error) {
 	vmType := core.GetVMType(scAddress)
 	vm, err := service.vmContainer.Get(vmType)
@@ -52,10 +108,18 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 	if len(query.FuncName) == 0 {
 		return nil, process.ErrEmptyFunctionName
 	}
+	if query.CallValue != nil && query.CallValue.Sign() < 0 {
+		return nil, process.ErrNegativeValue
+	}
 
 	service.mutRunSc.Lock()
 	defer service.mutRunSc.Unlock()
 
+	err := service.recreateStateForQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
 	vm, err := service.getVMFromAddress(query.ScAddress)
 	if err != nil {
 		return nil, err
@@ -75,10 +139,68 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 	return vmOutput, nil
 }
 
+func (service *SCQueryService) recreateStateForQuery(query *process.SCQuery) error {
+	isPastStateQuery := query.BlockNonce != nil || len(query.BlockRootHash) > 0
+	if check.IfNil(service.accounts) {
+		if isPastStateQuery {
+			return process.ErrPastStateQueriesNotSupported
+		}
+		return nil
+	}
+
+	rootHash, err := service.getRootHashForQuery(query)
+	if err != nil {
+		return err
+	}
+
+	return service.accounts.RecreateTrie(rootHash)
+}
+
+func (service *SCQueryService) getRootHashForQuery(query *process.SCQuery) ([]byte, error) {
+	if len(query.BlockRootHash) > 0 {
+		return query.BlockRootHash, nil
+	}
+
+	if query.BlockNonce != nil {
+		header, _, err := process.GetHeaderFromStorageWithNonce(
+			*query.BlockNonce,
+			service.shardCoordinator.SelfId(),
+			service.storageService,
+			service.uint64Converter,
+			service.marshalizer,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return header.GetRootHash(), nil
+	}
+
+	header := service.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		header = service.blockChain.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return nil, process.ErrNilBlockHeader
+	}
+
+	return header.GetRootHash(), nil
+}
+
 func (service *SCQueryService) createVMCallInput(query *process.SCQuery) *vmcommon.ContractCallInput {
+	callerAddr := query.CallerAddr
+	if len(callerAddr) == 0 {
+		callerAddr = query.ScAddress
+	}
+
+	callValue := big.NewInt(0)
+	if query.CallValue != nil {
+		callValue.Set(query.CallValue)
+	}
+
 	vmInput := vmcommon.VMInput{
-		CallerAddr:  query.ScAddress,
-		CallValue:   big.NewInt(0),
+		CallerAddr:  callerAddr,
+		CallValue:   callValue,
 		GasPrice:    0,
 		GasProvided: service.gasLimitPerBlock,
 		Arguments:   query.Arguments,
@@ -8,6 +8,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -16,10 +20,49 @@ import (
 
 const DummyScAddress = "00000000000000000500fabd9501b7e5353de57a4e319857c2fb99089770720a"
 
+func createMockArgumentsForSCQuery() ArgsNewSCQueryService {
+	return ArgsNewSCQueryService{
+		VmContainer:      &mock.VMContainerMock{},
+		GasLimitPerBlock: uint64(math.MaxUint64),
+	}
+}
+
+func createMockPastStateArgumentsForSCQuery(vmContainer process.VirtualMachinesContainer) ArgsNewSCQueryService {
+	return ArgsNewSCQueryService{
+		VmContainer:      vmContainer,
+		GasLimitPerBlock: uint64(math.MaxUint64),
+		Accounts:         &mock.AccountsStub{},
+		BlockChain:       &mock.BlockChainMock{},
+		StorageService:   &mock.ChainStorerMock{},
+		Marshalizer:      &mock.MarshalizerMock{},
+		Uint64Converter:  &mock.Uint64ByteSliceConverterMock{},
+		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(2),
+	}
+}
+
+func createOkVMContainer(runCalled func(input *vmcommon.ContractCallInput)) process.VirtualMachinesContainer {
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			runCalled(input)
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+			}, nil
+		},
+	}
+
+	return &mock.VMContainerMock{
+		GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+			return mockVM, nil
+		},
+	}
+}
+
 func TestNewSCQueryService_NilVmShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(nil, uint64(math.MaxUint64))
+	args := createMockArgumentsForSCQuery()
+	args.VmContainer = nil
+	target, err := NewSCQueryService(args)
 
 	assert.Nil(t, target)
 	assert.Equal(t, process.ErrNoVM, err)
@@ -28,7 +71,49 @@ func TestNewSCQueryService_NilVmShouldErr(t *testing.T) {
 func TestNewSCQueryService_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(&mock.VMContainerMock{}, uint64(math.MaxUint64))
+	target, err := NewSCQueryService(createMockArgumentsForSCQuery())
+
+	assert.NotNil(t, target)
+	assert.Nil(t, err)
+}
+
+func TestNewSCQueryService_WithAccountsAndNilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPastStateArgumentsForSCQuery(&mock.VMContainerMock{})
+	args.BlockChain = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilBlockChain, err)
+}
+
+func TestNewSCQueryService_WithAccountsAndNilStorageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPastStateArgumentsForSCQuery(&mock.VMContainerMock{})
+	args.StorageService = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilStorage, err)
+}
+
+func TestNewSCQueryService_WithAccountsAndNilUint64ConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPastStateArgumentsForSCQuery(&mock.VMContainerMock{})
+	args.Uint64Converter = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilUint64Converter, err)
+}
+
+func TestNewSCQueryService_WithAccountsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	target, err := NewSCQueryService(createMockPastStateArgumentsForSCQuery(&mock.VMContainerMock{}))
 
 	assert.NotNil(t, target)
 	assert.Nil(t, err)
@@ -37,7 +122,7 @@ func TestNewSCQueryService_ShouldWork(t *testing.T) {
 func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, _ := NewSCQueryService(&mock.VMContainerMock{}, uint64(math.MaxUint64))
+	target, _ := NewSCQueryService(createMockArgumentsForSCQuery())
 
 	query := process.SCQuery{
 		ScAddress: nil,
@@ -54,7 +139,7 @@ func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 func TestExecuteQuery_EmptyFunctionShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, _ := NewSCQueryService(&mock.VMContainerMock{}, uint64(math.MaxUint64))
+	target, _ := NewSCQueryService(createMockArgumentsForSCQuery())
 
 	query := process.SCQuery{
 		ScAddress: []byte{0},
@@ -90,14 +175,14 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		uint64(math.MaxUint64),
-	)
+		GasLimitPerBlock: uint64(math.MaxUint64),
+	})
 
 	dataArgs := make([][]byte, len(args))
 	for i, arg := range args {
@@ -127,14 +212,14 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		uint64(math.MaxUint64),
-	)
+		GasLimitPerBlock: uint64(math.MaxUint64),
+	})
 
 	query := process.SCQuery{
 		ScAddress: []byte(DummyScAddress),
@@ -159,14 +244,14 @@ func TestExecuteQuery_WhenNotOkCodeShouldErr(t *testing.T) {
 			}, nil
 		},
 	}
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		uint64(math.MaxUint64),
-	)
+		GasLimitPerBlock: uint64(math.MaxUint64),
+	})
 
 	query := process.SCQuery{
 		ScAddress: []byte(DummyScAddress),
@@ -202,14 +287,14 @@ func TestExecuteQuery_ShouldCallRunScSequentially(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		uint64(math.MaxUint64),
-	)
+		GasLimitPerBlock: uint64(math.MaxUint64),
+	})
 
 	noOfGoRoutines := 1000
 	wg := sync.WaitGroup{}
@@ -229,3 +314,171 @@ func TestExecuteQuery_ShouldCallRunScSequentially(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestExecuteQuery_ShouldPassCallerAndValue(t *testing.T) {
+	t.Parallel()
+
+	callerAddr := []byte("caller")
+	callValue := big.NewInt(37)
+	runWasCalled := false
+	vmContainer := createOkVMContainer(func(input *vmcommon.ContractCallInput) {
+		runWasCalled = true
+		assert.Equal(t, callerAddr, input.CallerAddr)
+		assert.Equal(t, callValue, input.CallValue)
+	})
+
+	args := createMockArgumentsForSCQuery()
+	args.VmContainer = vmContainer
+	target, _ := NewSCQueryService(args)
+
+	query := process.SCQuery{
+		ScAddress:  []byte(DummyScAddress),
+		FuncName:   "function",
+		CallerAddr: callerAddr,
+		CallValue:  callValue,
+	}
+
+	_, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, err)
+	assert.True(t, runWasCalled)
+}
+
+func TestExecuteQuery_NegativeValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	target, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+		CallValue: big.NewInt(-1),
+	}
+
+	output, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, output)
+	assert.Equal(t, process.ErrNegativeValue, err)
+}
+
+func TestExecuteQuery_PastStateWithoutAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	target, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+	nonce := uint64(5)
+	query := process.SCQuery{
+		ScAddress:  []byte(DummyScAddress),
+		FuncName:   "function",
+		BlockNonce: &nonce,
+	}
+
+	output, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, output)
+	assert.Equal(t, process.ErrPastStateQueriesNotSupported, err)
+}
+
+func TestExecuteQuery_WithRootHashShouldRecreateTrieBeforeRunning(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	recreatedRootHash := make([]byte, 0)
+	vmContainer := createOkVMContainer(func(input *vmcommon.ContractCallInput) {
+		assert.Equal(t, rootHash, recreatedRootHash)
+	})
+
+	args := createMockPastStateArgumentsForSCQuery(vmContainer)
+	args.Accounts = &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			recreatedRootHash = rootHash
+			return nil
+		},
+	}
+	target, _ := NewSCQueryService(args)
+
+	query := process.SCQuery{
+		ScAddress:     []byte(DummyScAddress),
+		FuncName:      "function",
+		BlockRootHash: rootHash,
+	}
+
+	_, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, rootHash, recreatedRootHash)
+}
+
+func TestExecuteQuery_WithBlockNonceShouldRecreateTrieAtThatBlock(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(7)
+	header := &block.Header{Nonce: nonce, ShardId: 0, RootHash: []byte("past root hash")}
+	marshalizer := &mock.MarshalizerMock{}
+	uint64Converter := uint64ByteSlice.NewBigEndianConverter()
+	headerBuff, _ := marshalizer.Marshal(header)
+	headerHash := []byte("header hash")
+
+	hdrNonceHashStorer := mock.NewStorerMock()
+	_ = hdrNonceHashStorer.Put(uint64Converter.ToByteSlice(nonce), headerHash)
+	headerStorer := mock.NewStorerMock()
+	_ = headerStorer.Put(headerHash, headerBuff)
+
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit, hdrNonceHashStorer)
+	store.AddStorer(dataRetriever.BlockHeaderUnit, headerStorer)
+
+	var recreatedRootHash []byte
+	args := createMockPastStateArgumentsForSCQuery(createOkVMContainer(func(input *vmcommon.ContractCallInput) {}))
+	args.StorageService = store
+	args.Marshalizer = marshalizer
+	args.Uint64Converter = uint64Converter
+	args.Accounts = &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			recreatedRootHash = rootHash
+			return nil
+		},
+	}
+	target, _ := NewSCQueryService(args)
+
+	query := process.SCQuery{
+		ScAddress:  []byte(DummyScAddress),
+		FuncName:   "function",
+		BlockNonce: &nonce,
+	}
+
+	_, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, header.RootHash, recreatedRootHash)
+}
+
+func TestExecuteQuery_WithAccountsAndNoBlockShouldRecreateTrieAtCurrentBlock(t *testing.T) {
+	t.Parallel()
+
+	currentHeader := &block.Header{RootHash: []byte("current root hash")}
+	var recreatedRootHash []byte
+	args := createMockPastStateArgumentsForSCQuery(createOkVMContainer(func(input *vmcommon.ContractCallInput) {}))
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return currentHeader
+		},
+	}
+	args.Accounts = &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			recreatedRootHash = rootHash
+			return nil
+		},
+	}
+	target, _ := NewSCQueryService(args)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+	}
+
+	_, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, currentHeader.RootHash, recreatedRootHash)
+}
@@ -3,6 +3,7 @@ package transaction
 import (
 	"bytes"
 	"math/big"
+	"strings"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -30,6 +31,8 @@ type InterceptedTransaction struct {
 	isForCurrentShard bool
 	sndAddr           state.AddressContainer
 	feeHandler        process.FeeHandler
+	chainID           []byte
+	minTxVersion      uint32
 }
 
 // NewInterceptedTransaction returns a new instance of InterceptedTransaction
@@ -42,6 +45,8 @@ func NewInterceptedTransaction(
 	addrConv state.AddressConverter,
 	coordinator sharding.Coordinator,
 	feeHandler process.FeeHandler,
+	chainID []byte,
+	minTxVersion uint32,
 ) (*InterceptedTransaction, error) {
 
 	if txBuff == nil {
@@ -68,6 +73,9 @@ func NewInterceptedTransaction(
 	if feeHandler == nil || coordinator.IsInterfaceNil() {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if len(chainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
 
 	tx, err := createTx(marshalizer, txBuff)
 	if err != nil {
@@ -83,6 +91,8 @@ func NewInterceptedTransaction(
 		keyGen:       keyGen,
 		coordinator:  coordinator,
 		feeHandler:   feeHandler,
+		chainID:      chainID,
+		minTxVersion: minTxVersion,
 	}
 
 	err = inTx.processFields(txBuff)
@@ -118,6 +128,32 @@ func (inTx *InterceptedTransaction) CheckValidity() error {
 	return nil
 }
 
+// CheckValidityWithoutSignature checks if the received transaction is valid, except its own signature which
+// should be verified using SignedMessage. The signature of a relayed user transaction is verified here
+func (inTx *InterceptedTransaction) CheckValidityWithoutSignature() error {
+	return inTx.integrity()
+}
+
+// SignedMessage returns the sender's public key, the signed message and the signature of the transaction. The
+// transactions of the multisignature accounts do not have a single signature
+func (inTx *InterceptedTransaction) SignedMessage() (crypto.PublicKey, []byte, []byte, error) {
+	if inTx.hasSignatureSet() {
+		return nil, nil, nil, process.ErrNoSingleSignature
+	}
+
+	buffCopiedTx, err := signingBytes(inTx.marshalizer, inTx.tx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	senderPubKey, err := inTx.keyGen.PublicKeyFromByteArray(inTx.tx.SndAddr)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return senderPubKey, buffCopiedTx, inTx.tx.Signature, nil
+}
+
 func (inTx *InterceptedTransaction) processFields(txBuff []byte) error {
 	inTx.hash = inTx.hasher.Compute(string(txBuff))
 
@@ -148,8 +184,9 @@ func (inTx *InterceptedTransaction) processFields(txBuff []byte) error {
 
 // integrity checks for not nil fields and negative value
 func (inTx *InterceptedTransaction) integrity() error {
-	if inTx.tx.Signature == nil {
-		return process.ErrNilSignature
+	err := checkSignatureForm(inTx.tx)
+	if err != nil {
+		return err
 	}
 	if inTx.tx.RcvAddr == nil {
 		return process.ErrNilRcvAddr
@@ -164,29 +201,121 @@ func (inTx *InterceptedTransaction) integrity() error {
 		return process.ErrNegativeValue
 	}
 
-	return inTx.feeHandler.CheckValidityTxValues(inTx.tx)
+	err = checkChainIDAndVersion(inTx.tx, inTx.chainID, inTx.minTxVersion)
+	if err != nil {
+		return err
+	}
+
+	err = checkRoundWindowBounds(inTx.tx)
+	if err != nil {
+		return err
+	}
+
+	err = inTx.feeHandler.CheckValidityTxValues(inTx.tx)
+	if err != nil {
+		return err
+	}
+
+	return inTx.checkRelayedTx()
 }
 
-// verifySig checks if the tx is correctly signed
-func (inTx *InterceptedTransaction) verifySig() error {
-	copiedTx := *inTx.tx
-	copiedTx.Signature = nil
-	buffCopiedTx, err := inTx.marshalizer.Marshal(&copiedTx)
+// checkSignatureForm rejects the transactions which are not authorized either by a single signature or, for the
+// multisignature accounts, by a signature set
+func checkSignatureForm(tx *transaction.Transaction) error {
+	hasSignatureSet := len(tx.Signatures) > 0
+	if tx.Signature == nil && !hasSignatureSet {
+		return process.ErrNilSignature
+	}
+	if tx.Signature != nil && hasSignatureSet {
+		return process.ErrSignatureAndSignatureSet
+	}
+
+	return nil
+}
+
+// checkRoundWindowBounds rejects the transactions which expire before becoming valid. The window itself is checked
+// against the current round when the transaction is executed
+func checkRoundWindowBounds(tx *transaction.Transaction) error {
+	if tx.ValidUntilRound > 0 && tx.ValidUntilRound < tx.ValidAfterRound {
+		return process.ErrInvalidRoundWindow
+	}
+
+	return nil
+}
+
+// checkChainIDAndVersion rejects the transactions signed for another network or with a version this node does not
+// accept anymore
+func checkChainIDAndVersion(tx *transaction.Transaction, chainID []byte, minTxVersion uint32) error {
+	if !bytes.Equal(tx.ChainID, chainID) {
+		return process.ErrInvalidChainID
+	}
+	if tx.Version < minTxVersion {
+		return process.ErrInvalidTransactionVersion
+	}
+
+	return nil
+}
+
+// checkRelayedTx verifies the user transaction wrapped by a relayed transaction, including the user's signature
+func (inTx *InterceptedTransaction) checkRelayedTx() error {
+	if !strings.HasPrefix(inTx.tx.Data, relayedTxPrefix) {
+		return nil
+	}
+
+	userTx, err := extractUserTx(inTx.marshalizer, inTx.tx)
+	if err != nil {
+		return err
+	}
+	err = checkSignatureForm(userTx)
 	if err != nil {
 		return err
 	}
 
-	senderPubKey, err := inTx.keyGen.PublicKeyFromByteArray(inTx.tx.SndAddr)
+	err = checkChainIDAndVersion(userTx, inTx.chainID, inTx.minTxVersion)
 	if err != nil {
 		return err
 	}
 
-	err = inTx.singleSigner.Verify(senderPubKey, buffCopiedTx, inTx.tx.Signature)
+	err = checkUserTx(inTx.tx, userTx, inTx.feeHandler)
 	if err != nil {
 		return err
 	}
+	if len(userTx.Signatures) > 0 {
+		// the signature set of a multisignature user is verified against the user account when processing
+		return nil
+	}
 
-	return nil
+	userPubKey, err := inTx.keyGen.PublicKeyFromByteArray(userTx.SndAddr)
+	if err != nil {
+		return err
+	}
+
+	buffCopiedUserTx, err := signingBytes(inTx.marshalizer, userTx)
+	if err != nil {
+		return err
+	}
+
+	return inTx.singleSigner.Verify(userPubKey, buffCopiedUserTx, userTx.Signature)
+}
+
+// hasSignatureSet returns true if the transaction is sent from a multisignature account. Its signature set can only
+// be verified against the sender account, which is done by the transaction validator
+func (inTx *InterceptedTransaction) hasSignatureSet() bool {
+	return len(inTx.tx.Signatures) > 0
+}
+
+// verifySig checks if the tx is correctly signed
+func (inTx *InterceptedTransaction) verifySig() error {
+	if inTx.hasSignatureSet() {
+		return nil
+	}
+
+	senderPubKey, buffCopiedTx, signature, err := inTx.SignedMessage()
+	if err != nil {
+		return err
+	}
+
+	return inTx.singleSigner.Verify(senderPubKey, buffCopiedTx, signature)
 }
 
 // ReceiverShardId returns the receiver shard id
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -25,6 +26,9 @@ var recvShard = uint32(3)
 var senderAddress = []byte("sender")
 var recvAddress = []byte("receiver")
 var sigOk = []byte("signature")
+var testChainID = []byte("chainID")
+
+const testMinTxVersion = uint32(1)
 
 func createDummySigner() crypto.SingleSigner {
 	return &mock.SignerMock{
@@ -87,6 +91,8 @@ func createInterceptedTxFromPlainTx(tx *dataTransaction.Transaction, txFeeHandle
 		},
 		shardCoordinator,
 		txFeeHandler,
+		testChainID,
+		testMinTxVersion,
 	)
 }
 
@@ -104,6 +110,8 @@ func TestNewInterceptedTransaction_NilBufferShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -122,6 +130,8 @@ func TestNewInterceptedTransaction_NilMarshalizerShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -140,6 +150,8 @@ func TestNewInterceptedTransaction_NilHasherShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -158,6 +170,8 @@ func TestNewInterceptedTransaction_NilKeyGenShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -176,6 +190,8 @@ func TestNewInterceptedTransaction_NilSignerShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -194,6 +210,8 @@ func TestNewInterceptedTransaction_NilAddressConverterShouldErr(t *testing.T) {
 		nil,
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -212,6 +230,8 @@ func TestNewInterceptedTransaction_NilCoordinatorShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		nil,
 		&mock.FeeHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -230,6 +250,8 @@ func TestNewInterceptedTransaction_NilFeeHandlerShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		nil,
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -254,6 +276,8 @@ func TestNewInterceptedTransaction_UnmarshalingTxFailsShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -276,6 +300,8 @@ func TestNewInterceptedTransaction_AddrConvFailsShouldErr(t *testing.T) {
 		},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -294,6 +320,8 @@ func TestNewInterceptedTransaction_ShouldWork(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 
 	txi, err := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -317,6 +345,8 @@ func TestInterceptedTransaction_CheckValidityNilSignatureShouldErr(t *testing.T)
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: nil,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
 
@@ -337,6 +367,8 @@ func TestInterceptedTransaction_CheckValidityNilRecvAddressShouldErr(t *testing.
 		RcvAddr:   nil,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
 
@@ -357,6 +389,8 @@ func TestInterceptedTransaction_CheckValidityNilSenderAddressShouldErr(t *testin
 		RcvAddr:   recvAddress,
 		SndAddr:   nil,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
 
@@ -377,6 +411,8 @@ func TestInterceptedTransaction_CheckValidityNilValueShouldErr(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	_, err := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
 
@@ -395,6 +431,8 @@ func TestInterceptedTransaction_CheckValidityNilNegativeValueShouldErr(t *testin
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
 
@@ -417,6 +455,8 @@ func TestNewInterceptedTransaction_InsufficientFeeShouldErr(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	errExpected := errors.New("insufficient fee")
 	feeHandler := &mock.FeeHandlerStub{
@@ -443,6 +483,8 @@ func TestInterceptedTransaction_CheckValidityInvalidSenderShouldErr(t *testing.T
 		RcvAddr:   recvAddress,
 		SndAddr:   []byte(""),
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
 
@@ -463,6 +505,8 @@ func TestInterceptedTransaction_CheckValidityVerifyFailsShouldErr(t *testing.T)
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: []byte("wrong sig"),
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
 
@@ -483,14 +527,320 @@ func TestInterceptedTransaction_CheckValidityOkValsShouldWork(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Nil(t, err)
+}
+
+func TestInterceptedTransaction_CheckValidityWrongChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      "data",
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+		ChainID:   []byte("other chainID"),
+		Version:   testMinTxVersion,
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
+func TestInterceptedTransaction_CheckValidityLowerVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      "data",
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion - 1,
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrInvalidTransactionVersion, err)
+}
+
+func TestInterceptedTransaction_CheckValidityInvalidRoundWindowShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:           1,
+		Value:           big.NewInt(2),
+		RcvAddr:         recvAddress,
+		SndAddr:         senderAddress,
+		Signature:       sigOk,
+		ChainID:         testChainID,
+		Version:         testMinTxVersion,
+		ValidAfterRound: 11,
+		ValidUntilRound: 10,
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrInvalidRoundWindow, err)
+}
+
+func TestInterceptedTransaction_CheckValidityOpenEndedRoundWindowShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:           1,
+		Value:           big.NewInt(2),
+		RcvAddr:         recvAddress,
+		SndAddr:         senderAddress,
+		Signature:       sigOk,
+		ChainID:         testChainID,
+		Version:         testMinTxVersion,
+		ValidAfterRound: 11,
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Nil(t, err)
+}
+
+func TestInterceptedTransaction_CheckValidityWithoutSignatureShouldNotVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      "data",
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: []byte("wrong sig"),
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidityWithoutSignature()
+
+	assert.Nil(t, err)
+}
+
+func TestInterceptedTransaction_SignedMessageShouldReturnTheTxWithoutSignature(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      "data",
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
 
+	publicKey, message, signature, err := txi.SignedMessage()
+
+	unsignedTx := *tx
+	unsignedTx.Signature = nil
+	expectedMessage, _ := (&mock.MarshalizerMock{}).Marshal(&unsignedTx)
+	assert.Nil(t, err)
+	assert.NotNil(t, publicKey)
+	assert.Equal(t, expectedMessage, message)
+	assert.Equal(t, sigOk, signature)
+}
+
+func TestInterceptedTransaction_CheckValiditySignatureAndSignatureSetShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:      1,
+		Value:      big.NewInt(2),
+		RcvAddr:    recvAddress,
+		SndAddr:    senderAddress,
+		Signature:  sigOk,
+		Signatures: [][]byte{sigOk},
+		ChainID:    testChainID,
+		Version:    testMinTxVersion,
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrSignatureAndSignatureSet, err)
+}
+
+func TestInterceptedTransaction_CheckValiditySignatureSetShouldNotVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:      1,
+		Value:      big.NewInt(2),
+		RcvAddr:    recvAddress,
+		SndAddr:    senderAddress,
+		Signatures: [][]byte{[]byte("not checked here"), nil},
+		ChainID:    testChainID,
+		Version:    testMinTxVersion,
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Nil(t, err)
+}
+
+func TestInterceptedTransaction_SignedMessageWithSignatureSetShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:      1,
+		Value:      big.NewInt(2),
+		RcvAddr:    recvAddress,
+		SndAddr:    senderAddress,
+		Signatures: [][]byte{sigOk},
+		ChainID:    testChainID,
+		Version:    testMinTxVersion,
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	_, _, _, err := txi.SignedMessage()
+
+	assert.Equal(t, process.ErrNoSingleSignature, err)
+}
+
+func createRelayedUserTx() *dataTransaction.Transaction {
+	return &dataTransaction.Transaction{
+		Nonce:     5,
+		Value:     big.NewInt(2),
+		Data:      "data",
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   []byte("user receiver"),
+		SndAddr:   recvAddress,
+		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
+	}
+}
+
+func TestInterceptedTransaction_CheckValidityRelayedTxOkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tx := createRelayedTx(createRelayedUserTx(), senderAddress, 1)
+	tx.Signature = sigOk
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
 	err := txi.CheckValidity()
 
 	assert.Nil(t, err)
 }
 
+func TestInterceptedTransaction_CheckValidityRelayedTxWithOtherUserChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	userTx := createRelayedUserTx()
+	userTx.ChainID = []byte("other chainID")
+	tx := createRelayedTx(userTx, senderAddress, 1)
+	tx.ChainID = testChainID
+	tx.Signature = sigOk
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
+func TestInterceptedTransaction_CheckValidityRelayedTxWithInvalidUserSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	userTx := createRelayedUserTx()
+	userTx.Signature = []byte("wrong sig")
+	tx := createRelayedTx(userTx, senderAddress, 1)
+	tx.Signature = sigOk
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidityWithoutSignature()
+
+	assert.Equal(t, errSignerMockVerifySigFails, err)
+}
+
+func TestInterceptedTransaction_CheckValidityRelayedTxWithUserSignatureSetShouldNotVerifyIt(t *testing.T) {
+	t.Parallel()
+
+	userTx := createRelayedUserTx()
+	userTx.Signature = nil
+	userTx.Signatures = [][]byte{[]byte("not checked here"), nil}
+	tx := createRelayedTx(userTx, senderAddress, 1)
+	tx.Signature = sigOk
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Nil(t, err)
+}
+
+func TestInterceptedTransaction_CheckValidityRelayedTxWithUserSignatureAndSignatureSetShouldErr(t *testing.T) {
+	t.Parallel()
+
+	userTx := createRelayedUserTx()
+	userTx.Signatures = [][]byte{sigOk}
+	tx := createRelayedTx(userTx, senderAddress, 1)
+	tx.Signature = sigOk
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrSignatureAndSignatureSet, err)
+}
+
+func TestInterceptedTransaction_CheckValidityRelayedTxWithOtherBeneficiaryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := createRelayedTx(createRelayedUserTx(), senderAddress, 1)
+	tx.RcvAddr = []byte("other user")
+	tx.Signature = sigOk
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrRelayedTxBeneficiaryDoesNotMatchReceiver, err)
+}
+
+func TestInterceptedTransaction_CheckValidityRelayedTxWithInvalidDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := createRelayedTx(createRelayedUserTx(), senderAddress, 1)
+	tx.Data = core.RelayedTransaction + "@not hex"
+	tx.Signature = sigOk
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrInvalidRelayedTxData, err)
+}
+
 func TestInterceptedTransaction_OkValsGettersShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -503,6 +853,8 @@ func TestInterceptedTransaction_OkValsGettersShouldWork(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -530,6 +882,8 @@ func TestInterceptedTransaction_ScTxDeployRecvShardIdShouldBeSendersShardId(t *t
 		RcvAddr:   recvAddressDeploy,
 		SndAddr:   senderAddressInShard1,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	marshalizer := &mock.MarshalizerMock{}
 	txBuff, _ := marshalizer.Marshal(tx)
@@ -560,6 +914,8 @@ func TestInterceptedTransaction_ScTxDeployRecvShardIdShouldBeSendersShardId(t *t
 		},
 		shardCoordinator,
 		createFreeTxFeeHandler(),
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, err)
@@ -581,6 +937,8 @@ func TestInterceptedTransaction_GetNonce(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -601,6 +959,8 @@ func TestInterceptedTransaction_SenderShardId(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -629,6 +989,8 @@ func TestInterceptedTransaction_GetTotalValue(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -649,6 +1011,8 @@ func TestInterceptedTransaction_GetSenderAddress(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -83,6 +83,10 @@ func (txProc *metaTxProcessor) ProcessTransaction(tx *transaction.Transaction, r
 		return txProc.processSCDeployment(tx, adrSrc, roundIndex)
 	case process.SCInvoking:
 		return txProc.processSCInvoking(tx, adrSrc, adrDst, roundIndex)
+	case process.BuiltInFunctionCall:
+		// tokens are sent to the metachain only to be burnt by the esdt smart contract, which is called by the smart
+		// contract result created by the sender's shard together with the value of the transaction
+		return nil
 	}
 
 	return process.ErrWrongTransaction
@@ -303,6 +303,68 @@ func TestMetaTxProcessor_ProcessTransactionScTxShouldWork(t *testing.T) {
 	assert.Equal(t, 0, saveAccountCalled)
 }
 
+func TestMetaTxProcessor_ProcessTransactionBuiltInFunctionCallShouldNotInvokeSC(t *testing.T) {
+	t.Parallel()
+
+	journalizeCalled := 0
+	saveAccountCalled := 0
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+			journalizeCalled++
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			saveAccountCalled++
+			return nil
+		},
+	}
+
+	addrConverter := &mock.AddressConverterMock{}
+
+	tx := transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = generateRandomByteSlice(addrConverter.AddressLen())
+	tx.Value = big.NewInt(45)
+	tx.GasPrice = 1
+	tx.GasLimit = 1
+
+	acntSrc, err := state.NewAccount(mock.NewAddressMock(tx.SndAddr), tracker)
+	assert.Nil(t, err)
+
+	acntDst, err := state.NewAccount(mock.NewAddressMock(tx.RcvAddr), tracker)
+	assert.Nil(t, err)
+
+	acntSrc.Balance = big.NewInt(46)
+	acntDst.SetCode([]byte{65})
+
+	accounts := createAccountStub(tx.SndAddr, tx.RcvAddr, acntSrc, acntDst)
+	scProcessorMock := &mock.SCProcessorMock{}
+
+	wasCalled := false
+	scProcessorMock.ExecuteSmartContractTransactionCalled = func(tx data.TransactionHandler, acntSrc, acntDst state.AccountHandler, round uint64) error {
+		wasCalled = true
+		return nil
+	}
+
+	execTx, _ := txproc.NewMetaTxProcessor(
+		accounts,
+		&mock.AddressConverterMock{},
+		mock.NewOneShardCoordinatorMock(),
+		scProcessorMock,
+		&mock.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (transactionType process.TransactionType, e error) {
+				return process.BuiltInFunctionCall, nil
+			},
+		},
+	)
+
+	err = execTx.ProcessTransaction(&tx, 4)
+	assert.Nil(t, err)
+	assert.False(t, wasCalled)
+	assert.Equal(t, 0, journalizeCalled)
+	assert.Equal(t, 0, saveAccountCalled)
+}
+
 func TestMetaTxProcessor_ProcessTransactionScTxShouldReturnErrWhenExecutionFails(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,79 @@
+package transaction
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const relayedTxPrefix = core.RelayedTransaction + "@"
+
+// extractUserTx decodes the user transaction wrapped in the data field of a relayed transaction
+func extractUserTx(marshalizer marshal.Marshalizer, relayedTx *transaction.Transaction) (*transaction.Transaction, error) {
+	if !strings.HasPrefix(relayedTx.Data, relayedTxPrefix) {
+		return nil, process.ErrInvalidRelayedTxData
+	}
+
+	userTxBuff, err := hex.DecodeString(relayedTx.Data[len(relayedTxPrefix):])
+	if err != nil {
+		return nil, process.ErrInvalidRelayedTxData
+	}
+
+	userTx := &transaction.Transaction{}
+	err = marshalizer.Unmarshal(userTx, userTxBuff)
+	if err != nil {
+		return nil, process.ErrInvalidRelayedTxData
+	}
+
+	return userTx, nil
+}
+
+// checkUserTx verifies that the user transaction is consistent with the relayed transaction wrapping it: the
+// relayed transaction is sent to the user, carries the same value, gas price and chain ID and pays for all the gas
+func checkUserTx(
+	relayedTx *transaction.Transaction,
+	userTx *transaction.Transaction,
+	feeHandler process.FeeHandler,
+) error {
+	if userTx.RcvAddr == nil {
+		return process.ErrNilRcvAddr
+	}
+	if userTx.Value == nil {
+		return process.ErrNilValue
+	}
+	if userTx.Value.Cmp(big.NewInt(0)) < 0 {
+		return process.ErrNegativeValue
+	}
+	if strings.HasPrefix(userTx.Data, relayedTxPrefix) {
+		return process.ErrRecursiveRelayedTx
+	}
+	if !bytes.Equal(userTx.SndAddr, relayedTx.RcvAddr) {
+		return process.ErrRelayedTxBeneficiaryDoesNotMatchReceiver
+	}
+	if relayedTx.Value == nil || userTx.Value.Cmp(relayedTx.Value) != 0 {
+		return process.ErrRelayedTxValueMismatch
+	}
+	if userTx.GasPrice != relayedTx.GasPrice {
+		return process.ErrRelayedTxGasPriceMismatch
+	}
+	if !bytes.Equal(userTx.ChainID, relayedTx.ChainID) {
+		return process.ErrInvalidChainID
+	}
+	err := checkRoundWindowBounds(userTx)
+	if err != nil {
+		return err
+	}
+
+	relayerGasLimit := feeHandler.ComputeGasLimit(relayedTx)
+	if relayedTx.GasLimit < relayerGasLimit || relayedTx.GasLimit-relayerGasLimit < userTx.GasLimit {
+		return process.ErrRelayedTxGasLimitTooLow
+	}
+
+	return feeHandler.CheckValidityTxValues(userTx)
+}
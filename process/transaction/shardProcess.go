@@ -1,16 +1,26 @@
 package transaction
 
 import (
+	"bytes"
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/logger"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/esdt"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
 )
 
+var log = logger.GetOrCreate("process/transaction")
+
 // txProcessor implements TransactionProcessor interface and can modify account states according to a transaction
 type txProcessor struct {
 	*baseTxProcessor
@@ -21,6 +31,10 @@ type txProcessor struct {
 	txTypeHandler    process.TxTypeHandler
 	shardCoordinator sharding.Coordinator
 	economicsFee     process.FeeHandler
+	scrForwarder     process.IntermediateTransactionHandler
+	sigSetHandler    process.SignatureSetHandler
+	chainID          []byte
+	minTxVersion     uint32
 }
 
 // NewTxProcessor creates a new txProcessor engine
@@ -34,6 +48,10 @@ func NewTxProcessor(
 	txFeeHandler process.TransactionFeeHandler,
 	txTypeHandler process.TxTypeHandler,
 	economicsFee process.FeeHandler,
+	scrForwarder process.IntermediateTransactionHandler,
+	sigSetHandler process.SignatureSetHandler,
+	chainID []byte,
+	minTxVersion uint32,
 ) (*txProcessor, error) {
 
 	if accounts == nil || accounts.IsInterfaceNil() {
@@ -63,6 +81,15 @@ func NewTxProcessor(
 	if economicsFee == nil || economicsFee.IsInterfaceNil() {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if check.IfNil(scrForwarder) {
+		return nil, process.ErrNilIntermediateTransactionHandler
+	}
+	if check.IfNil(sigSetHandler) {
+		return nil, process.ErrNilSignatureSetHandler
+	}
+	if len(chainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
 
 	baseTxProcess := &baseTxProcessor{
 		accounts:         accounts,
@@ -71,13 +98,18 @@ func NewTxProcessor(
 	}
 
 	return &txProcessor{
-		baseTxProcessor: baseTxProcess,
-		hasher:          hasher,
-		marshalizer:     marshalizer,
-		scProcessor:     scProcessor,
-		txFeeHandler:    txFeeHandler,
-		txTypeHandler:   txTypeHandler,
-		economicsFee:    economicsFee,
+		baseTxProcessor:  baseTxProcess,
+		hasher:           hasher,
+		marshalizer:      marshalizer,
+		scProcessor:      scProcessor,
+		txFeeHandler:     txFeeHandler,
+		txTypeHandler:    txTypeHandler,
+		shardCoordinator: shardCoordinator,
+		economicsFee:     economicsFee,
+		scrForwarder:     scrForwarder,
+		sigSetHandler:    sigSetHandler,
+		chainID:          chainID,
+		minTxVersion:     minTxVersion,
 	}, nil
 }
 
@@ -97,6 +129,25 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction, round
 		return err
 	}
 
+	err = txProc.verifySignatureSet(tx, acntSnd)
+	if err != nil {
+		return err
+	}
+
+	// the chain ID, the version and the round window are checked only by the sender's shard, the destination shard
+	// executes the transaction later
+	if !check.IfNil(acntSnd) {
+		err = checkChainIDAndVersion(tx, txProc.chainID, txProc.minTxVersion)
+		if err != nil {
+			return err
+		}
+
+		err = checkRoundWindow(tx, roundIndex)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = txProc.checkTxValues(tx, acntSnd)
 	if err != nil {
 		return err
@@ -114,11 +165,44 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction, round
 		return txProc.processSCDeployment(tx, adrSrc, roundIndex)
 	case process.SCInvoking:
 		return txProc.processSCInvoking(tx, adrSrc, adrDst, roundIndex)
+	case process.RelayedTx:
+		return txProc.processRelayedTx(tx, adrSrc, adrDst, roundIndex)
+	case process.MultiSigRegistration:
+		return txProc.processMultiSigRegistration(tx, adrSrc)
+	case process.BuiltInFunctionCall:
+		return txProc.processBuiltInFunctionCall(tx, adrSrc, adrDst, roundIndex)
 	}
 
 	return process.ErrWrongTransaction
 }
 
+// verifySignatureSet checks that a transaction sent from an account of this shard is signed as the account requires
+func (txProc *txProcessor) verifySignatureSet(tx *transaction.Transaction, acntSnd state.AccountHandler) error {
+	if check.IfNil(acntSnd) {
+		// the signatures were already checked by the sender's shard
+		return nil
+	}
+
+	account, ok := acntSnd.(*state.Account)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	return txProc.sigSetHandler.VerifySignatureSet(tx, account)
+}
+
+// checkRoundWindow rejects a transaction executed outside the rounds it was signed for
+func checkRoundWindow(tx *transaction.Transaction, roundIndex uint64) error {
+	if tx.IsNotYetValidInRound(roundIndex) {
+		return process.ErrTransactionNotYetValid
+	}
+	if tx.IsExpiredInRound(roundIndex) {
+		return process.ErrTransactionExpired
+	}
+
+	return nil
+}
+
 func (txProc *txProcessor) processTxFee(tx *transaction.Transaction, acntSnd *state.Account) (*big.Int, error) {
 	if acntSnd == nil {
 		return big.NewInt(0), nil
@@ -212,6 +296,465 @@ func (txProc *txProcessor) processSCInvoking(
 	return err
 }
 
+// processMultiSigRegistration turns the sender into a multisignature account, or replaces the public keys and the
+// threshold of an account which is already a multisignature one. The value of the transaction stays with the sender
+func (txProc *txProcessor) processMultiSigRegistration(
+	tx *transaction.Transaction,
+	adrSrc state.AddressContainer,
+) error {
+	threshold, pubKeys, err := txProc.sigSetHandler.ParseMultiSigRegistration(tx.Data)
+	if err != nil {
+		return err
+	}
+
+	// the registration is sent by the account to itself, so it is processed only in the account's shard
+	acntSrc, _, err := txProc.getAccounts(adrSrc, adrSrc)
+	if err != nil {
+		return err
+	}
+	if acntSrc == nil {
+		return nil
+	}
+
+	txFee, err := txProc.processTxFee(tx, acntSrc)
+	if err != nil {
+		return err
+	}
+
+	err = acntSrc.SetMultiSigWithJournal(threshold, pubKeys)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.increaseNonce(acntSrc)
+	if err != nil {
+		return err
+	}
+
+	txProc.txFeeHandler.ProcessTransactionFee(txFee)
+
+	return nil
+}
+
+// processBuiltInFunctionCall moves fungible tokens between accounts. Users can only transfer tokens, which are always
+// debited by the sender's shard. A user receiver from the same shard is credited directly and one from another shard
+// through a smart contract result. A smart contract receiver from the same shard is credited and then invoked to
+// handle the tokens, while one from another shard is credited and invoked by the smart contract result which also
+// carries the value and the gas of the call. The esdt smart contract burns the tokens it receives
+func (txProc *txProcessor) processBuiltInFunctionCall(
+	tx *transaction.Transaction,
+	adrSrc, adrDst state.AddressContainer,
+	roundIndex uint64,
+) error {
+	call, err := esdt.ParseCall(tx.Data)
+	if err != nil {
+		return err
+	}
+	if call.Function != core.BuiltInFunctionESDTTransfer {
+		return process.ErrBuiltInFunctionCallNotAllowed
+	}
+
+	isReceiverInMetachain := txProc.shardCoordinator.ComputeId(adrDst) == sharding.MetachainShardId
+	if isReceiverInMetachain && !bytes.Equal(tx.RcvAddr, factory.ESDTSCAddress) {
+		return process.ErrInvalidESDTReceiver
+	}
+
+	acntSrc, acntDst, err := txProc.getAccounts(adrSrc, adrDst)
+	if err != nil {
+		return err
+	}
+
+	isReceiverSmartContract := core.IsSmartContractAddress(tx.RcvAddr)
+	if acntSrc == nil {
+		// the tokens of a cross shard transfer are credited through the smart contract result created by the sender's
+		// shard, which also carries the value of a smart contract call
+		if isReceiverSmartContract {
+			return nil
+		}
+		return txProc.moveBalances(nil, acntDst, tx.Value)
+	}
+
+	err = esdt.Debit(txProc.marshalizer, txProc.accounts, acntSrc, call.TokenName, call.Value)
+	if err != nil {
+		return err
+	}
+
+	if isReceiverSmartContract {
+		if acntDst == nil {
+			return txProc.forwardSCBuiltInFunctionCall(tx, acntSrc)
+		}
+
+		err = esdt.Credit(txProc.marshalizer, txProc.accounts, acntDst, call.TokenName, call.Value)
+		if err != nil {
+			return err
+		}
+
+		return txProc.scProcessor.ExecuteSmartContractTransaction(tx, acntSrc, acntDst, roundIndex)
+	}
+
+	txFee, err := txProc.processTxFee(tx, acntSrc)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.moveBalances(acntSrc, acntDst, tx.Value)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.increaseNonce(acntSrc)
+	if err != nil {
+		return err
+	}
+
+	if acntDst != nil {
+		err = esdt.Credit(txProc.marshalizer, txProc.accounts, acntDst, call.TokenName, call.Value)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = txProc.forwardBuiltInFunctionCall(tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	txProc.txFeeHandler.ProcessTransactionFee(txFee)
+
+	return nil
+}
+
+// forwardBuiltInFunctionCall creates the smart contract result which credits the tokens in the receiver's shard. The
+// value of the transaction is moved by the receiver's shard when it processes the transaction itself
+func (txProc *txProcessor) forwardBuiltInFunctionCall(tx *transaction.Transaction) error {
+	txHash, err := core.CalculateHash(txProc.marshalizer, txProc.hasher, tx)
+	if err != nil {
+		return err
+	}
+
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:    tx.Nonce,
+		Value:    big.NewInt(0),
+		RcvAddr:  tx.RcvAddr,
+		SndAddr:  tx.SndAddr,
+		Data:     tx.Data,
+		TxHash:   txHash,
+		GasPrice: tx.GasPrice,
+	}
+
+	return txProc.scrForwarder.AddIntermediateTransactions([]data.TransactionHandler{scr})
+}
+
+// forwardSCBuiltInFunctionCall charges the sender of a transfer to a smart contract from another shard and creates the
+// smart contract result which credits the tokens and invokes the contract, with the value and the gas left after the
+// fee of the transaction
+func (txProc *txProcessor) forwardSCBuiltInFunctionCall(tx *transaction.Transaction, acntSrc *state.Account) error {
+	err := txProc.economicsFee.CheckValidityTxValues(tx)
+	if err != nil {
+		return err
+	}
+
+	txHash, err := core.CalculateHash(txProc.marshalizer, txProc.hasher, tx)
+	if err != nil {
+		return err
+	}
+
+	txFee := txProc.economicsFee.ComputeFee(tx)
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:    tx.Nonce,
+		Value:    tx.Value,
+		RcvAddr:  tx.RcvAddr,
+		SndAddr:  tx.SndAddr,
+		Data:     tx.Data,
+		TxHash:   txHash,
+		GasLimit: tx.GasLimit - txProc.economicsFee.ComputeGasLimit(tx),
+		GasPrice: tx.GasPrice,
+	}
+
+	gasForwarded := big.NewInt(0).SetUint64(scr.GasLimit)
+	gasForwarded.Mul(gasForwarded, big.NewInt(0).SetUint64(scr.GasPrice))
+	totalCost := big.NewInt(0).Add(txFee, tx.Value)
+	totalCost.Add(totalCost, gasForwarded)
+	if acntSrc.Balance.Cmp(totalCost) < 0 {
+		return process.ErrInsufficientFunds
+	}
+
+	err = acntSrc.SetBalanceWithJournal(big.NewInt(0).Sub(acntSrc.Balance, totalCost))
+	if err != nil {
+		return err
+	}
+
+	err = txProc.increaseNonce(acntSrc)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.scrForwarder.AddIntermediateTransactions([]data.TransactionHandler{scr})
+	if err != nil {
+		return err
+	}
+
+	txProc.txFeeHandler.ProcessTransactionFee(txFee)
+
+	return nil
+}
+
+// processRelayedTx charges the relayer for the relayed transaction in the relayer's shard and executes the wrapped
+// user transaction in the user's shard, as if the user sent it with the value and the gas paid by the relayer
+func (txProc *txProcessor) processRelayedTx(
+	tx *transaction.Transaction,
+	adrSrc, adrDst state.AddressContainer,
+	roundIndex uint64,
+) error {
+	userTx, err := extractUserTx(txProc.marshalizer, tx)
+	if err != nil {
+		return err
+	}
+
+	err = checkUserTx(tx, userTx, txProc.economicsFee)
+	if err != nil {
+		return err
+	}
+
+	// getAccounts returns acntRelayer not nil if the relayer is in the node shard, the same, acntUser will be not
+	// nil if the user is in the node shard
+	acntRelayer, acntUser, err := txProc.getAccounts(adrSrc, adrDst)
+	if err != nil {
+		return err
+	}
+
+	if acntRelayer != nil {
+		err = checkChainIDAndVersion(userTx, txProc.chainID, txProc.minTxVersion)
+		if err != nil {
+			return err
+		}
+
+		err = checkRoundWindow(userTx, roundIndex)
+		if err != nil {
+			return err
+		}
+
+		err = txProc.chargeRelayer(tx, userTx, acntRelayer)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the user transaction is executed by the user's shard when it processes the cross shard relayed transaction
+	if acntUser == nil {
+		return nil
+	}
+
+	relayedTxHash, err := core.CalculateHash(txProc.marshalizer, txProc.hasher, tx)
+	if err != nil {
+		return err
+	}
+
+	snapshot := txProc.accounts.JournalLen()
+	err = txProc.processUserTx(userTx, acntUser, relayedTxHash, roundIndex)
+	if err != nil {
+		log.Debug("relayed user transaction failed",
+			"relayed tx hash", relayedTxHash,
+			"error", err.Error(),
+		)
+
+		errRevert := txProc.accounts.RevertToSnapshot(snapshot)
+		if errRevert != nil {
+			return errRevert
+		}
+
+		return txProc.refundRelayer(tx, userTx, acntRelayer, relayedTxHash)
+	}
+
+	return nil
+}
+
+// chargeRelayer takes the value and the whole gas of the relayed transaction from the relayer. The gas of the user
+// transaction is passed to the user together with the value, the rest of it is the relayer's fee
+func (txProc *txProcessor) chargeRelayer(
+	tx *transaction.Transaction,
+	userTx *transaction.Transaction,
+	acntRelayer *state.Account,
+) error {
+	err := txProc.economicsFee.CheckValidityTxValues(tx)
+	if err != nil {
+		return err
+	}
+
+	totalCost := big.NewInt(0).SetUint64(tx.GasLimit)
+	totalCost.Mul(totalCost, big.NewInt(0).SetUint64(tx.GasPrice))
+	totalCost.Add(totalCost, tx.Value)
+	if acntRelayer.Balance.Cmp(totalCost) < 0 {
+		return process.ErrInsufficientFunds
+	}
+
+	operation := big.NewInt(0)
+	err = acntRelayer.SetBalanceWithJournal(operation.Sub(acntRelayer.Balance, totalCost))
+	if err != nil {
+		return err
+	}
+
+	err = txProc.increaseNonce(acntRelayer)
+	if err != nil {
+		return err
+	}
+
+	relayerFee := big.NewInt(0).SetUint64(tx.GasLimit - userTx.GasLimit)
+	relayerFee.Mul(relayerFee, big.NewInt(0).SetUint64(tx.GasPrice))
+	txProc.txFeeHandler.ProcessTransactionFee(relayerFee)
+
+	return nil
+}
+
+// processUserTx gives the user the value and the gas paid by the relayer and executes the user transaction. If the
+// receiver of the user transaction is in another shard, the value and the call are forwarded through a smart
+// contract result
+func (txProc *txProcessor) processUserTx(
+	userTx *transaction.Transaction,
+	acntUser *state.Account,
+	relayedTxHash []byte,
+	roundIndex uint64,
+) error {
+	userFunds := big.NewInt(0).SetUint64(userTx.GasLimit)
+	userFunds.Mul(userFunds, big.NewInt(0).SetUint64(userTx.GasPrice))
+	userFunds.Add(userFunds, userTx.Value)
+
+	operation := big.NewInt(0)
+	err := acntUser.SetBalanceWithJournal(operation.Add(acntUser.Balance, userFunds))
+	if err != nil {
+		return err
+	}
+
+	isCrossShard, err := txProc.isCrossShardUserTx(userTx)
+	if err != nil {
+		return err
+	}
+	if !isCrossShard {
+		return txProc.ProcessTransaction(userTx, roundIndex)
+	}
+
+	return txProc.processCrossShardUserTx(userTx, acntUser, relayedTxHash)
+}
+
+func (txProc *txProcessor) isCrossShardUserTx(userTx *transaction.Transaction) (bool, error) {
+	if bytes.Equal(userTx.RcvAddr, make([]byte, txProc.adrConv.AddressLen())) {
+		return false, nil
+	}
+
+	adrDst, err := txProc.adrConv.CreateAddressFromPublicKeyBytes(userTx.RcvAddr)
+	if err != nil {
+		return false, err
+	}
+
+	return txProc.shardCoordinator.ComputeId(adrDst) != txProc.shardCoordinator.SelfId(), nil
+}
+
+// processCrossShardUserTx charges the user as the sender of the user transaction and creates the smart contract
+// result which moves the value to the receiver. A smart contract receiver also gets the call data and the gas left
+func (txProc *txProcessor) processCrossShardUserTx(
+	userTx *transaction.Transaction,
+	acntUser *state.Account,
+	relayedTxHash []byte,
+) error {
+	// the tokens can not be debited here, as the destination shard executes the forwarded call as a smart contract
+	// result and not as the user transaction
+	if esdt.IsBuiltInFunctionCall(userTx.Data) {
+		return process.ErrBuiltInFunctionCallNotAllowed
+	}
+
+	err := txProc.sigSetHandler.VerifySignatureSet(userTx, acntUser)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.checkTxValues(userTx, acntUser)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.economicsFee.CheckValidityTxValues(userTx)
+	if err != nil {
+		return err
+	}
+
+	txFee := txProc.economicsFee.ComputeFee(userTx)
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:    userTx.Nonce,
+		Value:    userTx.Value,
+		RcvAddr:  userTx.RcvAddr,
+		SndAddr:  userTx.SndAddr,
+		TxHash:   relayedTxHash,
+		GasPrice: userTx.GasPrice,
+	}
+
+	totalCost := big.NewInt(0).Add(txFee, userTx.Value)
+	if core.IsSmartContractAddress(userTx.RcvAddr) {
+		scr.Data = userTx.Data
+		scr.GasLimit = userTx.GasLimit - txProc.economicsFee.ComputeGasLimit(userTx)
+
+		gasForwarded := big.NewInt(0).SetUint64(scr.GasLimit)
+		gasForwarded.Mul(gasForwarded, big.NewInt(0).SetUint64(scr.GasPrice))
+		totalCost.Add(totalCost, gasForwarded)
+	}
+
+	if acntUser.Balance.Cmp(totalCost) < 0 {
+		return process.ErrInsufficientFunds
+	}
+
+	operation := big.NewInt(0)
+	err = acntUser.SetBalanceWithJournal(operation.Sub(acntUser.Balance, totalCost))
+	if err != nil {
+		return err
+	}
+
+	err = txProc.increaseNonce(acntUser)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.scrForwarder.AddIntermediateTransactions([]data.TransactionHandler{scr})
+	if err != nil {
+		return err
+	}
+
+	txProc.txFeeHandler.ProcessTransactionFee(txFee)
+
+	return nil
+}
+
+// refundRelayer gives back the value of a failed user transaction to the relayer, while the gas of the user
+// transaction is kept as fee
+func (txProc *txProcessor) refundRelayer(
+	tx *transaction.Transaction,
+	userTx *transaction.Transaction,
+	acntRelayer *state.Account,
+	relayedTxHash []byte,
+) error {
+	userFee := big.NewInt(0).SetUint64(userTx.GasLimit)
+	userFee.Mul(userFee, big.NewInt(0).SetUint64(userTx.GasPrice))
+	txProc.txFeeHandler.ProcessTransactionFee(userFee)
+
+	if tx.Value.Cmp(big.NewInt(0)) == 0 {
+		return nil
+	}
+
+	if acntRelayer != nil {
+		operation := big.NewInt(0)
+		return acntRelayer.SetBalanceWithJournal(operation.Add(acntRelayer.Balance, tx.Value))
+	}
+
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:    tx.Nonce,
+		Value:    tx.Value,
+		RcvAddr:  tx.SndAddr,
+		SndAddr:  tx.RcvAddr,
+		TxHash:   relayedTxHash,
+		GasPrice: tx.GasPrice,
+	}
+
+	return txProc.scrForwarder.AddIntermediateTransactions([]data.TransactionHandler{scr})
+}
+
 func (txProc *txProcessor) moveBalances(acntSrc, acntDst *state.Account,
 	value *big.Int,
 ) error {
@@ -3,20 +3,27 @@ package transaction_test
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
+	"github.com/ElrondNetwork/elrond-go/process/esdt"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	txproc "github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/stretchr/testify/assert"
 )
 
+var chainID = []byte("chain ID")
+
 func generateRandomByteSlice(size int) []byte {
 	buff := make([]byte, size)
 	_, _ = rand.Reader.Read(buff)
@@ -66,6 +73,10 @@ func createTxProcessor() txproc.TxProcessor {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	return txProc
@@ -86,6 +97,10 @@ func TestNewTxProcessor_NilAccountsShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilAccountsAdapter, err)
@@ -105,6 +120,10 @@ func TestNewTxProcessor_NilHasherShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilHasher, err)
@@ -124,6 +143,10 @@ func TestNewTxProcessor_NilAddressConverterMockShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilAddressConverter, err)
@@ -143,6 +166,10 @@ func TestNewTxProcessor_NilMarshalizerMockShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilMarshalizer, err)
@@ -162,6 +189,10 @@ func TestNewTxProcessor_NilShardCoordinatorMockShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
@@ -181,6 +212,10 @@ func TestNewTxProcessor_NilSCProcessorShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilSmartContractProcessor, err)
@@ -200,6 +235,10 @@ func TestNewTxProcessor_NilTxFeeHandlerShouldErr(t *testing.T) {
 		nil,
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilUnsignedTxHandler, err)
@@ -219,6 +258,10 @@ func TestNewTxProcessor_OkValsShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	assert.Nil(t, err)
@@ -242,11 +285,15 @@ func TestTxProcessor_GetAddressErrAddressConvShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	addressConv.Fail = true
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 
 	_, _, err := execTx.GetAddresses(&tx)
 	assert.NotNil(t, err)
@@ -257,7 +304,7 @@ func TestTxProcessor_GetAddressOkValsShouldWork(t *testing.T) {
 
 	execTx := *createTxProcessor()
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.RcvAddr = []byte{65, 66, 67}
 	tx.SndAddr = []byte{32, 33, 34}
 
@@ -284,6 +331,10 @@ func TestTxProcessor_GetAccountsShouldErrNilAddressContainer(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	adr1 := mock.NewAddressMock([]byte{65})
@@ -311,6 +362,10 @@ func TestTxProcessor_GetAccountsMalfunctionAccountsShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	adr1 := mock.NewAddressMock([]byte{65})
@@ -355,6 +410,10 @@ func TestTxProcessor_GetAccountsOkValsSrcShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
@@ -408,6 +467,10 @@ func TestTxProcessor_GetAccountsOkValsDsthouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
@@ -446,6 +509,10 @@ func TestTxProcessor_GetAccountsOkValsShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	a1, a2, err := execTx.GetAccounts(adr1, adr2)
@@ -475,6 +542,10 @@ func TestTxProcessor_GetSameAccountShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	a1, a2, err := execTx.GetAccounts(adr1, adr1)
@@ -544,7 +615,7 @@ func TestTxProcessor_CheckTxValuesOkValsShouldErr(t *testing.T) {
 	assert.Nil(t, err)
 }
 
-//------- moveBalances
+// ------- moveBalances
 func TestTxProcessor_MoveBalancesShouldNotFailWhenAcntSrcIsNotInNodeShard(t *testing.T) {
 	t.Parallel()
 
@@ -718,11 +789,15 @@ func TestTxProcessor_ProcessTransactionErrAddressConvShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	addressConv.Fail = true
 
-	err := execTx.ProcessTransaction(&transaction.Transaction{}, 4)
+	err := execTx.ProcessTransaction(&transaction.Transaction{ChainID: chainID}, 4)
 	assert.NotNil(t, err)
 }
 
@@ -741,9 +816,13 @@ func TestTxProcessor_ProcessTransactionMalfunctionAccountsShouldErr(t *testing.T
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 1
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = []byte("DST")
@@ -757,7 +836,7 @@ func TestTxProcessor_ProcessCheckNotPassShouldErr(t *testing.T) {
 	t.Parallel()
 
 	//these values will trigger ErrHigherNonceInTransaction
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 1
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = []byte("DST")
@@ -780,6 +859,10 @@ func TestTxProcessor_ProcessCheckNotPassShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -803,7 +886,7 @@ func TestTxProcessor_ProcessCheckShouldPassWhenAdrSrcIsNotInNodeShard(t *testing
 
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 1
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = []byte("DST")
@@ -834,6 +917,10 @@ func TestTxProcessor_ProcessCheckShouldPassWhenAdrSrcIsNotInNodeShard(t *testing
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -857,7 +944,7 @@ func TestTxProcessor_ProcessMoveBalancesShouldWork(t *testing.T) {
 		},
 	}
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 0
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = []byte("DST")
@@ -880,6 +967,10 @@ func TestTxProcessor_ProcessMoveBalancesShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -905,7 +996,7 @@ func TestTxProcessor_ProcessMoveBalancesShouldPassWhenAdrSrcIsNotInNodeShard(t *
 
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 0
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = []byte("DST")
@@ -936,6 +1027,10 @@ func TestTxProcessor_ProcessMoveBalancesShouldPassWhenAdrSrcIsNotInNodeShard(t *
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -961,7 +1056,7 @@ func TestTxProcessor_ProcessIncreaseNonceShouldPassWhenAdrSrcIsNotInNodeShard(t
 
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 0
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = []byte("DST")
@@ -992,6 +1087,10 @@ func TestTxProcessor_ProcessIncreaseNonceShouldPassWhenAdrSrcIsNotInNodeShard(t
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1015,7 +1114,7 @@ func TestTxProcessor_ProcessOkValsShouldWork(t *testing.T) {
 		},
 	}
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 4
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = []byte("DST")
@@ -1042,6 +1141,10 @@ func TestTxProcessor_ProcessOkValsShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1066,7 +1169,7 @@ func TestTxProcessor_MoveBalanceWithFeesShouldWork(t *testing.T) {
 		},
 	}
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 4
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = []byte("DST")
@@ -1105,6 +1208,10 @@ func TestTxProcessor_MoveBalanceWithFeesShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandler,
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1133,7 +1240,7 @@ func TestTxProcessor_ProcessTransactionScTxShouldWork(t *testing.T) {
 
 	addrConverter := &mock.AddressConverterMock{}
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 0
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = generateRandomByteSlice(addrConverter.AddressLen())
@@ -1173,6 +1280,10 @@ func TestTxProcessor_ProcessTransactionScTxShouldWork(t *testing.T) {
 			},
 		},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1199,7 +1310,7 @@ func TestTxProcessor_ProcessTransactionScTxShouldReturnErrWhenExecutionFails(t *
 
 	addrConverter := &mock.AddressConverterMock{}
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 0
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = generateRandomByteSlice(addrConverter.AddressLen())
@@ -1234,6 +1345,10 @@ func TestTxProcessor_ProcessTransactionScTxShouldReturnErrWhenExecutionFails(t *
 			return process.SCInvoking, nil
 		}},
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1262,7 +1377,7 @@ func TestTxProcessor_ProcessTransactionScTxShouldNotBeCalledWhenAdrDstIsNotInNod
 
 	addrConverter := &mock.AddressConverterMock{}
 
-	tx := transaction.Transaction{}
+	tx := transaction.Transaction{ChainID: chainID}
 	tx.Nonce = 0
 	tx.SndAddr = []byte("SRC")
 	tx.RcvAddr = generateRandomByteSlice(addrConverter.AddressLen())
@@ -1307,6 +1422,10 @@ func TestTxProcessor_ProcessTransactionScTxShouldNotBeCalledWhenAdrDstIsNotInNod
 		&mock.UnsignedTxHandlerMock{},
 		computeType,
 		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1315,3 +1434,926 @@ func TestTxProcessor_ProcessTransactionScTxShouldNotBeCalledWhenAdrDstIsNotInNod
 	assert.Equal(t, 3, journalizeCalled)
 	assert.Equal(t, 3, saveAccountCalled)
 }
+
+//------- relayed transactions
+
+func relayedTxFeeHandlerMock() *mock.FeeHandlerStub {
+	return &mock.FeeHandlerStub{
+		CheckValidityTxValuesCalled: func(tx process.TransactionWithFeeHandler) error {
+			return nil
+		},
+		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+			return 1
+		},
+		ComputeFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+			return big.NewInt(0).SetUint64(tx.GetGasPrice())
+		},
+	}
+}
+
+func relayedTxTrackerStub() *mock.AccountTrackerStub {
+	return &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			return nil
+		},
+	}
+}
+
+func createRelayedTx(userTx *transaction.Transaction, relayerAddr []byte, relayerNonce uint64) *transaction.Transaction {
+	userTxBuff, _ := (&mock.MarshalizerMock{}).Marshal(userTx)
+
+	return &transaction.Transaction{
+		Nonce:    relayerNonce,
+		Value:    big.NewInt(0).Set(userTx.Value),
+		SndAddr:  relayerAddr,
+		RcvAddr:  userTx.SndAddr,
+		GasPrice: userTx.GasPrice,
+		GasLimit: userTx.GasLimit + 1,
+		Data:     core.RelayedTransaction + "@" + hex.EncodeToString(userTxBuff),
+		ChainID:  userTx.ChainID,
+		Version:  userTx.Version,
+	}
+}
+
+func createAccountsStubForAccounts(accountsList ...*state.Account) *mock.AccountsStub {
+	return &mock.AccountsStub{
+		GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+			for _, acnt := range accountsList {
+				if bytes.Equal(addressContainer.Bytes(), acnt.AddressContainer().Bytes()) {
+					return acnt, nil
+				}
+			}
+
+			return nil, errors.New("failure")
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			return nil
+		},
+	}
+}
+
+func createRelayedTxProcessor(
+	accounts state.AccountsAdapter,
+	shardCoordinator sharding.Coordinator,
+	txFeeHandler process.TransactionFeeHandler,
+	scrForwarder process.IntermediateTransactionHandler,
+) process.TransactionProcessor {
+	txTypeHandler, _ := coordinator.NewTxTypeHandler(&mock.AddressConverterMock{}, shardCoordinator, accounts)
+	txProc, _ := txproc.NewTxProcessor(
+		accounts,
+		mock.HasherMock{},
+		&mock.AddressConverterMock{},
+		&mock.MarshalizerMock{},
+		shardCoordinator,
+		&mock.SCProcessorMock{},
+		txFeeHandler,
+		txTypeHandler,
+		relayedTxFeeHandlerMock(),
+		scrForwarder,
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
+	)
+
+	return txProc
+}
+
+func TestNewTxProcessor_NilScrForwarderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txProc, err := txproc.NewTxProcessor(
+		&mock.AccountsStub{},
+		mock.HasherMock{},
+		&mock.AddressConverterMock{},
+		&mock.MarshalizerMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.TxTypeHandlerMock{},
+		feeHandlerMock(),
+		nil,
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
+	)
+
+	assert.Equal(t, process.ErrNilIntermediateTransactionHandler, err)
+	assert.Nil(t, txProc)
+}
+
+func TestTxProcessor_ProcessRelayedTransactionInSameShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	relayer, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	user, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	receiver, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	relayer.Nonce = 3
+	relayer.Balance = big.NewInt(100)
+	user.Nonce = 7
+
+	userTx := &transaction.Transaction{
+		ChainID:  chainID,
+		Nonce:    7,
+		Value:    big.NewInt(10),
+		SndAddr:  user.AddressContainer().Bytes(),
+		RcvAddr:  receiver.AddressContainer().Bytes(),
+		GasPrice: 1,
+		GasLimit: 5,
+	}
+	relayedTx := createRelayedTx(userTx, relayer.AddressContainer().Bytes(), 3)
+
+	totalFees := big.NewInt(0)
+	txFeeHandler := &mock.UnsignedTxHandlerMock{
+		ProcessTransactionFeeCalled: func(cost *big.Int) {
+			totalFees.Add(totalFees, cost)
+		},
+	}
+	accounts := createAccountsStubForAccounts(relayer, user, receiver)
+	execTx := createRelayedTxProcessor(accounts, mock.NewOneShardCoordinatorMock(), txFeeHandler, &mock.IntermediateTransactionHandlerMock{})
+
+	err := execTx.ProcessTransaction(relayedTx, 4)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4), relayer.Nonce)
+	assert.Equal(t, big.NewInt(84), relayer.Balance)
+	assert.Equal(t, uint64(8), user.Nonce)
+	assert.Equal(t, big.NewInt(4), user.Balance)
+	assert.Equal(t, big.NewInt(10), receiver.Balance)
+	assert.Equal(t, big.NewInt(2), totalFees)
+}
+
+func TestTxProcessor_ProcessRelayedTransactionOnlyChargesRelayerWhenUserIsInOtherShard(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	relayer, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	relayer.Balance = big.NewInt(100)
+	userAddr := generateRandomByteSlice(addrConv.AddressLen())
+
+	userTx := &transaction.Transaction{
+		ChainID:  chainID,
+		Value:    big.NewInt(10),
+		SndAddr:  userAddr,
+		RcvAddr:  generateRandomByteSlice(addrConv.AddressLen()),
+		GasPrice: 1,
+		GasLimit: 5,
+	}
+	relayedTx := createRelayedTx(userTx, relayer.AddressContainer().Bytes(), 0)
+
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
+		if bytes.Equal(container.Bytes(), relayer.AddressContainer().Bytes()) {
+			return 0
+		}
+		return 1
+	}
+	scrForwarder := &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			assert.Fail(t, "should have not created smart contract results")
+			return nil
+		},
+	}
+	accounts := createAccountsStubForAccounts(relayer)
+	execTx := createRelayedTxProcessor(accounts, shardCoordinator, &mock.UnsignedTxHandlerMock{}, scrForwarder)
+
+	err := execTx.ProcessTransaction(relayedTx, 4)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), relayer.Nonce)
+	assert.Equal(t, big.NewInt(84), relayer.Balance)
+}
+
+func TestTxProcessor_ProcessRelayedTransactionWithCrossShardSCCallShouldCreateSCR(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	userAddr := generateRandomByteSlice(addrConv.AddressLen())
+	scAddr := make([]byte, addrConv.AddressLen())
+	copy(scAddr[core.NumInitCharactersForScAddress:], generateRandomByteSlice(addrConv.AddressLen()-core.NumInitCharactersForScAddress))
+	user, _ := state.NewAccount(mock.NewAddressMock(userAddr), relayedTxTrackerStub())
+
+	userTx := &transaction.Transaction{
+		ChainID:  chainID,
+		Value:    big.NewInt(10),
+		SndAddr:  userAddr,
+		RcvAddr:  scAddr,
+		GasPrice: 1,
+		GasLimit: 20,
+		Data:     "function@01",
+	}
+	relayedTx := createRelayedTx(userTx, generateRandomByteSlice(addrConv.AddressLen()), 0)
+
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
+		if bytes.Equal(container.Bytes(), userAddr) {
+			return 0
+		}
+		return 1
+	}
+	var createdSCRs []data.TransactionHandler
+	scrForwarder := &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			createdSCRs = append(createdSCRs, txs...)
+			return nil
+		},
+	}
+	accounts := createAccountsStubForAccounts(user)
+	execTx := createRelayedTxProcessor(accounts, shardCoordinator, &mock.UnsignedTxHandlerMock{}, scrForwarder)
+
+	err := execTx.ProcessTransaction(relayedTx, 4)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), user.Nonce)
+	assert.Equal(t, uint64(0), user.Balance.Uint64())
+	assert.Equal(t, 1, len(createdSCRs))
+	scr := createdSCRs[0].(*smartContractResult.SmartContractResult)
+	assert.Equal(t, userAddr, scr.SndAddr)
+	assert.Equal(t, scAddr, scr.RcvAddr)
+	assert.Equal(t, big.NewInt(10), scr.Value)
+	assert.Equal(t, userTx.Data, scr.Data)
+	assert.Equal(t, uint64(19), scr.GasLimit)
+}
+
+func TestTxProcessor_ProcessRelayedTransactionFailedUserTxShouldRefundRelayer(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	relayer, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	user, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	relayer.Balance = big.NewInt(100)
+	user.Nonce = 7
+
+	userTx := &transaction.Transaction{
+		ChainID:  chainID,
+		Nonce:    5,
+		Value:    big.NewInt(10),
+		SndAddr:  user.AddressContainer().Bytes(),
+		RcvAddr:  generateRandomByteSlice(addrConv.AddressLen()),
+		GasPrice: 1,
+		GasLimit: 5,
+	}
+	relayedTx := createRelayedTx(userTx, relayer.AddressContainer().Bytes(), 0)
+
+	revertCalled := false
+	accounts := createAccountsStubForAccounts(relayer, user)
+	accounts.RevertToSnapshotCalled = func(snapshot int) error {
+		revertCalled = true
+		return nil
+	}
+	execTx := createRelayedTxProcessor(accounts, mock.NewOneShardCoordinatorMock(), &mock.UnsignedTxHandlerMock{}, &mock.IntermediateTransactionHandlerMock{})
+
+	err := execTx.ProcessTransaction(relayedTx, 4)
+
+	assert.Nil(t, err)
+	assert.True(t, revertCalled)
+	assert.Equal(t, uint64(1), relayer.Nonce)
+	assert.Equal(t, big.NewInt(94), relayer.Balance)
+}
+
+func TestTxProcessor_ProcessRelayedTransactionWithOtherBeneficiaryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	relayer, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	relayer.Balance = big.NewInt(100)
+
+	userTx := &transaction.Transaction{
+		ChainID:  chainID,
+		Value:    big.NewInt(10),
+		SndAddr:  generateRandomByteSlice(addrConv.AddressLen()),
+		RcvAddr:  generateRandomByteSlice(addrConv.AddressLen()),
+		GasPrice: 1,
+		GasLimit: 5,
+	}
+	relayedTx := createRelayedTx(userTx, relayer.AddressContainer().Bytes(), 0)
+	relayedTx.RcvAddr = generateRandomByteSlice(addrConv.AddressLen())
+
+	accounts := createAccountsStubForAccounts(relayer)
+	execTx := createRelayedTxProcessor(accounts, mock.NewOneShardCoordinatorMock(), &mock.UnsignedTxHandlerMock{}, &mock.IntermediateTransactionHandlerMock{})
+
+	err := execTx.ProcessTransaction(relayedTx, 4)
+
+	assert.Equal(t, process.ErrRelayedTxBeneficiaryDoesNotMatchReceiver, err)
+	assert.Equal(t, big.NewInt(100), relayer.Balance)
+}
+
+func TestNewTxProcessor_NilSignatureSetHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txProc, err := txproc.NewTxProcessor(
+		&mock.AccountsStub{},
+		mock.HasherMock{},
+		&mock.AddressConverterMock{},
+		&mock.MarshalizerMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.TxTypeHandlerMock{},
+		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		nil,
+		chainID,
+		0,
+	)
+
+	assert.Equal(t, process.ErrNilSignatureSetHandler, err)
+	assert.Nil(t, txProc)
+}
+
+func TestNewTxProcessor_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txProc, err := txproc.NewTxProcessor(
+		&mock.AccountsStub{},
+		mock.HasherMock{},
+		&mock.AddressConverterMock{},
+		&mock.MarshalizerMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.TxTypeHandlerMock{},
+		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		nil,
+		0,
+	)
+
+	assert.Equal(t, process.ErrInvalidChainID, err)
+	assert.Nil(t, txProc)
+}
+
+func createMultiSigTxProcessor(
+	accounts state.AccountsAdapter,
+	txTypeHandler process.TxTypeHandler,
+	sigSetHandler process.SignatureSetHandler,
+) process.TransactionProcessor {
+	txProc, _ := txproc.NewTxProcessor(
+		accounts,
+		mock.HasherMock{},
+		&mock.AddressConverterMock{},
+		&mock.MarshalizerMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.UnsignedTxHandlerMock{},
+		txTypeHandler,
+		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		sigSetHandler,
+		chainID,
+		0,
+	)
+
+	return txProc
+}
+
+func TestTxProcessor_ProcessTransactionSignatureSetFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	sender, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	sender.Balance = big.NewInt(100)
+	tx := &transaction.Transaction{
+		ChainID: chainID,
+		Value:   big.NewInt(10),
+		SndAddr: sender.AddressContainer().Bytes(),
+		RcvAddr: generateRandomByteSlice(addrConv.AddressLen()),
+	}
+
+	var verifiedAccount *state.Account
+	sigSetHandler := &mock.SignatureSetHandlerStub{
+		VerifySignatureSetCalled: func(tx *transaction.Transaction, account *state.Account) error {
+			verifiedAccount = account
+			return process.ErrNotEnoughSignatures
+		},
+	}
+	execTx := createMultiSigTxProcessor(createAccountsStubForAccounts(sender), &mock.TxTypeHandlerMock{}, sigSetHandler)
+
+	err := execTx.ProcessTransaction(tx, 4)
+
+	assert.Equal(t, process.ErrNotEnoughSignatures, err)
+	assert.True(t, verifiedAccount == sender)
+	assert.Equal(t, big.NewInt(100), sender.Balance)
+}
+
+func TestTxProcessor_ProcessMultiSigRegistrationInvalidDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	sender, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	tx := &transaction.Transaction{
+		ChainID: chainID,
+		Value:   big.NewInt(0),
+		SndAddr: sender.AddressContainer().Bytes(),
+		RcvAddr: sender.AddressContainer().Bytes(),
+		Data:    core.MultiSigRegistration + "@00",
+	}
+
+	txTypeHandler := &mock.TxTypeHandlerMock{
+		ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, error) {
+			return process.MultiSigRegistration, nil
+		},
+	}
+	sigSetHandler := &mock.SignatureSetHandlerStub{
+		ParseMultiSigRegistrationCalled: func(data string) (uint32, [][]byte, error) {
+			return 0, nil, process.ErrInvalidMultiSigThreshold
+		},
+	}
+	execTx := createMultiSigTxProcessor(createAccountsStubForAccounts(sender), txTypeHandler, sigSetHandler)
+
+	err := execTx.ProcessTransaction(tx, 4)
+
+	assert.Equal(t, process.ErrInvalidMultiSigThreshold, err)
+	assert.False(t, sender.IsMultiSig())
+	assert.Equal(t, uint64(0), sender.Nonce)
+}
+
+func TestTxProcessor_ProcessMultiSigRegistrationShouldWork(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	sender, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	sender.Balance = big.NewInt(100)
+	tx := &transaction.Transaction{
+		ChainID: chainID,
+		Value:   big.NewInt(10),
+		SndAddr: sender.AddressContainer().Bytes(),
+		RcvAddr: sender.AddressContainer().Bytes(),
+		Data:    core.MultiSigRegistration + "@02@aa@bb@cc",
+	}
+
+	pubKeys := [][]byte{{0xaa}, {0xbb}, {0xcc}}
+	txTypeHandler := &mock.TxTypeHandlerMock{
+		ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, error) {
+			return process.MultiSigRegistration, nil
+		},
+	}
+	sigSetHandler := &mock.SignatureSetHandlerStub{
+		ParseMultiSigRegistrationCalled: func(data string) (uint32, [][]byte, error) {
+			return 2, pubKeys, nil
+		},
+	}
+	execTx := createMultiSigTxProcessor(createAccountsStubForAccounts(sender), txTypeHandler, sigSetHandler)
+
+	err := execTx.ProcessTransaction(tx, 4)
+
+	assert.Nil(t, err)
+	assert.True(t, sender.IsMultiSig())
+	assert.Equal(t, uint32(2), sender.MultiSigThreshold)
+	assert.Equal(t, pubKeys, sender.MultiSigPubKeys)
+	assert.Equal(t, uint64(1), sender.Nonce)
+	assert.Equal(t, big.NewInt(100), sender.Balance)
+}
+
+func TestTxProcessor_ProcessTransactionOutsideRoundWindowShouldErr(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	sender, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	receiver, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	sender.Balance = big.NewInt(100)
+	tx := &transaction.Transaction{
+		ChainID:         chainID,
+		Value:           big.NewInt(10),
+		SndAddr:         sender.AddressContainer().Bytes(),
+		RcvAddr:         receiver.AddressContainer().Bytes(),
+		ValidAfterRound: 5,
+		ValidUntilRound: 10,
+	}
+	execTx := createMultiSigTxProcessor(
+		createAccountsStubForAccounts(sender, receiver),
+		&mock.TxTypeHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+	)
+
+	err := execTx.ProcessTransaction(tx, 4)
+	assert.Equal(t, process.ErrTransactionNotYetValid, err)
+
+	err = execTx.ProcessTransaction(tx, 11)
+	assert.Equal(t, process.ErrTransactionExpired, err)
+
+	assert.Equal(t, big.NewInt(100), sender.Balance)
+	assert.Equal(t, uint64(0), sender.Nonce)
+}
+
+func TestTxProcessor_ProcessTransactionWithInvalidChainIDOrVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	sender, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	receiver, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	sender.Balance = big.NewInt(100)
+	execTx, _ := txproc.NewTxProcessor(
+		createAccountsStubForAccounts(sender, receiver),
+		mock.HasherMock{},
+		addrConv,
+		&mock.MarshalizerMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.TxTypeHandlerMock{},
+		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		2,
+	)
+
+	tx := &transaction.Transaction{
+		ChainID: []byte("other chain ID"),
+		Version: 2,
+		Value:   big.NewInt(10),
+		SndAddr: sender.AddressContainer().Bytes(),
+		RcvAddr: receiver.AddressContainer().Bytes(),
+	}
+	err := execTx.ProcessTransaction(tx, 4)
+	assert.Equal(t, process.ErrInvalidChainID, err)
+
+	tx.ChainID = chainID
+	tx.Version = 1
+	err = execTx.ProcessTransaction(tx, 4)
+	assert.Equal(t, process.ErrInvalidTransactionVersion, err)
+
+	assert.Equal(t, big.NewInt(100), sender.Balance)
+	assert.Equal(t, uint64(0), sender.Nonce)
+}
+
+func TestTxProcessor_ProcessTransactionInRoundWindowShouldWork(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	sender, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	receiver, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	sender.Balance = big.NewInt(100)
+	tx := &transaction.Transaction{
+		ChainID:         chainID,
+		Value:           big.NewInt(10),
+		SndAddr:         sender.AddressContainer().Bytes(),
+		RcvAddr:         receiver.AddressContainer().Bytes(),
+		ValidAfterRound: 5,
+		ValidUntilRound: 10,
+	}
+	txTypeHandler := &mock.TxTypeHandlerMock{
+		ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, error) {
+			return process.MoveBalance, nil
+		},
+	}
+	execTx := createMultiSigTxProcessor(createAccountsStubForAccounts(sender, receiver), txTypeHandler, &mock.SignatureSetHandlerStub{})
+
+	err := execTx.ProcessTransaction(tx, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(90), sender.Balance)
+	assert.Equal(t, big.NewInt(10), receiver.Balance)
+}
+
+func TestTxProcessor_ProcessTransactionExpiredWhenSenderIsInOtherShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	addrConv := &mock.AddressConverterMock{}
+	senderAddr := generateRandomByteSlice(addrConv.AddressLen())
+	receiver, _ := state.NewAccount(mock.NewAddressMock(generateRandomByteSlice(addrConv.AddressLen())), relayedTxTrackerStub())
+	tx := &transaction.Transaction{
+		ChainID:         chainID,
+		Value:           big.NewInt(10),
+		SndAddr:         senderAddr,
+		RcvAddr:         receiver.AddressContainer().Bytes(),
+		ValidUntilRound: 10,
+	}
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
+		if bytes.Equal(container.Bytes(), senderAddr) {
+			return 1
+		}
+		return 0
+	}
+	txTypeHandler := &mock.TxTypeHandlerMock{
+		ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, error) {
+			return process.MoveBalance, nil
+		},
+	}
+	execTx, _ := txproc.NewTxProcessor(
+		createAccountsStubForAccounts(receiver),
+		mock.HasherMock{},
+		addrConv,
+		&mock.MarshalizerMock{},
+		shardCoordinator,
+		&mock.SCProcessorMock{},
+		&mock.UnsignedTxHandlerMock{},
+		txTypeHandler,
+		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
+	)
+
+	err := execTx.ProcessTransaction(tx, 20)
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), receiver.Balance)
+}
+
+func createBuiltInFunctionTxProcessor(
+	accounts state.AccountsAdapter,
+	shardCoordinator sharding.Coordinator,
+	scrForwarder process.IntermediateTransactionHandler,
+	scProcessor process.SmartContractProcessor,
+) process.TransactionProcessor {
+	txProc, _ := txproc.NewTxProcessor(
+		accounts,
+		mock.HasherMock{},
+		&mock.AddressConverterMock{},
+		&mock.MarshalizerMock{},
+		shardCoordinator,
+		scProcessor,
+		&mock.UnsignedTxHandlerMock{},
+		&mock.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, error) {
+				return process.BuiltInFunctionCall, nil
+			},
+		},
+		feeHandlerMock(),
+		scrForwarder,
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
+	)
+
+	return txProc
+}
+
+func createESDTAccounts(tx *transaction.Transaction, srcTokens int64) (*state.Account, *state.Account, *mock.AccountsStub) {
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			return nil
+		},
+	}
+	acntSrc, _ := state.NewAccount(mock.NewAddressMock(tx.SndAddr), tracker)
+	acntDst, _ := state.NewAccount(mock.NewAddressMock(tx.RcvAddr), tracker)
+	acntSrc.Balance = big.NewInt(100)
+
+	accounts := createAccountStub(tx.SndAddr, tx.RcvAddr, acntSrc, acntDst)
+	accounts.SaveDataTrieCalled = func(acountWrapper state.AccountHandler) error {
+		return nil
+	}
+	_ = esdt.Credit(&mock.MarshalizerMock{}, accounts, acntSrc, []byte("TOKEN"), big.NewInt(srcTokens))
+
+	return acntSrc, acntDst, accounts
+}
+
+func createESDTTransferTx(value int64) *transaction.Transaction {
+	return &transaction.Transaction{
+		ChainID: chainID,
+		SndAddr: []byte("SRC"),
+		RcvAddr: []byte("DST"),
+		Value:   big.NewInt(0),
+		Data:    core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString([]byte("TOKEN")) + "@" + hex.EncodeToString(big.NewInt(value).Bytes()),
+	}
+}
+
+func TestTxProcessor_ProcessBuiltInFunctionCallSameShardShouldMoveTokens(t *testing.T) {
+	t.Parallel()
+
+	tx := createESDTTransferTx(30)
+	acntSrc, acntDst, accounts := createESDTAccounts(tx, 100)
+	execTx := createBuiltInFunctionTxProcessor(
+		accounts,
+		mock.NewOneShardCoordinatorMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SCProcessorMock{},
+	)
+
+	err := execTx.ProcessTransaction(tx, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), acntSrc.Nonce)
+
+	srcToken, _ := esdt.GetToken(&mock.MarshalizerMock{}, acntSrc, []byte("TOKEN"))
+	dstToken, _ := esdt.GetToken(&mock.MarshalizerMock{}, acntDst, []byte("TOKEN"))
+	assert.Equal(t, big.NewInt(70), srcToken.Value)
+	assert.Equal(t, big.NewInt(30), dstToken.Value)
+}
+
+func TestTxProcessor_ProcessBuiltInFunctionCallInsufficientTokensShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := createESDTTransferTx(101)
+	_, _, accounts := createESDTAccounts(tx, 100)
+	execTx := createBuiltInFunctionTxProcessor(
+		accounts,
+		mock.NewOneShardCoordinatorMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SCProcessorMock{},
+	)
+
+	err := execTx.ProcessTransaction(tx, 4)
+	assert.Equal(t, process.ErrInsufficientESDTFunds, err)
+}
+
+func TestTxProcessor_ProcessBuiltInFunctionCallFreezeFromUserShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := createESDTTransferTx(1)
+	tx.Data = core.BuiltInFunctionESDTFreeze + "@" + hex.EncodeToString([]byte("TOKEN"))
+	_, _, accounts := createESDTAccounts(tx, 100)
+	execTx := createBuiltInFunctionTxProcessor(
+		accounts,
+		mock.NewOneShardCoordinatorMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SCProcessorMock{},
+	)
+
+	err := execTx.ProcessTransaction(tx, 4)
+	assert.Equal(t, process.ErrBuiltInFunctionCallNotAllowed, err)
+}
+
+func TestTxProcessor_ProcessBuiltInFunctionCallCrossShardShouldCreateSCR(t *testing.T) {
+	t.Parallel()
+
+	tx := createESDTTransferTx(30)
+	acntSrc, _, accounts := createESDTAccounts(tx, 100)
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
+		if bytes.Equal(container.Bytes(), tx.RcvAddr) {
+			return 1
+		}
+		return 0
+	}
+
+	var forwardedSCR *smartContractResult.SmartContractResult
+	scrForwarder := &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			forwardedSCR = txs[0].(*smartContractResult.SmartContractResult)
+			return nil
+		},
+	}
+	execTx := createBuiltInFunctionTxProcessor(accounts, shardCoordinator, scrForwarder, &mock.SCProcessorMock{})
+
+	err := execTx.ProcessTransaction(tx, 4)
+	assert.Nil(t, err)
+
+	srcToken, _ := esdt.GetToken(&mock.MarshalizerMock{}, acntSrc, []byte("TOKEN"))
+	assert.Equal(t, big.NewInt(70), srcToken.Value)
+	assert.NotNil(t, forwardedSCR)
+	assert.Equal(t, tx.Data, forwardedSCR.Data)
+	assert.Equal(t, tx.RcvAddr, forwardedSCR.RcvAddr)
+	assert.Equal(t, big.NewInt(0), forwardedSCR.Value)
+}
+
+func TestTxProcessor_ProcessBuiltInFunctionCallToMetachainAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := createESDTTransferTx(30)
+	_, _, accounts := createESDTAccounts(tx, 100)
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
+		if bytes.Equal(container.Bytes(), tx.RcvAddr) {
+			return sharding.MetachainShardId
+		}
+		return 0
+	}
+	execTx := createBuiltInFunctionTxProcessor(
+		accounts,
+		shardCoordinator,
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SCProcessorMock{},
+	)
+
+	err := execTx.ProcessTransaction(tx, 4)
+	assert.Equal(t, process.ErrInvalidESDTReceiver, err)
+}
+
+func createESDTTransferToSCTx(value int64) *transaction.Transaction {
+	tx := createESDTTransferTx(value)
+	tx.RcvAddr = make([]byte, (&mock.AddressConverterMock{}).AddressLen())
+	tx.RcvAddr[len(tx.RcvAddr)-1] = 1
+	tx.Value = big.NewInt(5)
+	tx.GasPrice = 2
+	tx.GasLimit = 10
+
+	return tx
+}
+
+func TestTxProcessor_ProcessBuiltInFunctionCallToSCSameShardShouldMoveTokensAndInvokeIt(t *testing.T) {
+	t.Parallel()
+
+	tx := createESDTTransferToSCTx(30)
+	acntSrc, acntDst, accounts := createESDTAccounts(tx, 100)
+	acntDst.SetCode([]byte("code"))
+
+	var dstTokensWhenInvoked *big.Int
+	scProcessor := &mock.SCProcessorMock{
+		ExecuteSmartContractTransactionCalled: func(tx data.TransactionHandler, acntSrc, acntDst state.AccountHandler, round uint64) error {
+			dstToken, _ := esdt.GetToken(&mock.MarshalizerMock{}, acntDst, []byte("TOKEN"))
+			dstTokensWhenInvoked = dstToken.Value
+			return nil
+		},
+	}
+	execTx := createBuiltInFunctionTxProcessor(
+		accounts,
+		mock.NewOneShardCoordinatorMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		scProcessor,
+	)
+
+	err := execTx.ProcessTransaction(tx, 4)
+	assert.Nil(t, err)
+
+	srcToken, _ := esdt.GetToken(&mock.MarshalizerMock{}, acntSrc, []byte("TOKEN"))
+	assert.Equal(t, big.NewInt(70), srcToken.Value)
+	assert.Equal(t, big.NewInt(30), dstTokensWhenInvoked)
+}
+
+func TestTxProcessor_ProcessBuiltInFunctionCallToSCCrossShardShouldDebitAndCreateSCRWithTheCall(t *testing.T) {
+	t.Parallel()
+
+	tx := createESDTTransferToSCTx(30)
+	acntSrc, _, accounts := createESDTAccounts(tx, 100)
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
+		if bytes.Equal(container.Bytes(), tx.RcvAddr) {
+			return 1
+		}
+		return 0
+	}
+
+	var forwardedSCR *smartContractResult.SmartContractResult
+	scrForwarder := &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			forwardedSCR = txs[0].(*smartContractResult.SmartContractResult)
+			return nil
+		},
+	}
+	txProc, _ := txproc.NewTxProcessor(
+		accounts,
+		mock.HasherMock{},
+		&mock.AddressConverterMock{},
+		&mock.MarshalizerMock{},
+		shardCoordinator,
+		&mock.SCProcessorMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, error) {
+				return process.BuiltInFunctionCall, nil
+			},
+		},
+		&mock.FeeHandlerStub{
+			ComputeFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+				return big.NewInt(8)
+			},
+			ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+				return 4
+			},
+		},
+		scrForwarder,
+		&mock.SignatureSetHandlerStub{},
+		chainID,
+		0,
+	)
+
+	err := txProc.ProcessTransaction(tx, 4)
+	assert.Nil(t, err)
+
+	srcToken, _ := esdt.GetToken(&mock.MarshalizerMock{}, acntSrc, []byte("TOKEN"))
+	assert.Equal(t, big.NewInt(70), srcToken.Value)
+	assert.Equal(t, uint64(1), acntSrc.Nonce)
+	// fee 8, value 5 and 6 gas forwarded at price 2
+	assert.Equal(t, big.NewInt(100-8-5-12), acntSrc.Balance)
+	assert.NotNil(t, forwardedSCR)
+	assert.Equal(t, tx.Data, forwardedSCR.Data)
+	assert.Equal(t, tx.Value, forwardedSCR.Value)
+	assert.Equal(t, uint64(6), forwardedSCR.GasLimit)
+}
+
+func TestTxProcessor_ProcessBuiltInFunctionCallToSCInDestinationShardShouldDoNothing(t *testing.T) {
+	t.Parallel()
+
+	tx := createESDTTransferToSCTx(30)
+	_, acntDst, accounts := createESDTAccounts(tx, 100)
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
+		if bytes.Equal(container.Bytes(), tx.SndAddr) {
+			return 1
+		}
+		return 0
+	}
+	execTx := createBuiltInFunctionTxProcessor(
+		accounts,
+		shardCoordinator,
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.SCProcessorMock{
+			ExecuteSmartContractTransactionCalled: func(tx data.TransactionHandler, acntSrc, acntDst state.AccountHandler, round uint64) error {
+				assert.Fail(t, "the contract should be invoked by the smart contract result")
+				return nil
+			},
+		},
+	)
+
+	err := execTx.ProcessTransaction(tx, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), acntDst.Balance)
+}
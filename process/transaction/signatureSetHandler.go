@@ -0,0 +1,176 @@
+package transaction
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const multiSigRegistrationPrefix = core.MultiSigRegistration + "@"
+
+// maxMultiSigPubKeys bounds the number of signatures verified for a single transaction
+const maxMultiSigPubKeys = 32
+
+// signatureSetHandler decodes the multisignature registrations and verifies the signature sets of the transactions
+// sent from multisignature accounts
+type signatureSetHandler struct {
+	marshalizer  marshal.Marshalizer
+	keyGen       crypto.KeyGenerator
+	singleSigner crypto.SingleSigner
+}
+
+// NewSignatureSetHandler creates a new signature set handler
+func NewSignatureSetHandler(
+	marshalizer marshal.Marshalizer,
+	keyGen crypto.KeyGenerator,
+	singleSigner crypto.SingleSigner,
+) (*signatureSetHandler, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(keyGen) {
+		return nil, process.ErrNilKeyGen
+	}
+	if check.IfNil(singleSigner) {
+		return nil, process.ErrNilSingleSigner
+	}
+
+	return &signatureSetHandler{
+		marshalizer:  marshalizer,
+		keyGen:       keyGen,
+		singleSigner: singleSigner,
+	}, nil
+}
+
+// VerifySignatureSet checks the signature set of a transaction sent from a multisignature account against the
+// account's public keys and threshold: at least the threshold number of registered keys must have signed. The set is
+// the only authorization of these transactions. The transactions of the other accounts may not carry a signature set
+func (ssh *signatureSetHandler) VerifySignatureSet(tx *transaction.Transaction, account *state.Account) error {
+	if tx == nil {
+		return process.ErrNilTransaction
+	}
+	if account == nil {
+		// the sender is not in this shard, so its signatures were already checked by the sender's shard
+		return nil
+	}
+	if !account.IsMultiSig() {
+		if len(tx.Signatures) > 0 {
+			return process.ErrSignatureSetNotAllowed
+		}
+		return nil
+	}
+
+	if len(tx.Signatures) == 0 {
+		return process.ErrMissingSignatureSet
+	}
+	if len(tx.Signatures) != len(account.MultiSigPubKeys) {
+		return process.ErrInvalidSignatureSetLength
+	}
+
+	message, err := signingBytes(ssh.marshalizer, tx)
+	if err != nil {
+		return err
+	}
+
+	numSignatures := uint32(0)
+	for i, signature := range tx.Signatures {
+		if len(signature) == 0 {
+			continue
+		}
+
+		pubKey, err := ssh.keyGen.PublicKeyFromByteArray(account.MultiSigPubKeys[i])
+		if err != nil {
+			return err
+		}
+
+		err = ssh.singleSigner.Verify(pubKey, message, signature)
+		if err != nil {
+			return err
+		}
+
+		numSignatures++
+	}
+
+	if numSignatures < account.MultiSigThreshold {
+		return process.ErrNotEnoughSignatures
+	}
+
+	return nil
+}
+
+// ParseMultiSigRegistration decodes the threshold and the public keys of a multisignature registration transaction
+func (ssh *signatureSetHandler) ParseMultiSigRegistration(data string) (uint32, [][]byte, error) {
+	if !strings.HasPrefix(data, multiSigRegistrationPrefix) {
+		return 0, nil, process.ErrInvalidMultiSigRegistration
+	}
+
+	tokens := strings.Split(data[len(multiSigRegistrationPrefix):], "@")
+	if len(tokens) < 2 {
+		return 0, nil, process.ErrInvalidMultiSigRegistration
+	}
+
+	thresholdBytes, err := hex.DecodeString(tokens[0])
+	if err != nil {
+		return 0, nil, process.ErrInvalidMultiSigRegistration
+	}
+
+	pubKeys := make([][]byte, 0, len(tokens)-1)
+	for _, token := range tokens[1:] {
+		pubKey, err := hex.DecodeString(token)
+		if err != nil {
+			return 0, nil, process.ErrInvalidMultiSigRegistration
+		}
+
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	threshold := big.NewInt(0).SetBytes(thresholdBytes)
+	if threshold.Sign() == 0 || threshold.Cmp(big.NewInt(int64(len(pubKeys)))) > 0 {
+		return 0, nil, process.ErrInvalidMultiSigThreshold
+	}
+	if len(pubKeys) > maxMultiSigPubKeys {
+		return 0, nil, process.ErrTooManyMultiSigPubKeys
+	}
+
+	for i, pubKey := range pubKeys {
+		_, err = ssh.keyGen.PublicKeyFromByteArray(pubKey)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		for _, otherPubKey := range pubKeys[:i] {
+			if bytes.Equal(pubKey, otherPubKey) {
+				return 0, nil, process.ErrDuplicatedMultiSigPubKey
+			}
+		}
+	}
+
+	return uint32(threshold.Uint64()), pubKeys, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ssh *signatureSetHandler) IsInterfaceNil() bool {
+	if ssh == nil {
+		return true
+	}
+	return false
+}
+
+// signingBytes returns the message signed by the sender of a transaction: the transaction without its signature or
+// signature set
+func signingBytes(marshalizer marshal.Marshalizer, tx *transaction.Transaction) ([]byte, error) {
+	copiedTx := *tx
+	copiedTx.Signature = nil
+	copiedTx.Signatures = nil
+
+	return marshalizer.Marshal(&copiedTx)
+}
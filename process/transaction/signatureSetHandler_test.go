@@ -0,0 +1,331 @@
+package transaction_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	dataTransaction "github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+var multiSigPubKeys = [][]byte{[]byte("pk1"), []byte("pk2"), []byte("pk3")}
+
+// createSignatureSetSigner accepts a signature only if it is the public key followed by "sig"
+func createSignatureSetSigner() crypto.SingleSigner {
+	return &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			pubKey, _ := public.ToByteArray()
+			if !bytes.Equal(sig, append(pubKey, []byte("sig")...)) {
+				return errSignerMockVerifySigFails
+			}
+			return nil
+		},
+	}
+}
+
+func createSignatureSetKeyGen() crypto.KeyGenerator {
+	return &mock.SingleSignKeyGenMock{
+		PublicKeyFromByteArrayCalled: func(b []byte) (crypto.PublicKey, error) {
+			if len(b) == 0 {
+				return nil, errSingleSignKeyGenMock
+			}
+
+			return &mock.SingleSignPublicKey{
+				ToByteArrayCalled: func() ([]byte, error) {
+					return b, nil
+				},
+			}, nil
+		},
+	}
+}
+
+func createSignatureSetHandler() process.SignatureSetHandler {
+	ssh, _ := transaction.NewSignatureSetHandler(
+		&mock.MarshalizerMock{},
+		createSignatureSetKeyGen(),
+		createSignatureSetSigner(),
+	)
+
+	return ssh
+}
+
+func createMultiSigAccount(threshold uint32) *state.Account {
+	account, _ := state.NewAccount(mock.NewAddressMock(senderAddress), &mock.AccountTrackerStub{})
+	account.MultiSigThreshold = threshold
+	account.MultiSigPubKeys = multiSigPubKeys
+
+	return account
+}
+
+func createMultiSigTx(signatures [][]byte) *dataTransaction.Transaction {
+	return &dataTransaction.Transaction{
+		Nonce:      1,
+		Value:      big.NewInt(2),
+		RcvAddr:    recvAddress,
+		SndAddr:    senderAddress,
+		ChainID:    testChainID,
+		Version:    testMinTxVersion,
+		Signatures: signatures,
+	}
+}
+
+func createMultiSigRegistrationData(threshold byte, pubKeys ...[]byte) string {
+	data := core.MultiSigRegistration + "@" + hex.EncodeToString([]byte{threshold})
+	for _, pubKey := range pubKeys {
+		data += "@" + hex.EncodeToString(pubKey)
+	}
+
+	return data
+}
+
+//------- NewSignatureSetHandler
+
+func TestNewSignatureSetHandler_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssh, err := transaction.NewSignatureSetHandler(nil, createSignatureSetKeyGen(), createSignatureSetSigner())
+
+	assert.True(t, check.IfNil(ssh))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewSignatureSetHandler_NilKeyGenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssh, err := transaction.NewSignatureSetHandler(&mock.MarshalizerMock{}, nil, createSignatureSetSigner())
+
+	assert.True(t, check.IfNil(ssh))
+	assert.Equal(t, process.ErrNilKeyGen, err)
+}
+
+func TestNewSignatureSetHandler_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssh, err := transaction.NewSignatureSetHandler(&mock.MarshalizerMock{}, createSignatureSetKeyGen(), nil)
+
+	assert.True(t, check.IfNil(ssh))
+	assert.Equal(t, process.ErrNilSingleSigner, err)
+}
+
+func TestNewSignatureSetHandler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ssh, err := transaction.NewSignatureSetHandler(
+		&mock.MarshalizerMock{},
+		createSignatureSetKeyGen(),
+		createSignatureSetSigner(),
+	)
+
+	assert.False(t, check.IfNil(ssh))
+	assert.Nil(t, err)
+}
+
+//------- VerifySignatureSet
+
+func TestSignatureSetHandler_VerifySignatureSetNotMultiSigAccountShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ssh := createSignatureSetHandler()
+	err := ssh.VerifySignatureSet(createMultiSigTx(nil), createMultiSigAccount(0))
+
+	assert.Nil(t, err)
+}
+
+func TestSignatureSetHandler_VerifySignatureSetNotMultiSigAccountWithSignatureSetShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssh := createSignatureSetHandler()
+	tx := createMultiSigTx([][]byte{[]byte("pk1sig"), nil, nil})
+	err := ssh.VerifySignatureSet(tx, createMultiSigAccount(0))
+
+	assert.Equal(t, process.ErrSignatureSetNotAllowed, err)
+}
+
+func TestSignatureSetHandler_VerifySignatureSetSenderInOtherShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ssh := createSignatureSetHandler()
+	tx := createMultiSigTx([][]byte{[]byte("pk1sig"), nil, nil})
+	err := ssh.VerifySignatureSet(tx, nil)
+
+	assert.Nil(t, err)
+}
+
+func TestSignatureSetHandler_VerifySignatureSetMissingSignatureSetShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssh := createSignatureSetHandler()
+	tx := createMultiSigTx(nil)
+	tx.Signature = []byte("pk1sig")
+	err := ssh.VerifySignatureSet(tx, createMultiSigAccount(2))
+
+	assert.Equal(t, process.ErrMissingSignatureSet, err)
+}
+
+func TestSignatureSetHandler_VerifySignatureSetWrongLengthShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssh := createSignatureSetHandler()
+	tx := createMultiSigTx([][]byte{[]byte("pk1sig"), []byte("pk2sig")})
+	err := ssh.VerifySignatureSet(tx, createMultiSigAccount(2))
+
+	assert.Equal(t, process.ErrInvalidSignatureSetLength, err)
+}
+
+func TestSignatureSetHandler_VerifySignatureSetInvalidSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssh := createSignatureSetHandler()
+	tx := createMultiSigTx([][]byte{[]byte("pk1sig"), []byte("pk1sig"), nil})
+	err := ssh.VerifySignatureSet(tx, createMultiSigAccount(2))
+
+	assert.Equal(t, errSignerMockVerifySigFails, err)
+}
+
+func TestSignatureSetHandler_VerifySignatureSetNotEnoughSignaturesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssh := createSignatureSetHandler()
+	tx := createMultiSigTx([][]byte{nil, []byte("pk2sig"), nil})
+	err := ssh.VerifySignatureSet(tx, createMultiSigAccount(2))
+
+	assert.Equal(t, process.ErrNotEnoughSignatures, err)
+}
+
+func TestSignatureSetHandler_VerifySignatureSetShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ssh := createSignatureSetHandler()
+	tx := createMultiSigTx([][]byte{[]byte("pk1sig"), nil, []byte("pk3sig")})
+	err := ssh.VerifySignatureSet(tx, createMultiSigAccount(2))
+
+	assert.Nil(t, err)
+}
+
+func TestSignatureSetHandler_VerifySignatureSetMoreSignaturesThanThresholdShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ssh := createSignatureSetHandler()
+	tx := createMultiSigTx([][]byte{[]byte("pk1sig"), []byte("pk2sig"), []byte("pk3sig")})
+	err := ssh.VerifySignatureSet(tx, createMultiSigAccount(2))
+
+	assert.Nil(t, err)
+}
+
+func TestSignatureSetHandler_VerifySignatureSetSignsTxWithoutSignatures(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tx := createMultiSigTx([][]byte{[]byte("pk1sig"), nil, nil})
+	copiedTx := *tx
+	copiedTx.Signatures = nil
+	expectedMessage, _ := marshalizer.Marshal(&copiedTx)
+
+	ssh, _ := transaction.NewSignatureSetHandler(
+		marshalizer,
+		createSignatureSetKeyGen(),
+		&mock.SignerMock{
+			VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				if !bytes.Equal(msg, expectedMessage) {
+					return errors.New("wrong message")
+				}
+				return nil
+			},
+		},
+	)
+	err := ssh.VerifySignatureSet(tx, createMultiSigAccount(1))
+
+	assert.Nil(t, err)
+}
+
+//------- ParseMultiSigRegistration
+
+func TestSignatureSetHandler_ParseMultiSigRegistrationWrongPrefixShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssh := createSignatureSetHandler()
+	_, _, err := ssh.ParseMultiSigRegistration("register@01@aa")
+
+	assert.Equal(t, process.ErrInvalidMultiSigRegistration, err)
+}
+
+func TestSignatureSetHandler_ParseMultiSigRegistrationNoPubKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssh := createSignatureSetHandler()
+	_, _, err := ssh.ParseMultiSigRegistration(createMultiSigRegistrationData(1))
+
+	assert.Equal(t, process.ErrInvalidMultiSigRegistration, err)
+}
+
+func TestSignatureSetHandler_ParseMultiSigRegistrationInvalidHexShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssh := createSignatureSetHandler()
+	_, _, err := ssh.ParseMultiSigRegistration(createMultiSigRegistrationData(1) + "@zz")
+
+	assert.Equal(t, process.ErrInvalidMultiSigRegistration, err)
+}
+
+func TestSignatureSetHandler_ParseMultiSigRegistrationZeroThresholdShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssh := createSignatureSetHandler()
+	_, _, err := ssh.ParseMultiSigRegistration(createMultiSigRegistrationData(0, multiSigPubKeys...))
+
+	assert.Equal(t, process.ErrInvalidMultiSigThreshold, err)
+}
+
+func TestSignatureSetHandler_ParseMultiSigRegistrationThresholdTooHighShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssh := createSignatureSetHandler()
+	_, _, err := ssh.ParseMultiSigRegistration(createMultiSigRegistrationData(4, multiSigPubKeys...))
+
+	assert.Equal(t, process.ErrInvalidMultiSigThreshold, err)
+}
+
+func TestSignatureSetHandler_ParseMultiSigRegistrationDuplicatedPubKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssh := createSignatureSetHandler()
+	data := createMultiSigRegistrationData(2, []byte("pk1"), []byte("pk2"), []byte("pk1"))
+	_, _, err := ssh.ParseMultiSigRegistration(data)
+
+	assert.Equal(t, process.ErrDuplicatedMultiSigPubKey, err)
+}
+
+func TestSignatureSetHandler_ParseMultiSigRegistrationTooManyPubKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pubKeys := make([][]byte, 0)
+	for i := 0; i < 33; i++ {
+		pubKeys = append(pubKeys, []byte{byte(i + 1)})
+	}
+
+	ssh := createSignatureSetHandler()
+	_, _, err := ssh.ParseMultiSigRegistration(createMultiSigRegistrationData(2, pubKeys...))
+
+	assert.Equal(t, process.ErrTooManyMultiSigPubKeys, err)
+}
+
+func TestSignatureSetHandler_ParseMultiSigRegistrationShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ssh := createSignatureSetHandler()
+	threshold, pubKeys, err := ssh.ParseMultiSigRegistration(createMultiSigRegistrationData(2, multiSigPubKeys...))
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), threshold)
+	assert.Equal(t, multiSigPubKeys, pubKeys)
+}
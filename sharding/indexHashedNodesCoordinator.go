@@ -5,6 +5,8 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math/big"
+	"strconv"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -17,6 +19,7 @@ type indexHashedNodesCoordinator struct {
 	nodesMap                map[uint32][]Validator
 	shardConsensusGroupSize int
 	metaConsensusGroupSize  int
+	mutConsensusGroupSize   sync.RWMutex
 	selfPubKey              []byte
 }
 
@@ -72,13 +75,14 @@ func (ihgs *indexHashedNodesCoordinator) SetNodesPerShards(nodes map[uint32][]Va
 	}
 
 	nodesList, ok := nodes[MetachainShardId]
-	if ok && len(nodesList) < ihgs.metaConsensusGroupSize {
+	if ok && len(nodesList) < ihgs.consensusGroupSize(MetachainShardId) {
 		return ErrSmallMetachainEligibleListSize
 	}
 
+	shardConsensusGroupSize := ihgs.consensusGroupSize(0)
 	for shardId := uint32(0); shardId < ihgs.nbShards; shardId++ {
 		nbNodesShard := len(nodes[shardId])
-		if nbNodesShard < ihgs.shardConsensusGroupSize {
+		if nbNodesShard < shardConsensusGroupSize {
 			return ErrSmallShardEligibleListSize
 		}
 	}
@@ -313,6 +317,9 @@ func (ihgs *indexHashedNodesCoordinator) validatorIsInList(v Validator, list []V
 }
 
 func (ihgs *indexHashedNodesCoordinator) consensusGroupSize(shardId uint32) int {
+	ihgs.mutConsensusGroupSize.RLock()
+	defer ihgs.mutConsensusGroupSize.RUnlock()
+
 	if shardId == MetachainShardId {
 		return ihgs.metaConsensusGroupSize
 	}
@@ -320,6 +327,49 @@ func (ihgs *indexHashedNodesCoordinator) consensusGroupSize(shardId uint32) int
 	return ihgs.shardConsensusGroupSize
 }
 
+// ParameterValue returns the value of one of the consensus parameters which can be changed by governance
+func (ihgs *indexHashedNodesCoordinator) ParameterValue(name string) (string, error) {
+	switch name {
+	case "ShardConsensusGroupSize":
+		return strconv.Itoa(ihgs.consensusGroupSize(0)), nil
+	case "MetaConsensusGroupSize":
+		return strconv.Itoa(ihgs.consensusGroupSize(MetachainShardId)), nil
+	}
+
+	return "", ErrUnknownParameter
+}
+
+// UpdateParameter changes the value of one of the consensus parameters which can be changed by governance. A
+// consensus group can not be larger than the eligible list it is selected from
+func (ihgs *indexHashedNodesCoordinator) UpdateParameter(name string, value string) error {
+	size, err := strconv.Atoi(value)
+	if err != nil || size < 1 {
+		return ErrInvalidConsensusGroupSize
+	}
+
+	ihgs.mutConsensusGroupSize.Lock()
+	defer ihgs.mutConsensusGroupSize.Unlock()
+
+	switch name {
+	case "ShardConsensusGroupSize":
+		for shardId := uint32(0); shardId < ihgs.nbShards; shardId++ {
+			if len(ihgs.nodesMap[shardId]) < size {
+				return ErrSmallShardEligibleListSize
+			}
+		}
+		ihgs.shardConsensusGroupSize = size
+	case "MetaConsensusGroupSize":
+		if len(ihgs.nodesMap[MetachainShardId]) < size {
+			return ErrSmallMetachainEligibleListSize
+		}
+		ihgs.metaConsensusGroupSize = size
+	default:
+		return ErrUnknownParameter
+	}
+
+	return nil
+}
+
 // GetOwnPublicKey will return current node public key  for block sign
 func (ihgs *indexHashedNodesCoordinator) GetOwnPublicKey() []byte {
 	return ihgs.selfPubKey
@@ -678,3 +678,73 @@ func TestIndexHashedGroupSelector_GetAllValidatorsPublicKeys(t *testing.T) {
 	allValidatorsPublicKeys := ihgs.GetAllValidatorsPublicKeys()
 	assert.Equal(t, expectedValidatorsPubKeys, allValidatorsPublicKeys)
 }
+
+func createArgumentsForParametersUpdate() sharding.ArgNodesCoordinator {
+	return sharding.ArgNodesCoordinator{
+		ShardConsensusGroupSize: 1,
+		MetaConsensusGroupSize:  1,
+		Hasher:                  &mock.HasherMock{},
+		NbShards:                1,
+		Nodes:                   createDummyNodesMap(),
+		SelfPublicKey:           []byte("key"),
+	}
+}
+
+func TestIndexHashedGroupSelector_ParameterValue(t *testing.T) {
+	t.Parallel()
+
+	arguments := createArgumentsForParametersUpdate()
+	arguments.MetaConsensusGroupSize = 2
+	ihgs, _ := sharding.NewIndexHashedNodesCoordinator(arguments)
+
+	value, err := ihgs.ParameterValue("ShardConsensusGroupSize")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", value)
+
+	value, err = ihgs.ParameterValue("MetaConsensusGroupSize")
+	assert.Nil(t, err)
+	assert.Equal(t, "2", value)
+
+	_, err = ihgs.ParameterValue("Unknown")
+	assert.Equal(t, sharding.ErrUnknownParameter, err)
+}
+
+func TestIndexHashedGroupSelector_UpdateParameterInvalidValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ihgs, _ := sharding.NewIndexHashedNodesCoordinator(createArgumentsForParametersUpdate())
+
+	err := ihgs.UpdateParameter("ShardConsensusGroupSize", "0")
+	assert.Equal(t, sharding.ErrInvalidConsensusGroupSize, err)
+
+	err = ihgs.UpdateParameter("ShardConsensusGroupSize", "two")
+	assert.Equal(t, sharding.ErrInvalidConsensusGroupSize, err)
+
+	err = ihgs.UpdateParameter("ShardConsensusGroupSize", "3")
+	assert.Equal(t, sharding.ErrSmallShardEligibleListSize, err)
+
+	err = ihgs.UpdateParameter("MetaConsensusGroupSize", "3")
+	assert.Equal(t, sharding.ErrSmallMetachainEligibleListSize, err)
+
+	err = ihgs.UpdateParameter("Unknown", "1")
+	assert.Equal(t, sharding.ErrUnknownParameter, err)
+}
+
+func TestIndexHashedGroupSelector_UpdateParameterShouldChangeTheConsensusGroupSize(t *testing.T) {
+	t.Parallel()
+
+	ihgs, _ := sharding.NewIndexHashedNodesCoordinator(createArgumentsForParametersUpdate())
+
+	err := ihgs.UpdateParameter("ShardConsensusGroupSize", "2")
+	assert.Nil(t, err)
+	err = ihgs.UpdateParameter("MetaConsensusGroupSize", "2")
+	assert.Nil(t, err)
+
+	shardGroup, err := ihgs.ComputeValidatorsGroup([]byte("randomness"), 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(shardGroup))
+
+	metaGroup, err := ihgs.ComputeValidatorsGroup([]byte("randomness"), 0, sharding.MetachainShardId)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(metaGroup))
+}
@@ -0,0 +1,13 @@
+package presenter
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// GetStorageCacheHits will return the number of cache hits summed over all the storage units
+func (psh *PresenterStatusHandler) GetStorageCacheHits() uint64 {
+	return psh.getFromCacheAsUint64(core.MetricStorageCacheHits)
+}
+
+// GetStorageCacheMisses will return the number of cache misses summed over all the storage units
+func (psh *PresenterStatusHandler) GetStorageCacheMisses() uint64 {
+	return psh.getFromCacheAsUint64(core.MetricStorageCacheMisses)
+}
@@ -0,0 +1,30 @@
+package presenter
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresenterStatusHandler_GetStorageCacheHits(t *testing.T) {
+	t.Parallel()
+
+	cacheHits := uint64(1000)
+	presenterStatusHandler := NewPresenterStatusHandler()
+	presenterStatusHandler.SetUInt64Value(core.MetricStorageCacheHits, cacheHits)
+	result := presenterStatusHandler.GetStorageCacheHits()
+
+	assert.Equal(t, cacheHits, result)
+}
+
+func TestPresenterStatusHandler_GetStorageCacheMisses(t *testing.T) {
+	t.Parallel()
+
+	cacheMisses := uint64(100)
+	presenterStatusHandler := NewPresenterStatusHandler()
+	presenterStatusHandler.SetUInt64Value(core.MetricStorageCacheMisses, cacheMisses)
+	result := presenterStatusHandler.GetStorageCacheMisses()
+
+	assert.Equal(t, cacheMisses, result)
+}
@@ -1,12 +1,30 @@
 package statusHandler
 
 import (
+	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/logger"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+var log = logger.GetOrCreate("statusHandler")
+
+// storageMetricsHelp holds, for each per storage unit metric suffix, the help text of the metric. The storage
+// unit name is filled in place of the %s
+var storageMetricsHelp = map[string]string{
+	core.MetricStorageCacheHitsSuffix:             "The number of cache hits of the %s storage unit",
+	core.MetricStorageCacheMissesSuffix:           "The number of cache misses of the %s storage unit",
+	core.MetricStorageBloomNegativesSuffix:        "The number of lookups of the %s storage unit stopped by the bloom filter",
+	core.MetricStorageBloomFalsePositivesSuffix:   "The number of lookups of the %s storage unit missed by the persister after the bloom filter",
+	core.MetricStoragePersisterReadsSuffix:        "The number of persister reads of the %s storage unit",
+	core.MetricStoragePersisterWritesSuffix:       "The number of persister writes of the %s storage unit",
+	core.MetricStoragePersisterReadLatencySuffix:  "The average persister read latency of the %s storage unit, in microseconds",
+	core.MetricStoragePersisterWriteLatencySuffix: "The average persister write latency of the %s storage unit, in microseconds",
+}
+
 // PrometheusStatusHandler will define the handler which will update prometheus metrics
 type PrometheusStatusHandler struct {
 	prometheusGaugeMetrics sync.Map
@@ -36,6 +54,8 @@ func (psh *PrometheusStatusHandler) InitMetrics() {
 	psh.addMetric(core.MetricNumConnectedPeers, "The current number of peers connected")
 	psh.addMetric(core.MetricIsSyncing, "The synchronization state. If it's in process of syncing will be 1"+
 		" and if it's synchronized will be 0")
+	psh.addMetric(core.MetricStorageCacheHits, "The number of cache hits summed over all the storage units")
+	psh.addMetric(core.MetricStorageCacheMisses, "The number of cache misses summed over all the storage units")
 
 	psh.prometheusGaugeMetrics.Range(func(key, value interface{}) bool {
 		gauge := value.(prometheus.Gauge)
@@ -89,11 +109,73 @@ func (psh *PrometheusStatusHandler) SetInt64Value(key string, value int64) {
 
 // SetUInt64Value method - will update the value for a key
 func (psh *PrometheusStatusHandler) SetUInt64Value(key string, value uint64) {
-	if metric, ok := psh.prometheusGaugeMetrics.Load(key); ok {
+	if metric, ok := psh.loadOrCreateStorageMetric(key); ok {
 		metric.(prometheus.Gauge).Set(float64(value))
 	}
 }
 
+// loadOrCreateStorageMetric returns the gauge for the provided key. The per storage unit metrics can not be
+// declared in InitMetrics as the storage units are known only after the node's components are created,
+// so their gauges are created and registered the first time a value is received
+func (psh *PrometheusStatusHandler) loadOrCreateStorageMetric(key string) (interface{}, bool) {
+	metric, ok := psh.prometheusGaugeMetrics.Load(key)
+	if ok || !strings.HasPrefix(key, core.MetricStoragePrefix) {
+		return metric, ok
+	}
+
+	help, ok := storageMetricHelp(key)
+	if !ok {
+		return nil, false
+	}
+
+	var gauge prometheus.Gauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: key,
+		Help: help,
+	})
+	err := prometheus.Register(gauge)
+	if err != nil {
+		gauge, ok = registeredGauge(err)
+		if !ok {
+			log.Warn("storage metric could not be registered", "metric", key, "error", err.Error())
+			return nil, false
+		}
+	}
+
+	metric, _ = psh.prometheusGaugeMetrics.LoadOrStore(key, gauge)
+
+	return metric, true
+}
+
+// storageMetricHelp returns the help text of the per storage unit metric having the provided key
+func storageMetricHelp(key string) (string, bool) {
+	for suffix, help := range storageMetricsHelp {
+		if !strings.HasSuffix(key, suffix) {
+			continue
+		}
+
+		unitName := strings.TrimSuffix(strings.TrimPrefix(key, core.MetricStoragePrefix), suffix)
+		if len(unitName) == 0 {
+			return "", false
+		}
+
+		return fmt.Sprintf(help, unitName), true
+	}
+
+	return "", false
+}
+
+// registeredGauge returns the gauge already registered under the same name, if this is why the registration failed
+func registeredGauge(err error) (prometheus.Gauge, bool) {
+	alreadyRegistered, ok := err.(prometheus.AlreadyRegisteredError)
+	if !ok {
+		return nil, false
+	}
+
+	gauge, ok := alreadyRegistered.ExistingCollector.(prometheus.Gauge)
+
+	return gauge, ok
+}
+
 // SetStringValue method - will update the value for a key
 func (psh *PrometheusStatusHandler) SetStringValue(key string, value string) {
 }
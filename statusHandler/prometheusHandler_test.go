@@ -104,6 +104,44 @@ func TestPrometheusStatusHandler_TestSetUInt64Value(t *testing.T) {
 	assert.Equal(t, float64(20), result)
 }
 
+func TestPrometheusStatusHandler_SetUInt64ValueShouldCreateStorageMetric(t *testing.T) {
+	t.Parallel()
+
+	metricKey := core.MetricStoragePrefix + "test_unit" + core.MetricStorageCacheHitsSuffix
+
+	promStatusHandler := statusHandler.NewPrometheusStatusHandler()
+	promStatusHandler.SetUInt64Value(metricKey, uint64(7))
+
+	gauge, err := promStatusHandler.GetPrometheusMetricByKey(metricKey)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(7), prometheusUtils.ToFloat64(gauge))
+	assert.Contains(t, gauge.Desc().String(), "The number of cache hits of the test_unit storage unit")
+}
+
+func TestPrometheusStatusHandler_SetUInt64ValueShouldNotCreateUnknownStorageMetric(t *testing.T) {
+	t.Parallel()
+
+	metricKey := core.MetricStoragePrefix + "test_unit_unknown_counter"
+
+	promStatusHandler := statusHandler.NewPrometheusStatusHandler()
+	promStatusHandler.SetUInt64Value(metricKey, uint64(7))
+
+	_, err := promStatusHandler.GetPrometheusMetricByKey(metricKey)
+	assert.NotNil(t, err)
+}
+
+func TestPrometheusStatusHandler_SetUInt64ValueShouldNotCreateUnknownMetric(t *testing.T) {
+	t.Parallel()
+
+	metricKey := "erd_unknown_metric"
+
+	promStatusHandler := statusHandler.NewPrometheusStatusHandler()
+	promStatusHandler.SetUInt64Value(metricKey, uint64(7))
+
+	_, err := promStatusHandler.GetPrometheusMetricByKey(metricKey)
+	assert.NotNil(t, err)
+}
+
 func BenchmarkPrometheusStatusHandler_Increment(b *testing.B) {
 	var promStatusHandler core.AppStatusHandler
 	promStatusHandler = statusHandler.NewPrometheusStatusHandler()
@@ -48,6 +48,8 @@ type Presenter interface {
 	GetNumShardHeadersProcessed() uint64
 	GetHighestFinalBlockInShard() uint64
 	CheckSoftwareVersion() (bool, string)
+	GetStorageCacheHits() uint64
+	GetStorageCacheMisses() uint64
 
 	GetTotalRewardsValue() (string, string)
 	CalculateRewardsPerHour() string
@@ -250,8 +250,8 @@ func (wr *WidgetsRender) prepareChainInfo() {
 }
 
 func (wr *WidgetsRender) prepareBlockInfo() {
-	//7 rows and one column
-	numRows := 8
+	//9 rows and one column
+	numRows := 9
 	rows := make([][]string, numRows)
 
 	currentBlockHeight := wr.presenter.GetNonce()
@@ -296,6 +296,14 @@ func (wr *WidgetsRender) prepareBlockInfo() {
 	currentRoundTimestamp := wr.presenter.GetCurrentRoundTimestamp()
 	rows[7] = []string{fmt.Sprintf("Current round timestamp : %d", currentRoundTimestamp)}
 
+	cacheHits := wr.presenter.GetStorageCacheHits()
+	cacheMisses := wr.presenter.GetStorageCacheMisses()
+	hitRate := uint64(0)
+	if cacheHits+cacheMisses > 0 {
+		hitRate = cacheHits * 100 / (cacheHits + cacheMisses)
+	}
+	rows[8] = []string{fmt.Sprintf("Storage cache hits / misses: %d / %d (%d%% hit rate)", cacheHits, cacheMisses, hitRate)}
+
 	wr.blockInfo.Title = "Block info"
 	wr.blockInfo.RowSeparator = false
 	wr.blockInfo.Rows = rows
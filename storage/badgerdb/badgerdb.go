@@ -164,6 +164,26 @@ func (s *DB) Has(key []byte) error {
 	return err
 }
 
+// RangeKeys iterates over all the keys stored in the persistence medium, calling the handler for each of them.
+// The iteration stops when the handler returns false
+func (s *DB) RangeKeys(handler func(key []byte) bool) error {
+	return s.db.View(func(txn *badger.Txn) error {
+		options := badger.DefaultIteratorOptions
+		options.PrefetchValues = false
+		iterator := txn.NewIterator(options)
+		defer iterator.Close()
+
+		for iterator.Rewind(); iterator.Valid(); iterator.Next() {
+			key := iterator.Item().KeyCopy(nil)
+			if !handler(key) {
+				break
+			}
+		}
+
+		return nil
+	})
+}
+
 // Init initializes the storage medium and prepares it for usage
 func (s *DB) Init() error {
 	// no special initialization needed
@@ -21,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
 	"github.com/ElrondNetwork/elrond-go/hashing/fnv"
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 const (
@@ -99,6 +100,28 @@ func (b *Bloom) Clear() {
 	}
 }
 
+// Bytes returns a copy of the filter's bits
+func (b *Bloom) Bytes() []byte {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return append([]byte{}, b.filter...)
+}
+
+// Load replaces the filter's bits with the provided ones. The provided data must have been produced
+// by a filter with the same size
+func (b *Bloom) Load(data []byte) error {
+	if len(data) != len(b.filter) {
+		return storage.ErrBloomFilterSizeMismatch
+	}
+
+	b.mutex.Lock()
+	copy(b.filter, data)
+	b.mutex.Unlock()
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (b *Bloom) IsInterfaceNil() bool {
 	if b == nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
 	"github.com/ElrondNetwork/elrond-go/hashing/fnv"
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/bloom"
 
 	"github.com/stretchr/testify/assert"
@@ -86,3 +87,37 @@ func TestConcurrency(t *testing.T) {
 		assert.True(t, b.MayContain([]byte("j"+strconv.Itoa(i))), "j"+strconv.Itoa(i))
 	}
 }
+
+func TestBloom_LoadShouldRestoreBytes(t *testing.T) {
+	b := bloom.NewDefaultFilter()
+	b.Add([]byte("key1"))
+	b.Add([]byte("key2"))
+
+	restored := bloom.NewDefaultFilter()
+	err := restored.Load(b.Bytes())
+
+	assert.Nil(t, err)
+	assert.True(t, restored.MayContain([]byte("key1")))
+	assert.True(t, restored.MayContain([]byte("key2")))
+	assert.Equal(t, b.Bytes(), restored.Bytes())
+}
+
+func TestBloom_LoadWithDifferentSizeShouldErr(t *testing.T) {
+	b, _ := bloom.NewFilter(200, []hashing.Hasher{keccak.Keccak{}, blake2b.Blake2b{}, fnv.Fnv{}})
+
+	err := b.Load(make([]byte, 100))
+
+	assert.Equal(t, storage.ErrBloomFilterSizeMismatch, err)
+}
+
+func TestBloom_BytesShouldReturnACopy(t *testing.T) {
+	b := bloom.NewDefaultFilter()
+	b.Add([]byte("key"))
+
+	buff := b.Bytes()
+	for i := range buff {
+		buff[i] = 0
+	}
+
+	assert.True(t, b.MayContain([]byte("key")))
+}
@@ -109,6 +109,22 @@ func (s *DB) Has(key []byte) error {
 	})
 }
 
+// RangeKeys iterates over all the keys stored in the persistence medium, calling the handler for each of them.
+// The iteration stops when the handler returns false
+func (s *DB) RangeKeys(handler func(key []byte) bool) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket([]byte(s.parentFolder)).Cursor()
+		for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+			key := append([]byte{}, k...)
+			if !handler(key) {
+				break
+			}
+		}
+
+		return nil
+	})
+}
+
 // Init initializes the storage medium and prepares it for usage
 func (s *DB) Init() error {
 	// no special initialization needed
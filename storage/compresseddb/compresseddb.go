@@ -0,0 +1,163 @@
+package compresseddb
+
+import (
+	"bytes"
+	"compress/flate"
+	"io/ioutil"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// compressedValueMarker prefixes every value written compressed in the wrapped persister.
+// Values shorter than the threshold are stored as they are, so a database can start using the
+// compression without being rebuilt. The leading 0xFF byte can not start a value produced by the json
+// (invalid UTF-8) or protobuf (invalid wire type) marshalizers, so values written before the compression
+// was enabled are never mistaken for compressed ones
+var compressedValueMarker = []byte{0xFF, 0xE1, 0x5A, 0x1F}
+
+// DB is a persister wrapper that transparently compresses, using deflate, the values larger
+// than a configured threshold
+type DB struct {
+	persister         storage.Persister
+	minSizeToCompress int
+	writersPool       sync.Pool
+}
+
+// NewDB creates a new compressing persister wrapper. Values with a length greater than or equal to
+// minSizeToCompress are compressed before being written in the provided persister
+func NewDB(persister storage.Persister, minSizeToCompress int) (*DB, error) {
+	if persister == nil || persister.IsInterfaceNil() {
+		return nil, storage.ErrNilPersister
+	}
+	if minSizeToCompress < 1 {
+		return nil, storage.ErrInvalidCompressionThreshold
+	}
+
+	return &DB{
+		persister:         persister,
+		minSizeToCompress: minSizeToCompress,
+		writersPool: sync.Pool{
+			New: func() interface{} {
+				// the error is returned only for invalid compression levels
+				writer, _ := flate.NewWriter(nil, flate.BestSpeed)
+				return writer
+			},
+		},
+	}, nil
+}
+
+// Put compresses the value if needed and adds it to the wrapped persister
+func (s *DB) Put(key, val []byte) error {
+	buff, err := s.encode(val)
+	if err != nil {
+		return err
+	}
+
+	return s.persister.Put(key, buff)
+}
+
+// Get returns the decompressed value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	buff, err := s.persister.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decode(buff)
+}
+
+// Has returns nil if the given key is present in the wrapped persister
+func (s *DB) Has(key []byte) error {
+	return s.persister.Has(key)
+}
+
+// Init initializes the wrapped persister
+func (s *DB) Init() error {
+	return s.persister.Init()
+}
+
+// Close closes the wrapped persister
+func (s *DB) Close() error {
+	return s.persister.Close()
+}
+
+// Remove removes the data associated to the given key from the wrapped persister
+func (s *DB) Remove(key []byte) error {
+	return s.persister.Remove(key)
+}
+
+// Destroy removes the wrapped persister's stored data
+func (s *DB) Destroy() error {
+	return s.persister.Destroy()
+}
+
+// RangeKeys iterates over the keys of the wrapped persister, if it supports iteration
+func (s *DB) RangeKeys(handler func(key []byte) bool) error {
+	keysIterator, ok := s.persister.(storage.KeysIterator)
+	if !ok {
+		return storage.ErrPersisterCanNotIterateKeys
+	}
+
+	return keysIterator.RangeKeys(handler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	if s == nil {
+		return true
+	}
+	return false
+}
+
+// encode compresses the value if it is large enough and the compression actually reduces its size.
+// A small value that happens to begin with the marker is always compressed, otherwise it would be
+// mistaken for a compressed one when read
+func (s *DB) encode(val []byte) ([]byte, error) {
+	hasMarker := bytes.HasPrefix(val, compressedValueMarker)
+	if len(val) < s.minSizeToCompress && !hasMarker {
+		return val, nil
+	}
+
+	buff := bytes.NewBuffer(make([]byte, 0, len(val)/2+len(compressedValueMarker)))
+	buff.Write(compressedValueMarker)
+
+	writer := s.writersPool.Get().(*flate.Writer)
+	defer s.writersPool.Put(writer)
+
+	writer.Reset(buff)
+	_, err := writer.Write(val)
+	if err != nil {
+		return nil, err
+	}
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if buff.Len() >= len(val) && !hasMarker {
+		return val, nil
+	}
+
+	return buff.Bytes(), nil
+}
+
+// decode returns the decompressed value. A value that carries the marker but can not be decompressed
+// is corrupted and an error is returned
+func (s *DB) decode(buff []byte) ([]byte, error) {
+	if !bytes.HasPrefix(buff, compressedValueMarker) {
+		return buff, nil
+	}
+
+	reader := flate.NewReader(bytes.NewReader(buff[len(compressedValueMarker):]))
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	val, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, storage.ErrCorruptedCompressedValue
+	}
+
+	return val, nil
+}
@@ -0,0 +1,129 @@
+package compresseddb_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compresseddb"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+)
+
+var marker = []byte{0xFF, 0xE1, 0x5A, 0x1F}
+
+func TestNewDB_NilPersisterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	db, err := compresseddb.NewDB(nil, 10)
+
+	assert.Nil(t, db)
+	assert.Equal(t, storage.ErrNilPersister, err)
+}
+
+func TestNewDB_InvalidThresholdShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mdb, _ := memorydb.New()
+	db, err := compresseddb.NewDB(mdb, 0)
+
+	assert.Nil(t, db)
+	assert.Equal(t, storage.ErrInvalidCompressionThreshold, err)
+}
+
+func TestDB_PutSmallValueShouldStoreItUncompressed(t *testing.T) {
+	t.Parallel()
+
+	mdb, _ := memorydb.New()
+	db, _ := compresseddb.NewDB(mdb, 100)
+	key, val := []byte("key"), []byte("small value")
+
+	err := db.Put(key, val)
+	assert.Nil(t, err)
+
+	stored, _ := mdb.Get(key)
+	assert.Equal(t, val, stored)
+
+	recovered, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+}
+
+func TestDB_PutLargeValueShouldStoreItCompressed(t *testing.T) {
+	t.Parallel()
+
+	mdb, _ := memorydb.New()
+	db, _ := compresseddb.NewDB(mdb, 100)
+	key, val := []byte("key"), bytes.Repeat([]byte("compressible"), 100)
+
+	err := db.Put(key, val)
+	assert.Nil(t, err)
+
+	stored, _ := mdb.Get(key)
+	assert.True(t, len(stored) < len(val))
+	assert.True(t, bytes.HasPrefix(stored, marker))
+
+	recovered, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+}
+
+func TestDB_PutSmallValueWithMarkerShouldRecoverIt(t *testing.T) {
+	t.Parallel()
+
+	mdb, _ := memorydb.New()
+	db, _ := compresseddb.NewDB(mdb, 100)
+	key, val := []byte("key"), append(append([]byte{}, marker...), []byte("data")...)
+
+	err := db.Put(key, val)
+	assert.Nil(t, err)
+
+	recovered, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+}
+
+func TestDB_GetValueWrittenWithoutCompressionShouldReturnIt(t *testing.T) {
+	t.Parallel()
+
+	mdb, _ := memorydb.New()
+	key, val := []byte("key"), []byte(`{"Nonce":1,"Data":"not compressed"}`)
+	_ = mdb.Put(key, val)
+
+	db, _ := compresseddb.NewDB(mdb, 1)
+	recovered, err := db.Get(key)
+
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+}
+
+func TestDB_GetCorruptedCompressedValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mdb, _ := memorydb.New()
+	key, val := []byte("key"), append(append([]byte{}, marker...), []byte("not deflate data")...)
+	_ = mdb.Put(key, val)
+
+	db, _ := compresseddb.NewDB(mdb, 1)
+	recovered, err := db.Get(key)
+
+	assert.Nil(t, recovered)
+	assert.Equal(t, storage.ErrCorruptedCompressedValue, err)
+}
+
+func TestDB_RangeKeysShouldCallWrappedPersister(t *testing.T) {
+	t.Parallel()
+
+	mdb, _ := memorydb.New()
+	db, _ := compresseddb.NewDB(mdb, 1)
+	_ = db.Put([]byte("key"), []byte("value"))
+
+	keys := make([]string, 0)
+	err := db.RangeKeys(func(key []byte) bool {
+		keys = append(keys, string(key))
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"key"}, keys)
+}
@@ -39,3 +39,37 @@ var ErrDuplicateKeyToAdd = errors.New("the key can not be added as it already ex
 
 // ErrEmptyKey is raised when a key is empty
 var ErrEmptyKey = errors.New("key is empty")
+
+// ErrBloomFilterSizeMismatch is raised when loading into a bloom filter data produced by a filter with a different size
+var ErrBloomFilterSizeMismatch = errors.New("bloom filter size mismatch")
+
+// ErrPersisterCanNotIterateKeys is raised when a persistent bloom filter needs to be rebuilt
+// over a persister that can not iterate over its keys
+var ErrPersisterCanNotIterateKeys = errors.New("persister can not iterate over its keys")
+
+// ErrBloomSnapshotIsStale is raised when the saved bloom filter misses keys added after it was written
+var ErrBloomSnapshotIsStale = errors.New("bloom filter snapshot is stale")
+
+// ErrEmptyBloomFilePath is raised when a persistent bloom filter is requested without a file path
+var ErrEmptyBloomFilePath = errors.New("empty bloom filter file path")
+
+// ErrInvalidBloomPersistInterval is raised when the bloom filter persist interval is not positive
+var ErrInvalidBloomPersistInterval = errors.New("invalid bloom filter persist interval")
+
+// ErrBloomFilterCanNotBePersisted is raised when the created bloom filter does not support saving its content
+var ErrBloomFilterCanNotBePersisted = errors.New("bloom filter can not be persisted")
+
+// ErrInvalidCompressionThreshold is raised when the minimum size of a value to be compressed is not positive
+var ErrInvalidCompressionThreshold = errors.New("invalid compression threshold")
+
+// ErrCorruptedCompressedValue is raised when a value marked as compressed can not be decompressed
+var ErrCorruptedCompressedValue = errors.New("corrupted compressed value")
+
+// ErrReadOnlyPersister is raised when trying to modify the content of a persister opened in read only mode
+var ErrReadOnlyPersister = errors.New("persister is opened in read only mode")
+
+// ErrCacheSizeInvalid is raised when the provided cache size is not positive
+var ErrCacheSizeInvalid = errors.New("cache size is invalid")
+
+// ErrInvalidMaxTxsPerSender is raised when the maximum number of transactions a sender can hold in cache is not positive
+var ErrInvalidMaxTxsPerSender = errors.New("invalid maximum number of transactions per sender")
@@ -20,6 +20,12 @@ type Persister interface {
 	IsInterfaceNil() bool
 }
 
+// KeysIterator defines the persisters able to iterate over all the keys they hold
+type KeysIterator interface {
+	// RangeKeys calls the handler for every stored key until the handler returns false
+	RangeKeys(handler func(key []byte) bool) error
+}
+
 // Batcher allows to batch the data first then write the batch to the persister in one go
 type Batcher interface {
 	// Put inserts one entry - key, value pair - into the batch
@@ -48,7 +54,8 @@ type Cacher interface {
 	Peek(key []byte) (value interface{}, ok bool)
 	// HasOrAdd checks if a key is in the cache  without updating the
 	// recent-ness or deleting it for being stale,  and if not, adds the value.
-	// Returns whether found and whether an eviction occurred.
+	// Returns whether the value was not added, because the key was found or the cache refused it, and whether an
+	// eviction occurred.
 	HasOrAdd(key []byte, value interface{}) (ok, evicted bool)
 	// Remove removes the provided key from the cache.
 	Remove(key []byte)
@@ -79,6 +86,15 @@ type BloomFilter interface {
 	IsInterfaceNil() bool
 }
 
+// PersistentBloomFilter is a bloom filter whose content can be exported and loaded back
+type PersistentBloomFilter interface {
+	BloomFilter
+	// Bytes returns a copy of the filter's content
+	Bytes() []byte
+	// Load replaces the filter's content with the provided one
+	Load(data []byte) error
+}
+
 // Storer provides storage services in a two layered storage construct, where the first layer is
 // represented by a cache and second layer by a persitent storage (DB-like)
 type Storer interface {
@@ -87,6 +103,7 @@ type Storer interface {
 	Has(key []byte) error
 	Remove(key []byte) error
 	ClearCache()
+	Close() error
 	DestroyUnit() error
 	IsInterfaceNil() bool
 }
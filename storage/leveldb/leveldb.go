@@ -142,6 +142,22 @@ func (s *DB) Has(key []byte) error {
 	return storage.ErrKeyNotFound
 }
 
+// RangeKeys iterates over all the keys stored in the persistence medium, calling the handler for each of them.
+// The iteration stops when the handler returns false
+func (s *DB) RangeKeys(handler func(key []byte) bool) error {
+	iterator := s.db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		key := append([]byte{}, iterator.Key()...)
+		if !handler(key) {
+			break
+		}
+	}
+
+	return iterator.Error()
+}
+
 // Init initializes the storage medium and prepares it for usage
 func (s *DB) Init() error {
 	// no special initialization needed
@@ -0,0 +1,115 @@
+package leveldb
+
+import (
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// ReadOnlyDB holds a pointer to a leveldb database opened in read only mode. It is used by the tools
+// that inspect the databases of a stopped node
+type ReadOnlyDB struct {
+	db   *leveldb.DB
+	path string
+}
+
+// NewReadOnlyDB opens an existing leveldb database, found at the given path, in read only mode
+func NewReadOnlyDB(path string, maxOpenFiles int) (*ReadOnlyDB, error) {
+	if maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+
+	options := &opt.Options{
+		BlockCacheCapacity:     -1,
+		OpenFilesCacheCapacity: maxOpenFiles,
+		ErrorIfMissing:         true,
+		ReadOnly:               true,
+	}
+
+	db, err := leveldb.OpenFile(path, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReadOnlyDB{
+		db:   db,
+		path: path,
+	}, nil
+}
+
+// Put returns an error as the database can not be written
+func (s *ReadOnlyDB) Put(_, _ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Get returns the value associated to the key
+func (s *ReadOnlyDB) Get(key []byte) ([]byte, error) {
+	data, err := s.db.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *ReadOnlyDB) Has(key []byte) error {
+	has, err := s.db.Has(key, nil)
+	if err != nil {
+		return err
+	}
+
+	if has {
+		return nil
+	}
+
+	return storage.ErrKeyNotFound
+}
+
+// RangeKeys iterates over all the keys stored in the persistence medium, calling the handler for each of them.
+// The iteration stops when the handler returns false
+func (s *ReadOnlyDB) RangeKeys(handler func(key []byte) bool) error {
+	iterator := s.db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		key := append([]byte{}, iterator.Key()...)
+		if !handler(key) {
+			break
+		}
+	}
+
+	return iterator.Error()
+}
+
+// Init initializes the storage medium and prepares it for usage
+func (s *ReadOnlyDB) Init() error {
+	// no special initialization needed
+	return nil
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *ReadOnlyDB) Close() error {
+	return s.db.Close()
+}
+
+// Remove returns an error as the database can not be written
+func (s *ReadOnlyDB) Remove(_ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Destroy only closes the database, the stored data is never removed
+func (s *ReadOnlyDB) Destroy() error {
+	return s.db.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *ReadOnlyDB) IsInterfaceNil() bool {
+	if s == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package leveldb_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/stretchr/testify/assert"
+)
+
+func createPopulatedLevelDbFolder(t *testing.T, key []byte, val []byte) string {
+	dir, _ := ioutil.TempDir("", "leveldb_temp")
+	ldb, err := leveldb.NewSerialDB(dir, 1, 1, 10)
+	assert.Nil(t, err)
+
+	_ = ldb.Put(key, val)
+	_ = ldb.Close()
+
+	return dir
+}
+
+func TestNewReadOnlyDB_InvalidNumOpenFilesShouldErr(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "leveldb_temp")
+	ldb, err := leveldb.NewReadOnlyDB(dir, 0)
+
+	assert.Nil(t, ldb)
+	assert.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+}
+
+func TestNewReadOnlyDB_MissingDatabaseShouldErr(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "leveldb_temp")
+	ldb, err := leveldb.NewReadOnlyDB(dir, 10)
+
+	assert.Nil(t, ldb)
+	assert.NotNil(t, err)
+}
+
+func TestReadOnlyDB_GetAndHasShouldWork(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	dir := createPopulatedLevelDbFolder(t, key, val)
+
+	ldb, err := leveldb.NewReadOnlyDB(dir, 10)
+	assert.Nil(t, err)
+	defer func() {
+		_ = ldb.Close()
+	}()
+
+	recovered, err := ldb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+	assert.Nil(t, ldb.Has(key))
+
+	_, err = ldb.Get([]byte("missing key"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, ldb.Has([]byte("missing key")))
+}
+
+func TestReadOnlyDB_WritesShouldErr(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	dir := createPopulatedLevelDbFolder(t, key, val)
+
+	ldb, _ := leveldb.NewReadOnlyDB(dir, 10)
+	defer func() {
+		_ = ldb.Close()
+	}()
+
+	assert.Equal(t, storage.ErrReadOnlyPersister, ldb.Put([]byte("key2"), val))
+	assert.Equal(t, storage.ErrReadOnlyPersister, ldb.Remove(key))
+
+	recovered, err := ldb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+}
+
+func TestReadOnlyDB_RangeKeysShouldWork(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	dir := createPopulatedLevelDbFolder(t, key, val)
+
+	ldb, _ := leveldb.NewReadOnlyDB(dir, 10)
+	defer func() {
+		_ = ldb.Close()
+	}()
+
+	keys := make([]string, 0)
+	err := ldb.RangeKeys(func(key []byte) bool {
+		keys = append(keys, string(key))
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"key"}, keys)
+}
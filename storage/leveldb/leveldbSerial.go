@@ -157,6 +157,26 @@ func (s *SerialDB) Has(key []byte) error {
 	return result
 }
 
+// RangeKeys iterates over all the keys stored in the persistence medium, calling the handler for each of them.
+// The iteration stops when the handler returns false
+func (s *SerialDB) RangeKeys(handler func(key []byte) bool) error {
+	if s.isClosed() {
+		return storage.ErrSerialDBIsClosed
+	}
+
+	iterator := s.db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		key := append([]byte{}, iterator.Key()...)
+		if !handler(key) {
+			break
+		}
+	}
+
+	return iterator.Error()
+}
+
 // Init initializes the storage medium and prepares it for usage
 func (s *SerialDB) Init() error {
 	// no special initialization needed
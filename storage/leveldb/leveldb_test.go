@@ -190,3 +190,21 @@ func TestDB_Destroy(t *testing.T) {
 
 	assert.Nil(t, err, "no error expected but got %s", err)
 }
+
+func TestDB_RangeKeysShouldIterateFlushedKeys(t *testing.T) {
+	ldb := createLevelDb(t, 10, 1, 10)
+
+	_ = ldb.Put([]byte("key1"), []byte("value1"))
+	_ = ldb.Put([]byte("key2"), []byte("value2"))
+
+	keys := make([]string, 0)
+	err := ldb.RangeKeys(func(key []byte) bool {
+		keys = append(keys, string(key))
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"key1", "key2"}, keys)
+
+	_ = ldb.Destroy()
+}
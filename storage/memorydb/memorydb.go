@@ -1,10 +1,9 @@
 package memorydb
 
 import (
-	"encoding/base64"
-	"errors"
-	"fmt"
 	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // DB represents the memory database storage. It holds a map of key value pairs
@@ -40,7 +39,7 @@ func (s *DB) Get(key []byte) ([]byte, error) {
 	val, ok := s.db[string(key)]
 
 	if !ok {
-		return nil, errors.New(fmt.Sprintf("key: %s not found", base64.StdEncoding.EncodeToString(key)))
+		return nil, storage.ErrKeyNotFound
 	}
 
 	return val, nil
@@ -54,11 +53,30 @@ func (s *DB) Has(key []byte) error {
 	_, ok := s.db[string(key)]
 
 	if !ok {
-		return errors.New("key not found")
+		return storage.ErrKeyNotFound
 	}
 	return nil
 }
 
+// RangeKeys iterates over all the keys stored in the persistence medium, calling the handler for each of them.
+// The iteration stops when the handler returns false
+func (s *DB) RangeKeys(handler func(key []byte) bool) error {
+	s.mutx.RLock()
+	keys := make([][]byte, 0, len(s.db))
+	for key := range s.db {
+		keys = append(keys, []byte(key))
+	}
+	s.mutx.RUnlock()
+
+	for _, key := range keys {
+		if !handler(key) {
+			break
+		}
+	}
+
+	return nil
+}
+
 // Init initializes the storage medium and prepares it for usage
 func (s *DB) Init() error {
 	// no special initialization needed
@@ -131,3 +131,35 @@ func TestDestroy(t *testing.T) {
 
 	assert.Nil(t, err, "no error expected but got %s", err)
 }
+
+func TestRangeKeysShouldIterateAllKeys(t *testing.T) {
+	mdb, _ := memorydb.New()
+	_ = mdb.Put([]byte("key1"), []byte("value1"))
+	_ = mdb.Put([]byte("key2"), []byte("value2"))
+
+	keys := make(map[string]struct{})
+	err := mdb.RangeKeys(func(key []byte) bool {
+		keys[string(key)] = struct{}{}
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(keys))
+	assert.Contains(t, keys, "key1")
+	assert.Contains(t, keys, "key2")
+}
+
+func TestRangeKeysShouldStopWhenHandlerReturnsFalse(t *testing.T) {
+	mdb, _ := memorydb.New()
+	_ = mdb.Put([]byte("key1"), []byte("value1"))
+	_ = mdb.Put([]byte("key2"), []byte("value2"))
+
+	numCalls := 0
+	err := mdb.RangeKeys(func(key []byte) bool {
+		numCalls++
+		return false
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numCalls)
+}
@@ -0,0 +1,194 @@
+package storageUnit
+
+import (
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// read + write for owner
+const rwOwner = 0600
+
+const bloomSnapshotExtension = ".bloom"
+const bloomStaleMarkerExtension = ".stale"
+const bloomTempExtension = ".tmp"
+
+// bloomSnapshot keeps a copy of a unit's bloom filter on disk, next to the unit's persister.
+// The snapshot is written periodically. Between two writes, the keys added to the filter are missing
+// from the snapshot, so the first Put after a write creates a stale marker file. A snapshot is loaded at
+// startup only if no stale marker exists; otherwise the filter is rebuilt from the persister's keys
+type bloomSnapshot struct {
+	filePath        string
+	staleMarkerPath string
+	isUpToDate      bool
+	persistInterval time.Duration
+	chanClose       chan struct{}
+	isClosed        bool
+}
+
+func newBloomSnapshot(filePath string, persistInterval time.Duration) *bloomSnapshot {
+	return &bloomSnapshot{
+		filePath:        filePath,
+		staleMarkerPath: filePath + bloomStaleMarkerExtension,
+		persistInterval: persistInterval,
+		chanClose:       make(chan struct{}),
+	}
+}
+
+// loadOrRebuildBloomFilter fills the unit's bloom filter either from a valid snapshot or, if none exists,
+// by adding all the keys found in the persister
+func (s *Unit) loadOrRebuildBloomFilter(bf storage.PersistentBloomFilter) error {
+	err := s.loadBloomSnapshot(bf)
+	if err == nil {
+		s.bloomSnapshot.isUpToDate = true
+		return nil
+	}
+
+	log.Debug("rebuilding bloom filter from persister", "path", s.bloomSnapshot.filePath, "reason", err.Error())
+
+	keysIterator, ok := s.persister.(storage.KeysIterator)
+	if !ok {
+		return storage.ErrPersisterCanNotIterateKeys
+	}
+
+	bf.Clear()
+	err = keysIterator.RangeKeys(func(key []byte) bool {
+		bf.Add(key)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	// a failed write is retried by the persisting loop, the stale marker (if any) is kept until then
+	err = s.writeBloomSnapshot(bf)
+	if err != nil {
+		log.Warn("bloom snapshot write", "path", s.bloomSnapshot.filePath, "error", err.Error())
+	}
+
+	return nil
+}
+
+func (s *Unit) loadBloomSnapshot(bf storage.PersistentBloomFilter) error {
+	_, err := os.Stat(s.bloomSnapshot.staleMarkerPath)
+	if err == nil {
+		return storage.ErrBloomSnapshotIsStale
+	}
+
+	data, err := ioutil.ReadFile(s.bloomSnapshot.filePath)
+	if err != nil {
+		return err
+	}
+
+	return bf.Load(data)
+}
+
+// writeBloomSnapshot atomically replaces the snapshot file and then removes the stale marker.
+// It must be called while holding the unit's lock
+func (s *Unit) writeBloomSnapshot(bf storage.PersistentBloomFilter) error {
+	tempPath := s.bloomSnapshot.filePath + bloomTempExtension
+	err := ioutil.WriteFile(tempPath, bf.Bytes(), rwOwner)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(tempPath, s.bloomSnapshot.filePath)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(s.bloomSnapshot.staleMarkerPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	s.bloomSnapshot.isUpToDate = true
+
+	return nil
+}
+
+// markBloomSnapshotStale is called before a key is added to the bloom filter. If the snapshot on disk
+// was up to date, a stale marker is created so that the snapshot is not trusted after an unclean shutdown.
+// It must be called while holding the unit's lock
+func (s *Unit) markBloomSnapshotStale() {
+	if s.bloomSnapshot == nil || !s.bloomSnapshot.isUpToDate {
+		return
+	}
+
+	s.bloomSnapshot.isUpToDate = false
+	err := ioutil.WriteFile(s.bloomSnapshot.staleMarkerPath, []byte{}, rwOwner)
+	if err == nil {
+		return
+	}
+
+	log.Warn("can not create bloom stale marker, removing the snapshot", "error", err.Error())
+	err = os.Remove(s.bloomSnapshot.filePath)
+	if err != nil && !os.IsNotExist(err) {
+		log.Error("can not remove bloom snapshot", "path", s.bloomSnapshot.filePath, "error", err.Error())
+	}
+}
+
+func (s *Unit) persistBloomSnapshotLoop(bf storage.PersistentBloomFilter) {
+	for {
+		select {
+		case <-time.After(s.bloomSnapshot.persistInterval):
+			s.lock.Lock()
+			if !s.bloomSnapshot.isUpToDate && !s.bloomSnapshot.isClosed {
+				err := s.writeBloomSnapshot(bf)
+				if err != nil {
+					log.Warn("bloom snapshot write", "path", s.bloomSnapshot.filePath, "error", err.Error())
+				}
+			}
+			s.lock.Unlock()
+		case <-s.bloomSnapshot.chanClose:
+			return
+		}
+	}
+}
+
+// stopPersistingLoop stops the persisting loop, if not already stopped. It must be called while holding the unit's lock
+func (s *Unit) stopPersistingLoop() {
+	if s.bloomSnapshot.isClosed {
+		return
+	}
+
+	s.bloomSnapshot.isClosed = true
+	close(s.bloomSnapshot.chanClose)
+}
+
+// closeBloomSnapshot stops the persisting loop and writes the snapshot if keys were added since the last write,
+// so that a restart after a normal shutdown does not have to rebuild the filter. It must be called while holding
+// the unit's lock
+func (s *Unit) closeBloomSnapshot() {
+	if s.bloomSnapshot == nil || s.bloomSnapshot.isClosed {
+		return
+	}
+
+	s.stopPersistingLoop()
+	if s.bloomSnapshot.isUpToDate {
+		return
+	}
+
+	bf, ok := s.bloomFilter.(storage.PersistentBloomFilter)
+	if !ok {
+		return
+	}
+
+	err := s.writeBloomSnapshot(bf)
+	if err != nil {
+		log.Warn("bloom snapshot write on close", "path", s.bloomSnapshot.filePath, "error", err.Error())
+	}
+}
+
+// removeBloomSnapshot stops the persisting loop and deletes the files. It must be called while holding the unit's lock
+func (s *Unit) removeBloomSnapshot() {
+	if s.bloomSnapshot == nil {
+		return
+	}
+
+	s.stopPersistingLoop()
+	_ = os.Remove(s.bloomSnapshot.filePath)
+	_ = os.Remove(s.bloomSnapshot.staleMarkerPath)
+}
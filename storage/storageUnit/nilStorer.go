@@ -27,6 +27,10 @@ func (ns *nilStorer) Remove(key []byte) error {
 func (ns *nilStorer) ClearCache() {
 }
 
+func (ns *nilStorer) Close() error {
+	return nil
+}
+
 func (ns *nilStorer) DestroyUnit() error {
 	return nil
 }
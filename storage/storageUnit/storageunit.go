@@ -6,20 +6,25 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
 	"github.com/ElrondNetwork/elrond-go/hashing/fnv"
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/logger"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
 	"github.com/ElrondNetwork/elrond-go/storage/bloom"
 	"github.com/ElrondNetwork/elrond-go/storage/boltdb"
+	"github.com/ElrondNetwork/elrond-go/storage/compresseddb"
 	"github.com/ElrondNetwork/elrond-go/storage/fifocache"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 )
 
+var log = logger.GetOrCreate("storage/storageUnit")
+
 // CacheType represents the type of the supported caches
 type CacheType string
 
@@ -67,29 +72,36 @@ type CacheConfig struct {
 	Shards uint32
 }
 
-// DBConfig holds the configurable elements of a database
+// DBConfig holds the configurable elements of a database.
+// If CompressionThreshold is greater than 0, the values of at least that many bytes are stored compressed
 type DBConfig struct {
-	FilePath          string
-	Type              DBType
-	BatchDelaySeconds int
-	MaxBatchSize      int
-	MaxOpenFiles      int
+	FilePath             string
+	Type                 DBType
+	BatchDelaySeconds    int
+	MaxBatchSize         int
+	MaxOpenFiles         int
+	CompressionThreshold int
 }
 
-// BloomConfig holds the configurable elements of a bloom filter
+// BloomConfig holds the configurable elements of a bloom filter.
+// If PersistIntervalSeconds is greater than 0, the filter is saved next to the database at the given
+// interval and restored at startup
 type BloomConfig struct {
-	Size     uint
-	HashFunc []HasherType
+	Size                   uint
+	HashFunc               []HasherType
+	PersistIntervalSeconds int
 }
 
 // Unit represents a storer's data bank
 // holding the cache, persistence unit and bloom filter
 type Unit struct {
-	lock        sync.RWMutex
-	batcher     storage.Batcher
-	persister   storage.Persister
-	cacher      storage.Cacher
-	bloomFilter storage.BloomFilter
+	lock          sync.RWMutex
+	batcher       storage.Batcher
+	persister     storage.Persister
+	cacher        storage.Cacher
+	bloomFilter   storage.BloomFilter
+	bloomSnapshot *bloomSnapshot
+	metrics       *unitMetrics
 }
 
 // Put adds data to both cache and persistence medium and updates the bloom filter
@@ -98,8 +110,11 @@ func (s *Unit) Put(key, data []byte) error {
 	defer s.lock.Unlock()
 
 	s.cacher.Put(key, data)
+	s.markBloomSnapshotStale()
 
+	startTime := time.Now()
 	err := s.persister.Put(key, data)
+	s.metrics.addPersisterWrite(time.Since(startTime))
 	if err != nil {
 		s.cacher.Remove(key)
 		return err
@@ -121,26 +136,33 @@ func (s *Unit) Get(key []byte) ([]byte, error) {
 	defer s.lock.Unlock()
 
 	v, ok := s.cacher.Get(key)
-	var err error
-
-	if !ok {
-		// not found in cache
-		// search it in second persistence medium
-		if s.bloomFilter == nil || s.bloomFilter.MayContain(key) == true {
-			v, err = s.persister.Get(key)
+	if ok {
+		s.metrics.addCacheHit()
+		return v.([]byte), nil
+	}
 
-			if err != nil {
-				return nil, err
-			}
+	// not found in cache
+	// search it in second persistence medium
+	s.metrics.addCacheMiss()
+	if s.bloomFilter != nil && !s.bloomFilter.MayContain(key) {
+		s.metrics.addBloomNegative()
+		return nil, errors.New(fmt.Sprintf("key: %s not found", base64.StdEncoding.EncodeToString(key)))
+	}
 
-			// if found in persistence unit, add it in cache
-			s.cacher.Put(key, v)
-		} else {
-			return nil, errors.New(fmt.Sprintf("key: %s not found", base64.StdEncoding.EncodeToString(key)))
+	startTime := time.Now()
+	buff, err := s.persister.Get(key)
+	s.metrics.addPersisterRead(time.Since(startTime))
+	if err != nil {
+		if err == storage.ErrKeyNotFound && s.bloomFilter != nil {
+			s.metrics.addBloomFalsePositive()
 		}
+		return nil, err
 	}
 
-	return v.([]byte), nil
+	// if found in persistence unit, add it in cache
+	s.cacher.Put(key, buff)
+
+	return buff, nil
 }
 
 // Has checks if the key is in the Unit.
@@ -152,14 +174,24 @@ func (s *Unit) Has(key []byte) error {
 
 	has := s.cacher.Has(key)
 	if has {
+		s.metrics.addCacheHit()
 		return nil
 	}
 
-	if s.bloomFilter == nil || s.bloomFilter.MayContain(key) == true {
-		return s.persister.Has(key)
+	s.metrics.addCacheMiss()
+	if s.bloomFilter != nil && !s.bloomFilter.MayContain(key) {
+		s.metrics.addBloomNegative()
+		return storage.ErrKeyNotFound
 	}
 
-	return storage.ErrKeyNotFound
+	startTime := time.Now()
+	err := s.persister.Has(key)
+	s.metrics.addPersisterRead(time.Since(startTime))
+	if err == storage.ErrKeyNotFound && s.bloomFilter != nil {
+		s.metrics.addBloomFalsePositive()
+	}
+
+	return err
 }
 
 // Remove removes the data associated to the given key from both cache and persistence medium
@@ -178,6 +210,16 @@ func (s *Unit) ClearCache() {
 	s.cacher.Clear()
 }
 
+// Close stops the bloom filter persisting, saving the bloom filter one last time if needed, and closes the db
+func (s *Unit) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.closeBloomSnapshot()
+
+	return s.persister.Close()
+}
+
 // DestroyUnit cleans up the bloom filter, the cache, and the db
 func (s *Unit) DestroyUnit() error {
 	s.lock.Lock()
@@ -186,11 +228,17 @@ func (s *Unit) DestroyUnit() error {
 	if s.bloomFilter != nil {
 		s.bloomFilter.Clear()
 	}
+	s.removeBloomSnapshot()
 
 	s.cacher.Clear()
 	return s.persister.Destroy()
 }
 
+// Metrics returns a snapshot of the cache, bloom filter and persister counters of this unit
+func (s *Unit) Metrics() UnitMetrics {
+	return s.metrics.snapshot()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (s *Unit) IsInterfaceNil() bool {
 	if s == nil {
@@ -213,6 +261,7 @@ func NewStorageUnit(c storage.Cacher, p storage.Persister) (*Unit, error) {
 		persister:   p,
 		cacher:      c,
 		bloomFilter: nil,
+		metrics:     &unitMetrics{},
 	}
 
 	err := sUnit.persister.Init()
@@ -240,6 +289,49 @@ func NewStorageUnitWithBloomFilter(c storage.Cacher, p storage.Persister, b stor
 		persister:   p,
 		cacher:      c,
 		bloomFilter: b,
+		metrics:     &unitMetrics{},
+	}
+
+	err := sUnit.persister.Init()
+	if err != nil {
+		return nil, err
+	}
+
+	return sUnit, nil
+}
+
+// NewStorageUnitWithPersistentBloomFilter is the constructor for the storage unit, creating a new storage unit
+// from the given cacher, persister and bloom filter. The bloom filter is restored from the snapshot found at
+// bloomFilePath or rebuilt from the persister's keys, and afterwards saved at every persistInterval
+func NewStorageUnitWithPersistentBloomFilter(
+	c storage.Cacher,
+	p storage.Persister,
+	b storage.PersistentBloomFilter,
+	bloomFilePath string,
+	persistInterval time.Duration,
+) (*Unit, error) {
+	if p == nil || p.IsInterfaceNil() {
+		return nil, storage.ErrNilPersister
+	}
+	if c == nil || c.IsInterfaceNil() {
+		return nil, storage.ErrNilCacher
+	}
+	if b == nil || b.IsInterfaceNil() {
+		return nil, storage.ErrNilBloomFilter
+	}
+	if len(bloomFilePath) == 0 {
+		return nil, storage.ErrEmptyBloomFilePath
+	}
+	if persistInterval <= 0 {
+		return nil, storage.ErrInvalidBloomPersistInterval
+	}
+
+	sUnit := &Unit{
+		persister:     p,
+		cacher:        c,
+		bloomFilter:   b,
+		bloomSnapshot: newBloomSnapshot(bloomFilePath, persistInterval),
+		metrics:       &unitMetrics{},
 	}
 
 	err := sUnit.persister.Init()
@@ -247,6 +339,13 @@ func NewStorageUnitWithBloomFilter(c storage.Cacher, p storage.Persister, b stor
 		return nil, err
 	}
 
+	err = sUnit.loadOrRebuildBloomFilter(b)
+	if err != nil {
+		return nil, err
+	}
+
+	go sUnit.persistBloomSnapshotLoop(b)
+
 	return sUnit, nil
 }
 
@@ -254,7 +353,6 @@ func NewStorageUnitWithBloomFilter(c storage.Cacher, p storage.Persister, b stor
 func NewStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig, bloomFilterConf BloomConfig) (*Unit, error) {
 	var cache storage.Cacher
 	var db storage.Persister
-	var bf storage.BloomFilter
 	var err error
 
 	defer func() {
@@ -273,23 +371,24 @@ func NewStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig, bloomFilterC
 		return nil, err
 	}
 
-	if reflect.DeepEqual(bloomFilterConf, BloomConfig{}) {
-		return NewStorageUnit(cache, db)
-	}
-
-	bf, err = NewBloomFilter(bloomFilterConf)
+	db, err = wrapWithCompression(db, dbConf.CompressionThreshold)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewStorageUnitWithBloomFilter(cache, db, bf)
+	if reflect.DeepEqual(bloomFilterConf, BloomConfig{}) {
+		return NewStorageUnit(cache, db)
+	}
+
+	var sUnit *Unit
+	sUnit, err = newStorageUnitWithConfiguredBloomFilter(cache, db, bloomFilterConf, dbConf.FilePath)
+	return sUnit, err
 }
 
 // NewShardedStorageUnitFromConf creates a new sharded storage unit from a storage unit config
 func NewShardedStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig, bloomFilterConf BloomConfig, shardId uint32) (*Unit, error) {
 	var cache storage.Cacher
 	var db storage.Persister
-	var bf storage.BloomFilter
 	var err error
 
 	defer func() {
@@ -309,20 +408,106 @@ func NewShardedStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig, bloom
 		return nil, err
 	}
 
+	db, err = wrapWithCompression(db, dbConf.CompressionThreshold)
+	if err != nil {
+		return nil, err
+	}
+
 	if reflect.DeepEqual(bloomFilterConf, BloomConfig{}) {
 		return NewStorageUnit(cache, db)
 	}
 
-	bf, err = NewBloomFilter(bloomFilterConf)
+	var sUnit *Unit
+	sUnit, err = newStorageUnitWithConfiguredBloomFilter(cache, db, bloomFilterConf, filePath)
+	return sUnit, err
+}
+
+// NewReadOnlyStorageUnitFromConf opens an existing database in read only mode. It is meant to be used by the tools
+// that inspect the storage of a stopped node, so no bloom filter is created and only leveldb databases are supported
+func NewReadOnlyStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig) (*Unit, error) {
+	var cache storage.Cacher
+	var db storage.Persister
+	var err error
+
+	defer func() {
+		if err != nil && db != nil {
+			_ = db.Close()
+		}
+	}()
+
+	cache, err = NewCache(cacheConf.Type, cacheConf.Size, cacheConf.Shards)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbConf.Type != LvlDB && dbConf.Type != LvlDbSerial {
+		err = storage.ErrNotSupportedDBType
+		return nil, err
+	}
+
+	var readOnlyDb *leveldb.ReadOnlyDB
+	readOnlyDb, err = leveldb.NewReadOnlyDB(dbConf.FilePath, dbConf.MaxOpenFiles)
+	if err != nil {
+		return nil, err
+	}
+	db = readOnlyDb
+
+	db, err = wrapWithCompression(db, dbConf.CompressionThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	var sUnit *Unit
+	sUnit, err = NewStorageUnit(cache, db)
+	return sUnit, err
+}
+
+// wrapWithCompression returns the provided persister wrapped in a compressing one if the threshold is set.
+// The provided persister is returned also in case of error so that the caller can release it
+func wrapWithCompression(db storage.Persister, compressionThreshold int) (storage.Persister, error) {
+	if compressionThreshold <= 0 {
+		return db, nil
+	}
+
+	compressedDb, err := compresseddb.NewDB(db, compressionThreshold)
+	if err != nil {
+		return db, err
+	}
+
+	return compressedDb, nil
+}
+
+func newStorageUnitWithConfiguredBloomFilter(
+	cache storage.Cacher,
+	db storage.Persister,
+	bloomFilterConf BloomConfig,
+	dbFilePath string,
+) (*Unit, error) {
+	bf, err := NewBloomFilter(bloomFilterConf)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewStorageUnitWithBloomFilter(cache, db, bf)
+	if bloomFilterConf.PersistIntervalSeconds <= 0 {
+		return NewStorageUnitWithBloomFilter(cache, db, bf)
+	}
+
+	persistentBf, ok := bf.(storage.PersistentBloomFilter)
+	if !ok {
+		return nil, storage.ErrBloomFilterCanNotBePersisted
+	}
+
+	return NewStorageUnitWithPersistentBloomFilter(
+		cache,
+		db,
+		persistentBf,
+		dbFilePath+bloomSnapshotExtension,
+		time.Duration(bloomFilterConf.PersistIntervalSeconds)*time.Second,
+	)
 }
 
-//NewCache creates a new cache from a cache config
-//TODO: add a cacher factory or a cacheConfig param instead
+// NewCache creates a new cache from a cache config
+// TODO: add a cacher factory or a cacheConfig param instead
 func NewCache(cacheType CacheType, size uint32, shards uint32) (storage.Cacher, error) {
 	var cacher storage.Cacher
 	var err error
@@ -7,7 +7,9 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
@@ -616,6 +618,83 @@ func TestNewStorageUnit_FromConfLvlDBOk(t *testing.T) {
 	assert.Nil(t, err, "no error expected destroying the persister")
 }
 
+func TestNewStorageUnit_FromConfWithCompressionOk(t *testing.T) {
+	storer, err := storageUnit.NewStorageUnitFromConf(storageUnit.CacheConfig{
+		Size: 10,
+		Type: storageUnit.LRUCache,
+	}, storageUnit.DBConfig{
+		FilePath:             "CompressedBlocks",
+		Type:                 storageUnit.LvlDB,
+		MaxBatchSize:         1,
+		BatchDelaySeconds:    1,
+		MaxOpenFiles:         10,
+		CompressionThreshold: 16,
+	}, storageUnit.BloomConfig{})
+	assert.Nil(t, err, "no error expected but got %s", err)
+
+	key, val := []byte("key"), []byte(strings.Repeat("value", 100))
+	err = storer.Put(key, val)
+	assert.Nil(t, err)
+	storer.ClearCache()
+
+	recovered, err := storer.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+
+	err = storer.DestroyUnit()
+	assert.Nil(t, err, "no error expected destroying the persister")
+}
+
+func TestNewReadOnlyStorageUnitFromConf_WrongDBTypeShouldErr(t *testing.T) {
+	storer, err := storageUnit.NewReadOnlyStorageUnitFromConf(storageUnit.CacheConfig{
+		Size: 10,
+		Type: storageUnit.LRUCache,
+	}, storageUnit.DBConfig{
+		FilePath:     "Blocks",
+		Type:         storageUnit.BoltDB,
+		MaxOpenFiles: 10,
+	})
+
+	assert.Nil(t, storer)
+	assert.Equal(t, storage.ErrNotSupportedDBType, err)
+}
+
+func TestNewReadOnlyStorageUnitFromConf_ShouldReadExistingData(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "storage_unit_read_only")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	cacheConf := storageUnit.CacheConfig{
+		Size: 10,
+		Type: storageUnit.LRUCache,
+	}
+	dbConf := storageUnit.DBConfig{
+		FilePath:     filepath.Join(dir, "Blocks"),
+		Type:         storageUnit.LvlDbSerial,
+		MaxOpenFiles: 10,
+	}
+
+	key, val := []byte("key"), []byte("value")
+	ldb, _ := leveldb.NewSerialDB(dbConf.FilePath, 1, 1, 10)
+	_ = ldb.Put(key, val)
+	_ = ldb.Close()
+
+	storer, err := storageUnit.NewReadOnlyStorageUnitFromConf(cacheConf, dbConf)
+	assert.Nil(t, err)
+
+	recovered, err := storer.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+
+	err = storer.Put([]byte("key2"), val)
+	assert.Equal(t, storage.ErrReadOnlyPersister, err)
+
+	_ = storer.DestroyUnit()
+	_, err = os.Stat(dbConf.FilePath)
+	assert.Nil(t, err, "the data of a read only unit should not be removed")
+}
+
 func TestNewStorageUnit_FromConfBoltDBOk(t *testing.T) {
 	storer, err := storageUnit.NewStorageUnitFromConf(storageUnit.CacheConfig{
 		Size: 10,
@@ -737,6 +816,261 @@ func TestNewStorageUnit_WithConfigBloomFilterShouldCreateBloomFilterLvlDB(t *tes
 	assert.Nil(t, err, "no error expected destroying the persister")
 }
 
+func TestStorageUnit_MetricsShouldCountCacheHitsAndPersisterAccesses(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	s := initStorageUnitWithNilBloomFilter(t, 10)
+
+	err := s.Put(key, val)
+	assert.Nil(t, err)
+
+	_, err = s.Get(key)
+	assert.Nil(t, err)
+
+	s.ClearCache()
+	_, err = s.Get(key)
+	assert.Nil(t, err)
+
+	metrics := s.Metrics()
+	assert.Equal(t, uint64(1), metrics.CacheHits)
+	assert.Equal(t, uint64(1), metrics.CacheMisses)
+	assert.Equal(t, uint64(1), metrics.PersisterReads)
+	assert.Equal(t, uint64(1), metrics.PersisterWrites)
+	assert.Equal(t, uint64(0), metrics.BloomNegatives)
+	assert.Equal(t, uint64(0), metrics.BloomFalsePositives)
+}
+
+func TestStorageUnit_MetricsShouldCountBloomNegatives(t *testing.T) {
+	s := initStorageUnitWithBloomFilter(t, 10)
+
+	_, err := s.Get([]byte("missing key"))
+	assert.NotNil(t, err)
+
+	err = s.Has([]byte("missing key"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	metrics := s.Metrics()
+	assert.Equal(t, uint64(2), metrics.CacheMisses)
+	assert.Equal(t, uint64(2), metrics.BloomNegatives)
+	assert.Equal(t, uint64(0), metrics.PersisterReads)
+}
+
+func TestStorageUnit_MetricsShouldCountBloomFalsePositives(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	s := initStorageUnitWithBloomFilter(t, 10)
+
+	err := s.Put(key, val)
+	assert.Nil(t, err)
+
+	// removing from the unit does not clear the key from the bloom filter
+	err = s.Remove(key)
+	assert.Nil(t, err)
+
+	_, err = s.Get(key)
+	assert.NotNil(t, err)
+
+	metrics := s.Metrics()
+	assert.Equal(t, uint64(1), metrics.BloomFalsePositives)
+	assert.Equal(t, uint64(1), metrics.PersisterReads)
+}
+
+func TestStorageUnit_MetricsShouldNotCountPersisterErrorsAsBloomFalsePositives(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	dir, err := ioutil.TempDir("", "unit_metrics")
+	assert.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	ldb, _ := leveldb.NewDB(filepath.Join(dir, "levelDB"), 10, 10, 10)
+	cache, _ := lrucache.NewCache(10)
+	s, _ := storageUnit.NewStorageUnitWithBloomFilter(cache, ldb, bloom.NewDefaultFilter())
+
+	err = s.Put(key, val)
+	assert.Nil(t, err)
+
+	// the persister fails as it was closed, the key being still in the bloom filter
+	s.ClearCache()
+	err = s.Close()
+	assert.Nil(t, err)
+
+	_, err = s.Get(key)
+	assert.NotNil(t, err)
+	err = s.Has(key)
+	assert.NotNil(t, err)
+
+	metrics := s.Metrics()
+	assert.Equal(t, uint64(0), metrics.BloomFalsePositives)
+	assert.Equal(t, uint64(2), metrics.PersisterReads)
+}
+
+func TestUnitMetrics_AverageLatencyWithNoAccessesShouldBeZero(t *testing.T) {
+	metrics := storageUnit.UnitMetrics{}
+
+	assert.Equal(t, time.Duration(0), metrics.AverageReadLatency())
+	assert.Equal(t, time.Duration(0), metrics.AverageWriteLatency())
+}
+
+func TestUnitMetrics_AverageLatency(t *testing.T) {
+	metrics := storageUnit.UnitMetrics{
+		PersisterReads:         4,
+		PersisterReadDuration:  time.Second,
+		PersisterWrites:        2,
+		PersisterWriteDuration: time.Second,
+	}
+
+	assert.Equal(t, 250*time.Millisecond, metrics.AverageReadLatency())
+	assert.Equal(t, 500*time.Millisecond, metrics.AverageWriteLatency())
+}
+
+func createBloomSnapshotPath(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "bloom_snapshot")
+	assert.Nil(t, err)
+
+	return filepath.Join(dir, "unit.bloom"), func() {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestNewStorageUnitWithPersistentBloomFilter_NilBloomFilterShouldErr(t *testing.T) {
+	cache, _ := lrucache.NewCache(10)
+	mdb, _ := memorydb.New()
+
+	sUnit, err := storageUnit.NewStorageUnitWithPersistentBloomFilter(cache, mdb, nil, "path", time.Second)
+
+	assert.Nil(t, sUnit)
+	assert.Equal(t, storage.ErrNilBloomFilter, err)
+}
+
+func TestNewStorageUnitWithPersistentBloomFilter_EmptyPathShouldErr(t *testing.T) {
+	cache, _ := lrucache.NewCache(10)
+	mdb, _ := memorydb.New()
+
+	sUnit, err := storageUnit.NewStorageUnitWithPersistentBloomFilter(cache, mdb, bloom.NewDefaultFilter(), "", time.Second)
+
+	assert.Nil(t, sUnit)
+	assert.Equal(t, storage.ErrEmptyBloomFilePath, err)
+}
+
+func TestNewStorageUnitWithPersistentBloomFilter_InvalidIntervalShouldErr(t *testing.T) {
+	cache, _ := lrucache.NewCache(10)
+	mdb, _ := memorydb.New()
+
+	sUnit, err := storageUnit.NewStorageUnitWithPersistentBloomFilter(cache, mdb, bloom.NewDefaultFilter(), "path", 0)
+
+	assert.Nil(t, sUnit)
+	assert.Equal(t, storage.ErrInvalidBloomPersistInterval, err)
+}
+
+func TestNewStorageUnitWithPersistentBloomFilter_ShouldRebuildFromPersister(t *testing.T) {
+	cache, _ := lrucache.NewCache(10)
+	mdb, _ := memorydb.New()
+	_ = mdb.Put([]byte("key"), []byte("value"))
+	bloomPath, cleanup := createBloomSnapshotPath(t)
+	defer cleanup()
+
+	sUnit, err := storageUnit.NewStorageUnitWithPersistentBloomFilter(cache, mdb, bloom.NewDefaultFilter(), bloomPath, time.Hour)
+	assert.Nil(t, err)
+
+	value, err := sUnit.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	_, err = os.Stat(bloomPath)
+	assert.Nil(t, err)
+
+	_ = sUnit.DestroyUnit()
+}
+
+func TestNewStorageUnitWithPersistentBloomFilter_ShouldLoadSnapshot(t *testing.T) {
+	bloomPath, cleanup := createBloomSnapshotPath(t)
+	defer cleanup()
+	persistInterval := 10 * time.Millisecond
+
+	cache, _ := lrucache.NewCache(10)
+	mdb, _ := memorydb.New()
+	sUnit, _ := storageUnit.NewStorageUnitWithPersistentBloomFilter(cache, mdb, bloom.NewDefaultFilter(), bloomPath, persistInterval)
+	_ = sUnit.Put([]byte("key"), []byte("value"))
+	time.Sleep(persistInterval * 10)
+
+	// an empty persister would produce an empty filter if it were rebuilt
+	emptyCache, _ := lrucache.NewCache(10)
+	emptyDb, _ := memorydb.New()
+	bf := bloom.NewDefaultFilter()
+	restartedUnit, err := storageUnit.NewStorageUnitWithPersistentBloomFilter(emptyCache, emptyDb, bf, bloomPath, time.Hour)
+
+	assert.Nil(t, err)
+	assert.True(t, bf.MayContain([]byte("key")))
+
+	_ = restartedUnit.Close()
+	_ = sUnit.DestroyUnit()
+}
+
+func TestNewStorageUnitWithPersistentBloomFilter_StaleSnapshotShouldRebuild(t *testing.T) {
+	bloomPath, cleanup := createBloomSnapshotPath(t)
+	defer cleanup()
+
+	cache, _ := lrucache.NewCache(10)
+	mdb, _ := memorydb.New()
+	sUnit, _ := storageUnit.NewStorageUnitWithPersistentBloomFilter(cache, mdb, bloom.NewDefaultFilter(), bloomPath, time.Hour)
+	// the snapshot was written at creation, this put is not in it
+	_ = sUnit.Put([]byte("key"), []byte("value"))
+
+	bf := bloom.NewDefaultFilter()
+	restartedCache, _ := lrucache.NewCache(10)
+	restartedUnit, err := storageUnit.NewStorageUnitWithPersistentBloomFilter(restartedCache, mdb, bf, bloomPath, time.Hour)
+
+	assert.Nil(t, err)
+	assert.True(t, bf.MayContain([]byte("key")))
+	value, err := restartedUnit.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	_ = restartedUnit.Close()
+	_ = sUnit.DestroyUnit()
+}
+
+func TestUnit_CloseShouldFlushBloomSnapshot(t *testing.T) {
+	bloomPath, cleanup := createBloomSnapshotPath(t)
+	defer cleanup()
+
+	cache, _ := lrucache.NewCache(10)
+	mdb, _ := memorydb.New()
+	sUnit, _ := storageUnit.NewStorageUnitWithPersistentBloomFilter(cache, mdb, bloom.NewDefaultFilter(), bloomPath, time.Hour)
+	_ = sUnit.Put([]byte("key"), []byte("value"))
+
+	err := sUnit.Close()
+	assert.Nil(t, err)
+
+	// the snapshot must be trusted on restart, so an empty persister must not be used to rebuild the filter
+	_, err = os.Stat(bloomPath + ".stale")
+	assert.True(t, os.IsNotExist(err))
+
+	bf := bloom.NewDefaultFilter()
+	emptyCache, _ := lrucache.NewCache(10)
+	emptyDb, _ := memorydb.New()
+	restartedUnit, err := storageUnit.NewStorageUnitWithPersistentBloomFilter(emptyCache, emptyDb, bf, bloomPath, time.Hour)
+
+	assert.Nil(t, err)
+	assert.True(t, bf.MayContain([]byte("key")))
+
+	_ = restartedUnit.Close()
+}
+
+func TestUnit_CloseTwiceShouldNotPanic(t *testing.T) {
+	bloomPath, cleanup := createBloomSnapshotPath(t)
+	defer cleanup()
+
+	cache, _ := lrucache.NewCache(10)
+	mdb, _ := memorydb.New()
+	sUnit, _ := storageUnit.NewStorageUnitWithPersistentBloomFilter(cache, mdb, bloom.NewDefaultFilter(), bloomPath, time.Hour)
+
+	assert.NotPanics(t, func() {
+		_ = sUnit.Close()
+		_ = sUnit.Close()
+		_ = sUnit.DestroyUnit()
+	})
+}
+
 func TestNewStorageUnit_WithConfigBloomFilterShouldCreateBloomFilterBoltDB(t *testing.T) {
 	storer, err := storageUnit.NewStorageUnitFromConf(storageUnit.CacheConfig{
 		Size: 10,
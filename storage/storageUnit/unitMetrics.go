@@ -0,0 +1,88 @@
+package storageUnit
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// UnitMetrics holds a snapshot of the counters gathered by a storage unit since its creation
+type UnitMetrics struct {
+	CacheHits              uint64
+	CacheMisses            uint64
+	BloomNegatives         uint64
+	BloomFalsePositives    uint64
+	PersisterReads         uint64
+	PersisterWrites        uint64
+	PersisterReadDuration  time.Duration
+	PersisterWriteDuration time.Duration
+}
+
+// AverageReadLatency returns the average duration of a persister read
+func (um UnitMetrics) AverageReadLatency() time.Duration {
+	if um.PersisterReads == 0 {
+		return 0
+	}
+
+	return um.PersisterReadDuration / time.Duration(um.PersisterReads)
+}
+
+// AverageWriteLatency returns the average duration of a persister write
+func (um UnitMetrics) AverageWriteLatency() time.Duration {
+	if um.PersisterWrites == 0 {
+		return 0
+	}
+
+	return um.PersisterWriteDuration / time.Duration(um.PersisterWrites)
+}
+
+// unitMetrics holds the counters of a storage unit. All the fields are accessed atomically as the unit's Has
+// calls run concurrently under the read lock and the snapshot is taken without locking the unit
+type unitMetrics struct {
+	cacheHits              uint64
+	cacheMisses            uint64
+	bloomNegatives         uint64
+	bloomFalsePositives    uint64
+	persisterReads         uint64
+	persisterWrites        uint64
+	persisterReadDuration  uint64
+	persisterWriteDuration uint64
+}
+
+func (um *unitMetrics) addCacheHit() {
+	atomic.AddUint64(&um.cacheHits, 1)
+}
+
+func (um *unitMetrics) addCacheMiss() {
+	atomic.AddUint64(&um.cacheMisses, 1)
+}
+
+func (um *unitMetrics) addBloomNegative() {
+	atomic.AddUint64(&um.bloomNegatives, 1)
+}
+
+func (um *unitMetrics) addBloomFalsePositive() {
+	atomic.AddUint64(&um.bloomFalsePositives, 1)
+}
+
+func (um *unitMetrics) addPersisterRead(duration time.Duration) {
+	atomic.AddUint64(&um.persisterReads, 1)
+	atomic.AddUint64(&um.persisterReadDuration, uint64(duration))
+}
+
+func (um *unitMetrics) addPersisterWrite(duration time.Duration) {
+	atomic.AddUint64(&um.persisterWrites, 1)
+	atomic.AddUint64(&um.persisterWriteDuration, uint64(duration))
+}
+
+func (um *unitMetrics) snapshot() UnitMetrics {
+	return UnitMetrics{
+		CacheHits:              atomic.LoadUint64(&um.cacheHits),
+		CacheMisses:            atomic.LoadUint64(&um.cacheMisses),
+		BloomNegatives:         atomic.LoadUint64(&um.bloomNegatives),
+		BloomFalsePositives:    atomic.LoadUint64(&um.bloomFalsePositives),
+		PersisterReads:         atomic.LoadUint64(&um.persisterReads),
+		PersisterWrites:        atomic.LoadUint64(&um.persisterWrites),
+		PersisterReadDuration:  time.Duration(atomic.LoadUint64(&um.persisterReadDuration)),
+		PersisterWriteDuration: time.Duration(atomic.LoadUint64(&um.persisterWriteDuration)),
+	}
+}
@@ -0,0 +1,158 @@
+package txcache
+
+import (
+	"container/heap"
+)
+
+// sendersHeap is a heap of the senders' transaction lists, ordered by the average gas price paid. The lowest paying
+// heap has at index 0 the sender evicted from first, the best paying heap the sender selected from first.
+// Each transaction list remembers its index in both heaps, so a sender can be updated or removed without searching
+type sendersHeap struct {
+	lists           []*txListForSender
+	bestPayingFirst bool
+}
+
+func newSendersHeap(bestPayingFirst bool) *sendersHeap {
+	return &sendersHeap{
+		lists:           make([]*txListForSender, 0),
+		bestPayingFirst: bestPayingFirst,
+	}
+}
+
+// Len returns the number of senders in the heap
+func (h *sendersHeap) Len() int {
+	return len(h.lists)
+}
+
+// Less ranks the senders by average gas price. Equal prices are ordered by sender to keep the eviction and the
+// selection deterministic
+func (h *sendersHeap) Less(i, j int) bool {
+	firstPrice := h.lists[i].averageGasPrice()
+	secondPrice := h.lists[j].averageGasPrice()
+	if firstPrice != secondPrice {
+		return (firstPrice < secondPrice) != h.bestPayingFirst
+	}
+
+	return h.lists[i].sender < h.lists[j].sender
+}
+
+// Swap swaps two senders, updating their indexes
+func (h *sendersHeap) Swap(i, j int) {
+	h.lists[i], h.lists[j] = h.lists[j], h.lists[i]
+	h.setIndex(h.lists[i], i)
+	h.setIndex(h.lists[j], j)
+}
+
+// Push appends a sender at the end of the heap. It must only be called through heap.Push
+func (h *sendersHeap) Push(x interface{}) {
+	txList := x.(*txListForSender)
+	h.setIndex(txList, len(h.lists))
+	h.lists = append(h.lists, txList)
+}
+
+// Pop removes the last sender of the heap. It must only be called through heap.Pop or heap.Remove
+func (h *sendersHeap) Pop() interface{} {
+	n := len(h.lists)
+	txList := h.lists[n-1]
+	h.lists[n-1] = nil
+	h.setIndex(txList, -1)
+	h.lists = h.lists[:n-1]
+
+	return txList
+}
+
+func (h *sendersHeap) setIndex(txList *txListForSender, index int) {
+	if h.bestPayingFirst {
+		txList.bestPayingIndex = index
+		return
+	}
+
+	txList.lowestPayingIndex = index
+}
+
+func (h *sendersHeap) indexOf(txList *txListForSender) int {
+	if h.bestPayingFirst {
+		return txList.bestPayingIndex
+	}
+
+	return txList.lowestPayingIndex
+}
+
+// first returns the sender at the top of the heap
+func (h *sendersHeap) first() *txListForSender {
+	if len(h.lists) == 0 {
+		return nil
+	}
+
+	return h.lists[0]
+}
+
+func (h *sendersHeap) add(txList *txListForSender) {
+	heap.Push(h, txList)
+}
+
+func (h *sendersHeap) update(txList *txListForSender) {
+	heap.Fix(h, h.indexOf(txList))
+}
+
+func (h *sendersHeap) remove(txList *txListForSender) {
+	heap.Remove(h, h.indexOf(txList))
+}
+
+// forEachInOrder calls the handler for the senders in heap order, without changing the heap, until the handler
+// returns false. Only the senders actually visited are ranked, so stopping early does not cost a full sort
+func (h *sendersHeap) forEachInOrder(handler func(txList *txListForSender) bool) {
+	if len(h.lists) == 0 {
+		return
+	}
+
+	walk := &sendersHeapWalk{senders: h, indexes: []int{0}}
+	for walk.Len() > 0 {
+		index := heap.Pop(walk).(int)
+		if !handler(h.lists[index]) {
+			return
+		}
+
+		for _, child := range []int{2*index + 1, 2*index + 2} {
+			if child < len(h.lists) {
+				heap.Push(walk, child)
+			}
+		}
+	}
+}
+
+// sendersHeapWalk holds the indexes of the senders which can be visited next while walking a senders heap in order.
+// A sender can only be visited after its parent, so the next one in order is always the top of the walk
+type sendersHeapWalk struct {
+	senders *sendersHeap
+	indexes []int
+}
+
+// Len returns the number of senders which can be visited next
+func (w *sendersHeapWalk) Len() int {
+	return len(w.indexes)
+}
+
+// Less ranks the senders as in the walked heap
+func (w *sendersHeapWalk) Less(i, j int) bool {
+	return w.senders.Less(w.indexes[i], w.indexes[j])
+}
+
+// Swap swaps two senders' indexes
+func (w *sendersHeapWalk) Swap(i, j int) {
+	w.indexes[i], w.indexes[j] = w.indexes[j], w.indexes[i]
+}
+
+// Push appends a sender's index. It must only be called through heap.Push
+func (w *sendersHeapWalk) Push(x interface{}) {
+	w.indexes = append(w.indexes, x.(int))
+}
+
+// Pop removes the last sender's index. It must only be called through heap.Pop
+func (w *sendersHeapWalk) Pop() interface{} {
+	n := len(w.indexes)
+	index := w.indexes[n-1]
+	w.indexes = w.indexes[:n-1]
+
+	return index
+}
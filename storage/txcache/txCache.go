@@ -0,0 +1,409 @@
+package txcache
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("storage/txcache")
+
+// TxCache is a cacher specialized for transactions. The transactions are grouped by sender, each group being kept
+// sorted by nonce, so the transactions to be included in a block can be selected without sorting the whole cache.
+// Values that are not transactions are accepted as well, as if sent by an anonymous sender paying no gas.
+// When the cache is full, the transactions with the highest nonces of the lowest paying senders are evicted. The senders
+// are kept in two heaps ordered by the average gas price, one for the eviction and one for the selection, and the entries
+// in a list ordered by insertion, so neither the eviction, the selection nor the removal of the oldest entry has to
+// scan or sort the whole cache.
+// A sender holds at most one transaction for each nonce: a new transaction with an already used nonce replaces the
+// old one only if its gas price is higher by at least minGasPriceIncreasePercent, otherwise it is dropped
+type TxCache struct {
+	mutTxs                     sync.RWMutex
+	txByKey                    map[string]*txEntry
+	txListBySender             map[string]*txListForSender
+	lowestPayingSenders        *sendersHeap
+	bestPayingSenders          *sendersHeap
+	entriesByInsertion         *list.List
+	maxSize                    int
+	maxTxsPerSender            int
+	minGasPriceIncreasePercent uint32
+	insertionCount             uint64
+
+	mutAddedDataHandlers sync.RWMutex
+	addedDataHandlers    []func(key []byte)
+}
+
+// NewTxCache creates a new transactions cache holding at most size transactions, of which at most
+// maxTxsPerSender can belong to the same sender. A pending transaction can be replaced by one having the same sender
+// and nonce if the gas price is increased by at least minGasPriceIncreasePercent
+func NewTxCache(size int, maxTxsPerSender int, minGasPriceIncreasePercent uint32) (*TxCache, error) {
+	if size < 1 {
+		return nil, storage.ErrCacheSizeInvalid
+	}
+	if maxTxsPerSender < 1 {
+		return nil, storage.ErrInvalidMaxTxsPerSender
+	}
+
+	return &TxCache{
+		txByKey:                    make(map[string]*txEntry),
+		txListBySender:             make(map[string]*txListForSender),
+		lowestPayingSenders:        newSendersHeap(false),
+		bestPayingSenders:          newSendersHeap(true),
+		entriesByInsertion:         list.New(),
+		maxSize:                    size,
+		maxTxsPerSender:            maxTxsPerSender,
+		minGasPriceIncreasePercent: minGasPriceIncreasePercent,
+		addedDataHandlers:          make([]func(key []byte), 0),
+	}, nil
+}
+
+// Clear is used to completely clear the cache.
+func (tc *TxCache) Clear() {
+	tc.mutTxs.Lock()
+	tc.txByKey = make(map[string]*txEntry)
+	tc.txListBySender = make(map[string]*txListForSender)
+	tc.lowestPayingSenders = newSendersHeap(false)
+	tc.bestPayingSenders = newSendersHeap(true)
+	tc.entriesByInsertion = list.New()
+	tc.mutTxs.Unlock()
+}
+
+// Put adds a value to the cache. Returns true if an eviction occurred.
+// A transaction not paying enough to replace the one having the same sender and nonce is not added
+func (tc *TxCache) Put(key []byte, value interface{}) (evicted bool) {
+	tc.mutTxs.Lock()
+	tc.removeNoLock(string(key))
+	added, evicted := tc.addNoLock(string(key), value)
+	tc.mutTxs.Unlock()
+
+	if added {
+		tc.callAddedDataHandlers(key)
+	}
+
+	return evicted
+}
+
+// Get looks up a key's value from the cache.
+func (tc *TxCache) Get(key []byte) (value interface{}, ok bool) {
+	return tc.Peek(key)
+}
+
+// Has checks if a key is in the cache.
+func (tc *TxCache) Has(key []byte) bool {
+	tc.mutTxs.RLock()
+	_, ok := tc.txByKey[string(key)]
+	tc.mutTxs.RUnlock()
+
+	return ok
+}
+
+// Peek returns the key value (or undefined if not found).
+func (tc *TxCache) Peek(key []byte) (value interface{}, ok bool) {
+	tc.mutTxs.RLock()
+	entry, ok := tc.txByKey[string(key)]
+	tc.mutTxs.RUnlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+// HasOrAdd checks if a key is in the cache and if not, adds the value.
+// Returns whether the value was not added and whether an eviction occurred.
+// A transaction not paying enough to replace the one having the same sender and nonce is not added
+func (tc *TxCache) HasOrAdd(key []byte, value interface{}) (ok, evicted bool) {
+	added := false
+
+	tc.mutTxs.Lock()
+	_, found := tc.txByKey[string(key)]
+	if !found {
+		added, evicted = tc.addNoLock(string(key), value)
+	}
+	tc.mutTxs.Unlock()
+
+	if added {
+		tc.callAddedDataHandlers(key)
+	}
+
+	return !added, evicted
+}
+
+// Remove removes the provided key from the cache.
+func (tc *TxCache) Remove(key []byte) {
+	tc.mutTxs.Lock()
+	tc.removeNoLock(string(key))
+	tc.mutTxs.Unlock()
+}
+
+// RemoveOldest removes the oldest item from the cache.
+func (tc *TxCache) RemoveOldest() {
+	tc.mutTxs.Lock()
+	defer tc.mutTxs.Unlock()
+
+	oldest := tc.entriesByInsertion.Front()
+	if oldest != nil {
+		tc.removeNoLock(oldest.Value.(*txEntry).key)
+	}
+}
+
+// Keys returns a slice of the keys in the cache, from oldest to newest.
+func (tc *TxCache) Keys() [][]byte {
+	tc.mutTxs.RLock()
+	defer tc.mutTxs.RUnlock()
+
+	keys := make([][]byte, 0, tc.entriesByInsertion.Len())
+	for element := tc.entriesByInsertion.Front(); element != nil; element = element.Next() {
+		keys = append(keys, []byte(element.Value.(*txEntry).key))
+	}
+
+	return keys
+}
+
+// Len returns the number of items in the cache.
+func (tc *TxCache) Len() int {
+	tc.mutTxs.RLock()
+	defer tc.mutTxs.RUnlock()
+
+	return len(tc.txByKey)
+}
+
+// MaxSize returns the maximum number of items which can be stored in the cache.
+func (tc *TxCache) MaxSize() int {
+	return tc.maxSize
+}
+
+// SelectTransactions returns at most numRequested transactions, together with their keys. The senders are taken in
+// the descending order of the average gas price they pay and the transactions of each sender are returned in
+// nonce order
+func (tc *TxCache) SelectTransactions(numRequested int) ([]data.TransactionHandler, [][]byte) {
+	tc.mutTxs.RLock()
+	defer tc.mutTxs.RUnlock()
+
+	txs := make([]data.TransactionHandler, 0, numRequested)
+	keys := make([][]byte, 0, numRequested)
+	tc.bestPayingSenders.forEachInOrder(func(txList *txListForSender) bool {
+		for _, entry := range txList.items {
+			if len(txs) >= numRequested {
+				return false
+			}
+
+			tx, ok := entry.value.(data.TransactionHandler)
+			if !ok {
+				continue
+			}
+
+			txs = append(txs, tx)
+			keys = append(keys, []byte(entry.key))
+		}
+
+		return len(txs) < numRequested
+	})
+
+	return txs, keys
+}
+
+// GetTransactionsBySenderAndNonces returns the pending transactions of the sender having the provided nonces.
+// The nonces for which no transaction is pending are skipped
+func (tc *TxCache) GetTransactionsBySenderAndNonces(sender []byte, nonces []uint64) []data.TransactionHandler {
+	tc.mutTxs.RLock()
+	defer tc.mutTxs.RUnlock()
+
+	txs := make([]data.TransactionHandler, 0, len(nonces))
+	if len(sender) == 0 {
+		return txs
+	}
+
+	txList, ok := tc.txListBySender[string(sender)]
+	if !ok {
+		return txs
+	}
+
+	for _, nonce := range nonces {
+		entry := txList.findByNonce(nonce)
+		if entry == nil {
+			continue
+		}
+
+		tx, isTx := entry.value.(data.TransactionHandler)
+		if !isTx {
+			continue
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return txs
+}
+
+// CanAddTransaction returns true if the transaction, stored under the provided key, would be accepted by the cache.
+// It returns false only if the sender already has a pending transaction with the same nonce and the new one does not
+// increase the gas price enough to replace it
+func (tc *TxCache) CanAddTransaction(key []byte, tx data.TransactionHandler) bool {
+	tc.mutTxs.RLock()
+	defer tc.mutTxs.RUnlock()
+
+	_, found := tc.txByKey[string(key)]
+	if found {
+		return true
+	}
+
+	entry := newTxEntry(string(key), tx, 0)
+	sameNonceEntry := tc.findSameNonceNoLock(entry)
+	if sameNonceEntry == nil {
+		return true
+	}
+
+	return tc.isReplacementAllowed(sameNonceEntry, entry)
+}
+
+// RegisterHandler registers a new handler to be called when a new data is added
+func (tc *TxCache) RegisterHandler(handler func(key []byte)) {
+	if handler == nil {
+		log.Error("attempt to register a nil handler to a cacher object")
+		return
+	}
+
+	tc.mutAddedDataHandlers.Lock()
+	tc.addedDataHandlers = append(tc.addedDataHandlers, handler)
+	tc.mutAddedDataHandlers.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tc *TxCache) IsInterfaceNil() bool {
+	if tc == nil {
+		return true
+	}
+	return false
+}
+
+// addNoLock adds the value, replacing the transaction with the same sender and nonce, and applies the per sender and
+// the total limits. It returns whether the value was added and whether a transaction, possibly the added one, was evicted
+func (tc *TxCache) addNoLock(key string, value interface{}) (bool, bool) {
+	entry := newTxEntry(key, value, tc.insertionCount)
+
+	evicted := false
+	sameNonceEntry := tc.findSameNonceNoLock(entry)
+	if sameNonceEntry != nil {
+		if !tc.isReplacementAllowed(sameNonceEntry, entry) {
+			log.Trace("transaction not added, gas price too low for replacement",
+				"nonce", entry.nonce,
+				"gas price", entry.gasPrice,
+				"pending gas price", sameNonceEntry.gasPrice,
+			)
+			return false, false
+		}
+
+		tc.removeNoLock(sameNonceEntry.key)
+		evicted = true
+	}
+
+	tc.insertionCount++
+
+	txList, ok := tc.txListBySender[entry.sender]
+	if !ok {
+		txList = newTxListForSender(entry.sender)
+		tc.txListBySender[entry.sender] = txList
+		tc.lowestPayingSenders.add(txList)
+		tc.bestPayingSenders.add(txList)
+	}
+
+	txList.add(entry)
+	tc.lowestPayingSenders.update(txList)
+	tc.bestPayingSenders.update(txList)
+	entry.element = tc.entriesByInsertion.PushBack(entry)
+	tc.txByKey[key] = entry
+
+	if txList.len() > tc.maxTxsPerSender {
+		tc.removeNoLock(txList.last().key)
+		evicted = true
+	}
+
+	for len(tc.txByKey) > tc.maxSize {
+		tc.evictFromLowestPayingSenderNoLock()
+		evicted = true
+	}
+
+	return true, evicted
+}
+
+// findSameNonceNoLock returns the pending transaction having the sender and the nonce of the provided entry.
+// Values that are not transactions are never replaced
+func (tc *TxCache) findSameNonceNoLock(entry *txEntry) *txEntry {
+	if len(entry.sender) == 0 {
+		return nil
+	}
+
+	txList, ok := tc.txListBySender[entry.sender]
+	if !ok {
+		return nil
+	}
+
+	return txList.findByNonce(entry.nonce)
+}
+
+func (tc *TxCache) isReplacementAllowed(pending *txEntry, candidate *txEntry) bool {
+	minGasPrice := pending.gasPrice + pending.gasPrice*uint64(tc.minGasPriceIncreasePercent)/100
+
+	return candidate.gasPrice > pending.gasPrice && candidate.gasPrice >= minGasPrice
+}
+
+func (tc *TxCache) evictFromLowestPayingSenderNoLock() {
+	lowestPaying := tc.lowestPayingSenders.first()
+	if lowestPaying == nil {
+		return
+	}
+
+	tc.removeNoLock(lowestPaying.last().key)
+}
+
+func (tc *TxCache) removeNoLock(key string) {
+	entry, ok := tc.txByKey[key]
+	if !ok {
+		return
+	}
+
+	delete(tc.txByKey, key)
+	tc.entriesByInsertion.Remove(entry.element)
+
+	txList := tc.txListBySender[entry.sender]
+	txList.remove(entry)
+	if txList.len() == 0 {
+		delete(tc.txListBySender, entry.sender)
+		tc.lowestPayingSenders.remove(txList)
+		tc.bestPayingSenders.remove(txList)
+		return
+	}
+
+	tc.lowestPayingSenders.update(txList)
+	tc.bestPayingSenders.update(txList)
+}
+
+func (tc *TxCache) callAddedDataHandlers(key []byte) {
+	tc.mutAddedDataHandlers.RLock()
+	for _, handler := range tc.addedDataHandlers {
+		go handler(key)
+	}
+	tc.mutAddedDataHandlers.RUnlock()
+}
+
+func newTxEntry(key string, value interface{}, order uint64) *txEntry {
+	entry := &txEntry{
+		key:   key,
+		value: value,
+		order: order,
+	}
+
+	tx, ok := value.(data.TransactionHandler)
+	if ok && !tx.IsInterfaceNil() {
+		entry.sender = string(tx.GetSndAddress())
+		entry.nonce = tx.GetNonce()
+		entry.gasPrice = tx.GetGasPrice()
+	}
+
+	return entry
+}
@@ -0,0 +1,397 @@
+package txcache_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTx(sender string, nonce uint64, gasPrice uint64) *transaction.Transaction {
+	return &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+	}
+}
+
+func txKey(sender string, nonce uint64, gasPrice uint64) []byte {
+	return []byte(fmt.Sprintf("%s-%d-%d", sender, nonce, gasPrice))
+}
+
+func addTx(tc *txcache.TxCache, sender string, nonce uint64, gasPrice uint64) {
+	tc.Put(txKey(sender, nonce, gasPrice), createTx(sender, nonce, gasPrice))
+}
+
+func selectedNonces(txs []data.TransactionHandler) []string {
+	result := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		result = append(result, fmt.Sprintf("%s-%d", tx.GetSndAddress(), tx.GetNonce()))
+	}
+
+	return result
+}
+
+func TestNewTxCache_InvalidSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tc, err := txcache.NewTxCache(0, 10, 10)
+
+	assert.Nil(t, tc)
+	assert.Equal(t, storage.ErrCacheSizeInvalid, err)
+}
+
+func TestNewTxCache_InvalidMaxTxsPerSenderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tc, err := txcache.NewTxCache(10, 0, 10)
+
+	assert.Nil(t, tc)
+	assert.Equal(t, storage.ErrInvalidMaxTxsPerSender, err)
+}
+
+func TestTxCache_PutGetRemoveShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(10, 10, 10)
+	tx := createTx("alice", 1, 10)
+
+	evicted := tc.Put([]byte("key"), tx)
+	assert.False(t, evicted)
+	assert.True(t, tc.Has([]byte("key")))
+	assert.Equal(t, 1, tc.Len())
+
+	value, ok := tc.Get([]byte("key"))
+	assert.True(t, ok)
+	assert.Equal(t, tx, value)
+
+	tc.Remove([]byte("key"))
+	assert.False(t, tc.Has([]byte("key")))
+	assert.Equal(t, 0, tc.Len())
+
+	value, ok = tc.Peek([]byte("key"))
+	assert.False(t, ok)
+	assert.Nil(t, value)
+}
+
+func TestTxCache_PutExistingKeyShouldReplaceValue(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(10, 10, 10)
+	tc.Put([]byte("key"), createTx("alice", 1, 10))
+	tc.Put([]byte("key"), createTx("bob", 2, 20))
+
+	txs, _ := tc.SelectTransactions(10)
+
+	assert.Equal(t, 1, tc.Len())
+	assert.Equal(t, []string{"bob-2"}, selectedNonces(txs))
+}
+
+func TestTxCache_HasOrAddShouldNotOverwrite(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(10, 10, 10)
+	tx := createTx("alice", 1, 10)
+
+	found, _ := tc.HasOrAdd([]byte("key"), tx)
+	assert.False(t, found)
+
+	found, _ = tc.HasOrAdd([]byte("key"), createTx("alice", 2, 10))
+	assert.True(t, found)
+
+	value, _ := tc.Peek([]byte("key"))
+	assert.Equal(t, tx, value)
+}
+
+func TestTxCache_SelectTransactionsShouldOrderSendersByGasPriceAndTxsByNonce(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(100, 100, 10)
+	addTx(tc, "alice", 3, 10)
+	addTx(tc, "alice", 1, 10)
+	addTx(tc, "alice", 2, 10)
+	addTx(tc, "bob", 8, 50)
+	addTx(tc, "bob", 7, 50)
+	addTx(tc, "carol", 1, 30)
+
+	txs, keys := tc.SelectTransactions(100)
+
+	assert.Equal(t, []string{"bob-7", "bob-8", "carol-1", "alice-1", "alice-2", "alice-3"}, selectedNonces(txs))
+	assert.Equal(t, txKey("bob", 7, 50), keys[0])
+	assert.Equal(t, txKey("alice", 3, 10), keys[5])
+}
+
+func TestTxCache_SelectTransactionsShouldFollowSenderGasPriceChanges(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(100, 100, 10)
+	for i := 0; i < 20; i++ {
+		addTx(tc, fmt.Sprintf("sender%02d", i), 1, uint64(100+(i*7)%20))
+	}
+	// sender00's average gas price rises above all the others and sender19 leaves the cache
+	addTx(tc, "sender00", 2, 1000)
+	tc.Remove(txKey("sender19", 1, uint64(100+(19*7)%20)))
+
+	txs, _ := tc.SelectTransactions(100)
+
+	assert.Equal(t, 20, len(txs))
+	assert.Equal(t, []string{"sender00-1", "sender00-2"}, selectedNonces(txs[:2]))
+	for i := 2; i < len(txs)-1; i++ {
+		assert.True(t, txs[i].GetGasPrice() >= txs[i+1].GetGasPrice())
+	}
+	for _, tx := range txs {
+		assert.NotEqual(t, "sender19", string(tx.GetSndAddress()))
+	}
+
+	txs, _ = tc.SelectTransactions(3)
+	assert.Equal(t, []string{"sender00-1", "sender00-2", "sender17-1"}, selectedNonces(txs))
+}
+
+func TestTxCache_GetTransactionsBySenderAndNoncesShouldReturnOnlyPending(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(100, 100, 10)
+	addTx(tc, "alice", 1, 10)
+	addTx(tc, "alice", 3, 10)
+	addTx(tc, "alice", 4, 10)
+	addTx(tc, "bob", 2, 10)
+
+	txs := tc.GetTransactionsBySenderAndNonces([]byte("alice"), []uint64{2, 3, 4})
+	assert.Equal(t, []string{"alice-3", "alice-4"}, selectedNonces(txs))
+
+	txs = tc.GetTransactionsBySenderAndNonces([]byte("carol"), []uint64{1})
+	assert.Equal(t, 0, len(txs))
+
+	txs = tc.GetTransactionsBySenderAndNonces(nil, []uint64{1})
+	assert.Equal(t, 0, len(txs))
+}
+
+func TestTxCache_SelectTransactionsShouldReturnAtMostRequested(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(100, 100, 10)
+	addTx(tc, "alice", 1, 10)
+	addTx(tc, "alice", 2, 10)
+	addTx(tc, "bob", 1, 50)
+
+	txs, keys := tc.SelectTransactions(2)
+
+	assert.Equal(t, []string{"bob-1", "alice-1"}, selectedNonces(txs))
+	assert.Equal(t, 2, len(keys))
+}
+
+func TestTxCache_SameNonceWithHigherGasPriceShouldReplace(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(100, 100, 10)
+	addTx(tc, "alice", 1, 100)
+	evicted := tc.Put(txKey("alice", 1, 110), createTx("alice", 1, 110))
+
+	_, keys := tc.SelectTransactions(100)
+
+	assert.True(t, evicted)
+	assert.Equal(t, [][]byte{txKey("alice", 1, 110)}, keys)
+	assert.False(t, tc.Has(txKey("alice", 1, 100)))
+}
+
+func TestTxCache_SameNonceWithoutEnoughGasPriceIncreaseShouldNotAdd(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(100, 100, 10)
+	addTx(tc, "alice", 1, 100)
+	addTx(tc, "alice", 1, 109)
+	addTx(tc, "alice", 1, 90)
+	notAdded, _ := tc.HasOrAdd(txKey("alice", 1, 105), createTx("alice", 1, 105))
+
+	_, keys := tc.SelectTransactions(100)
+
+	assert.True(t, notAdded)
+	assert.Equal(t, [][]byte{txKey("alice", 1, 100)}, keys)
+}
+
+func TestTxCache_SameNonceWithZeroIncreasePercentShouldReplaceOnlyOnHigherGasPrice(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(100, 100, 0)
+	addTx(tc, "alice", 1, 100)
+	addTx(tc, "alice", 1, 100)
+	tc.Put([]byte("same price, other key"), createTx("alice", 1, 100))
+	assert.Equal(t, 1, tc.Len())
+
+	addTx(tc, "alice", 1, 101)
+	_, keys := tc.SelectTransactions(100)
+	assert.Equal(t, [][]byte{txKey("alice", 1, 101)}, keys)
+}
+
+func TestTxCache_CanAddTransaction(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(100, 100, 10)
+	addTx(tc, "alice", 1, 100)
+
+	assert.True(t, tc.CanAddTransaction(txKey("alice", 1, 100), createTx("alice", 1, 100)))
+	assert.True(t, tc.CanAddTransaction(txKey("alice", 2, 1), createTx("alice", 2, 1)))
+	assert.True(t, tc.CanAddTransaction(txKey("bob", 1, 1), createTx("bob", 1, 1)))
+	assert.True(t, tc.CanAddTransaction(txKey("alice", 1, 110), createTx("alice", 1, 110)))
+	assert.False(t, tc.CanAddTransaction(txKey("alice", 1, 109), createTx("alice", 1, 109)))
+}
+
+func TestTxCache_ReplacedTransactionShouldNotCallHandlerWhenRejected(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(100, 100, 10)
+	addTx(tc, "alice", 1, 100)
+
+	chCalled := make(chan struct{}, 1)
+	tc.RegisterHandler(func(key []byte) {
+		chCalled <- struct{}{}
+	})
+	addTx(tc, "alice", 1, 101)
+
+	select {
+	case <-chCalled:
+		assert.Fail(t, "handler should not have been called")
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+
+func TestTxCache_MaxTxsPerSenderShouldDropHighestNonces(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(100, 2, 10)
+	addTx(tc, "alice", 2, 10)
+	addTx(tc, "alice", 3, 10)
+
+	evicted := tc.Put(txKey("alice", 4, 10), createTx("alice", 4, 10))
+	assert.True(t, evicted)
+	assert.False(t, tc.Has(txKey("alice", 4, 10)))
+
+	evicted = tc.Put(txKey("alice", 1, 10), createTx("alice", 1, 10))
+	assert.True(t, evicted)
+	assert.True(t, tc.Has(txKey("alice", 1, 10)))
+	assert.False(t, tc.Has(txKey("alice", 3, 10)))
+	assert.Equal(t, 2, tc.Len())
+}
+
+func TestTxCache_FullCacheShouldEvictFromLowestPayingSender(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(3, 10, 10)
+	addTx(tc, "alice", 1, 10)
+	addTx(tc, "alice", 2, 10)
+	addTx(tc, "bob", 1, 50)
+
+	evicted := tc.Put(txKey("carol", 1, 30), createTx("carol", 1, 30))
+
+	assert.True(t, evicted)
+	assert.Equal(t, 3, tc.Len())
+	assert.False(t, tc.Has(txKey("alice", 2, 10)))
+	assert.True(t, tc.Has(txKey("alice", 1, 10)))
+	assert.True(t, tc.Has(txKey("carol", 1, 30)))
+}
+
+func TestTxCache_EvictionShouldFollowSenderGasPriceChanges(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(3, 10, 10)
+	addTx(tc, "alice", 1, 20)
+	addTx(tc, "bob", 1, 30)
+	// bob's average gas price drops to 16, below alice's
+	addTx(tc, "bob", 2, 2)
+
+	tc.Put(txKey("carol", 1, 100), createTx("carol", 1, 100))
+	assert.False(t, tc.Has(txKey("bob", 2, 2)))
+
+	// bob's average is back to 30, so alice is the lowest paying sender
+	tc.Put(txKey("dave", 1, 100), createTx("dave", 1, 100))
+	assert.False(t, tc.Has(txKey("alice", 1, 20)))
+
+	// alice has no transactions left and must not be chosen again
+	tc.Put(txKey("erin", 1, 100), createTx("erin", 1, 100))
+	assert.False(t, tc.Has(txKey("bob", 1, 30)))
+	assert.Equal(t, 3, tc.Len())
+	assert.True(t, tc.Has(txKey("carol", 1, 100)))
+	assert.True(t, tc.Has(txKey("dave", 1, 100)))
+	assert.True(t, tc.Has(txKey("erin", 1, 100)))
+}
+
+func TestTxCache_KeysAndRemoveOldestShouldUseInsertionOrder(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(10, 10, 10)
+	addTx(tc, "bob", 1, 50)
+	addTx(tc, "alice", 1, 10)
+	tc.Put([]byte("not a transaction"), "value")
+
+	assert.Equal(t, [][]byte{txKey("bob", 1, 50), txKey("alice", 1, 10), []byte("not a transaction")}, tc.Keys())
+
+	tc.RemoveOldest()
+	assert.Equal(t, [][]byte{txKey("alice", 1, 10), []byte("not a transaction")}, tc.Keys())
+
+	txs, _ := tc.SelectTransactions(10)
+	assert.Equal(t, []string{"alice-1"}, selectedNonces(txs))
+}
+
+func TestTxCache_ClearShouldEmptyTheCache(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(10, 10, 10)
+	addTx(tc, "alice", 1, 10)
+	tc.Clear()
+
+	txs, _ := tc.SelectTransactions(10)
+	assert.Equal(t, 0, tc.Len())
+	assert.Equal(t, 0, len(txs))
+}
+
+func TestTxCache_RegisteredHandlerShouldBeCalledOnAdd(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(10, 10, 10)
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	tc.RegisterHandler(func(key []byte) {
+		wg.Done()
+	})
+
+	_, _ = tc.HasOrAdd([]byte("key"), createTx("alice", 1, 10))
+
+	chDone := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(chDone)
+	}()
+
+	select {
+	case <-chDone:
+	case <-time.After(time.Second):
+		assert.Fail(t, "handler was not called")
+	}
+}
+
+func TestTxCache_ConcurrentAccessShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := txcache.NewTxCache(100, 10, 10)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(idx int) {
+			sender := fmt.Sprintf("sender%d", idx)
+			for nonce := uint64(0); nonce < 20; nonce++ {
+				addTx(tc, sender, nonce, uint64(idx))
+				_, _ = tc.SelectTransactions(10)
+			}
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 100, tc.Len())
+}
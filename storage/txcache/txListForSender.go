@@ -0,0 +1,105 @@
+package txcache
+
+import (
+	"container/list"
+	"sort"
+)
+
+// txEntry holds a cached transaction together with the fields used to index it
+type txEntry struct {
+	key      string
+	value    interface{}
+	sender   string
+	nonce    uint64
+	gasPrice uint64
+	order    uint64
+	element  *list.Element
+}
+
+// txListForSender keeps the transactions of one sender, sorted by nonce. Transactions with the same nonce are
+// sorted by gas price, the best paying first
+type txListForSender struct {
+	sender            string
+	items             []*txEntry
+	totalGasPrice     uint64
+	lowestPayingIndex int
+	bestPayingIndex   int
+}
+
+func newTxListForSender(sender string) *txListForSender {
+	return &txListForSender{
+		sender: sender,
+		items:  make([]*txEntry, 0),
+	}
+}
+
+func (l *txListForSender) add(entry *txEntry) {
+	index := sort.Search(len(l.items), func(i int) bool {
+		return isBefore(entry, l.items[i])
+	})
+
+	l.items = append(l.items, nil)
+	copy(l.items[index+1:], l.items[index:])
+	l.items[index] = entry
+	l.totalGasPrice += entry.gasPrice
+}
+
+func (l *txListForSender) remove(entry *txEntry) {
+	for i, item := range l.items {
+		if item != entry {
+			continue
+		}
+
+		copy(l.items[i:], l.items[i+1:])
+		l.items[len(l.items)-1] = nil
+		l.items = l.items[:len(l.items)-1]
+		l.totalGasPrice -= entry.gasPrice
+		return
+	}
+}
+
+// findByNonce returns the best paying transaction having the provided nonce
+func (l *txListForSender) findByNonce(nonce uint64) *txEntry {
+	index := sort.Search(len(l.items), func(i int) bool {
+		return l.items[i].nonce >= nonce
+	})
+
+	if index < len(l.items) && l.items[index].nonce == nonce {
+		return l.items[index]
+	}
+
+	return nil
+}
+
+// last returns the transaction with the highest nonce
+func (l *txListForSender) last() *txEntry {
+	if len(l.items) == 0 {
+		return nil
+	}
+
+	return l.items[len(l.items)-1]
+}
+
+func (l *txListForSender) len() int {
+	return len(l.items)
+}
+
+// averageGasPrice is the value used to rank the senders, both when selecting and when evicting transactions
+func (l *txListForSender) averageGasPrice() uint64 {
+	if len(l.items) == 0 {
+		return 0
+	}
+
+	return l.totalGasPrice / uint64(len(l.items))
+}
+
+func isBefore(first *txEntry, second *txEntry) bool {
+	if first.nonce != second.nonce {
+		return first.nonce < second.nonce
+	}
+	if first.gasPrice != second.gasPrice {
+		return first.gasPrice > second.gasPrice
+	}
+
+	return first.order < second.order
+}
@@ -46,3 +46,61 @@ var ErrNilEconomicsData = errors.New("nil economics data")
 
 // ErrNegativeInitialStakeValue signals that a negative initial stake value was provided
 var ErrNegativeInitialStakeValue = errors.New("initial stake value is negative")
+
+// ErrNilESDTSettings signals that nil fungible token settings have been provided
+var ErrNilESDTSettings = errors.New("nil esdt settings")
+
+// ErrNilBaseIssuingCost signals that a nil base issuing cost was provided
+var ErrNilBaseIssuingCost = errors.New("base issuing cost is nil")
+
+// ErrNegativeBaseIssuingCost signals that a negative or zero base issuing cost was provided
+var ErrNegativeBaseIssuingCost = errors.New("base issuing cost is not positive")
+
+// ErrOnlyOwnerCanCall signals that the function can be called only by the owner of the token
+var ErrOnlyOwnerCanCall = errors.New("only the owner can call this function")
+
+// ErrNoTokenWithGivenName signals that no token was issued under the given name
+var ErrNoTokenWithGivenName = errors.New("no token with given name")
+
+// ErrNilStakingSCAddress signals that a nil staking smart contract address was provided
+var ErrNilStakingSCAddress = errors.New("nil staking smart contract address")
+
+// ErrUnknownProvider signals that no staking provider was created by the given address
+var ErrUnknownProvider = errors.New("unknown staking provider")
+
+// ErrNilGovernanceSettings signals that nil governance settings have been provided
+var ErrNilGovernanceSettings = errors.New("nil governance settings")
+
+// ErrNilMinQuorum signals that a nil minimum quorum was provided
+var ErrNilMinQuorum = errors.New("min quorum is nil")
+
+// ErrNegativeMinQuorum signals that a negative or zero minimum quorum was provided
+var ErrNegativeMinQuorum = errors.New("min quorum is not positive")
+
+// ErrUnknownProposal signals that no governance proposal exists under the given identifier
+var ErrUnknownProposal = errors.New("unknown proposal")
+
+// ErrUnknownNode signals that the node is not registered in the staking smart contract
+var ErrUnknownNode = errors.New("unknown node")
+
+// ErrUnknownStaker signals that the address has no node registered in the staking smart contract
+var ErrUnknownStaker = errors.New("unknown staker")
+
+// ErrNilDoubleSigningVerifier signals that a nil double signing verifier was provided
+var ErrNilDoubleSigningVerifier = errors.New("nil double signing verifier")
+
+// ErrNilKeyGenerator signals that a nil key generator was provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilSingleSigner signals that a nil single signer was provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrInvalidDoubleSigningEvidence signals that the two messages do not prove that a validator signed two different
+// headers in the same round
+var ErrInvalidDoubleSigningEvidence = errors.New("invalid double signing evidence")
+
+// ErrEmptyBurnAddress signals that an empty burn address was provided
+var ErrEmptyBurnAddress = errors.New("empty burn address")
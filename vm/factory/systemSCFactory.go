@@ -1,20 +1,28 @@
 package factory
 
 import (
+	"encoding/hex"
+
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 )
 
 type systemSCFactory struct {
-	systemEI          vm.SystemEI
-	validatorSettings process.ValidatorSettingsHandler
+	systemEI              vm.SystemEI
+	validatorSettings     process.ValidatorSettingsHandler
+	esdtSettings          process.ESDTSettingsHandler
+	governanceSettings    process.GovernanceSettingsHandler
+	doubleSigningVerifier vm.DoubleSigningVerifier
 }
 
 // NewSystemSCFactory creates a factory which will instantiate the system smart contracts
 func NewSystemSCFactory(
 	systemEI vm.SystemEI,
 	validatorSettings process.ValidatorSettingsHandler,
+	esdtSettings process.ESDTSettingsHandler,
+	governanceSettings process.GovernanceSettingsHandler,
+	doubleSigningVerifier vm.DoubleSigningVerifier,
 ) (*systemSCFactory, error) {
 	if systemEI == nil || systemEI.IsInterfaceNil() {
 		return nil, vm.ErrNilSystemEnvironmentInterface
@@ -22,19 +30,39 @@ func NewSystemSCFactory(
 	if validatorSettings == nil || validatorSettings.IsInterfaceNil() {
 		return nil, vm.ErrNilEconomicsData
 	}
+	if esdtSettings == nil || esdtSettings.IsInterfaceNil() {
+		return nil, vm.ErrNilESDTSettings
+	}
+	if governanceSettings == nil || governanceSettings.IsInterfaceNil() {
+		return nil, vm.ErrNilGovernanceSettings
+	}
+	if doubleSigningVerifier == nil || doubleSigningVerifier.IsInterfaceNil() {
+		return nil, vm.ErrNilDoubleSigningVerifier
+	}
 
 	return &systemSCFactory{
-		systemEI:          systemEI,
-		validatorSettings: validatorSettings}, nil
+		systemEI:              systemEI,
+		validatorSettings:     validatorSettings,
+		esdtSettings:          esdtSettings,
+		governanceSettings:    governanceSettings,
+		doubleSigningVerifier: doubleSigningVerifier,
+	}, nil
 }
 
 // Create instantiates all the system smart contracts and returns a container
 func (scf *systemSCFactory) Create() (vm.SystemSCContainer, error) {
 	scContainer := NewSystemSCContainer()
 
+	burnAddress, err := hex.DecodeString(scf.validatorSettings.BurnAddress())
+	if err != nil {
+		return nil, err
+	}
+
 	sc, err := systemSmartContracts.NewStakingSmartContract(
 		scf.validatorSettings.StakeValue(),
 		scf.validatorSettings.UnBoundPeriod(),
+		burnAddress,
+		scf.doubleSigningVerifier,
 		scf.systemEI,
 	)
 	if err != nil {
@@ -46,6 +74,54 @@ func (scf *systemSCFactory) Create() (vm.SystemSCContainer, error) {
 		return nil, err
 	}
 
+	esdtSC, err := systemSmartContracts.NewESDTSmartContract(
+		scf.esdtSettings.BaseIssuingCost(),
+		scf.systemEI,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scContainer.Add(ESDTSCAddress, esdtSC)
+	if err != nil {
+		return nil, err
+	}
+
+	delegationSC, err := systemSmartContracts.NewDelegationSmartContract(
+		scf.validatorSettings.StakeValue(),
+		scf.validatorSettings.UnBoundPeriod(),
+		StakingSCAddress,
+		scf.systemEI,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scContainer.Add(DelegationSCAddress, delegationSC)
+	if err != nil {
+		return nil, err
+	}
+
+	governanceSC, err := systemSmartContracts.NewGovernanceSmartContract(
+		scf.governanceSettings.VotingPeriod(),
+		scf.governanceSettings.MinQuorum(),
+		StakingSCAddress,
+		scf.systemEI,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scContainer.Add(GovernanceSCAddress, governanceSC)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scf.systemEI.SetSystemSCContainer(scContainer)
+	if err != nil {
+		return nil, err
+	}
+
 	return scContainer, nil
 }
 
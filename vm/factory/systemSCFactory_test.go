@@ -12,7 +12,7 @@ import (
 func TestNewSystemSCFactory_NilSystemEI(t *testing.T) {
 	t.Parallel()
 
-	scFactory, err := NewSystemSCFactory(nil, &mock.ValidatorSettingsStub{})
+	scFactory, err := NewSystemSCFactory(nil, &mock.ValidatorSettingsStub{}, &mock.ESDTSettingsStub{}, &mock.GovernanceSettingsStub{}, &mock.DoubleSigningVerifierStub{})
 
 	assert.Nil(t, scFactory)
 	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
@@ -21,16 +21,43 @@ func TestNewSystemSCFactory_NilSystemEI(t *testing.T) {
 func TestNewSystemSCFactory_NilEconomicsData(t *testing.T) {
 	t.Parallel()
 
-	scFactory, err := NewSystemSCFactory(&mock.SystemEIStub{}, nil)
+	scFactory, err := NewSystemSCFactory(&mock.SystemEIStub{}, nil, &mock.ESDTSettingsStub{}, &mock.GovernanceSettingsStub{}, &mock.DoubleSigningVerifierStub{})
 
 	assert.Nil(t, scFactory)
 	assert.Equal(t, vm.ErrNilEconomicsData, err)
 }
 
+func TestNewSystemSCFactory_NilESDTSettings(t *testing.T) {
+	t.Parallel()
+
+	scFactory, err := NewSystemSCFactory(&mock.SystemEIStub{}, &mock.ValidatorSettingsStub{}, nil, &mock.GovernanceSettingsStub{}, &mock.DoubleSigningVerifierStub{})
+
+	assert.Nil(t, scFactory)
+	assert.Equal(t, vm.ErrNilESDTSettings, err)
+}
+
+func TestNewSystemSCFactory_NilGovernanceSettings(t *testing.T) {
+	t.Parallel()
+
+	scFactory, err := NewSystemSCFactory(&mock.SystemEIStub{}, &mock.ValidatorSettingsStub{}, &mock.ESDTSettingsStub{}, nil, &mock.DoubleSigningVerifierStub{})
+
+	assert.Nil(t, scFactory)
+	assert.Equal(t, vm.ErrNilGovernanceSettings, err)
+}
+
+func TestNewSystemSCFactory_NilDoubleSigningVerifier(t *testing.T) {
+	t.Parallel()
+
+	scFactory, err := NewSystemSCFactory(&mock.SystemEIStub{}, &mock.ValidatorSettingsStub{}, &mock.ESDTSettingsStub{}, &mock.GovernanceSettingsStub{}, nil)
+
+	assert.Nil(t, scFactory)
+	assert.Equal(t, vm.ErrNilDoubleSigningVerifier, err)
+}
+
 func TestNewSystemSCFactory_Ok(t *testing.T) {
 	t.Parallel()
 
-	scFactory, err := NewSystemSCFactory(&mock.SystemEIStub{}, &mock.ValidatorSettingsStub{})
+	scFactory, err := NewSystemSCFactory(&mock.SystemEIStub{}, &mock.ValidatorSettingsStub{}, &mock.ESDTSettingsStub{}, &mock.GovernanceSettingsStub{}, &mock.DoubleSigningVerifierStub{})
 
 	assert.Nil(t, err)
 	assert.NotNil(t, scFactory)
@@ -39,17 +66,17 @@ func TestNewSystemSCFactory_Ok(t *testing.T) {
 func TestSystemSCFactory_Create(t *testing.T) {
 	t.Parallel()
 
-	scFactory, _ := NewSystemSCFactory(&mock.SystemEIStub{}, &mock.ValidatorSettingsStub{})
+	scFactory, _ := NewSystemSCFactory(&mock.SystemEIStub{}, &mock.ValidatorSettingsStub{}, &mock.ESDTSettingsStub{}, &mock.GovernanceSettingsStub{}, &mock.DoubleSigningVerifierStub{})
 
 	container, err := scFactory.Create()
 	assert.Nil(t, err)
-	assert.Equal(t, 1, container.Len())
+	assert.Equal(t, 4, container.Len())
 }
 
 func TestSystemSCFactory_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
-	scFactory, _ := NewSystemSCFactory(&mock.SystemEIStub{}, &mock.ValidatorSettingsStub{})
+	scFactory, _ := NewSystemSCFactory(&mock.SystemEIStub{}, &mock.ValidatorSettingsStub{}, &mock.ESDTSettingsStub{}, &mock.GovernanceSettingsStub{}, &mock.DoubleSigningVerifierStub{})
 	assert.False(t, scFactory.IsInterfaceNil())
 
 	scFactory = nil
@@ -36,6 +36,7 @@ type SystemEI interface {
 	GetBalance(addr []byte) *big.Int
 	SetStorage(key []byte, value []byte)
 	GetStorage(key []byte) []byte
+	GetStorageFromAddress(address []byte, key []byte) []byte
 	SelfDestruct(beneficiary []byte)
 	Finish(value []byte)
 	BlockChainHook() vmcommon.BlockchainHook
@@ -47,6 +48,9 @@ type SystemEI interface {
 	AddCode(addr []byte, code []byte)
 	AddTxValueToSmartContract(value *big.Int, scAddress []byte)
 
+	ExecuteOnDestContext(destination []byte, sender []byte, value *big.Int, function string, arguments [][]byte) (vmcommon.ReturnCode, error)
+	SetSystemSCContainer(scContainer SystemSCContainer) error
+
 	IsInterfaceNil() bool
 }
 
@@ -59,3 +63,9 @@ type PeerChangesEI interface {
 	CreatePeerChangesOutput()
 	IsInterfaceNil() bool
 }
+
+// DoubleSigningVerifier verifies the evidence that a validator signed two different headers in the same round
+type DoubleSigningVerifier interface {
+	VerifyDoubleSigning(firstMessage []byte, secondMessage []byte) (blsPubKey []byte, round uint64, err error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,14 @@
+package mock
+
+import "math/big"
+
+type ESDTSettingsStub struct {
+}
+
+func (e *ESDTSettingsStub) BaseIssuingCost() *big.Int {
+	return big.NewInt(100)
+}
+
+func (e *ESDTSettingsStub) IsInterfaceNil() bool {
+	return e == nil
+}
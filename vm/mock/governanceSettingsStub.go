@@ -0,0 +1,18 @@
+package mock
+
+import "math/big"
+
+type GovernanceSettingsStub struct {
+}
+
+func (g *GovernanceSettingsStub) VotingPeriod() uint64 {
+	return 10
+}
+
+func (g *GovernanceSettingsStub) MinQuorum() *big.Int {
+	return big.NewInt(100)
+}
+
+func (g *GovernanceSettingsStub) IsInterfaceNil() bool {
+	return g == nil
+}
@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/vm"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -12,6 +13,7 @@ type SystemEIStub struct {
 	GetBalanceCalled                func(addr []byte) *big.Int
 	SetStorageCalled                func(key []byte, value []byte)
 	GetStorageCalled                func(key []byte) []byte
+	GetStorageFromAddressCalled     func(address []byte, key []byte) []byte
 	SelfDestructCalled              func(beneficiary []byte)
 	CreateVMOutputCalled            func() *vmcommon.VMOutput
 	CleanCacheCalled                func()
@@ -20,6 +22,8 @@ type SystemEIStub struct {
 	AddTxValueToSmartContractCalled func(value *big.Int, scAddress []byte)
 	BlockChainHookCalled            func() vmcommon.BlockchainHook
 	CryptoHookCalled                func() vmcommon.CryptoHook
+	ExecuteOnDestContextCalled      func(destination []byte, sender []byte, value *big.Int, function string, arguments [][]byte) (vmcommon.ReturnCode, error)
+	SetSystemSCContainerCalled      func(scContainer vm.SystemSCContainer) error
 }
 
 func (s *SystemEIStub) BlockChainHook() vmcommon.BlockchainHook {
@@ -84,6 +88,13 @@ func (s *SystemEIStub) GetStorage(key []byte) []byte {
 	return nil
 }
 
+func (s *SystemEIStub) GetStorageFromAddress(address []byte, key []byte) []byte {
+	if s.GetStorageFromAddressCalled != nil {
+		return s.GetStorageFromAddressCalled(address, key)
+	}
+	return nil
+}
+
 func (s *SystemEIStub) SelfDestruct(beneficiary []byte) {
 	if s.SelfDestructCalled != nil {
 		s.SelfDestructCalled(beneficiary)
@@ -106,6 +117,26 @@ func (s *SystemEIStub) CleanCache() {
 	return
 }
 
+func (s *SystemEIStub) ExecuteOnDestContext(
+	destination []byte,
+	sender []byte,
+	value *big.Int,
+	function string,
+	arguments [][]byte,
+) (vmcommon.ReturnCode, error) {
+	if s.ExecuteOnDestContextCalled != nil {
+		return s.ExecuteOnDestContextCalled(destination, sender, value, function, arguments)
+	}
+	return vmcommon.Ok, nil
+}
+
+func (s *SystemEIStub) SetSystemSCContainer(scContainer vm.SystemSCContainer) error {
+	if s.SetSystemSCContainerCalled != nil {
+		return s.SetSystemSCContainerCalled(scContainer)
+	}
+	return nil
+}
+
 func (s *SystemEIStub) IsInterfaceNil() bool {
 	if s == nil {
 		return true
@@ -0,0 +1,722 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const providerPrefix = "provider"
+const delegatorPrefix = "delegator"
+const nodePrefix = "node"
+
+// maxServiceFee is the service fee, in hundredths of a percent, which gives all the rewards to the provider
+const maxServiceFee = 10000
+
+// maxUnDelegations is the number of values a delegator can have in the unbond period at once, which bounds the
+// work of a withdraw call
+const maxUnDelegations = 20
+
+// rewardPrecision scales the reward per share, so small rewards spread over many shares are not lost
+var rewardPrecision = big.NewInt(0).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+// DelegationProvider holds the pooled funds of a staking provider and the nodes it staked with them. The active stake
+// is split in shares, so a slashed node of the provider lowers the value of its own delegators' shares only
+type DelegationProvider struct {
+	OwnerAddress     []byte   `json:"OwnerAddress"`
+	ServiceFee       uint64   `json:"ServiceFee"`
+	TotalActive      *big.Int `json:"TotalActive"`
+	TotalShares      *big.Int `json:"TotalShares"`
+	TotalUnDelegated *big.Int `json:"TotalUnDelegated"`
+	FreeFunds        *big.Int `json:"FreeFunds"`
+	RewardPerShare   *big.Int `json:"RewardPerShare"`
+	StakedNodes      [][]byte `json:"StakedNodes"`
+}
+
+// UnDelegation holds a value taken out of the active stake, which can be withdrawn after the unbond period
+type UnDelegation struct {
+	Value *big.Int `json:"Value"`
+	Nonce uint64   `json:"Nonce"`
+}
+
+// Delegator holds the shares of the active stake a user owns at a staking provider and the rewards they earned
+type Delegator struct {
+	Shares           *big.Int        `json:"Shares"`
+	RewardDebt       *big.Int        `json:"RewardDebt"`
+	UnclaimedRewards *big.Int        `json:"UnclaimedRewards"`
+	UnDelegated      []*UnDelegation `json:"UnDelegated"`
+}
+
+type delegation struct {
+	eei              vm.SystemEI
+	stakeValue       *big.Int
+	unBondPeriod     uint64
+	stakingSCAddress []byte
+}
+
+// NewDelegationSmartContract creates the smart contract through which many users pool their funds with staking
+// providers, which stake nodes in the staking smart contract once the pooled funds cover the node stake
+func NewDelegationSmartContract(
+	stakeValue *big.Int,
+	unBondPeriod uint64,
+	stakingSCAddress []byte,
+	eei vm.SystemEI,
+) (*delegation, error) {
+	if stakeValue == nil {
+		return nil, vm.ErrNilInitialStakeValue
+	}
+	if stakeValue.Cmp(big.NewInt(0)) < 1 {
+		return nil, vm.ErrNegativeInitialStakeValue
+	}
+	if len(stakingSCAddress) == 0 {
+		return nil, vm.ErrNilStakingSCAddress
+	}
+	if eei == nil || eei.IsInterfaceNil() {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+
+	return &delegation{
+		eei:              eei,
+		stakeValue:       big.NewInt(0).Set(stakeValue),
+		unBondPeriod:     unBondPeriod,
+		stakingSCAddress: stakingSCAddress,
+	}, nil
+}
+
+// Execute calls one of the functions from the delegation smart contract and runs the code according to the input
+func (d *delegation) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if CheckIfNil(args) != nil {
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case "_init":
+		return d.init(args)
+	case "createProvider":
+		return d.createProvider(args)
+	case "delegate":
+		return d.delegate(args)
+	case "unDelegate":
+		return d.unDelegate(args)
+	case "withdraw":
+		return d.withdraw(args)
+	case "stakeNodes":
+		return d.stakeNodes(args)
+	case "unStakeNodes":
+		return d.unStakeNodes(args)
+	case "unBondNodes":
+		return d.unBondNodes(args)
+	case "depositRewards":
+		return d.depositRewards(args)
+	case "claimRewards":
+		return d.claimRewards(args)
+	case "getProvider":
+		return d.getProviderData(args)
+	case "getDelegator":
+		return d.getDelegatorData(args)
+	}
+
+	return vmcommon.UserError
+}
+
+func (d *delegation) init(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	ownerAddress := d.eei.GetStorage([]byte(ownerKey))
+	if ownerAddress != nil {
+		log.Error("delegation smart contract was already initialized")
+		return vmcommon.UserError
+	}
+
+	d.eei.SetStorage([]byte(ownerKey), args.CallerAddr)
+	return vmcommon.Ok
+}
+
+// createProvider registers the caller as a staking provider which keeps the service fee, given in hundredths of a
+// percent, out of the rewards of its nodes
+func (d *delegation) createProvider(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 || len(args.Arguments) != 1 {
+		log.Debug("createProvider function called with wrong value or number of arguments")
+		return vmcommon.UserError
+	}
+
+	serviceFee := big.NewInt(0).SetBytes(args.Arguments[0])
+	if serviceFee.Cmp(big.NewInt(maxServiceFee)) > 0 {
+		log.Debug("createProvider function called with a service fee too high")
+		return vmcommon.UserError
+	}
+	if len(d.eei.GetStorage(providerKey(args.CallerAddr))) > 0 {
+		log.Debug("createProvider function called by an existing provider")
+		return vmcommon.UserError
+	}
+
+	provider := &DelegationProvider{
+		OwnerAddress:     args.CallerAddr,
+		ServiceFee:       serviceFee.Uint64(),
+		TotalActive:      big.NewInt(0),
+		TotalShares:      big.NewInt(0),
+		TotalUnDelegated: big.NewInt(0),
+		FreeFunds:        big.NewInt(0),
+		RewardPerShare:   big.NewInt(0),
+		StakedNodes:      make([][]byte, 0),
+	}
+	err := d.saveProvider(provider)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) delegate(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() <= 0 || len(args.Arguments) != 1 {
+		log.Debug("delegate function called with wrong value or number of arguments")
+		return vmcommon.UserError
+	}
+
+	provider, err := d.getProvider(args.Arguments[0])
+	if err != nil {
+		return vmcommon.UserError
+	}
+	delegator, err := d.getDelegator(provider.OwnerAddress, args.CallerAddr)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	if provider.TotalShares.Sign() > 0 && provider.TotalActive.Sign() == 0 {
+		log.Debug("delegate function called for a provider which lost all its active stake")
+		return vmcommon.UserError
+	}
+
+	shares := sharesOfValue(provider, args.CallValue)
+	if shares.Sign() == 0 {
+		log.Debug("delegate function called with a value worth less than one share")
+		return vmcommon.UserError
+	}
+
+	accrueRewards(provider, delegator)
+	delegator.Shares.Add(delegator.Shares, shares)
+	updateRewardDebt(provider, delegator)
+
+	provider.TotalShares.Add(provider.TotalShares, shares)
+	provider.TotalActive.Add(provider.TotalActive, args.CallValue)
+	provider.FreeFunds.Add(provider.FreeFunds, args.CallValue)
+
+	return d.saveProviderAndDelegator(provider, args.CallerAddr, delegator)
+}
+
+// unDelegate takes the value out of the caller's active stake. The value stops earning rewards and can be withdrawn
+// once the unbond period passes and the provider has enough funds which are not locked in nodes
+func (d *delegation) unDelegate(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 || len(args.Arguments) != 2 {
+		log.Debug("unDelegate function called with wrong value or number of arguments")
+		return vmcommon.UserError
+	}
+
+	provider, err := d.getProvider(args.Arguments[0])
+	if err != nil {
+		return vmcommon.UserError
+	}
+	delegator, err := d.getDelegator(provider.OwnerAddress, args.CallerAddr)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	value := big.NewInt(0).SetBytes(args.Arguments[1])
+	if value.Sign() <= 0 || value.Cmp(valueOfShares(provider, delegator.Shares)) > 0 {
+		log.Debug("unDelegate function called with invalid value")
+		return vmcommon.UserError
+	}
+	if len(delegator.UnDelegated) >= maxUnDelegations {
+		log.Debug("unDelegate function called with too many values in the unbond period, withdraw them first")
+		return vmcommon.UserError
+	}
+
+	// the shares are rounded up, so the rounding is paid by the delegator leaving and not by the ones staying
+	shares := big.NewInt(0).Mul(value, provider.TotalShares)
+	shares.Add(shares, provider.TotalActive)
+	shares.Sub(shares, big.NewInt(1))
+	shares.Div(shares, provider.TotalActive)
+
+	accrueRewards(provider, delegator)
+	delegator.Shares.Sub(delegator.Shares, shares)
+	updateRewardDebt(provider, delegator)
+	delegator.UnDelegated = append(delegator.UnDelegated, &UnDelegation{
+		Value: value,
+		Nonce: d.eei.BlockChainHook().CurrentNonce(),
+	})
+
+	provider.TotalShares.Sub(provider.TotalShares, shares)
+	provider.TotalActive.Sub(provider.TotalActive, value)
+	provider.TotalUnDelegated.Add(provider.TotalUnDelegated, value)
+
+	return d.saveProviderAndDelegator(provider, args.CallerAddr, delegator)
+}
+
+func (d *delegation) withdraw(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 || len(args.Arguments) != 1 {
+		log.Debug("withdraw function called with wrong value or number of arguments")
+		return vmcommon.UserError
+	}
+
+	provider, err := d.getProvider(args.Arguments[0])
+	if err != nil {
+		return vmcommon.UserError
+	}
+	delegator, err := d.getDelegator(provider.OwnerAddress, args.CallerAddr)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	currentNonce := d.eei.BlockChainHook().CurrentNonce()
+	withdrawValue := big.NewInt(0)
+	stillUnBonding := make([]*UnDelegation, 0, len(delegator.UnDelegated))
+	for _, unDelegation := range delegator.UnDelegated {
+		if currentNonce-unDelegation.Nonce < d.unBondPeriod {
+			stillUnBonding = append(stillUnBonding, unDelegation)
+			continue
+		}
+		withdrawValue.Add(withdrawValue, unDelegation.Value)
+	}
+
+	if withdrawValue.Sign() == 0 {
+		log.Debug("withdraw is not possible because the unbond period did not pass")
+		return vmcommon.UserError
+	}
+	if provider.FreeFunds.Cmp(withdrawValue) < 0 {
+		log.Debug("withdraw is not possible because the provider did not unbond enough nodes")
+		return vmcommon.UserError
+	}
+
+	delegator.UnDelegated = stillUnBonding
+	provider.FreeFunds.Sub(provider.FreeFunds, withdrawValue)
+	provider.TotalUnDelegated.Sub(provider.TotalUnDelegated, withdrawValue)
+
+	err = d.eei.Transfer(args.CallerAddr, args.RecipientAddr, withdrawValue, nil)
+	if err != nil {
+		log.Debug("transfer error on withdraw function",
+			"error", err.Error(),
+		)
+		return vmcommon.UserError
+	}
+
+	return d.saveProviderAndDelegator(provider, args.CallerAddr, delegator)
+}
+
+// stakeNodes stakes the given BLS keys in the staking smart contract with the provider's free funds. The delegation
+// smart contract is the owner of all the delegated nodes, so their rewards are paid to it, while it keeps which
+// provider each node belongs to
+func (d *delegation) stakeNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 || len(args.Arguments) == 0 {
+		log.Debug("stakeNodes function called with wrong value or number of arguments")
+		return vmcommon.UserError
+	}
+
+	provider, err := d.getProvider(args.CallerAddr)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	neededFunds := big.NewInt(0).Mul(d.stakeValue, big.NewInt(int64(len(args.Arguments))))
+	availableFunds := big.NewInt(0).Sub(provider.FreeFunds, provider.TotalUnDelegated)
+	if availableFunds.Cmp(neededFunds) < 0 {
+		log.Debug("stakeNodes function called without enough delegated funds")
+		return vmcommon.UserError
+	}
+
+	for _, blsKey := range args.Arguments {
+		if len(d.eei.GetStorage(nodeKey(blsKey))) > 0 {
+			log.Debug("stakeNodes function called with an already staked key")
+			return vmcommon.UserError
+		}
+
+		returnCode := d.callStakingSC(args.RecipientAddr, d.stakeValue, "stake", [][]byte{blsKey})
+		if returnCode != vmcommon.Ok {
+			return returnCode
+		}
+
+		d.eei.SetStorage(nodeKey(blsKey), provider.OwnerAddress)
+		provider.StakedNodes = append(provider.StakedNodes, blsKey)
+	}
+
+	provider.FreeFunds.Sub(provider.FreeFunds, neededFunds)
+	err = d.saveProvider(provider)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) unStakeNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 || len(args.Arguments) == 0 {
+		log.Debug("unStakeNodes function called with wrong value or number of arguments")
+		return vmcommon.UserError
+	}
+
+	for _, blsKey := range args.Arguments {
+		if !bytes.Equal(d.eei.GetStorage(nodeKey(blsKey)), args.CallerAddr) {
+			log.Debug("unStakeNodes function called for a node of another provider")
+			return vmcommon.UserError
+		}
+
+		returnCode := d.callStakingSC(args.RecipientAddr, big.NewInt(0), "unStake", [][]byte{blsKey})
+		if returnCode != vmcommon.Ok {
+			return returnCode
+		}
+	}
+
+	return vmcommon.Ok
+}
+
+// unBondNodes takes back the stake of the unstaked nodes once their unbond period passes, so the funds can be
+// withdrawn by the delegators or staked again. The stake a node lost by being slashed is charged to the provider's
+// own delegators, lowering the value of their shares
+func (d *delegation) unBondNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 || len(args.Arguments) == 0 {
+		log.Debug("unBondNodes function called with wrong value or number of arguments")
+		return vmcommon.UserError
+	}
+
+	provider, err := d.getProvider(args.CallerAddr)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	for _, blsKey := range args.Arguments {
+		if !bytes.Equal(d.eei.GetStorage(nodeKey(blsKey)), args.CallerAddr) {
+			log.Debug("unBondNodes function called for a node of another provider")
+			return vmcommon.UserError
+		}
+
+		stakeValue, err := d.getNodeStakeValue(blsKey)
+		if err != nil {
+			return vmcommon.UserError
+		}
+
+		// the staking smart contract gives the stake back to its owner, which is the delegation smart contract
+		returnCode := d.callStakingSC(args.RecipientAddr, big.NewInt(0), "unBound", [][]byte{blsKey})
+		if returnCode != vmcommon.Ok {
+			return returnCode
+		}
+
+		d.eei.SetStorage(nodeKey(blsKey), nil)
+		provider.StakedNodes = removeKey(provider.StakedNodes, blsKey)
+		provider.FreeFunds.Add(provider.FreeFunds, stakeValue)
+
+		slashedValue := big.NewInt(0).Sub(d.stakeValue, stakeValue)
+		if slashedValue.Sign() > 0 {
+			chargeSlashedValue(provider, slashedValue)
+		}
+	}
+
+	err = d.saveProvider(provider)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// depositRewards splits the rewards of the delegated node given by its BLS key between the provider running it and
+// its delegators: the service fee goes to the provider, the rest is shared in proportion to the shares. The protocol
+// rewards of the delegated nodes are paid through it by the shards, but anyone can add to the rewards of a node
+func (d *delegation) depositRewards(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() <= 0 || len(args.Arguments) != 1 {
+		log.Debug("depositRewards function called with wrong value or number of arguments")
+		return vmcommon.UserError
+	}
+
+	providerAddress := d.eei.GetStorage(nodeKey(args.Arguments[0]))
+	if len(providerAddress) == 0 {
+		log.Debug("depositRewards function called for a node which is not delegated")
+		return vmcommon.UserError
+	}
+
+	provider, err := d.getProvider(providerAddress)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	providerReward := big.NewInt(0).Mul(args.CallValue, big.NewInt(0).SetUint64(provider.ServiceFee))
+	providerReward.Div(providerReward, big.NewInt(maxServiceFee))
+	delegatorsReward := big.NewInt(0).Sub(args.CallValue, providerReward)
+
+	if provider.TotalShares.Sign() == 0 {
+		providerReward.Add(providerReward, delegatorsReward)
+	} else {
+		rewardPerShare := big.NewInt(0).Mul(delegatorsReward, rewardPrecision)
+		rewardPerShare.Div(rewardPerShare, provider.TotalShares)
+		provider.RewardPerShare.Add(provider.RewardPerShare, rewardPerShare)
+	}
+
+	err = d.eei.Transfer(provider.OwnerAddress, args.RecipientAddr, providerReward, nil)
+	if err != nil {
+		log.Debug("transfer error on depositRewards function",
+			"error", err.Error(),
+		)
+		return vmcommon.UserError
+	}
+
+	err = d.saveProvider(provider)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) claimRewards(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 || len(args.Arguments) != 1 {
+		log.Debug("claimRewards function called with wrong value or number of arguments")
+		return vmcommon.UserError
+	}
+
+	provider, err := d.getProvider(args.Arguments[0])
+	if err != nil {
+		return vmcommon.UserError
+	}
+	delegator, err := d.getDelegator(provider.OwnerAddress, args.CallerAddr)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	accrueRewards(provider, delegator)
+	updateRewardDebt(provider, delegator)
+	if delegator.UnclaimedRewards.Sign() == 0 {
+		log.Debug("claimRewards function called without rewards to claim")
+		return vmcommon.UserError
+	}
+
+	err = d.eei.Transfer(args.CallerAddr, args.RecipientAddr, delegator.UnclaimedRewards, nil)
+	if err != nil {
+		log.Debug("transfer error on claimRewards function",
+			"error", err.Error(),
+		)
+		return vmcommon.UserError
+	}
+	delegator.UnclaimedRewards = big.NewInt(0)
+
+	return d.saveProviderAndDelegator(provider, args.CallerAddr, delegator)
+}
+
+func (d *delegation) getProviderData(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 1 {
+		return vmcommon.UserError
+	}
+
+	data := d.eei.GetStorage(providerKey(args.Arguments[0]))
+	if len(data) == 0 {
+		return vmcommon.UserError
+	}
+
+	d.eei.Finish(data)
+	return vmcommon.Ok
+}
+
+func (d *delegation) getDelegatorData(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 2 {
+		return vmcommon.UserError
+	}
+
+	data := d.eei.GetStorage(delegatorKey(args.Arguments[0], args.Arguments[1]))
+	if len(data) == 0 {
+		return vmcommon.UserError
+	}
+
+	d.eei.Finish(data)
+	return vmcommon.Ok
+}
+
+func (d *delegation) callStakingSC(
+	scAddress []byte,
+	value *big.Int,
+	function string,
+	arguments [][]byte,
+) vmcommon.ReturnCode {
+	returnCode, err := d.eei.ExecuteOnDestContext(d.stakingSCAddress, scAddress, value, function, arguments)
+	if err != nil {
+		log.Debug("staking smart contract call error on delegation smart contract",
+			"function", function,
+			"error", err.Error(),
+		)
+		return vmcommon.UserError
+	}
+
+	return returnCode
+}
+
+func (d *delegation) getNodeStakeValue(blsKey []byte) (*big.Int, error) {
+	registrationData := &StakingData{}
+	err := json.Unmarshal(d.eei.GetStorageFromAddress(d.stakingSCAddress, blsKey), registrationData)
+	if err != nil {
+		log.Debug("unmarshal error on delegation smart contract",
+			"error", err.Error(),
+		)
+		return nil, err
+	}
+	if registrationData.StakeValue == nil {
+		return big.NewInt(0), nil
+	}
+
+	return registrationData.StakeValue, nil
+}
+
+// chargeSlashedValue takes the stake lost by a slashed node out of the provider's active stake. What the active stake
+// can not cover stays missing from the provider's free funds, delaying the withdrawals of its own delegators
+func chargeSlashedValue(provider *DelegationProvider, slashedValue *big.Int) {
+	if slashedValue.Cmp(provider.TotalActive) > 0 {
+		slashedValue = provider.TotalActive
+	}
+
+	provider.TotalActive.Sub(provider.TotalActive, slashedValue)
+}
+
+// sharesOfValue returns the shares of the active stake which are worth the given value
+func sharesOfValue(provider *DelegationProvider, value *big.Int) *big.Int {
+	if provider.TotalShares.Sign() == 0 {
+		return big.NewInt(0).Set(value)
+	}
+
+	shares := big.NewInt(0).Mul(value, provider.TotalShares)
+	return shares.Div(shares, provider.TotalActive)
+}
+
+// valueOfShares returns the value of the given shares of the active stake
+func valueOfShares(provider *DelegationProvider, shares *big.Int) *big.Int {
+	if provider.TotalShares.Sign() == 0 {
+		return big.NewInt(0)
+	}
+
+	value := big.NewInt(0).Mul(shares, provider.TotalActive)
+	return value.Div(value, provider.TotalShares)
+}
+
+// accrueRewards moves the rewards earned by the shares since the last update into the unclaimed rewards
+func accrueRewards(provider *DelegationProvider, delegator *Delegator) {
+	earned := big.NewInt(0).Mul(delegator.Shares, provider.RewardPerShare)
+	earned.Div(earned, rewardPrecision)
+	earned.Sub(earned, delegator.RewardDebt)
+	delegator.UnclaimedRewards.Add(delegator.UnclaimedRewards, earned)
+}
+
+// updateRewardDebt marks the rewards of the current shares as already accounted for
+func updateRewardDebt(provider *DelegationProvider, delegator *Delegator) {
+	delegator.RewardDebt = big.NewInt(0).Mul(delegator.Shares, provider.RewardPerShare)
+	delegator.RewardDebt.Div(delegator.RewardDebt, rewardPrecision)
+}
+
+func (d *delegation) getProvider(providerAddress []byte) (*DelegationProvider, error) {
+	data := d.eei.GetStorage(providerKey(providerAddress))
+	if len(data) == 0 {
+		log.Debug("delegation function called for an unknown provider")
+		return nil, vm.ErrUnknownProvider
+	}
+
+	provider := &DelegationProvider{}
+	err := json.Unmarshal(data, provider)
+	if err != nil {
+		log.Debug("unmarshal error on delegation smart contract",
+			"error", err.Error(),
+		)
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+func (d *delegation) getDelegator(providerAddress []byte, delegatorAddress []byte) (*Delegator, error) {
+	delegator := &Delegator{
+		Shares:           big.NewInt(0),
+		RewardDebt:       big.NewInt(0),
+		UnclaimedRewards: big.NewInt(0),
+		UnDelegated:      make([]*UnDelegation, 0),
+	}
+
+	data := d.eei.GetStorage(delegatorKey(providerAddress, delegatorAddress))
+	if len(data) == 0 {
+		return delegator, nil
+	}
+
+	err := json.Unmarshal(data, delegator)
+	if err != nil {
+		log.Debug("unmarshal error on delegation smart contract",
+			"error", err.Error(),
+		)
+		return nil, err
+	}
+
+	return delegator, nil
+}
+
+func (d *delegation) saveProvider(provider *DelegationProvider) error {
+	data, err := json.Marshal(provider)
+	if err != nil {
+		log.Debug("marshal error on delegation smart contract",
+			"error", err.Error(),
+		)
+		return err
+	}
+
+	d.eei.SetStorage(providerKey(provider.OwnerAddress), data)
+	return nil
+}
+
+func (d *delegation) saveProviderAndDelegator(
+	provider *DelegationProvider,
+	delegatorAddress []byte,
+	delegator *Delegator,
+) vmcommon.ReturnCode {
+	data, err := json.Marshal(delegator)
+	if err != nil {
+		log.Debug("marshal error on delegation smart contract",
+			"error", err.Error(),
+		)
+		return vmcommon.UserError
+	}
+
+	err = d.saveProvider(provider)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	d.eei.SetStorage(delegatorKey(provider.OwnerAddress, delegatorAddress), data)
+	return vmcommon.Ok
+}
+
+func providerKey(providerAddress []byte) []byte {
+	return append([]byte(providerPrefix), providerAddress...)
+}
+
+func delegatorKey(providerAddress []byte, delegatorAddress []byte) []byte {
+	key := append([]byte(delegatorPrefix), providerAddress...)
+	return append(key, delegatorAddress...)
+}
+
+func nodeKey(blsKey []byte) []byte {
+	return append([]byte(nodePrefix), blsKey...)
+}
+
+func removeKey(keys [][]byte, key []byte) [][]byte {
+	for i := range keys {
+		if bytes.Equal(keys[i], key) {
+			return append(keys[:i], keys[i+1:]...)
+		}
+	}
+
+	return keys
+}
+
+// ValueOf returns the value of a selected key
+func (d *delegation) ValueOf(_ interface{}) interface{} {
+	return nil
+}
+
+// IsInterfaceNil verifies if the underlying object is nil or not
+func (d *delegation) IsInterfaceNil() bool {
+	if d == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,440 @@
+package systemSmartContracts
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+var delegationSCAddress = []byte("delegation")
+var stakingSCAddress = []byte("staking")
+
+func createDelegationAndContext(stakeValue int64, unBondPeriod uint64, currentNonce *uint64) (*delegation, *vmContext) {
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return *currentNonce
+		},
+	}, hooks.NewVMCryptoHook())
+
+	stakingSC, _ := NewStakingSmartContract(big.NewInt(stakeValue), unBondPeriod, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
+	delegationSC, _ := NewDelegationSmartContract(big.NewInt(stakeValue), unBondPeriod, stakingSCAddress, eei)
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{
+		GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+			if string(key) == string(stakingSCAddress) {
+				return stakingSC, nil
+			}
+			return nil, vm.ErrUnknownSystemSmartContract
+		},
+	})
+
+	eei.SetSCAddress(stakingSCAddress)
+	eei.SetStorage([]byte(ownerKey), stakingSCAddress)
+	eei.SetStorage([]byte(initialStakeKey), big.NewInt(stakeValue).Bytes())
+	eei.SetSCAddress(delegationSCAddress)
+
+	return delegationSC, eei
+}
+
+func createDelegationCallInput(function string, caller string, value int64, arguments ...[]byte) *vmcommon.ContractCallInput {
+	input := CreateVmContractCallInput()
+	input.Function = function
+	input.CallerAddr = []byte(caller)
+	input.RecipientAddr = delegationSCAddress
+	input.CallValue = big.NewInt(value)
+	input.Arguments = arguments
+
+	return input
+}
+
+func getDelegatorState(eei *vmContext, provider string, delegatorAddress string) *Delegator {
+	delegator := &Delegator{}
+	_ = json.Unmarshal(eei.GetStorage(delegatorKey([]byte(provider), []byte(delegatorAddress))), delegator)
+	return delegator
+}
+
+func getProviderState(eei *vmContext, provider string) *DelegationProvider {
+	providerState := &DelegationProvider{}
+	_ = json.Unmarshal(eei.GetStorage(providerKey([]byte(provider))), providerState)
+	return providerState
+}
+
+func TestNewDelegationSmartContract_NilStakeValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	delegationSC, err := NewDelegationSmartContract(nil, 0, stakingSCAddress, &mock.SystemEIStub{})
+
+	assert.Nil(t, delegationSC)
+	assert.Equal(t, vm.ErrNilInitialStakeValue, err)
+}
+
+func TestNewDelegationSmartContract_NegativeStakeValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	delegationSC, err := NewDelegationSmartContract(big.NewInt(-1), 0, stakingSCAddress, &mock.SystemEIStub{})
+
+	assert.Nil(t, delegationSC)
+	assert.Equal(t, vm.ErrNegativeInitialStakeValue, err)
+}
+
+func TestNewDelegationSmartContract_NilStakingSCAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	delegationSC, err := NewDelegationSmartContract(big.NewInt(10), 0, nil, &mock.SystemEIStub{})
+
+	assert.Nil(t, delegationSC)
+	assert.Equal(t, vm.ErrNilStakingSCAddress, err)
+}
+
+func TestNewDelegationSmartContract_NilSystemEIShouldErr(t *testing.T) {
+	t.Parallel()
+
+	delegationSC, err := NewDelegationSmartContract(big.NewInt(10), 0, stakingSCAddress, nil)
+
+	assert.Nil(t, delegationSC)
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+}
+
+func TestNewDelegationSmartContract(t *testing.T) {
+	t.Parallel()
+
+	delegationSC, err := NewDelegationSmartContract(big.NewInt(10), 0, stakingSCAddress, &mock.SystemEIStub{})
+
+	assert.Nil(t, err)
+	assert.False(t, delegationSC.IsInterfaceNil())
+}
+
+func TestDelegation_CreateProviderServiceFeeTooHighShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	delegationSC, _ := createDelegationAndContext(100, 10, &nonce)
+
+	retCode := delegationSC.Execute(createDelegationCallInput("createProvider", "provider", 0, big.NewInt(maxServiceFee+1).Bytes()))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestDelegation_CreateProviderTwiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	delegationSC, _ := createDelegationAndContext(100, 10, &nonce)
+
+	retCode := delegationSC.Execute(createDelegationCallInput("createProvider", "provider", 0, big.NewInt(1000).Bytes()))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = delegationSC.Execute(createDelegationCallInput("createProvider", "provider", 0, big.NewInt(1000).Bytes()))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestDelegation_DelegateToUnknownProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	delegationSC, _ := createDelegationAndContext(100, 10, &nonce)
+
+	retCode := delegationSC.Execute(createDelegationCallInput("delegate", "user", 50, []byte("provider")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestDelegation_StakeNodesWithoutEnoughFundsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	delegationSC, _ := createDelegationAndContext(100, 10, &nonce)
+	_ = delegationSC.Execute(createDelegationCallInput("createProvider", "provider", 0, big.NewInt(1000).Bytes()))
+	_ = delegationSC.Execute(createDelegationCallInput("delegate", "user1", 60, []byte("provider")))
+	_ = delegationSC.Execute(createDelegationCallInput("delegate", "user2", 30, []byte("provider")))
+
+	retCode := delegationSC.Execute(createDelegationCallInput("stakeNodes", "provider", 0, []byte("blsKey1")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestDelegation_StakeNodesWithPooledFundsShouldStakeInStakingSC(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	delegationSC, eei := createDelegationAndContext(100, 10, &nonce)
+	_ = delegationSC.Execute(createDelegationCallInput("createProvider", "provider", 0, big.NewInt(1000).Bytes()))
+	_ = delegationSC.Execute(createDelegationCallInput("delegate", "user1", 120, []byte("provider")))
+	_ = delegationSC.Execute(createDelegationCallInput("delegate", "user2", 80, []byte("provider")))
+
+	retCode := delegationSC.Execute(createDelegationCallInput("stakeNodes", "provider", 0, []byte("blsKey1"), []byte("blsKey2")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	provider := getProviderState(eei, "provider")
+	assert.Equal(t, 2, len(provider.StakedNodes))
+	assert.Equal(t, big.NewInt(0), provider.FreeFunds)
+
+	eei.SetSCAddress(stakingSCAddress)
+	registrationData := &StakingData{}
+	_ = json.Unmarshal(eei.GetStorage([]byte("blsKey1")), registrationData)
+	assert.True(t, registrationData.Staked)
+	assert.Equal(t, []byte("blsKey1"), registrationData.BlsPubKey)
+	assert.Equal(t, delegationSCAddress, registrationData.OwnerAddress)
+	assert.Equal(t, delegationSCAddress, registrationData.RewardAddress)
+	eei.SetSCAddress(delegationSCAddress)
+
+	retCode = delegationSC.Execute(createDelegationCallInput("stakeNodes", "provider", 0, []byte("blsKey1")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestDelegation_UnStakeNodesOfOtherProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	delegationSC, _ := createDelegationAndContext(100, 10, &nonce)
+	_ = delegationSC.Execute(createDelegationCallInput("createProvider", "provider", 0, big.NewInt(1000).Bytes()))
+	_ = delegationSC.Execute(createDelegationCallInput("delegate", "user1", 100, []byte("provider")))
+	_ = delegationSC.Execute(createDelegationCallInput("stakeNodes", "provider", 0, []byte("blsKey1")))
+
+	retCode := delegationSC.Execute(createDelegationCallInput("unStakeNodes", "other", 0, []byte("blsKey1")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestDelegation_DepositRewardsShouldSplitWithServiceFee(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	delegationSC, eei := createDelegationAndContext(100, 10, &nonce)
+	_ = delegationSC.Execute(createDelegationCallInput("createProvider", "provider", 0, big.NewInt(1000).Bytes()))
+	_ = delegationSC.Execute(createDelegationCallInput("delegate", "user1", 150, []byte("provider")))
+	_ = delegationSC.Execute(createDelegationCallInput("delegate", "user2", 50, []byte("provider")))
+	_ = delegationSC.Execute(createDelegationCallInput("stakeNodes", "provider", 0, []byte("blsKey1")))
+
+	retCode := delegationSC.Execute(createDelegationCallInput("depositRewards", "anyone", 100, []byte("blsKey2")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = delegationSC.Execute(createDelegationCallInput("depositRewards", "anyone", 100, []byte("blsKey1")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, int64(10), eei.GetBalance([]byte("provider")).Int64())
+
+	retCode = delegationSC.Execute(createDelegationCallInput("claimRewards", "user1", 0, []byte("provider")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, int64(67), eei.GetBalance([]byte("user1")).Int64())
+
+	retCode = delegationSC.Execute(createDelegationCallInput("claimRewards", "user2", 0, []byte("provider")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, int64(22), eei.GetBalance([]byte("user2")).Int64())
+
+	retCode = delegationSC.Execute(createDelegationCallInput("claimRewards", "user2", 0, []byte("provider")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestDelegation_DelegateAfterRewardsShouldNotEarnPastRewards(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	delegationSC, eei := createDelegationAndContext(100, 10, &nonce)
+	_ = delegationSC.Execute(createDelegationCallInput("createProvider", "provider", 0, big.NewInt(0).Bytes()))
+	_ = delegationSC.Execute(createDelegationCallInput("delegate", "user1", 100, []byte("provider")))
+	_ = delegationSC.Execute(createDelegationCallInput("stakeNodes", "provider", 0, []byte("blsKey1")))
+	_ = delegationSC.Execute(createDelegationCallInput("depositRewards", "anyone", 100, []byte("blsKey1")))
+	_ = delegationSC.Execute(createDelegationCallInput("delegate", "user2", 100, []byte("provider")))
+
+	retCode := delegationSC.Execute(createDelegationCallInput("claimRewards", "user2", 0, []byte("provider")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = delegationSC.Execute(createDelegationCallInput("claimRewards", "user1", 0, []byte("provider")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, int64(100), eei.GetBalance([]byte("user1")).Int64())
+}
+
+func TestDelegation_WithdrawShouldRespectUnBondPeriod(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(5)
+	delegationSC, eei := createDelegationAndContext(100, 10, &nonce)
+	_ = delegationSC.Execute(createDelegationCallInput("createProvider", "provider", 0, big.NewInt(0).Bytes()))
+	_ = delegationSC.Execute(createDelegationCallInput("delegate", "user1", 60, []byte("provider")))
+
+	retCode := delegationSC.Execute(createDelegationCallInput("unDelegate", "user1", 0, []byte("provider"), big.NewInt(61).Bytes()))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = delegationSC.Execute(createDelegationCallInput("unDelegate", "user1", 0, []byte("provider"), big.NewInt(40).Bytes()))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	nonce = 14
+	retCode = delegationSC.Execute(createDelegationCallInput("withdraw", "user1", 0, []byte("provider")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	nonce = 15
+	retCode = delegationSC.Execute(createDelegationCallInput("withdraw", "user1", 0, []byte("provider")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, int64(40), eei.GetBalance([]byte("user1")).Int64())
+
+	delegator := getDelegatorState(eei, "provider", "user1")
+	assert.Equal(t, big.NewInt(20), delegator.Shares)
+	assert.Equal(t, 0, len(delegator.UnDelegated))
+}
+
+func TestDelegation_UnDelegateTooManyTimesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	delegationSC, _ := createDelegationAndContext(100, 10, &nonce)
+	_ = delegationSC.Execute(createDelegationCallInput("createProvider", "provider", 0, big.NewInt(0).Bytes()))
+	_ = delegationSC.Execute(createDelegationCallInput("delegate", "user1", 100, []byte("provider")))
+
+	for i := 0; i < maxUnDelegations; i++ {
+		retCode := delegationSC.Execute(createDelegationCallInput("unDelegate", "user1", 0, []byte("provider"), big.NewInt(1).Bytes()))
+		assert.Equal(t, vmcommon.Ok, retCode)
+	}
+
+	retCode := delegationSC.Execute(createDelegationCallInput("unDelegate", "user1", 0, []byte("provider"), big.NewInt(1).Bytes()))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	nonce = 10
+	retCode = delegationSC.Execute(createDelegationCallInput("withdraw", "user1", 0, []byte("provider")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = delegationSC.Execute(createDelegationCallInput("unDelegate", "user1", 0, []byte("provider"), big.NewInt(1).Bytes()))
+	assert.Equal(t, vmcommon.Ok, retCode)
+}
+
+func TestDelegation_WithdrawLockedInNodesShouldWaitForUnBondNodes(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	delegationSC, eei := createDelegationAndContext(100, 10, &nonce)
+	_ = delegationSC.Execute(createDelegationCallInput("createProvider", "provider", 0, big.NewInt(0).Bytes()))
+	_ = delegationSC.Execute(createDelegationCallInput("delegate", "user1", 100, []byte("provider")))
+	_ = delegationSC.Execute(createDelegationCallInput("stakeNodes", "provider", 0, []byte("blsKey1")))
+	_ = delegationSC.Execute(createDelegationCallInput("unDelegate", "user1", 0, []byte("provider"), big.NewInt(100).Bytes()))
+
+	nonce = 1
+	retCode := delegationSC.Execute(createDelegationCallInput("unStakeNodes", "provider", 0, []byte("blsKey1")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	nonce = 20
+	retCode = delegationSC.Execute(createDelegationCallInput("withdraw", "user1", 0, []byte("provider")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = delegationSC.Execute(createDelegationCallInput("unBondNodes", "provider", 0, []byte("blsKey1")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = delegationSC.Execute(createDelegationCallInput("withdraw", "user1", 0, []byte("provider")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	provider := getProviderState(eei, "provider")
+	assert.Equal(t, 0, len(provider.StakedNodes))
+	assert.Equal(t, big.NewInt(0), provider.FreeFunds)
+	assert.Equal(t, big.NewInt(0), provider.TotalUnDelegated)
+}
+
+func TestDelegation_UnBondSlashedNodeShouldChargeOnlyItsProvider(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	delegationSC, eei := createDelegationAndContext(100, 10, &nonce)
+	_ = delegationSC.Execute(createDelegationCallInput("createProvider", "provider1", 0, big.NewInt(0).Bytes()))
+	_ = delegationSC.Execute(createDelegationCallInput("createProvider", "provider2", 0, big.NewInt(0).Bytes()))
+	_ = delegationSC.Execute(createDelegationCallInput("delegate", "user1", 100, []byte("provider1")))
+	_ = delegationSC.Execute(createDelegationCallInput("delegate", "user2", 100, []byte("provider2")))
+	_ = delegationSC.Execute(createDelegationCallInput("stakeNodes", "provider1", 0, []byte("blsKey1")))
+	_ = delegationSC.Execute(createDelegationCallInput("stakeNodes", "provider2", 0, []byte("blsKey2")))
+
+	nonce = 1
+	// the node of the first provider is slashed as the staking smart contract does on a double signing report
+	eei.SetSCAddress(stakingSCAddress)
+	registrationData := &StakingData{}
+	_ = json.Unmarshal(eei.GetStorage([]byte("blsKey1")), registrationData)
+	registrationData.StakeValue = big.NewInt(40)
+	registrationData.Staked = false
+	registrationData.UnStakedNonce = nonce
+	marshaledData, _ := json.Marshal(registrationData)
+	eei.SetStorage([]byte("blsKey1"), marshaledData)
+	eei.SetSCAddress(delegationSCAddress)
+	_ = delegationSC.Execute(createDelegationCallInput("unStakeNodes", "provider2", 0, []byte("blsKey2")))
+
+	nonce = 20
+	retCode := delegationSC.Execute(createDelegationCallInput("unBondNodes", "provider1", 0, []byte("blsKey1")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	retCode = delegationSC.Execute(createDelegationCallInput("unBondNodes", "provider2", 0, []byte("blsKey2")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	provider1 := getProviderState(eei, "provider1")
+	assert.Equal(t, big.NewInt(40), provider1.FreeFunds)
+	assert.Equal(t, big.NewInt(40), provider1.TotalActive)
+	provider2 := getProviderState(eei, "provider2")
+	assert.Equal(t, big.NewInt(100), provider2.FreeFunds)
+	assert.Equal(t, big.NewInt(100), provider2.TotalActive)
+
+	retCode = delegationSC.Execute(createDelegationCallInput("delegate", "user3", 40, []byte("provider1")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, big.NewInt(100), getDelegatorState(eei, "provider1", "user3").Shares)
+
+	retCode = delegationSC.Execute(createDelegationCallInput("unDelegate", "user1", 0, []byte("provider1"), big.NewInt(41).Bytes()))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	retCode = delegationSC.Execute(createDelegationCallInput("unDelegate", "user1", 0, []byte("provider1"), big.NewInt(40).Bytes()))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	retCode = delegationSC.Execute(createDelegationCallInput("unDelegate", "user2", 0, []byte("provider2"), big.NewInt(100).Bytes()))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	nonce = 30
+	retCode = delegationSC.Execute(createDelegationCallInput("withdraw", "user1", 0, []byte("provider1")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, int64(40), eei.GetBalance([]byte("user1")).Int64())
+	retCode = delegationSC.Execute(createDelegationCallInput("withdraw", "user2", 0, []byte("provider2")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, int64(100), eei.GetBalance([]byte("user2")).Int64())
+}
+
+func TestDelegation_DelegateToProviderWhichLostAllActiveStakeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	delegationSC, eei := createDelegationAndContext(100, 10, &nonce)
+	_ = delegationSC.Execute(createDelegationCallInput("createProvider", "provider", 0, big.NewInt(0).Bytes()))
+	_ = delegationSC.Execute(createDelegationCallInput("delegate", "user1", 100, []byte("provider")))
+	_ = delegationSC.Execute(createDelegationCallInput("stakeNodes", "provider", 0, []byte("blsKey1")))
+
+	nonce = 1
+	eei.SetSCAddress(stakingSCAddress)
+	registrationData := &StakingData{}
+	_ = json.Unmarshal(eei.GetStorage([]byte("blsKey1")), registrationData)
+	registrationData.StakeValue = big.NewInt(0)
+	registrationData.Staked = false
+	registrationData.UnStakedNonce = nonce
+	marshaledData, _ := json.Marshal(registrationData)
+	eei.SetStorage([]byte("blsKey1"), marshaledData)
+	eei.SetSCAddress(delegationSCAddress)
+
+	nonce = 20
+	retCode := delegationSC.Execute(createDelegationCallInput("unBondNodes", "provider", 0, []byte("blsKey1")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, big.NewInt(0), getProviderState(eei, "provider").FreeFunds)
+
+	retCode = delegationSC.Execute(createDelegationCallInput("delegate", "user2", 100, []byte("provider")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = delegationSC.Execute(createDelegationCallInput("unDelegate", "user1", 0, []byte("provider"), big.NewInt(1).Bytes()))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestDelegation_GetProviderAndDelegator(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	delegationSC, eei := createDelegationAndContext(100, 10, &nonce)
+	_ = delegationSC.Execute(createDelegationCallInput("createProvider", "provider", 0, big.NewInt(0).Bytes()))
+	_ = delegationSC.Execute(createDelegationCallInput("delegate", "user1", 100, []byte("provider")))
+	eei.output = make([]byte, 0)
+
+	retCode := delegationSC.Execute(createDelegationCallInput("getProvider", "anyone", 0, []byte("provider")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, eei.GetStorage(providerKey([]byte("provider"))), eei.output)
+	eei.output = make([]byte, 0)
+
+	retCode = delegationSC.Execute(createDelegationCallInput("getDelegator", "anyone", 0, []byte("provider"), []byte("user1")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, eei.GetStorage(delegatorKey([]byte("provider"), []byte("user1"))), eei.output)
+
+	retCode = delegationSC.Execute(createDelegationCallInput("getDelegator", "anyone", 0, []byte("provider"), []byte("user2")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
@@ -0,0 +1,104 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+// doubleSigningVerifier checks double signing evidence made of two consensus messages, with the same keys and
+// signing scheme the consensus uses to verify them when they are received
+type doubleSigningVerifier struct {
+	keyGen       crypto.KeyGenerator
+	singleSigner crypto.SingleSigner
+	marshalizer  marshal.Marshalizer
+}
+
+// NewDoubleSigningVerifier creates a verifier for double signing evidence
+func NewDoubleSigningVerifier(
+	keyGen crypto.KeyGenerator,
+	singleSigner crypto.SingleSigner,
+	marshalizer marshal.Marshalizer,
+) (*doubleSigningVerifier, error) {
+	if keyGen == nil || keyGen.IsInterfaceNil() {
+		return nil, vm.ErrNilKeyGenerator
+	}
+	if singleSigner == nil || singleSigner.IsInterfaceNil() {
+		return nil, vm.ErrNilSingleSigner
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, vm.ErrNilMarshalizer
+	}
+
+	return &doubleSigningVerifier{
+		keyGen:       keyGen,
+		singleSigner: singleSigner,
+		marshalizer:  marshalizer,
+	}, nil
+}
+
+// VerifyDoubleSigning checks that both marshalized consensus messages are correctly signed by the same key, for the
+// same shard, round and message type, but for different headers. It returns the public key which signed both
+// messages and the round in which they were signed
+func (dsv *doubleSigningVerifier) VerifyDoubleSigning(firstMessage []byte, secondMessage []byte) ([]byte, uint64, error) {
+	first, err := dsv.verifyMessage(firstMessage)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	second, err := dsv.verifyMessage(secondMessage)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	isSameSigner := bytes.Equal(first.PubKey, second.PubKey)
+	isSameSlot := first.ShardId == second.ShardId && first.RoundIndex == second.RoundIndex &&
+		first.MsgType == second.MsgType
+	isDifferentHeader := !bytes.Equal(first.BlockHeaderHash, second.BlockHeaderHash)
+	if !isSameSigner || !isSameSlot || !isDifferentHeader || first.RoundIndex < 0 {
+		return nil, 0, vm.ErrInvalidDoubleSigningEvidence
+	}
+
+	return first.PubKey, uint64(first.RoundIndex), nil
+}
+
+func (dsv *doubleSigningVerifier) verifyMessage(buff []byte) (*consensus.Message, error) {
+	message := &consensus.Message{}
+	err := dsv.marshalizer.Unmarshal(message, buff)
+	if err != nil {
+		return nil, err
+	}
+	if len(message.PubKey) == 0 || len(message.Signature) == 0 || len(message.BlockHeaderHash) == 0 {
+		return nil, vm.ErrInvalidDoubleSigningEvidence
+	}
+
+	pubKey, err := dsv.keyGen.PublicKeyFromByteArray(message.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	messageNoSig := *message
+	messageNoSig.Signature = nil
+	buffNoSig, err := dsv.marshalizer.Marshal(messageNoSig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dsv.singleSigner.Verify(pubKey, buffNoSig, message.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsv *doubleSigningVerifier) IsInterfaceNil() bool {
+	if dsv == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package systemSmartContracts
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+)
+
+func createSignedConsensusMessage(
+	sk crypto.PrivateKey,
+	pk crypto.PublicKey,
+	headerHash string,
+	round int64,
+	msgType int,
+	shardId uint32,
+) []byte {
+	marshalizer := &marshal.JsonMarshalizer{}
+	pkBytes, _ := pk.ToByteArray()
+	message := consensus.Message{
+		BlockHeaderHash: []byte(headerHash),
+		PubKey:          pkBytes,
+		MsgType:         msgType,
+		RoundIndex:      round,
+		ShardId:         shardId,
+	}
+
+	buffNoSig, _ := marshalizer.Marshal(message)
+	message.Signature, _ = (&singlesig.SchnorrSigner{}).Sign(sk, buffNoSig)
+	buff, _ := marshalizer.Marshal(message)
+
+	return buff
+}
+
+func createDoubleSigningVerifier() (*doubleSigningVerifier, crypto.KeyGenerator) {
+	keyGen := signing.NewKeyGenerator(kyber.NewBlakeSHA256Ed25519())
+	verifier, _ := NewDoubleSigningVerifier(keyGen, &singlesig.SchnorrSigner{}, &marshal.JsonMarshalizer{})
+
+	return verifier, keyGen
+}
+
+func TestNewDoubleSigningVerifier_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(kyber.NewBlakeSHA256Ed25519())
+
+	verifier, err := NewDoubleSigningVerifier(nil, &singlesig.SchnorrSigner{}, &marshal.JsonMarshalizer{})
+	assert.Nil(t, verifier)
+	assert.Equal(t, vm.ErrNilKeyGenerator, err)
+
+	verifier, err = NewDoubleSigningVerifier(keyGen, nil, &marshal.JsonMarshalizer{})
+	assert.Nil(t, verifier)
+	assert.Equal(t, vm.ErrNilSingleSigner, err)
+
+	verifier, err = NewDoubleSigningVerifier(keyGen, &singlesig.SchnorrSigner{}, nil)
+	assert.Nil(t, verifier)
+	assert.Equal(t, vm.ErrNilMarshalizer, err)
+}
+
+func TestDoubleSigningVerifier_VerifyDoubleSigningShouldWork(t *testing.T) {
+	t.Parallel()
+
+	verifier, keyGen := createDoubleSigningVerifier()
+	sk, pk := keyGen.GeneratePair()
+	pkBytes, _ := pk.ToByteArray()
+
+	first := createSignedConsensusMessage(sk, pk, "header1", 10, 3, 0)
+	second := createSignedConsensusMessage(sk, pk, "header2", 10, 3, 0)
+
+	signer, round, err := verifier.VerifyDoubleSigning(first, second)
+	assert.Nil(t, err)
+	assert.Equal(t, pkBytes, signer)
+	assert.Equal(t, uint64(10), round)
+}
+
+func TestDoubleSigningVerifier_VerifyDoubleSigningNotConflictingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	verifier, keyGen := createDoubleSigningVerifier()
+	sk, pk := keyGen.GeneratePair()
+	otherSk, otherPk := keyGen.GeneratePair()
+
+	first := createSignedConsensusMessage(sk, pk, "header1", 10, 3, 0)
+
+	_, _, err := verifier.VerifyDoubleSigning(first, createSignedConsensusMessage(sk, pk, "header1", 10, 3, 0))
+	assert.Equal(t, vm.ErrInvalidDoubleSigningEvidence, err)
+
+	_, _, err = verifier.VerifyDoubleSigning(first, createSignedConsensusMessage(sk, pk, "header2", 11, 3, 0))
+	assert.Equal(t, vm.ErrInvalidDoubleSigningEvidence, err)
+
+	_, _, err = verifier.VerifyDoubleSigning(first, createSignedConsensusMessage(sk, pk, "header2", 10, 2, 0))
+	assert.Equal(t, vm.ErrInvalidDoubleSigningEvidence, err)
+
+	_, _, err = verifier.VerifyDoubleSigning(first, createSignedConsensusMessage(otherSk, otherPk, "header2", 10, 3, 0))
+	assert.Equal(t, vm.ErrInvalidDoubleSigningEvidence, err)
+
+	_, _, err = verifier.VerifyDoubleSigning(first, createSignedConsensusMessage(sk, pk, "header2", 10, 3, 1))
+	assert.Equal(t, vm.ErrInvalidDoubleSigningEvidence, err)
+}
+
+func TestDoubleSigningVerifier_VerifyDoubleSigningNegativeRoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	verifier, keyGen := createDoubleSigningVerifier()
+	sk, pk := keyGen.GeneratePair()
+
+	first := createSignedConsensusMessage(sk, pk, "header1", -1, 3, 0)
+	second := createSignedConsensusMessage(sk, pk, "header2", -1, 3, 0)
+
+	_, _, err := verifier.VerifyDoubleSigning(first, second)
+	assert.Equal(t, vm.ErrInvalidDoubleSigningEvidence, err)
+}
+
+func TestDoubleSigningVerifier_VerifyDoubleSigningWrongSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	verifier, keyGen := createDoubleSigningVerifier()
+	sk, pk := keyGen.GeneratePair()
+	otherSk, _ := keyGen.GeneratePair()
+
+	first := createSignedConsensusMessage(sk, pk, "header1", 10, 3, 0)
+	forged := createSignedConsensusMessage(otherSk, pk, "header2", 10, 3, 0)
+
+	_, _, err := verifier.VerifyDoubleSigning(first, forged)
+	assert.NotNil(t, err)
+}
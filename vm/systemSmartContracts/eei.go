@@ -1,8 +1,11 @@
 package systemSmartContracts
 
 import (
+	"bytes"
 	"math/big"
+	"sort"
 
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
@@ -11,15 +14,26 @@ type vmContext struct {
 	blockChainHook vmcommon.BlockchainHook
 	cryptoHook     vmcommon.CryptoHook
 	scAddress      []byte
+	scContainer    vm.SystemSCContainer
 
 	storageUpdate  map[string]map[string][]byte
 	outputAccounts map[string]*vmcommon.OutputAccount
+	dataTransfers  []*vmcommon.OutputAccount
 
 	output []byte
 
 	selfDestruct map[string][]byte
 }
 
+// vmContextSnapshot holds copies of everything a call can change in the vmContext, so a failed call can be undone
+type vmContextSnapshot struct {
+	storageUpdate  map[string]map[string][]byte
+	outputAccounts map[string]*vmcommon.OutputAccount
+	dataTransfers  []*vmcommon.OutputAccount
+	output         []byte
+	selfDestruct   map[string][]byte
+}
+
 // NewVMContext creates a context where smart contracts can run and write
 func NewVMContext(blockChainHook vmcommon.BlockchainHook, cryptoHook vmcommon.CryptoHook) (*vmContext, error) {
 	if blockChainHook == nil {
@@ -42,14 +56,19 @@ func (host *vmContext) SelfDestruct(beneficiary []byte) {
 
 // GetStorage get the values saved for a certain key
 func (host *vmContext) GetStorage(key []byte) []byte {
-	strAdr := string(host.scAddress)
+	return host.GetStorageFromAddress(host.scAddress, key)
+}
+
+// GetStorageFromAddress gets the value saved for a certain key in the storage of the given address
+func (host *vmContext) GetStorageFromAddress(address []byte, key []byte) []byte {
+	strAdr := string(address)
 	if _, ok := host.storageUpdate[strAdr]; ok {
 		if value, ok := host.storageUpdate[strAdr][string(key)]; ok {
 			return value
 		}
 	}
 
-	data, err := host.blockChainHook.GetStorageData(host.scAddress, key)
+	data, err := host.blockChainHook.GetStorageData(address, key)
 	if err != nil {
 		return nil
 	}
@@ -123,6 +142,22 @@ func (host *vmContext) Transfer(
 	_ = senderAcc.BalanceDelta.Sub(senderAcc.BalanceDelta, value)
 	_ = destAcc.BalanceDelta.Add(destAcc.BalanceDelta, value)
 
+	if len(input) == 0 {
+		return nil
+	}
+	if len(destAcc.Data) == 0 {
+		destAcc.Data = input
+		return nil
+	}
+
+	// the destination already receives a call, so this one goes in its own output entry, and thus in its own
+	// smart contract result, instead of being glued to the previous data
+	host.dataTransfers = append(host.dataTransfers, &vmcommon.OutputAccount{
+		Address:      destination,
+		BalanceDelta: big.NewInt(0),
+		Data:         input,
+	})
+
 	return nil
 }
 
@@ -146,6 +181,7 @@ func (host *vmContext) CleanCache() {
 	host.storageUpdate = make(map[string]map[string][]byte, 0)
 	host.selfDestruct = make(map[string][]byte)
 	host.outputAccounts = make(map[string]*vmcommon.OutputAccount, 0)
+	host.dataTransfers = make([]*vmcommon.OutputAccount, 0)
 	host.output = make([]byte, 0)
 }
 
@@ -167,6 +203,12 @@ func (host *vmContext) CreateVMOutput() *vmcommon.VMOutput {
 
 			outAccs[addr].StorageUpdates = append(outAccs[addr].StorageUpdates, storageUpdate)
 		}
+
+		// the storage updates end up in smart contract results, so their order has to be the same on every node
+		storageUpdates := outAccs[addr].StorageUpdates
+		sort.Slice(storageUpdates, func(i, j int) bool {
+			return bytes.Compare(storageUpdates[i].Offset, storageUpdates[j].Offset) < 0
+		})
 	}
 
 	// add balances
@@ -200,6 +242,11 @@ func (host *vmContext) CreateVMOutput() *vmcommon.VMOutput {
 	for _, outAcc := range outAccs {
 		vmOutput.OutputAccounts = append(vmOutput.OutputAccounts, outAcc)
 	}
+	sort.Slice(vmOutput.OutputAccounts, func(i, j int) bool {
+		return bytes.Compare(vmOutput.OutputAccounts[i].Address, vmOutput.OutputAccounts[j].Address) < 0
+	})
+	// the additional data transfers keep the order in which they were made
+	vmOutput.OutputAccounts = append(vmOutput.OutputAccounts, host.dataTransfers...)
 
 	vmOutput.GasRemaining = 0
 	vmOutput.GasRefund = big.NewInt(0)
@@ -246,6 +293,112 @@ func (host *vmContext) AddTxValueToSmartContract(value *big.Int, scAddress []byt
 	destAcc.BalanceDelta = big.NewInt(0).Add(destAcc.BalanceDelta, value)
 }
 
+// SetSystemSCContainer sets the container of the system smart contracts which can be called from the current context
+func (host *vmContext) SetSystemSCContainer(scContainer vm.SystemSCContainer) error {
+	if check.IfNil(scContainer) {
+		return vm.ErrNilSystemContractsContainer
+	}
+
+	host.scContainer = scContainer
+	return nil
+}
+
+// ExecuteOnDestContext calls a function of another system smart contract in the current context. The callee sees the
+// sender as its caller and receives the value from the calling smart contract, while its storage updates and
+// transfers become part of the output of the current call. If the callee fails, the value transfer and everything
+// it wrote are rolled back
+func (host *vmContext) ExecuteOnDestContext(
+	destination []byte,
+	sender []byte,
+	value *big.Int,
+	function string,
+	arguments [][]byte,
+) (vmcommon.ReturnCode, error) {
+	if check.IfNil(host.scContainer) {
+		return vmcommon.ContractNotFound, vm.ErrNilSystemContractsContainer
+	}
+
+	contract, err := host.scContainer.Get(destination)
+	if err != nil {
+		return vmcommon.ContractNotFound, vm.ErrUnknownSystemSmartContract
+	}
+
+	snapshot := host.createSnapshot()
+	err = host.Transfer(destination, host.scAddress, value, nil)
+	if err != nil {
+		host.revertToSnapshot(snapshot)
+		return vmcommon.UserError, err
+	}
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: sender,
+			Arguments:  arguments,
+			CallValue:  big.NewInt(0).Set(value),
+		},
+		RecipientAddr: destination,
+		Function:      function,
+	}
+
+	currentSCAddress := host.scAddress
+	host.scAddress = destination
+	returnCode := contract.Execute(input)
+	host.scAddress = currentSCAddress
+
+	if returnCode != vmcommon.Ok {
+		host.revertToSnapshot(snapshot)
+	}
+
+	return returnCode, nil
+}
+
+func (host *vmContext) createSnapshot() *vmContextSnapshot {
+	snapshot := &vmContextSnapshot{
+		storageUpdate:  make(map[string]map[string][]byte, len(host.storageUpdate)),
+		outputAccounts: make(map[string]*vmcommon.OutputAccount, len(host.outputAccounts)),
+		dataTransfers:  make([]*vmcommon.OutputAccount, len(host.dataTransfers)),
+		output:         append([]byte{}, host.output...),
+		selfDestruct:   make(map[string][]byte, len(host.selfDestruct)),
+	}
+
+	for addr, updates := range host.storageUpdate {
+		snapshot.storageUpdate[addr] = make(map[string][]byte, len(updates))
+		for key, value := range updates {
+			snapshot.storageUpdate[addr][key] = value
+		}
+	}
+	for addr, outAcc := range host.outputAccounts {
+		snapshot.outputAccounts[addr] = copyOutputAccount(outAcc)
+	}
+	copy(snapshot.dataTransfers, host.dataTransfers)
+	for addr, beneficiary := range host.selfDestruct {
+		snapshot.selfDestruct[addr] = beneficiary
+	}
+
+	return snapshot
+}
+
+func (host *vmContext) revertToSnapshot(snapshot *vmContextSnapshot) {
+	host.storageUpdate = snapshot.storageUpdate
+	host.outputAccounts = snapshot.outputAccounts
+	host.dataTransfers = snapshot.dataTransfers
+	host.output = snapshot.output
+	host.selfDestruct = snapshot.selfDestruct
+}
+
+// copyOutputAccount copies the output account together with its balances, which the transfers change in place
+func copyOutputAccount(outAcc *vmcommon.OutputAccount) *vmcommon.OutputAccount {
+	outAccCopy := *outAcc
+	if outAcc.Balance != nil {
+		outAccCopy.Balance = big.NewInt(0).Set(outAcc.Balance)
+	}
+	if outAcc.BalanceDelta != nil {
+		outAccCopy.BalanceDelta = big.NewInt(0).Set(outAcc.BalanceDelta)
+	}
+
+	return &outAccCopy
+}
+
 // IsInterfaceNil returns if the underlying implementation is nil
 func (host *vmContext) IsInterfaceNil() bool {
 	if host == nil {
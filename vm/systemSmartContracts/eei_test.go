@@ -3,6 +3,7 @@ package systemSmartContracts
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/vm/mock"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewVMContext_NilBlockChainHook(t *testing.T) {
@@ -127,6 +129,53 @@ func TestVmContext_SetStorage(t *testing.T) {
 	assert.True(t, bytes.Equal(vmOutput.OutputAccounts[0].StorageUpdates[0].Data, data))
 }
 
+func TestVmContext_GetStorageFromAddress(t *testing.T) {
+	t.Parallel()
+
+	otherAddress := []byte("other")
+	vmContext, _ := NewVMContext(&mock.BlockChainHookStub{
+		GetStorageDataCalled: func(accountsAddress []byte, index []byte) ([]byte, error) {
+			if bytes.Equal(accountsAddress, otherAddress) {
+				return []byte("committed"), nil
+			}
+			return nil, nil
+		},
+	}, hooks.NewVMCryptoHook())
+
+	res := vmContext.GetStorageFromAddress(otherAddress, []byte("key"))
+	assert.Equal(t, []byte("committed"), res)
+
+	vmContext.SetSCAddress(otherAddress)
+	vmContext.SetStorage([]byte("key"), []byte("updated"))
+	vmContext.SetSCAddress([]byte("current"))
+
+	res = vmContext.GetStorageFromAddress(otherAddress, []byte("key"))
+	assert.Equal(t, []byte("updated"), res)
+	assert.Nil(t, vmContext.GetStorage([]byte("key")))
+}
+
+func TestVmContext_CreateVMOutputShouldBeSorted(t *testing.T) {
+	t.Parallel()
+
+	vmContext, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook())
+	for _, address := range []string{"addr3", "addr1", "addr2"} {
+		vmContext.SetSCAddress([]byte(address))
+		for _, key := range []string{"key3", "key1", "key2"} {
+			vmContext.SetStorage([]byte(key), []byte("value"))
+		}
+	}
+
+	vmOutput := vmContext.CreateVMOutput()
+	assert.Equal(t, 3, len(vmOutput.OutputAccounts))
+	for i, outAcc := range vmOutput.OutputAccounts {
+		assert.Equal(t, []byte(fmt.Sprintf("addr%d", i+1)), outAcc.Address)
+		assert.Equal(t, 3, len(outAcc.StorageUpdates))
+		for j, storageUpdate := range outAcc.StorageUpdates {
+			assert.Equal(t, []byte(fmt.Sprintf("key%d", j+1)), storageUpdate.Offset)
+		}
+	}
+}
+
 func TestVmContext_Transfer(t *testing.T) {
 	t.Parallel()
 
@@ -148,4 +197,134 @@ func TestVmContext_Transfer(t *testing.T) {
 
 	vmOutput := vmContext.CreateVMOutput()
 	assert.Equal(t, 2, len(vmOutput.OutputAccounts))
+	for _, outAcc := range vmOutput.OutputAccounts {
+		if bytes.Equal(outAcc.Address, destination) {
+			assert.Equal(t, input, outAcc.Data)
+		}
+	}
+}
+
+func TestVmContext_TransfersWithDataToTheSameDestinationShouldNotConcatenate(t *testing.T) {
+	t.Parallel()
+
+	vmContext, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook())
+
+	destination := []byte("dest")
+	sender := []byte("sender")
+	firstInput := []byte("ESDTTransfer@aa@01")
+	secondInput := []byte("ESDTTransfer@bb@02")
+
+	_ = vmContext.Transfer(destination, sender, big.NewInt(10), firstInput)
+	_ = vmContext.Transfer(destination, sender, big.NewInt(20), secondInput)
+
+	vmOutput := vmContext.CreateVMOutput()
+	destOutAccs := make([]*vmcommon.OutputAccount, 0)
+	for _, outAcc := range vmOutput.OutputAccounts {
+		if bytes.Equal(outAcc.Address, destination) {
+			destOutAccs = append(destOutAccs, outAcc)
+		}
+	}
+
+	require.Equal(t, 2, len(destOutAccs))
+	assert.Equal(t, firstInput, destOutAccs[0].Data)
+	assert.Equal(t, big.NewInt(30), destOutAccs[0].BalanceDelta)
+	assert.Equal(t, secondInput, destOutAccs[1].Data)
+	assert.Equal(t, big.NewInt(0), destOutAccs[1].BalanceDelta)
+}
+
+func TestVmContext_SetSystemSCContainerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vmContext, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook())
+
+	err := vmContext.SetSystemSCContainer(nil)
+	assert.Equal(t, vm.ErrNilSystemContractsContainer, err)
+}
+
+func TestVmContext_ExecuteOnDestContextWithoutContainerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vmContext, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook())
+
+	_, err := vmContext.ExecuteOnDestContext([]byte("dest"), []byte("sender"), big.NewInt(0), "function", nil)
+	assert.Equal(t, vm.ErrNilSystemContractsContainer, err)
+}
+
+func TestVmContext_ExecuteOnDestContextShouldRunInDestinationStorage(t *testing.T) {
+	t.Parallel()
+
+	vmContext, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook())
+	caller := []byte("caller")
+	destination := []byte("dest")
+	vmContext.SetSCAddress(caller)
+
+	var receivedInput *vmcommon.ContractCallInput
+	destinationSC := &mock.SystemSCStub{
+		ExecuteCalled: func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+			receivedInput = args
+			vmContext.SetStorage([]byte("key"), []byte("value"))
+			return vmcommon.Ok
+		},
+	}
+	_ = vmContext.SetSystemSCContainer(&mock.SystemSCContainerStub{
+		GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+			return destinationSC, nil
+		},
+	})
+
+	returnCode, err := vmContext.ExecuteOnDestContext(destination, []byte("sender"), big.NewInt(10), "function", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, returnCode)
+	assert.Equal(t, []byte("sender"), receivedInput.CallerAddr)
+	assert.Equal(t, big.NewInt(10), receivedInput.CallValue)
+
+	assert.Nil(t, vmContext.GetStorage([]byte("key")))
+	vmContext.SetSCAddress(destination)
+	assert.Equal(t, []byte("value"), vmContext.GetStorage([]byte("key")))
+	assert.Equal(t, int64(10), vmContext.GetBalance(destination).Int64())
+	assert.Equal(t, int64(-10), vmContext.GetBalance(caller).Int64())
+}
+
+func TestVmContext_ExecuteOnDestContextFailedShouldRollBackTheCallee(t *testing.T) {
+	t.Parallel()
+
+	vmContext, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook())
+	caller := []byte("caller")
+	destination := []byte("dest")
+	vmContext.SetSCAddress(caller)
+	vmContext.SetStorage([]byte("key"), []byte("caller value"))
+	_ = vmContext.Transfer([]byte("user"), caller, big.NewInt(5), nil)
+
+	destinationSC := &mock.SystemSCStub{
+		ExecuteCalled: func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+			vmContext.SetStorage([]byte("key"), []byte("value"))
+			_ = vmContext.Transfer([]byte("user"), destination, big.NewInt(3), []byte("data"))
+			vmContext.Finish([]byte("output"))
+			return vmcommon.UserError
+		},
+	}
+	_ = vmContext.SetSystemSCContainer(&mock.SystemSCContainerStub{
+		GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+			return destinationSC, nil
+		},
+	})
+
+	returnCode, err := vmContext.ExecuteOnDestContext(destination, []byte("sender"), big.NewInt(10), "function", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+
+	assert.Equal(t, []byte("caller value"), vmContext.GetStorage([]byte("key")))
+	vmOutput := vmContext.CreateVMOutput()
+	require.Equal(t, 2, len(vmOutput.OutputAccounts))
+	for _, outAcc := range vmOutput.OutputAccounts {
+		assert.False(t, bytes.Equal(destination, outAcc.Address))
+		if bytes.Equal(caller, outAcc.Address) {
+			assert.Equal(t, big.NewInt(-5), outAcc.BalanceDelta)
+			assert.Equal(t, 1, len(outAcc.StorageUpdates))
+		} else {
+			assert.Equal(t, big.NewInt(5), outAcc.BalanceDelta)
+			assert.Equal(t, 0, len(outAcc.Data))
+		}
+	}
+	assert.Equal(t, 0, len(vmOutput.ReturnData))
 }
@@ -0,0 +1,330 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const minLengthForTokenName = 3
+const maxLengthForTokenName = 10
+
+// ESDTData holds the properties of an issued fungible token
+type ESDTData struct {
+	OwnerAddress []byte   `json:"OwnerAddress"`
+	TokenName    []byte   `json:"TokenName"`
+	MintedValue  *big.Int `json:"MintedValue"`
+	BurntValue   *big.Int `json:"BurntValue"`
+}
+
+type esdt struct {
+	eei             vm.SystemEI
+	baseIssuingCost *big.Int
+}
+
+// NewESDTSmartContract creates the smart contract which issues and manages the fungible tokens. The token balances
+// are kept by the protocol in the accounts' storage and are moved between shards through smart contract results
+func NewESDTSmartContract(baseIssuingCost *big.Int, eei vm.SystemEI) (*esdt, error) {
+	if baseIssuingCost == nil {
+		return nil, vm.ErrNilBaseIssuingCost
+	}
+	if baseIssuingCost.Cmp(big.NewInt(0)) < 1 {
+		return nil, vm.ErrNegativeBaseIssuingCost
+	}
+	if eei == nil || eei.IsInterfaceNil() {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+
+	return &esdt{
+		eei:             eei,
+		baseIssuingCost: big.NewInt(0).Set(baseIssuingCost),
+	}, nil
+}
+
+// Execute calls one of the functions from the esdt smart contract and runs the code according to the input
+func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if CheckIfNil(args) != nil {
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case "_init":
+		return e.init(args)
+	case "issue":
+		return e.issue(args)
+	case "mint":
+		return e.mint(args)
+	case core.BuiltInFunctionESDTTransfer:
+		return e.burn(args)
+	case "freeze":
+		return e.toggleFreeze(args, core.BuiltInFunctionESDTFreeze)
+	case "unFreeze":
+		return e.toggleFreeze(args, core.BuiltInFunctionESDTUnFreeze)
+	case "transferOwnership":
+		return e.transferOwnership(args)
+	case "getTokenProperties":
+		return e.getTokenProperties(args)
+	}
+
+	return vmcommon.UserError
+}
+
+func (e *esdt) init(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	ownerAddress := e.eei.GetStorage([]byte(ownerKey))
+	if ownerAddress != nil {
+		log.Error("esdt smart contract was already initialized")
+		return vmcommon.UserError
+	}
+
+	e.eei.SetStorage([]byte(ownerKey), args.CallerAddr)
+	return vmcommon.Ok
+}
+
+func (e *esdt) issue(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 2 {
+		log.Debug("issue function called with wrong number of arguments")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(e.baseIssuingCost) != 0 {
+		log.Debug("issue function called with a value different from the issuing cost")
+		return vmcommon.UserError
+	}
+
+	tokenName := args.Arguments[0]
+	if !isTokenNameValid(tokenName) {
+		log.Debug("issue function called with an invalid token name")
+		return vmcommon.UserError
+	}
+	if len(e.eei.GetStorage(tokenName)) > 0 {
+		log.Debug("issue function called for an already issued token")
+		return vmcommon.UserError
+	}
+
+	initialSupply := big.NewInt(0).SetBytes(args.Arguments[1])
+	if initialSupply.Sign() <= 0 {
+		log.Debug("issue function called with invalid initial supply")
+		return vmcommon.UserError
+	}
+
+	token := &ESDTData{
+		OwnerAddress: args.CallerAddr,
+		TokenName:    tokenName,
+		MintedValue:  initialSupply,
+		BurntValue:   big.NewInt(0),
+	}
+	err := e.saveToken(token)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	return e.sendTokens(args.CallerAddr, args.RecipientAddr, tokenName, initialSupply)
+}
+
+func (e *esdt) mint(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 2 {
+		log.Debug("mint function called with wrong number of arguments")
+		return vmcommon.UserError
+	}
+
+	token, err := e.getOwnedToken(args.Arguments[0], args.CallerAddr)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	mintValue := big.NewInt(0).SetBytes(args.Arguments[1])
+	if mintValue.Sign() <= 0 {
+		log.Debug("mint function called with invalid value")
+		return vmcommon.UserError
+	}
+
+	token.MintedValue.Add(token.MintedValue, mintValue)
+	err = e.saveToken(token)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	return e.sendTokens(args.CallerAddr, args.RecipientAddr, token.TokenName, mintValue)
+}
+
+// burn is called when tokens are transferred to the esdt smart contract. The tokens were already debited by the
+// sender's shard. Tokens of an unknown name are rejected, the protocol returning them to the sender
+func (e *esdt) burn(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 2 {
+		log.Debug("burn function called with wrong number of arguments")
+		return vmcommon.UserError
+	}
+
+	tokenName := args.Arguments[0]
+	burnValue := big.NewInt(0).SetBytes(args.Arguments[1])
+	token, err := e.getToken(tokenName)
+	if err != nil {
+		log.Debug("burn function called for an unknown token",
+			"token", tokenName,
+		)
+		return vmcommon.UserError
+	}
+
+	token.BurntValue.Add(token.BurntValue, burnValue)
+	err = e.saveToken(token)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (e *esdt) toggleFreeze(args *vmcommon.ContractCallInput, builtInFunction string) vmcommon.ReturnCode {
+	if len(args.Arguments) != 2 {
+		log.Debug("freeze function called with wrong number of arguments",
+			"function", builtInFunction,
+		)
+		return vmcommon.UserError
+	}
+
+	token, err := e.getOwnedToken(args.Arguments[0], args.CallerAddr)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	data := builtInFunction + "@" + hex.EncodeToString(token.TokenName)
+	err = e.eei.Transfer(args.Arguments[1], args.RecipientAddr, big.NewInt(0), []byte(data))
+	if err != nil {
+		log.Debug("transfer error in freeze function",
+			"error", err.Error(),
+		)
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (e *esdt) transferOwnership(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 2 {
+		log.Debug("transferOwnership function called with wrong number of arguments")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments[1]) == 0 {
+		log.Debug("transferOwnership function called with empty new owner")
+		return vmcommon.UserError
+	}
+
+	token, err := e.getOwnedToken(args.Arguments[0], args.CallerAddr)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	token.OwnerAddress = args.Arguments[1]
+	err = e.saveToken(token)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (e *esdt) getTokenProperties(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 1 {
+		return vmcommon.UserError
+	}
+
+	data := e.eei.GetStorage(args.Arguments[0])
+	if len(data) == 0 {
+		return vmcommon.UserError
+	}
+
+	e.eei.Finish(data)
+	return vmcommon.Ok
+}
+
+func (e *esdt) sendTokens(destination []byte, scAddress []byte, tokenName []byte, value *big.Int) vmcommon.ReturnCode {
+	data := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenName) + "@" + hex.EncodeToString(value.Bytes())
+	err := e.eei.Transfer(destination, scAddress, big.NewInt(0), []byte(data))
+	if err != nil {
+		log.Debug("transfer error on esdt smart contract",
+			"error", err.Error(),
+		)
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (e *esdt) getOwnedToken(tokenName []byte, caller []byte) (*ESDTData, error) {
+	token, err := e.getToken(tokenName)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(token.OwnerAddress, caller) {
+		log.Debug("esdt function called by not the owner of the token",
+			"token", tokenName,
+		)
+		return nil, vm.ErrOnlyOwnerCanCall
+	}
+
+	return token, nil
+}
+
+func (e *esdt) getToken(tokenName []byte) (*ESDTData, error) {
+	data := e.eei.GetStorage(tokenName)
+	if len(data) == 0 {
+		return nil, vm.ErrNoTokenWithGivenName
+	}
+
+	token := &ESDTData{}
+	err := json.Unmarshal(data, token)
+	if err != nil {
+		log.Debug("unmarshal error on esdt smart contract",
+			"error", err.Error(),
+		)
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (e *esdt) saveToken(token *ESDTData) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		log.Debug("marshal error on esdt smart contract",
+			"error", err.Error(),
+		)
+		return err
+	}
+
+	e.eei.SetStorage(token.TokenName, data)
+	return nil
+}
+
+func isTokenNameValid(tokenName []byte) bool {
+	if len(tokenName) < minLengthForTokenName || len(tokenName) > maxLengthForTokenName {
+		return false
+	}
+
+	for _, ch := range tokenName {
+		isUpperCaseLetter := ch >= 'A' && ch <= 'Z'
+		isDigit := ch >= '0' && ch <= '9'
+		if !isUpperCaseLetter && !isDigit {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValueOf returns the value of a selected key
+func (e *esdt) ValueOf(_ interface{}) interface{} {
+	return nil
+}
+
+// IsInterfaceNil verifies if the underlying object is nil or not
+func (e *esdt) IsInterfaceNil() bool {
+	if e == nil {
+		return true
+	}
+	return false
+}
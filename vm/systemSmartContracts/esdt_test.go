@@ -0,0 +1,288 @@
+package systemSmartContracts
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func createESDTAndContext(baseIssuingCost *big.Int) (*esdt, *vmContext) {
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook())
+	eei.SetSCAddress([]byte("esdt"))
+	esdtSC, _ := NewESDTSmartContract(baseIssuingCost, eei)
+
+	return esdtSC, eei
+}
+
+func createIssueCallInput(tokenName string, supply int64, value *big.Int) *vmcommon.ContractCallInput {
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "issue"
+	arguments.CallerAddr = []byte("owner")
+	arguments.RecipientAddr = []byte("esdt")
+	arguments.CallValue = value
+	arguments.Arguments = [][]byte{[]byte(tokenName), big.NewInt(supply).Bytes()}
+
+	return arguments
+}
+
+func getOutputData(eei *vmContext, address []byte) string {
+	vmOutput := eei.CreateVMOutput()
+	for _, outAcc := range vmOutput.OutputAccounts {
+		if string(outAcc.Address) == string(address) {
+			return string(outAcc.Data)
+		}
+	}
+
+	return ""
+}
+
+func TestNewESDTSmartContract_NilBaseIssuingCostShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, err := NewESDTSmartContract(nil, &mock.SystemEIStub{})
+
+	assert.Nil(t, esdtSC)
+	assert.Equal(t, vm.ErrNilBaseIssuingCost, err)
+}
+
+func TestNewESDTSmartContract_NegativeBaseIssuingCostShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, err := NewESDTSmartContract(big.NewInt(-1), &mock.SystemEIStub{})
+
+	assert.Nil(t, esdtSC)
+	assert.Equal(t, vm.ErrNegativeBaseIssuingCost, err)
+}
+
+func TestNewESDTSmartContract_NilSystemEIShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, err := NewESDTSmartContract(big.NewInt(10), nil)
+
+	assert.Nil(t, esdtSC)
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+}
+
+func TestNewESDTSmartContract(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, err := NewESDTSmartContract(big.NewInt(10), &mock.SystemEIStub{})
+
+	assert.Nil(t, err)
+	assert.False(t, esdtSC.IsInterfaceNil())
+}
+
+func TestESDT_ExecuteIssueWrongValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, _ := createESDTAndContext(big.NewInt(10))
+
+	retCode := esdtSC.Execute(createIssueCallInput("TOKEN", 1000, big.NewInt(9)))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestESDT_ExecuteIssueInvalidNameShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, _ := createESDTAndContext(big.NewInt(10))
+
+	invalidNames := []string{"", "AB", "TOOLONGTOKEN", "token", "TOK-EN"}
+	for _, name := range invalidNames {
+		retCode := esdtSC.Execute(createIssueCallInput(name, 1000, big.NewInt(10)))
+		assert.Equal(t, vmcommon.UserError, retCode)
+	}
+}
+
+func TestESDT_ExecuteIssueZeroSupplyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, _ := createESDTAndContext(big.NewInt(10))
+
+	retCode := esdtSC.Execute(createIssueCallInput("TOKEN", 0, big.NewInt(10)))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestESDT_ExecuteIssueShouldSaveTokenAndSendSupplyToOwner(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, eei := createESDTAndContext(big.NewInt(10))
+
+	retCode := esdtSC.Execute(createIssueCallInput("TOKEN", 1000, big.NewInt(10)))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	token := &ESDTData{}
+	_ = json.Unmarshal(eei.GetStorage([]byte("TOKEN")), token)
+	assert.Equal(t, []byte("owner"), token.OwnerAddress)
+	assert.Equal(t, big.NewInt(1000), token.MintedValue)
+
+	expectedData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString([]byte("TOKEN")) + "@" +
+		hex.EncodeToString(big.NewInt(1000).Bytes())
+	assert.Equal(t, expectedData, getOutputData(eei, []byte("owner")))
+}
+
+func TestESDT_ExecuteIssueTwiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, _ := createESDTAndContext(big.NewInt(10))
+
+	retCode := esdtSC.Execute(createIssueCallInput("TOKEN", 1000, big.NewInt(10)))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = esdtSC.Execute(createIssueCallInput("TOKEN", 1000, big.NewInt(10)))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestESDT_ExecuteMintNotOwnerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, _ := createESDTAndContext(big.NewInt(10))
+	_ = esdtSC.Execute(createIssueCallInput("TOKEN", 1000, big.NewInt(10)))
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "mint"
+	arguments.CallerAddr = []byte("other")
+	arguments.Arguments = [][]byte{[]byte("TOKEN"), big.NewInt(10).Bytes()}
+
+	retCode := esdtSC.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestESDT_ExecuteMintShouldIncreaseMintedValue(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, eei := createESDTAndContext(big.NewInt(10))
+	_ = esdtSC.Execute(createIssueCallInput("TOKEN", 1000, big.NewInt(10)))
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "mint"
+	arguments.CallerAddr = []byte("owner")
+	arguments.Arguments = [][]byte{[]byte("TOKEN"), big.NewInt(10).Bytes()}
+
+	retCode := esdtSC.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	token := &ESDTData{}
+	_ = json.Unmarshal(eei.GetStorage([]byte("TOKEN")), token)
+	assert.Equal(t, big.NewInt(1010), token.MintedValue)
+}
+
+func TestESDT_ExecuteTransferShouldBurnTokens(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, eei := createESDTAndContext(big.NewInt(10))
+	_ = esdtSC.Execute(createIssueCallInput("TOKEN", 1000, big.NewInt(10)))
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = core.BuiltInFunctionESDTTransfer
+	arguments.CallerAddr = []byte("holder")
+	arguments.RecipientAddr = []byte("esdt")
+	arguments.Arguments = [][]byte{[]byte("TOKEN"), big.NewInt(100).Bytes()}
+
+	retCode := esdtSC.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	token := &ESDTData{}
+	_ = json.Unmarshal(eei.GetStorage([]byte("TOKEN")), token)
+	assert.Equal(t, big.NewInt(100), token.BurntValue)
+	assert.Equal(t, "", getOutputData(eei, []byte("holder")))
+}
+
+func TestESDT_ExecuteTransferOfUnknownTokenShouldErrAndNotMint(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, eei := createESDTAndContext(big.NewInt(10))
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = core.BuiltInFunctionESDTTransfer
+	arguments.CallerAddr = []byte("holder")
+	arguments.RecipientAddr = []byte("esdt")
+	arguments.Arguments = [][]byte{[]byte("UNKNOWN"), big.NewInt(100).Bytes()}
+
+	retCode := esdtSC.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "", getOutputData(eei, []byte("holder")))
+	assert.Equal(t, 0, len(eei.GetStorage([]byte("UNKNOWN"))))
+}
+
+func TestESDT_ExecuteFreezeShouldSendFreezeToAddress(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, eei := createESDTAndContext(big.NewInt(10))
+	_ = esdtSC.Execute(createIssueCallInput("TOKEN", 1000, big.NewInt(10)))
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "freeze"
+	arguments.CallerAddr = []byte("owner")
+	arguments.RecipientAddr = []byte("esdt")
+	arguments.Arguments = [][]byte{[]byte("TOKEN"), []byte("holder")}
+
+	retCode := esdtSC.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	expectedData := core.BuiltInFunctionESDTFreeze + "@" + hex.EncodeToString([]byte("TOKEN"))
+	assert.Equal(t, expectedData, getOutputData(eei, []byte("holder")))
+}
+
+func TestESDT_ExecuteUnFreezeNotOwnerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, _ := createESDTAndContext(big.NewInt(10))
+	_ = esdtSC.Execute(createIssueCallInput("TOKEN", 1000, big.NewInt(10)))
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "unFreeze"
+	arguments.CallerAddr = []byte("holder")
+	arguments.Arguments = [][]byte{[]byte("TOKEN"), []byte("holder")}
+
+	retCode := esdtSC.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestESDT_ExecuteTransferOwnershipShouldChangeOwner(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, eei := createESDTAndContext(big.NewInt(10))
+	_ = esdtSC.Execute(createIssueCallInput("TOKEN", 1000, big.NewInt(10)))
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "transferOwnership"
+	arguments.CallerAddr = []byte("owner")
+	arguments.Arguments = [][]byte{[]byte("TOKEN"), []byte("newOwner")}
+
+	retCode := esdtSC.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	token := &ESDTData{}
+	_ = json.Unmarshal(eei.GetStorage([]byte("TOKEN")), token)
+	assert.Equal(t, []byte("newOwner"), token.OwnerAddress)
+
+	retCode = esdtSC.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestESDT_ExecuteGetTokenProperties(t *testing.T) {
+	t.Parallel()
+
+	esdtSC, eei := createESDTAndContext(big.NewInt(10))
+	_ = esdtSC.Execute(createIssueCallInput("TOKEN", 1000, big.NewInt(10)))
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "getTokenProperties"
+	arguments.Arguments = [][]byte{[]byte("TOKEN")}
+
+	retCode := esdtSC.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, [][]byte{eei.GetStorage([]byte("TOKEN"))}, eei.CreateVMOutput().ReturnData)
+
+	arguments.Arguments = [][]byte{[]byte("UNKNOWN")}
+	retCode = esdtSC.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
@@ -0,0 +1,436 @@
+package systemSmartContracts
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const proposalPrefix = "proposal_"
+const votePrefix = "vote_"
+const lastProposalKey = "lastProposal"
+const acceptedChangesKey = "acceptedChanges"
+
+const yesVote = "yes"
+const noVote = "no"
+
+// governableParameters holds the parameters governance can change, which are the ones the protocol has an updater for,
+// together with the check of the values they accept
+var governableParameters = map[string]func(value string) bool{
+	core.GovernanceEconomicsNamespace + core.GovernanceNamespaceSeparator + "RewardsValue":            isNonNegativeNumber,
+	core.GovernanceEconomicsNamespace + core.GovernanceNamespaceSeparator + "MinGasPrice":             isPositiveUint64,
+	core.GovernanceEconomicsNamespace + core.GovernanceNamespaceSeparator + "MinGasLimit":             isPositiveUint64,
+	core.GovernanceEconomicsNamespace + core.GovernanceNamespaceSeparator + "MaxGasLimitPerBlock":     isPositiveUint64,
+	core.GovernanceConsensusNamespace + core.GovernanceNamespaceSeparator + "ShardConsensusGroupSize": isConsensusGroupSize,
+	core.GovernanceConsensusNamespace + core.GovernanceNamespaceSeparator + "MetaConsensusGroupSize":  isConsensusGroupSize,
+}
+
+// governableGasScheduleSections holds the sections of the gas schedule whose gas costs governance can change
+var governableGasScheduleSections = map[string]struct{}{
+	"BaseOperationCost": {},
+	"ElrondAPICost":     {},
+	"EthAPICost":        {},
+	"BigIntAPICost":     {},
+	"CryptoAPICost":     {},
+	"WASMOpcodeCost":    {},
+}
+
+// GovernanceProposal holds a change of a protocol parameter proposed by a staker and the stake which voted for it
+type GovernanceProposal struct {
+	ProposalID      uint64   `json:"ProposalID"`
+	Proposer        []byte   `json:"Proposer"`
+	Parameter       string   `json:"Parameter"`
+	Value           string   `json:"Value"`
+	ActivationNonce uint64   `json:"ActivationNonce"`
+	VoteEndNonce    uint64   `json:"VoteEndNonce"`
+	Yes             *big.Int `json:"Yes"`
+	No              *big.Int `json:"No"`
+	Closed          bool     `json:"Closed"`
+	Passed          bool     `json:"Passed"`
+}
+
+// GovernanceChange holds a protocol parameter change which passed the vote, and the nonce from which it applies
+type GovernanceChange struct {
+	ProposalID      uint64 `json:"ProposalID"`
+	Parameter       string `json:"Parameter"`
+	Value           string `json:"Value"`
+	ActivationNonce uint64 `json:"ActivationNonce"`
+}
+
+type governance struct {
+	eei              vm.SystemEI
+	votingPeriod     uint64
+	minQuorum        *big.Int
+	stakingSCAddress []byte
+}
+
+// NewGovernanceSmartContract creates the smart contract through which stakers propose and vote protocol parameter
+// changes, each vote weighing as much as the voter's stake
+func NewGovernanceSmartContract(
+	votingPeriod uint64,
+	minQuorum *big.Int,
+	stakingSCAddress []byte,
+	eei vm.SystemEI,
+) (*governance, error) {
+	if minQuorum == nil {
+		return nil, vm.ErrNilMinQuorum
+	}
+	if minQuorum.Cmp(big.NewInt(0)) < 1 {
+		return nil, vm.ErrNegativeMinQuorum
+	}
+	if len(stakingSCAddress) == 0 {
+		return nil, vm.ErrNilStakingSCAddress
+	}
+	if eei == nil || eei.IsInterfaceNil() {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+
+	return &governance{
+		eei:              eei,
+		votingPeriod:     votingPeriod,
+		minQuorum:        big.NewInt(0).Set(minQuorum),
+		stakingSCAddress: stakingSCAddress,
+	}, nil
+}
+
+// Execute calls one of the functions from the governance smart contract and runs the code according to the input
+func (g *governance) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if CheckIfNil(args) != nil {
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case "_init":
+		return g.init(args)
+	case "proposal":
+		return g.proposal(args)
+	case "vote":
+		return g.vote(args)
+	case "closeProposal":
+		return g.closeProposal(args)
+	case "getProposal":
+		return g.getProposal(args)
+	case "getAcceptedChanges":
+		return g.getAcceptedChanges(args)
+	}
+
+	return vmcommon.UserError
+}
+
+func (g *governance) init(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	ownerAddress := g.eei.GetStorage([]byte(ownerKey))
+	if ownerAddress != nil {
+		log.Error("governance smart contract was already initialized")
+		return vmcommon.UserError
+	}
+
+	g.eei.SetStorage([]byte(ownerKey), args.CallerAddr)
+	return vmcommon.Ok
+}
+
+// proposal records the change of a parameter, given as namespace.name, to a decimal value from the activation nonce
+// onwards. Only stakers can propose and the activation nonce has to come after the voting period
+func (g *governance) proposal(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 || len(args.Arguments) != 3 {
+		log.Debug("proposal function called with wrong value or number of arguments")
+		return vmcommon.UserError
+	}
+	if g.getVotingPower(args.CallerAddr).Sign() == 0 {
+		log.Debug("proposal function called by an address which is not staked")
+		return vmcommon.UserError
+	}
+
+	parameter := string(args.Arguments[0])
+	value := string(args.Arguments[1])
+	if !isValidParameterValue(parameter, value) {
+		log.Debug("proposal function called with an invalid parameter or value")
+		return vmcommon.UserError
+	}
+
+	voteEndNonce := g.eei.BlockChainHook().CurrentNonce() + g.votingPeriod
+	activationNonce := big.NewInt(0).SetBytes(args.Arguments[2])
+	if !activationNonce.IsUint64() || activationNonce.Uint64() <= voteEndNonce {
+		log.Debug("proposal function called with an activation nonce inside the voting period")
+		return vmcommon.UserError
+	}
+
+	proposalID := big.NewInt(0).SetBytes(g.eei.GetStorage([]byte(lastProposalKey))).Uint64() + 1
+	proposal := &GovernanceProposal{
+		ProposalID:      proposalID,
+		Proposer:        args.CallerAddr,
+		Parameter:       parameter,
+		Value:           value,
+		ActivationNonce: activationNonce.Uint64(),
+		VoteEndNonce:    voteEndNonce,
+		Yes:             big.NewInt(0),
+		No:              big.NewInt(0),
+	}
+	err := g.saveProposal(proposal)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	g.eei.SetStorage([]byte(lastProposalKey), big.NewInt(0).SetUint64(proposalID).Bytes())
+	g.eei.Finish(big.NewInt(0).SetUint64(proposalID).Bytes())
+
+	return vmcommon.Ok
+}
+
+// vote adds the caller's stake to the yes or the no side of an open proposal. Every staker votes once per proposal
+func (g *governance) vote(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 || len(args.Arguments) != 2 {
+		log.Debug("vote function called with wrong value or number of arguments")
+		return vmcommon.UserError
+	}
+
+	proposal, err := g.getProposalFromStorage(args.Arguments[0])
+	if err != nil {
+		return vmcommon.UserError
+	}
+	if proposal.Closed || g.eei.BlockChainHook().CurrentNonce() > proposal.VoteEndNonce {
+		log.Debug("vote function called after the voting period ended")
+		return vmcommon.UserError
+	}
+
+	key := voteKey(proposal.ProposalID, args.CallerAddr)
+	if len(g.eei.GetStorage(key)) > 0 {
+		log.Debug("vote function called by an address which already voted")
+		return vmcommon.UserError
+	}
+
+	votingPower := g.getVotingPower(args.CallerAddr)
+	if votingPower.Sign() == 0 {
+		log.Debug("vote function called by an address which is not staked")
+		return vmcommon.UserError
+	}
+
+	switch string(args.Arguments[1]) {
+	case yesVote:
+		proposal.Yes.Add(proposal.Yes, votingPower)
+	case noVote:
+		proposal.No.Add(proposal.No, votingPower)
+	default:
+		log.Debug("vote function called with an invalid vote")
+		return vmcommon.UserError
+	}
+
+	err = g.saveProposal(proposal)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	g.eei.SetStorage(key, args.Arguments[1])
+	return vmcommon.Ok
+}
+
+// closeProposal counts the votes once the voting period ended. A proposal passes if the yes side reaches the quorum
+// and outweighs the no side before its activation nonce, and then it is added to the changes the protocol applies
+// at the activation nonce
+func (g *governance) closeProposal(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 || len(args.Arguments) != 1 {
+		log.Debug("closeProposal function called with wrong value or number of arguments")
+		return vmcommon.UserError
+	}
+
+	proposal, err := g.getProposalFromStorage(args.Arguments[0])
+	if err != nil {
+		return vmcommon.UserError
+	}
+	if proposal.Closed || g.eei.BlockChainHook().CurrentNonce() <= proposal.VoteEndNonce {
+		log.Debug("closeProposal function called on a proposal which can not be closed")
+		return vmcommon.UserError
+	}
+
+	proposal.Closed = true
+	isBeforeActivation := g.eei.BlockChainHook().CurrentNonce() < proposal.ActivationNonce
+	proposal.Passed = isBeforeActivation && proposal.Yes.Cmp(g.minQuorum) >= 0 && proposal.Yes.Cmp(proposal.No) > 0
+	err = g.saveProposal(proposal)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	if !proposal.Passed {
+		return vmcommon.Ok
+	}
+
+	acceptedChanges, err := g.getAcceptedChangesFromStorage()
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	acceptedChanges = append(acceptedChanges, &GovernanceChange{
+		ProposalID:      proposal.ProposalID,
+		Parameter:       proposal.Parameter,
+		Value:           proposal.Value,
+		ActivationNonce: proposal.ActivationNonce,
+	})
+	marshaledData, err := json.Marshal(acceptedChanges)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	g.eei.SetStorage([]byte(acceptedChangesKey), marshaledData)
+	return vmcommon.Ok
+}
+
+func (g *governance) getProposal(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 1 {
+		return vmcommon.UserError
+	}
+
+	proposalID := big.NewInt(0).SetBytes(args.Arguments[0]).Uint64()
+	data := g.eei.GetStorage(proposalKey(proposalID))
+	if len(data) == 0 {
+		return vmcommon.UserError
+	}
+
+	g.eei.Finish(data)
+	return vmcommon.Ok
+}
+
+func (g *governance) getAcceptedChanges(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	data := g.eei.GetStorage([]byte(acceptedChangesKey))
+	if len(data) > 0 {
+		g.eei.Finish(data)
+	}
+
+	return vmcommon.Ok
+}
+
+// getVotingPower returns the stake of the staked nodes of the address together with its top up. An address without
+// staked nodes has no voting power
+func (g *governance) getVotingPower(address []byte) *big.Int {
+	votingPower := big.NewInt(0)
+	data := g.eei.GetStorageFromAddress(g.stakingSCAddress, stakerKey(address))
+	if len(data) == 0 {
+		return votingPower
+	}
+
+	stakerData := &StakerData{}
+	err := json.Unmarshal(data, stakerData)
+	if err != nil {
+		return votingPower
+	}
+
+	for _, blsPubKey := range stakerData.BlsPubKeys {
+		data = g.eei.GetStorageFromAddress(g.stakingSCAddress, blsPubKey)
+		if len(data) == 0 {
+			continue
+		}
+
+		stakingData := &StakingData{}
+		err = json.Unmarshal(data, stakingData)
+		if err != nil || !stakingData.Staked || stakingData.StakeValue == nil {
+			continue
+		}
+
+		votingPower.Add(votingPower, stakingData.StakeValue)
+	}
+
+	if votingPower.Sign() > 0 && stakerData.TopUpValue != nil {
+		votingPower.Add(votingPower, stakerData.TopUpValue)
+	}
+
+	return votingPower
+}
+
+func (g *governance) getProposalFromStorage(proposalIDBytes []byte) (*GovernanceProposal, error) {
+	proposalID := big.NewInt(0).SetBytes(proposalIDBytes).Uint64()
+	data := g.eei.GetStorage(proposalKey(proposalID))
+	if len(data) == 0 {
+		log.Debug("governance function called for an unknown proposal")
+		return nil, vm.ErrUnknownProposal
+	}
+
+	proposal := &GovernanceProposal{}
+	err := json.Unmarshal(data, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+func (g *governance) getAcceptedChangesFromStorage() ([]*GovernanceChange, error) {
+	acceptedChanges := make([]*GovernanceChange, 0)
+	data := g.eei.GetStorage([]byte(acceptedChangesKey))
+	if len(data) == 0 {
+		return acceptedChanges, nil
+	}
+
+	err := json.Unmarshal(data, &acceptedChanges)
+	if err != nil {
+		return nil, err
+	}
+
+	return acceptedChanges, nil
+}
+
+func (g *governance) saveProposal(proposal *GovernanceProposal) error {
+	marshaledData, err := json.Marshal(proposal)
+	if err != nil {
+		return err
+	}
+
+	g.eei.SetStorage(proposalKey(proposal.ProposalID), marshaledData)
+	return nil
+}
+
+func proposalKey(proposalID uint64) []byte {
+	return []byte(proposalPrefix + strconv.FormatUint(proposalID, 10))
+}
+
+func voteKey(proposalID uint64, voter []byte) []byte {
+	return append([]byte(votePrefix+strconv.FormatUint(proposalID, 10)+"_"), voter...)
+}
+
+// isValidParameterValue checks the parameter is given as namespace.name, is one of the parameters governance can
+// change and the value is within its bounds
+func isValidParameterValue(parameter string, value string) bool {
+	isValidValue, ok := governableParameters[parameter]
+	if ok {
+		return isValidValue(value)
+	}
+
+	tokens := strings.Split(parameter, core.GovernanceNamespaceSeparator)
+	if len(tokens) != 3 || tokens[0] != core.GovernanceGasScheduleNamespace || len(tokens[2]) == 0 {
+		return false
+	}
+	_, ok = governableGasScheduleSections[tokens[1]]
+
+	return ok && isPositiveUint64(value)
+}
+
+func isNonNegativeNumber(value string) bool {
+	number, ok := big.NewInt(0).SetString(value, 10)
+	return ok && number.Sign() >= 0
+}
+
+func isPositiveUint64(value string) bool {
+	number, err := strconv.ParseUint(value, 10, 64)
+	return err == nil && number > 0
+}
+
+func isConsensusGroupSize(value string) bool {
+	size, err := strconv.ParseUint(value, 10, 64)
+	return err == nil && size > 0 && size <= math.MaxInt32
+}
+
+// ValueOf returns the value of a selected key
+func (g *governance) ValueOf(_ interface{}) interface{} {
+	return nil
+}
+
+// IsInterfaceNil verifies if the underlying object is nil or not
+func (g *governance) IsInterfaceNil() bool {
+	if g == nil {
+		return true
+	}
+	return false
+}
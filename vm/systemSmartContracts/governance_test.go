@@ -0,0 +1,375 @@
+package systemSmartContracts
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+var governanceSCAddress = []byte("governance")
+
+func createGovernanceAndContext(votingPeriod uint64, minQuorum int64, currentNonce *uint64) (*governance, *vmContext) {
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return *currentNonce
+		},
+	}, hooks.NewVMCryptoHook())
+
+	governanceSC, _ := NewGovernanceSmartContract(votingPeriod, big.NewInt(minQuorum), stakingSCAddress, eei)
+	eei.SetSCAddress(governanceSCAddress)
+
+	return governanceSC, eei
+}
+
+func setStaked(eei *vmContext, address string, stakeValue int64, staked bool) {
+	blsPubKey := []byte("blsKey" + address)
+	stakingData := &StakingData{
+		Staked:        staked,
+		BlsPubKey:     blsPubKey,
+		StakeValue:    big.NewInt(stakeValue),
+		OwnerAddress:  []byte(address),
+		RewardAddress: []byte(address),
+	}
+	marshaledData, _ := json.Marshal(stakingData)
+	stakerData := &StakerData{
+		RewardAddress: []byte(address),
+		BlsPubKeys:    [][]byte{blsPubKey},
+		TopUpValue:    big.NewInt(0),
+	}
+	marshaledStakerData, _ := json.Marshal(stakerData)
+
+	eei.SetSCAddress(stakingSCAddress)
+	eei.SetStorage(blsPubKey, marshaledData)
+	eei.SetStorage(stakerKey([]byte(address)), marshaledStakerData)
+	eei.SetSCAddress(governanceSCAddress)
+}
+
+func createGovernanceCallInput(function string, caller string, arguments ...[]byte) *vmcommon.ContractCallInput {
+	input := CreateVmContractCallInput()
+	input.Function = function
+	input.CallerAddr = []byte(caller)
+	input.RecipientAddr = governanceSCAddress
+	input.CallValue = big.NewInt(0)
+	input.Arguments = arguments
+
+	return input
+}
+
+func createProposalInput(caller string, parameter string, value string, activationNonce int64) *vmcommon.ContractCallInput {
+	return createGovernanceCallInput(
+		"proposal",
+		caller,
+		[]byte(parameter),
+		[]byte(value),
+		big.NewInt(activationNonce).Bytes(),
+	)
+}
+
+func getProposalState(eei *vmContext, proposalID uint64) *GovernanceProposal {
+	proposal := &GovernanceProposal{}
+	_ = json.Unmarshal(eei.GetStorage(proposalKey(proposalID)), proposal)
+	return proposal
+}
+
+func getAcceptedChangesState(eei *vmContext) []*GovernanceChange {
+	acceptedChanges := make([]*GovernanceChange, 0)
+	_ = json.Unmarshal(eei.GetStorage([]byte(acceptedChangesKey)), &acceptedChanges)
+	return acceptedChanges
+}
+
+func TestNewGovernanceSmartContract_NilMinQuorumShouldErr(t *testing.T) {
+	t.Parallel()
+
+	governanceSC, err := NewGovernanceSmartContract(10, nil, stakingSCAddress, &mock.SystemEIStub{})
+
+	assert.Nil(t, governanceSC)
+	assert.Equal(t, vm.ErrNilMinQuorum, err)
+}
+
+func TestNewGovernanceSmartContract_ZeroMinQuorumShouldErr(t *testing.T) {
+	t.Parallel()
+
+	governanceSC, err := NewGovernanceSmartContract(10, big.NewInt(0), stakingSCAddress, &mock.SystemEIStub{})
+
+	assert.Nil(t, governanceSC)
+	assert.Equal(t, vm.ErrNegativeMinQuorum, err)
+}
+
+func TestNewGovernanceSmartContract_NilStakingSCAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	governanceSC, err := NewGovernanceSmartContract(10, big.NewInt(10), nil, &mock.SystemEIStub{})
+
+	assert.Nil(t, governanceSC)
+	assert.Equal(t, vm.ErrNilStakingSCAddress, err)
+}
+
+func TestNewGovernanceSmartContract_NilSystemEIShouldErr(t *testing.T) {
+	t.Parallel()
+
+	governanceSC, err := NewGovernanceSmartContract(10, big.NewInt(10), stakingSCAddress, nil)
+
+	assert.Nil(t, governanceSC)
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+}
+
+func TestNewGovernanceSmartContract(t *testing.T) {
+	t.Parallel()
+
+	governanceSC, err := NewGovernanceSmartContract(10, big.NewInt(10), stakingSCAddress, &mock.SystemEIStub{})
+
+	assert.Nil(t, err)
+	assert.False(t, governanceSC.IsInterfaceNil())
+}
+
+func TestGovernance_ProposalFromNotStakedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	governanceSC, eei := createGovernanceAndContext(10, 100, &nonce)
+	setStaked(eei, "unStaked", 100, false)
+
+	retCode := governanceSC.Execute(createProposalInput("nobody", "economics.MinGasPrice", "10", 20))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = governanceSC.Execute(createProposalInput("unStaked", "economics.MinGasPrice", "10", 20))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestGovernance_ProposalInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(5)
+	governanceSC, eei := createGovernanceAndContext(10, 100, &nonce)
+	setStaked(eei, "staker", 100, true)
+
+	invalidProposals := []*vmcommon.ContractCallInput{
+		createProposalInput("staker", "MinGasPrice", "10", 20),
+		createProposalInput("staker", "economics.", "10", 20),
+		createProposalInput("staker", "unknown.MinGasPrice", "10", 20),
+		createProposalInput("staker", "economics.Unknown", "10", 20),
+		createProposalInput("staker", "consensus.Unknown", "63", 20),
+		createProposalInput("staker", "gasSchedule.BaseOperationCost", "10", 20),
+		createProposalInput("staker", "gasSchedule.UnknownCost.StorePerByte", "10", 20),
+		createProposalInput("staker", "gasSchedule.BaseOperationCost.", "10", 20),
+		createProposalInput("staker", "economics.MinGasPrice", "-10", 20),
+		createProposalInput("staker", "economics.MinGasPrice", "ten", 20),
+		createProposalInput("staker", "economics.MinGasPrice", "0", 20),
+		createProposalInput("staker", "economics.MinGasLimit", "0", 20),
+		createProposalInput("staker", "economics.MaxGasLimitPerBlock", "0", 20),
+		createProposalInput("staker", "economics.MaxGasLimitPerBlock", "18446744073709551616", 20),
+		createProposalInput("staker", "economics.RewardsValue", "-1", 20),
+		createProposalInput("staker", "consensus.ShardConsensusGroupSize", "0", 20),
+		createProposalInput("staker", "consensus.MetaConsensusGroupSize", "2147483648", 20),
+		createProposalInput("staker", "gasSchedule.BaseOperationCost.StorePerByte", "0", 20),
+		createProposalInput("staker", "economics.MinGasPrice", "10", 15),
+	}
+
+	for _, input := range invalidProposals {
+		retCode := governanceSC.Execute(input)
+		assert.Equal(t, vmcommon.UserError, retCode)
+	}
+}
+
+func TestGovernance_ProposalShouldWork(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(5)
+	governanceSC, eei := createGovernanceAndContext(10, 100, &nonce)
+	setStaked(eei, "staker", 100, true)
+
+	retCode := governanceSC.Execute(createProposalInput("staker", "economics.MinGasPrice", "10", 16))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	retCode = governanceSC.Execute(createProposalInput("staker", "economics.MaxGasLimitPerBlock", "5000", 30))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	retCode = governanceSC.Execute(createProposalInput("staker", "consensus.ShardConsensusGroupSize", "21", 30))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	retCode = governanceSC.Execute(createProposalInput("staker", "gasSchedule.BaseOperationCost.StorePerByte", "100", 30))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	proposal := getProposalState(eei, 2)
+	assert.Equal(t, []byte("staker"), proposal.Proposer)
+	assert.Equal(t, "economics.MaxGasLimitPerBlock", proposal.Parameter)
+	assert.Equal(t, "5000", proposal.Value)
+	assert.Equal(t, uint64(15), proposal.VoteEndNonce)
+	assert.Equal(t, uint64(30), proposal.ActivationNonce)
+}
+
+func TestGovernance_VoteShouldBeWeightedByStake(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	governanceSC, eei := createGovernanceAndContext(10, 100, &nonce)
+	setStaked(eei, "staker1", 100, true)
+	setStaked(eei, "staker2", 250, true)
+	_ = governanceSC.Execute(createProposalInput("staker1", "economics.MinGasPrice", "10", 20))
+
+	retCode := governanceSC.Execute(createGovernanceCallInput("vote", "staker1", big.NewInt(1).Bytes(), []byte(yesVote)))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	retCode = governanceSC.Execute(createGovernanceCallInput("vote", "staker2", big.NewInt(1).Bytes(), []byte(noVote)))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	proposal := getProposalState(eei, 1)
+	assert.Equal(t, big.NewInt(100), proposal.Yes)
+	assert.Equal(t, big.NewInt(250), proposal.No)
+}
+
+func TestGovernance_VoteTwiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	governanceSC, eei := createGovernanceAndContext(10, 100, &nonce)
+	setStaked(eei, "staker", 100, true)
+	_ = governanceSC.Execute(createProposalInput("staker", "economics.MinGasPrice", "10", 20))
+
+	retCode := governanceSC.Execute(createGovernanceCallInput("vote", "staker", big.NewInt(1).Bytes(), []byte(yesVote)))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	retCode = governanceSC.Execute(createGovernanceCallInput("vote", "staker", big.NewInt(1).Bytes(), []byte(noVote)))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestGovernance_VoteInvalidShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	governanceSC, eei := createGovernanceAndContext(10, 100, &nonce)
+	setStaked(eei, "staker", 100, true)
+	_ = governanceSC.Execute(createProposalInput("staker", "economics.MinGasPrice", "10", 20))
+
+	retCode := governanceSC.Execute(createGovernanceCallInput("vote", "staker", big.NewInt(2).Bytes(), []byte(yesVote)))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	retCode = governanceSC.Execute(createGovernanceCallInput("vote", "staker", big.NewInt(1).Bytes(), []byte("maybe")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	retCode = governanceSC.Execute(createGovernanceCallInput("vote", "nobody", big.NewInt(1).Bytes(), []byte(yesVote)))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	nonce = 11
+	retCode = governanceSC.Execute(createGovernanceCallInput("vote", "staker", big.NewInt(1).Bytes(), []byte(yesVote)))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestGovernance_CloseProposalBeforeVoteEndShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	governanceSC, eei := createGovernanceAndContext(10, 100, &nonce)
+	setStaked(eei, "staker", 100, true)
+	_ = governanceSC.Execute(createProposalInput("staker", "economics.MinGasPrice", "10", 20))
+
+	nonce = 10
+	retCode := governanceSC.Execute(createGovernanceCallInput("closeProposal", "anyone", big.NewInt(1).Bytes()))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestGovernance_CloseProposalWithoutQuorumShouldNotPass(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	governanceSC, eei := createGovernanceAndContext(10, 200, &nonce)
+	setStaked(eei, "staker", 100, true)
+	_ = governanceSC.Execute(createProposalInput("staker", "economics.MinGasPrice", "10", 20))
+	_ = governanceSC.Execute(createGovernanceCallInput("vote", "staker", big.NewInt(1).Bytes(), []byte(yesVote)))
+
+	nonce = 11
+	retCode := governanceSC.Execute(createGovernanceCallInput("closeProposal", "anyone", big.NewInt(1).Bytes()))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	proposal := getProposalState(eei, 1)
+	assert.True(t, proposal.Closed)
+	assert.False(t, proposal.Passed)
+	assert.Equal(t, 0, len(getAcceptedChangesState(eei)))
+
+	retCode = governanceSC.Execute(createGovernanceCallInput("closeProposal", "anyone", big.NewInt(1).Bytes()))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestGovernance_CloseProposalOutvotedShouldNotPass(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	governanceSC, eei := createGovernanceAndContext(10, 100, &nonce)
+	setStaked(eei, "staker1", 100, true)
+	setStaked(eei, "staker2", 100, true)
+	_ = governanceSC.Execute(createProposalInput("staker1", "economics.MinGasPrice", "10", 20))
+	_ = governanceSC.Execute(createGovernanceCallInput("vote", "staker1", big.NewInt(1).Bytes(), []byte(yesVote)))
+	_ = governanceSC.Execute(createGovernanceCallInput("vote", "staker2", big.NewInt(1).Bytes(), []byte(noVote)))
+
+	nonce = 11
+	_ = governanceSC.Execute(createGovernanceCallInput("closeProposal", "anyone", big.NewInt(1).Bytes()))
+
+	assert.False(t, getProposalState(eei, 1).Passed)
+	assert.Equal(t, 0, len(getAcceptedChangesState(eei)))
+}
+
+func TestGovernance_CloseProposalPassedShouldAddAcceptedChange(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	governanceSC, eei := createGovernanceAndContext(10, 100, &nonce)
+	setStaked(eei, "staker1", 150, true)
+	setStaked(eei, "staker2", 100, true)
+	_ = governanceSC.Execute(createProposalInput("staker1", "economics.MinGasPrice", "10", 20))
+	_ = governanceSC.Execute(createGovernanceCallInput("vote", "staker1", big.NewInt(1).Bytes(), []byte(yesVote)))
+	_ = governanceSC.Execute(createGovernanceCallInput("vote", "staker2", big.NewInt(1).Bytes(), []byte(noVote)))
+
+	nonce = 11
+	retCode := governanceSC.Execute(createGovernanceCallInput("closeProposal", "anyone", big.NewInt(1).Bytes()))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	assert.True(t, getProposalState(eei, 1).Passed)
+	expectedChanges := []*GovernanceChange{
+		{
+			ProposalID:      1,
+			Parameter:       "economics.MinGasPrice",
+			Value:           "10",
+			ActivationNonce: 20,
+		},
+	}
+	assert.Equal(t, expectedChanges, getAcceptedChangesState(eei))
+
+	eei.output = make([]byte, 0)
+	retCode = governanceSC.Execute(createGovernanceCallInput("getAcceptedChanges", "anyone"))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, eei.GetStorage([]byte(acceptedChangesKey)), eei.output)
+}
+
+func TestGovernance_CloseProposalAfterActivationNonceShouldNotPass(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	governanceSC, eei := createGovernanceAndContext(10, 100, &nonce)
+	setStaked(eei, "staker1", 150, true)
+	_ = governanceSC.Execute(createProposalInput("staker1", "economics.MinGasPrice", "10", 20))
+	_ = governanceSC.Execute(createGovernanceCallInput("vote", "staker1", big.NewInt(1).Bytes(), []byte(yesVote)))
+
+	nonce = 20
+	retCode := governanceSC.Execute(createGovernanceCallInput("closeProposal", "anyone", big.NewInt(1).Bytes()))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	assert.True(t, getProposalState(eei, 1).Closed)
+	assert.False(t, getProposalState(eei, 1).Passed)
+	assert.Equal(t, 0, len(getAcceptedChangesState(eei)))
+}
+
+func TestGovernance_GetProposal(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(0)
+	governanceSC, eei := createGovernanceAndContext(10, 100, &nonce)
+	setStaked(eei, "staker", 100, true)
+	_ = governanceSC.Execute(createProposalInput("staker", "economics.MinGasLimit", "63", 20))
+	eei.output = make([]byte, 0)
+
+	retCode := governanceSC.Execute(createGovernanceCallInput("getProposal", "anyone", big.NewInt(1).Bytes()))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, eei.GetStorage(proposalKey(1)), eei.output)
+
+	retCode = governanceSC.Execute(createGovernanceCallInput("getProposal", "anyone", big.NewInt(2).Bytes()))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
@@ -15,36 +15,67 @@ var log = logger.GetOrCreate("vm/systemsmartcontracts")
 const ownerKey = "owner"
 const initialStakeKey = "initialStake"
 
+const stakerPrefix = "staker_"
+
+// StakingData holds the registration of a node, kept under the node's BLS public key
 type StakingData struct {
 	StartNonce    uint64   `json:"StartNonce"`
+	StartRound    uint64   `json:"StartRound"`
 	Staked        bool     `json:"Staked"`
 	UnStakedNonce uint64   `json:"UnStakedNonce"`
 	BlsPubKey     []byte   `json:"BlsPubKey"`
 	StakeValue    *big.Int `json:"StakeValue"`
+	OwnerAddress  []byte   `json:"OwnerAddress"`
+	RewardAddress []byte   `json:"RewardAddress"`
+}
+
+// StakerData holds the nodes an owner staked, the address which receives their rewards and the stake the owner
+// added above the minimum the nodes require
+type StakerData struct {
+	RewardAddress []byte   `json:"RewardAddress"`
+	BlsPubKeys    [][]byte `json:"BlsPubKeys"`
+	TopUpValue    *big.Int `json:"TopUpValue"`
 }
 
 type stakingSC struct {
-	eei           vm.SystemEI
-	stakeValue    *big.Int
-	unBoundPeriod uint64
+	eei                   vm.SystemEI
+	stakeValue            *big.Int
+	unBoundPeriod         uint64
+	burnAddress           []byte
+	doubleSigningVerifier vm.DoubleSigningVerifier
 }
 
-// NewStakingSmartContract creates a staking smart contract
-func NewStakingSmartContract(stakeValue *big.Int, unBoundPeriod uint64, eei vm.SystemEI) (*stakingSC, error) {
+// NewStakingSmartContract creates a staking smart contract. The stake slashed for double signing is sent to the
+// burn address
+func NewStakingSmartContract(
+	stakeValue *big.Int,
+	unBoundPeriod uint64,
+	burnAddress []byte,
+	doubleSigningVerifier vm.DoubleSigningVerifier,
+	eei vm.SystemEI,
+) (*stakingSC, error) {
 	if stakeValue == nil {
 		return nil, vm.ErrNilInitialStakeValue
 	}
 	if stakeValue.Cmp(big.NewInt(0)) < 1 {
 		return nil, vm.ErrNegativeInitialStakeValue
 	}
+	if len(burnAddress) == 0 {
+		return nil, vm.ErrEmptyBurnAddress
+	}
+	if doubleSigningVerifier == nil || doubleSigningVerifier.IsInterfaceNil() {
+		return nil, vm.ErrNilDoubleSigningVerifier
+	}
 	if eei == nil || eei.IsInterfaceNil() {
 		return nil, vm.ErrNilSystemEnvironmentInterface
 	}
 
 	reg := &stakingSC{
-		stakeValue:    big.NewInt(0).Set(stakeValue),
-		eei:           eei,
-		unBoundPeriod: unBoundPeriod,
+		stakeValue:            big.NewInt(0).Set(stakeValue),
+		eei:                   eei,
+		unBoundPeriod:         unBoundPeriod,
+		burnAddress:           burnAddress,
+		doubleSigningVerifier: doubleSigningVerifier,
 	}
 	return reg, nil
 }
@@ -60,14 +91,22 @@ func (r *stakingSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCod
 		return r.init(args)
 	case "stake":
 		return r.stake(args)
+	case "topUp":
+		return r.topUp(args)
+	case "changeRewardAddress":
+		return r.changeRewardAddress(args)
 	case "unStake":
 		return r.unStake(args)
 	case "unBound":
 		return r.unBound(args)
 	case "slash":
 		return r.slash(args)
+	case "reportDoubleSign":
+		return r.reportDoubleSign(args)
 	case "get":
 		return r.get(args)
+	case "getStaker":
+		return r.getStaker(args)
 	case "isStaked":
 		return r.isStaked(args)
 	}
@@ -86,6 +125,20 @@ func (r *stakingSC) get(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	return vmcommon.Ok
 }
 
+func (r *stakingSC) getStaker(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 1 {
+		return vmcommon.UserError
+	}
+
+	value := r.eei.GetStorage(stakerKey(args.Arguments[0]))
+	if len(value) == 0 {
+		return vmcommon.UserError
+	}
+
+	r.eei.Finish(value)
+	return vmcommon.Ok
+}
+
 func (r *stakingSC) init(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	ownerAddress := r.eei.GetStorage([]byte(ownerKey))
 	if ownerAddress != nil {
@@ -99,130 +152,235 @@ func (r *stakingSC) init(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	return vmcommon.Ok
 }
 
+// stake registers all the BLS public keys given as arguments as nodes of the caller. The call value has to cover
+// the minimum stake of every node, anything above it is added to the caller's top up
 func (r *stakingSC) stake(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	stakeValueBytes := r.eei.GetStorage([]byte(initialStakeKey))
 	stakeValue := big.NewInt(0).SetBytes(stakeValueBytes)
 
-	if args.CallValue.Cmp(stakeValue) != 0 || args.CallValue.Sign() <= 0 {
+	if len(args.Arguments) < 1 {
+		log.Debug("not enough arguments to process stake function")
 		return vmcommon.UserError
 	}
 
-	registrationData := StakingData{
-		StartNonce:    0,
-		Staked:        false,
-		BlsPubKey:     nil,
-		UnStakedNonce: 0,
-		StakeValue:    big.NewInt(0).Set(stakeValue),
+	numNodes := big.NewInt(int64(len(args.Arguments)))
+	requiredValue := big.NewInt(0).Mul(stakeValue, numNodes)
+	if args.CallValue.Cmp(requiredValue) < 0 || args.CallValue.Sign() <= 0 {
+		log.Debug("stake function called with a value which does not cover the minimum stake of all nodes")
+		return vmcommon.UserError
 	}
-	data := r.eei.GetStorage(args.CallerAddr)
 
-	if data != nil {
-		err := json.Unmarshal(data, &registrationData)
+	stakerData, err := r.getOrCreateStakerData(args.CallerAddr)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	newKeys := make(map[string]struct{}, len(args.Arguments))
+	for _, blsPubKey := range args.Arguments {
+		//TODO: verify if blsPubKey is valid
+		_, duplicated := newKeys[string(blsPubKey)]
+		if duplicated || !IsNodeKey(blsPubKey) || len(r.eei.GetStorage(blsPubKey)) > 0 {
+			log.Debug("node already staked, re-staking is invalid")
+			return vmcommon.UserError
+		}
+		newKeys[string(blsPubKey)] = struct{}{}
+	}
+
+	currentNonce := r.eei.BlockChainHook().CurrentNonce()
+	currentRound := r.eei.BlockChainHook().CurrentRound()
+	for _, blsPubKey := range args.Arguments {
+		registrationData := &StakingData{
+			StartNonce:    currentNonce,
+			StartRound:    currentRound,
+			Staked:        true,
+			UnStakedNonce: 0,
+			BlsPubKey:     blsPubKey,
+			StakeValue:    big.NewInt(0).Set(stakeValue),
+			OwnerAddress:  args.CallerAddr,
+			RewardAddress: stakerData.RewardAddress,
+		}
+		err = r.saveStakingData(registrationData)
 		if err != nil {
-			log.Debug("unmarshal error on staking SC stake function",
-				"error", err.Error(),
-			)
 			return vmcommon.UserError
 		}
+
+		stakerData.BlsPubKeys = append(stakerData.BlsPubKeys, blsPubKey)
 	}
 
-	if registrationData.Staked {
-		log.Debug("account already staked, re-staking is invalid")
+	topUpValue := big.NewInt(0).Sub(args.CallValue, requiredValue)
+	stakerData.TopUpValue.Add(stakerData.TopUpValue, topUpValue)
+
+	err = r.saveStakerData(args.CallerAddr, stakerData)
+	if err != nil {
 		return vmcommon.UserError
 	}
 
-	registrationData.Staked = true
+	return vmcommon.Ok
+}
 
-	if len(args.Arguments) < 1 {
-		log.Debug("not enough arguments to process stake function")
+// topUp adds the call value to the stake of the caller, above the minimum its nodes require
+func (r *stakingSC) topUp(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() <= 0 || len(args.Arguments) != 0 {
+		log.Debug("topUp function called with wrong value or number of arguments")
 		return vmcommon.UserError
 	}
 
-	registrationData.StartNonce = r.eei.BlockChainHook().CurrentNonce()
-	registrationData.BlsPubKey = args.Arguments[0]
-	//TODO: verify if blsPubKey is valid
-
-	data, err := json.Marshal(registrationData)
+	stakerData, err := r.getStakerData(args.CallerAddr)
 	if err != nil {
-		log.Debug("marshal error on staking SC stake function ",
-			"error", err.Error(),
-		)
 		return vmcommon.UserError
 	}
 
-	r.eei.SetStorage(args.CallerAddr, data)
+	stakerData.TopUpValue.Add(stakerData.TopUpValue, args.CallValue)
+
+	err = r.saveStakerData(args.CallerAddr, stakerData)
+	if err != nil {
+		return vmcommon.UserError
+	}
 
 	return vmcommon.Ok
 }
 
-func (r *stakingSC) unStake(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	var registrationData StakingData
-	data := r.eei.GetStorage(args.CallerAddr)
-	if data == nil {
-		log.Debug("unStake is not possible for address which is not staked")
+// changeRewardAddress sets the address which receives the rewards of all the nodes of the caller
+func (r *stakingSC) changeRewardAddress(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 || len(args.Arguments) != 1 || len(args.Arguments[0]) == 0 {
+		log.Debug("changeRewardAddress function called with wrong value or arguments")
 		return vmcommon.UserError
 	}
 
-	err := json.Unmarshal(data, &registrationData)
+	stakerData, err := r.getStakerData(args.CallerAddr)
 	if err != nil {
-		log.Debug("unmarshal error in unStake function of staking SC",
-			"error", err.Error(),
-		)
 		return vmcommon.UserError
 	}
 
-	if !registrationData.Staked {
-		log.Error("unStake is not possible for address with is already unStaked")
+	stakerData.RewardAddress = args.Arguments[0]
+	for _, blsPubKey := range stakerData.BlsPubKeys {
+		registrationData, err := r.getStakingData(blsPubKey)
+		if err != nil {
+			return vmcommon.UserError
+		}
+
+		registrationData.RewardAddress = stakerData.RewardAddress
+		err = r.saveStakingData(registrationData)
+		if err != nil {
+			return vmcommon.UserError
+		}
+	}
+
+	err = r.saveStakerData(args.CallerAddr, stakerData)
+	if err != nil {
 		return vmcommon.UserError
 	}
 
-	registrationData.Staked = false
-	registrationData.UnStakedNonce = r.eei.BlockChainHook().CurrentNonce()
+	return vmcommon.Ok
+}
 
-	data, err = json.Marshal(registrationData)
+// unStake stops the nodes given as arguments, or all the staked nodes of the caller if no argument is given
+func (r *stakingSC) unStake(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	stakerData, err := r.getStakerData(args.CallerAddr)
 	if err != nil {
-		log.Debug("marshal error in unStake function of staking SC",
-			"error", err.Error(),
-		)
+		log.Debug("unStake is not possible for address which is not staked")
 		return vmcommon.UserError
 	}
 
-	r.eei.SetStorage(args.CallerAddr, data)
+	blsPubKeys := args.Arguments
+	unStakeAll := len(blsPubKeys) == 0
+	if unStakeAll {
+		blsPubKeys = stakerData.BlsPubKeys
+	}
 
-	return vmcommon.Ok
-}
+	currentNonce := r.eei.BlockChainHook().CurrentNonce()
+	numUnStaked := 0
+	for _, blsPubKey := range blsPubKeys {
+		registrationData, err := r.getOwnedStakingData(args.CallerAddr, blsPubKey)
+		if err != nil {
+			return vmcommon.UserError
+		}
 
-func (r *stakingSC) unBound(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	var registrationData StakingData
-	data := r.eei.GetStorage(args.CallerAddr)
-	if data == nil {
-		log.Error("unBound is not possible for address which is not staked")
+		if !registrationData.Staked {
+			if unStakeAll {
+				continue
+			}
+			log.Debug("unStake is not possible for node which is already unStaked")
+			return vmcommon.UserError
+		}
+
+		registrationData.Staked = false
+		registrationData.UnStakedNonce = currentNonce
+		err = r.saveStakingData(registrationData)
+		if err != nil {
+			return vmcommon.UserError
+		}
+		numUnStaked++
+	}
+
+	if numUnStaked == 0 {
+		log.Debug("unStake is not possible for address with all nodes already unStaked")
 		return vmcommon.UserError
 	}
 
-	err := json.Unmarshal(data, &registrationData)
+	return vmcommon.Ok
+}
+
+// unBound removes the nodes given as arguments, or all the nodes of the caller which can be removed if no argument
+// is given, and returns their stake. Once the last node is removed, the top up is returned as well
+func (r *stakingSC) unBound(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	stakerData, err := r.getStakerData(args.CallerAddr)
 	if err != nil {
-		log.Debug("unmarshal error on finalize unstake function",
-			"error", err.Error(),
-		)
+		log.Debug("unBound is not possible for address which is not staked")
 		return vmcommon.UserError
 	}
 
-	if registrationData.Staked || registrationData.UnStakedNonce <= registrationData.StartNonce {
-		log.Debug("unBound is not possible for address which is staked or is not in unbound period")
-		return vmcommon.UserError
+	blsPubKeys := args.Arguments
+	unBoundAll := len(blsPubKeys) == 0
+	if unBoundAll {
+		blsPubKeys = stakerData.BlsPubKeys
 	}
 
 	currentNonce := r.eei.BlockChainHook().CurrentNonce()
-	if currentNonce-registrationData.UnStakedNonce < r.unBoundPeriod {
+	unBoundValue := big.NewInt(0)
+	unBoundKeys := make([][]byte, 0, len(blsPubKeys))
+	for _, blsPubKey := range blsPubKeys {
+		registrationData, err := r.getOwnedStakingData(args.CallerAddr, blsPubKey)
+		if err != nil {
+			return vmcommon.UserError
+		}
+
+		canUnBound := !registrationData.Staked && registrationData.UnStakedNonce > registrationData.StartNonce &&
+			currentNonce-registrationData.UnStakedNonce >= r.unBoundPeriod
+		if !canUnBound {
+			if unBoundAll {
+				continue
+			}
+			log.Debug("unBound is not possible for node which is staked or is in unbound period")
+			return vmcommon.UserError
+		}
+
+		unBoundValue.Add(unBoundValue, registrationData.StakeValue)
+		unBoundKeys = append(unBoundKeys, blsPubKey)
+	}
+
+	if len(unBoundKeys) == 0 {
 		log.Debug("unBound is not possible for address because unbound period did not pass")
 		return vmcommon.UserError
 	}
 
-	r.eei.SetStorage(args.CallerAddr, nil)
+	for _, blsPubKey := range unBoundKeys {
+		r.eei.SetStorage(blsPubKey, nil)
+		stakerData.BlsPubKeys = removeKey(stakerData.BlsPubKeys, blsPubKey)
+	}
+
+	if len(stakerData.BlsPubKeys) == 0 {
+		unBoundValue.Add(unBoundValue, stakerData.TopUpValue)
+		r.eei.SetStorage(stakerKey(args.CallerAddr), nil)
+	} else {
+		err = r.saveStakerData(args.CallerAddr, stakerData)
+		if err != nil {
+			return vmcommon.UserError
+		}
+	}
 
 	ownerAddress := r.eei.GetStorage([]byte(ownerKey))
-	err = r.eei.Transfer(args.CallerAddr, ownerAddress, registrationData.StakeValue, nil)
+	err = r.eei.Transfer(args.CallerAddr, ownerAddress, unBoundValue, nil)
 	if err != nil {
 		log.Debug("transfer error on finalizeUnStake function",
 			"error", err.Error(),
@@ -246,8 +404,8 @@ func (r *stakingSC) slash(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 	}
 
 	var registrationData StakingData
-	stakerAddress := args.Arguments[0]
-	data := r.eei.GetStorage(stakerAddress)
+	blsPubKey := args.Arguments[0]
+	data := r.eei.GetStorage(blsPubKey)
 	if data == nil {
 		return vmcommon.UserError
 	}
@@ -276,36 +434,210 @@ func (r *stakingSC) slash(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 		return vmcommon.UserError
 	}
 
-	r.eei.SetStorage(args.CallerAddr, data)
+	r.eei.SetStorage(blsPubKey, data)
+
+	return vmcommon.Ok
+}
+
+// reportDoubleSign takes as arguments two consensus messages which prove that a node signed two different headers in
+// the same round. Anyone can report the evidence: once verified, the whole stake of the node is slashed, sent to the
+// burn address, and the node is unStaked. Evidence signed before the node was staked, as it is when it is replayed
+// after the node is staked again, is rejected
+func (r *stakingSC) reportDoubleSign(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Sign() != 0 || len(args.Arguments) != 2 {
+		log.Debug("reportDoubleSign function called with wrong value or number of arguments")
+		return vmcommon.UserError
+	}
+
+	blsPubKey, round, err := r.doubleSigningVerifier.VerifyDoubleSigning(args.Arguments[0], args.Arguments[1])
+	if err != nil {
+		log.Debug("reportDoubleSign function called with invalid evidence",
+			"error", err.Error(),
+		)
+		return vmcommon.UserError
+	}
+
+	registrationData, err := r.getStakingData(blsPubKey)
+	if err != nil {
+		return vmcommon.UserError
+	}
+	if !registrationData.Staked {
+		log.Debug("cannot slash already unstaked or user not staked")
+		return vmcommon.UserError
+	}
+	if round < registrationData.StartRound {
+		log.Debug("reportDoubleSign function called with evidence from before the node was staked")
+		return vmcommon.UserError
+	}
+
+	log.Info("double signing reported, node slashed",
+		"bls key", blsPubKey,
+		"owner", registrationData.OwnerAddress,
+		"slashed value", registrationData.StakeValue,
+	)
+
+	ownerAddress := r.eei.GetStorage([]byte(ownerKey))
+	err = r.eei.Transfer(r.burnAddress, ownerAddress, registrationData.StakeValue, nil)
+	if err != nil {
+		log.Debug("transfer error on reportDoubleSign function",
+			"error", err.Error(),
+		)
+		return vmcommon.UserError
+	}
+
+	registrationData.StakeValue = big.NewInt(0)
+	registrationData.Staked = false
+	registrationData.UnStakedNonce = r.eei.BlockChainHook().CurrentNonce()
+	err = r.saveStakingData(registrationData)
+	if err != nil {
+		return vmcommon.UserError
+	}
 
 	return vmcommon.Ok
 }
 
+// isStaked returns Ok if the argument is the BLS public key of a staked node or the address of an owner with at
+// least one staked node
 func (r *stakingSC) isStaked(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if len(args.Arguments) < 1 {
 		return vmcommon.UserError
 	}
 
-	data := r.eei.GetStorage(args.Arguments[0])
-	registrationData := StakingData{}
-	if data != nil {
-		err := json.Unmarshal(data, &registrationData)
+	blsPubKeys := [][]byte{args.Arguments[0]}
+	stakerData, err := r.getStakerData(args.Arguments[0])
+	if err == nil {
+		blsPubKeys = stakerData.BlsPubKeys
+	}
+
+	for _, blsPubKey := range blsPubKeys {
+		data := r.eei.GetStorage(blsPubKey)
+		if data == nil {
+			continue
+		}
+
+		registrationData := StakingData{}
+		err = json.Unmarshal(data, &registrationData)
 		if err != nil {
 			log.Debug("unmarshal error on staking SC stake function",
 				"error", err.Error(),
 			)
 			return vmcommon.UserError
 		}
-	}
 
-	if registrationData.Staked {
-		log.Debug("account already staked, re-staking is invalid")
-		return vmcommon.Ok
+		if registrationData.Staked {
+			return vmcommon.Ok
+		}
 	}
 
 	return vmcommon.UserError
 }
 
+func (r *stakingSC) getStakingData(blsPubKey []byte) (*StakingData, error) {
+	data := r.eei.GetStorage(blsPubKey)
+	if len(data) == 0 {
+		return nil, vm.ErrUnknownNode
+	}
+
+	registrationData := &StakingData{}
+	err := json.Unmarshal(data, registrationData)
+	if err != nil {
+		log.Debug("unmarshal error on staking SC",
+			"error", err.Error(),
+		)
+		return nil, err
+	}
+
+	return registrationData, nil
+}
+
+func (r *stakingSC) getOwnedStakingData(ownerAddress []byte, blsPubKey []byte) (*StakingData, error) {
+	registrationData, err := r.getStakingData(blsPubKey)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(registrationData.OwnerAddress, ownerAddress) {
+		log.Debug("staking SC function called for a node of another owner")
+		return nil, vm.ErrOnlyOwnerCanCall
+	}
+
+	return registrationData, nil
+}
+
+func (r *stakingSC) saveStakingData(registrationData *StakingData) error {
+	data, err := json.Marshal(registrationData)
+	if err != nil {
+		log.Debug("marshal error on staking SC",
+			"error", err.Error(),
+		)
+		return err
+	}
+
+	r.eei.SetStorage(registrationData.BlsPubKey, data)
+	return nil
+}
+
+func (r *stakingSC) getStakerData(ownerAddress []byte) (*StakerData, error) {
+	data := r.eei.GetStorage(stakerKey(ownerAddress))
+	if len(data) == 0 {
+		return nil, vm.ErrUnknownStaker
+	}
+
+	stakerData := &StakerData{}
+	err := json.Unmarshal(data, stakerData)
+	if err != nil {
+		log.Debug("unmarshal error on staking SC",
+			"error", err.Error(),
+		)
+		return nil, err
+	}
+
+	return stakerData, nil
+}
+
+func (r *stakingSC) getOrCreateStakerData(ownerAddress []byte) (*StakerData, error) {
+	stakerData, err := r.getStakerData(ownerAddress)
+	if err == vm.ErrUnknownStaker {
+		return &StakerData{
+			RewardAddress: ownerAddress,
+			BlsPubKeys:    make([][]byte, 0),
+			TopUpValue:    big.NewInt(0),
+		}, nil
+	}
+
+	return stakerData, err
+}
+
+func (r *stakingSC) saveStakerData(ownerAddress []byte, stakerData *StakerData) error {
+	data, err := json.Marshal(stakerData)
+	if err != nil {
+		log.Debug("marshal error on staking SC",
+			"error", err.Error(),
+		)
+		return err
+	}
+
+	r.eei.SetStorage(stakerKey(ownerAddress), data)
+	return nil
+}
+
+func stakerKey(ownerAddress []byte) []byte {
+	return append([]byte(stakerPrefix), ownerAddress...)
+}
+
+// IsStakerKey returns true if the key of the staking smart contract storage holds the record of an owner's nodes
+func IsStakerKey(key []byte) bool {
+	return len(key) > len(stakerPrefix) && bytes.HasPrefix(key, []byte(stakerPrefix))
+}
+
+// IsNodeKey returns true if the key of the staking smart contract storage holds the registration of a node
+func IsNodeKey(key []byte) bool {
+	if len(key) == 0 || bytes.HasPrefix(key, []byte(stakerPrefix)) {
+		return false
+	}
+
+	return string(key) != ownerKey && string(key) != initialStakeKey
+}
+
 // ValueOf returns the value of a selected key
 func (r *stakingSC) ValueOf(key interface{}) interface{} {
 	return nil
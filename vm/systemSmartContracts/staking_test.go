@@ -27,11 +27,68 @@ func CreateVmContractCallInput() *vmcommon.ContractCallInput {
 	}
 }
 
+func createStakingAndContext(stakeValue int64, unBoundPeriod uint64) (*vmContext, *stakingSC, *uint64) {
+	nonce := uint64(0)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return nonce
+		},
+	}
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook())
+	eei.SetSCAddress([]byte("staking"))
+	eei.SetStorage([]byte(ownerKey), []byte("owner"))
+	eei.SetStorage([]byte(initialStakeKey), big.NewInt(stakeValue).Bytes())
+
+	stakingSmartContract, _ := NewStakingSmartContract(big.NewInt(stakeValue), unBoundPeriod, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
+
+	return eei, stakingSmartContract, &nonce
+}
+
+func createStakingCallInput(function string, caller string, value int64, arguments ...[]byte) *vmcommon.ContractCallInput {
+	input := CreateVmContractCallInput()
+	input.Function = function
+	input.CallerAddr = []byte(caller)
+	input.CallValue = big.NewInt(value)
+	input.Arguments = arguments
+
+	return input
+}
+
+func setStakedNodes(eei *vmContext, owner []byte, topUpValue *big.Int, nodes ...*StakingData) {
+	stakerData := &StakerData{
+		RewardAddress: owner,
+		BlsPubKeys:    make([][]byte, 0, len(nodes)),
+		TopUpValue:    topUpValue,
+	}
+	for _, node := range nodes {
+		marshaledData, _ := json.Marshal(node)
+		eei.SetStorage(node.BlsPubKey, marshaledData)
+		stakerData.BlsPubKeys = append(stakerData.BlsPubKeys, node.BlsPubKey)
+	}
+
+	marshaledData, _ := json.Marshal(stakerData)
+	eei.SetStorage(stakerKey(owner), marshaledData)
+}
+
+func getStakingData(eei *vmContext, blsPubKey []byte) *StakingData {
+	registrationData := &StakingData{}
+	_ = json.Unmarshal(eei.GetStorage(blsPubKey), registrationData)
+
+	return registrationData
+}
+
+func getStakerData(eei *vmContext, owner []byte) *StakerData {
+	stakerData := &StakerData{}
+	_ = json.Unmarshal(eei.GetStorage(stakerKey(owner)), stakerData)
+
+	return stakerData
+}
+
 func TestNewStakingSmartContract_NilStakeValueShouldErr(t *testing.T) {
 	t.Parallel()
 
 	eei := &mock.SystemEIStub{}
-	stakingSmartContract, err := NewStakingSmartContract(nil, 0, eei)
+	stakingSmartContract, err := NewStakingSmartContract(nil, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 
 	assert.Nil(t, stakingSmartContract)
 	assert.Equal(t, vm.ErrNilInitialStakeValue, err)
@@ -41,18 +98,40 @@ func TestNewStakingSmartContract_NilSystemEIShouldErr(t *testing.T) {
 	t.Parallel()
 
 	stakeValue := big.NewInt(100)
-	stakingSmartContract, err := NewStakingSmartContract(stakeValue, 0, nil)
+	stakingSmartContract, err := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, nil)
 
 	assert.Nil(t, stakingSmartContract)
 	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
 }
 
+func TestNewStakingSmartContract_EmptyBurnAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakeValue := big.NewInt(100)
+	eei := &mock.SystemEIStub{}
+	stakingSmartContract, err := NewStakingSmartContract(stakeValue, 0, nil, &mock.DoubleSigningVerifierStub{}, eei)
+
+	assert.Nil(t, stakingSmartContract)
+	assert.Equal(t, vm.ErrEmptyBurnAddress, err)
+}
+
+func TestNewStakingSmartContract_NilDoubleSigningVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakeValue := big.NewInt(100)
+	eei := &mock.SystemEIStub{}
+	stakingSmartContract, err := NewStakingSmartContract(stakeValue, 0, []byte("burn"), nil, eei)
+
+	assert.Nil(t, stakingSmartContract)
+	assert.Equal(t, vm.ErrNilDoubleSigningVerifier, err)
+}
+
 func TestNewStakingSmartContract_NegativeStakeValueShouldErr(t *testing.T) {
 	t.Parallel()
 
 	stakeValue := big.NewInt(-100)
 	eei := &mock.SystemEIStub{}
-	stakingSmartContract, err := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, err := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 
 	assert.Nil(t, stakingSmartContract)
 	assert.Equal(t, vm.ErrNegativeInitialStakeValue, err)
@@ -63,7 +142,7 @@ func TestNewStakingSmartContract(t *testing.T) {
 
 	stakeValue := big.NewInt(100)
 	eei := &mock.SystemEIStub{}
-	stakingSmartContract, err := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, err := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 
 	assert.NotNil(t, stakingSmartContract)
 	assert.Nil(t, err)
@@ -75,7 +154,7 @@ func TestStakingSC_ExecuteInit(t *testing.T) {
 	stakeValue := big.NewInt(100)
 	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook())
 	eei.SetSCAddress([]byte("addr"))
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "_init"
 
@@ -95,7 +174,7 @@ func TestStakingSC_ExecuteInitTwoTimeShouldReturnUserError(t *testing.T) {
 	stakeValue := big.NewInt(100)
 	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook())
 	eei.SetSCAddress([]byte("addr"))
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "_init"
 
@@ -113,7 +192,7 @@ func TestStakingSC_ExecuteStakeWrongStakeValueShouldErr(t *testing.T) {
 	blockChainHook := &mock.BlockChainHookStub{}
 	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook())
 	eei.SetSCAddress([]byte("addr"))
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "stake"
 
@@ -132,7 +211,7 @@ func TestStakingSC_ExecuteStakeWrongUnmarshalDataShouldErr(t *testing.T) {
 	eei.GetStorageCalled = func(key []byte) []byte {
 		return []byte("data")
 	}
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "stake"
 
@@ -149,7 +228,7 @@ func TestStakingSC_ExecuteStakeRegistrationDataStakedShouldErr(t *testing.T) {
 		registrationDataMarshalized, _ := json.Marshal(&StakingData{Staked: true})
 		return registrationDataMarshalized
 	}
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "stake"
 
@@ -166,7 +245,7 @@ func TestStakingSC_ExecuteStakeNotEnoughArgsShouldErr(t *testing.T) {
 		registrationDataMarshalized, _ := json.Marshal(&StakingData{})
 		return registrationDataMarshalized
 	}
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "stake"
 
@@ -179,14 +258,6 @@ func TestStakingSC_ExecuteStake(t *testing.T) {
 
 	stakeValue := big.NewInt(100)
 
-	expectedRegistrationData := StakingData{
-		StartNonce:    0,
-		Staked:        true,
-		UnStakedNonce: 0,
-		BlsPubKey:     []byte{100},
-		StakeValue:    big.NewInt(0).Set(stakeValue),
-	}
-
 	blockChainHook := &mock.BlockChainHookStub{}
 	blockChainHook.GetStorageDataCalled = func(accountsAddress []byte, index []byte) (i []byte, e error) {
 		switch {
@@ -200,22 +271,136 @@ func TestStakingSC_ExecuteStake(t *testing.T) {
 	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook())
 	eei.SetSCAddress([]byte("addr"))
 
-	stakerAddress := big.NewInt(100)
-	slashValue := big.NewInt(200)
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "stake"
-	arguments.Arguments = [][]byte{stakerAddress.Bytes(), slashValue.Bytes()}
-	arguments.CallValue = big.NewInt(100)
+	arguments.Arguments = [][]byte{[]byte("blsKey1"), []byte("blsKey2")}
+	arguments.CallValue = big.NewInt(250)
 
 	retCode := stakingSmartContract.Execute(arguments)
 	assert.Equal(t, vmcommon.Ok, retCode)
 
-	var registrationData StakingData
-	data := stakingSmartContract.eei.GetStorage(arguments.CallerAddr)
-	err := json.Unmarshal(data, &registrationData)
-	assert.Nil(t, err)
-	assert.Equal(t, expectedRegistrationData, registrationData)
+	for _, blsPubKey := range arguments.Arguments {
+		expectedRegistrationData := StakingData{
+			StartNonce:    0,
+			Staked:        true,
+			UnStakedNonce: 0,
+			BlsPubKey:     blsPubKey,
+			StakeValue:    big.NewInt(0).Set(stakeValue),
+			OwnerAddress:  arguments.CallerAddr,
+			RewardAddress: arguments.CallerAddr,
+		}
+
+		var registrationData StakingData
+		data := stakingSmartContract.eei.GetStorage(blsPubKey)
+		err := json.Unmarshal(data, &registrationData)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedRegistrationData, registrationData)
+	}
+
+	stakerData := getStakerData(eei, arguments.CallerAddr)
+	assert.Equal(t, arguments.Arguments, stakerData.BlsPubKeys)
+	assert.Equal(t, big.NewInt(50), stakerData.TopUpValue)
+}
+
+func TestStakingSC_ExecuteStakeNotCoveringAllNodesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	eei, stakingSmartContract, _ := createStakingAndContext(100, 0)
+
+	retCode := stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 199, []byte("blsKey1"), []byte("blsKey2")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, 0, len(eei.GetStorage([]byte("blsKey1"))))
+	assert.Equal(t, 0, len(eei.GetStorage(stakerKey([]byte("staker")))))
+}
+
+func TestStakingSC_ExecuteStakeDuplicatedKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, stakingSmartContract, _ := createStakingAndContext(100, 0)
+
+	retCode := stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 200, []byte("blsKey1"), []byte("blsKey1")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 100, []byte("blsKey1")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("stake", "other", 100, []byte("blsKey1")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestStakingSC_ExecuteStakeReservedKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, stakingSmartContract, _ := createStakingAndContext(100, 0)
+
+	retCode := stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 100, []byte(initialStakeKey)))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 100, stakerKey([]byte("other"))))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestStakingSC_ExecuteStakeAgainAddsNodesAndTopUp(t *testing.T) {
+	t.Parallel()
+
+	eei, stakingSmartContract, _ := createStakingAndContext(100, 0)
+
+	retCode := stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 110, []byte("blsKey1")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 120, []byte("blsKey2")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	stakerData := getStakerData(eei, []byte("staker"))
+	assert.Equal(t, [][]byte{[]byte("blsKey1"), []byte("blsKey2")}, stakerData.BlsPubKeys)
+	assert.Equal(t, big.NewInt(30), stakerData.TopUpValue)
+}
+
+func TestStakingSC_ExecuteTopUp(t *testing.T) {
+	t.Parallel()
+
+	eei, stakingSmartContract, _ := createStakingAndContext(100, 0)
+
+	retCode := stakingSmartContract.Execute(createStakingCallInput("topUp", "staker", 50))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	_ = stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 100, []byte("blsKey1")))
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("topUp", "staker", 0))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("topUp", "staker", 50))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	stakerData := getStakerData(eei, []byte("staker"))
+	assert.Equal(t, big.NewInt(50), stakerData.TopUpValue)
+}
+
+func TestStakingSC_ExecuteChangeRewardAddress(t *testing.T) {
+	t.Parallel()
+
+	eei, stakingSmartContract, _ := createStakingAndContext(100, 0)
+
+	retCode := stakingSmartContract.Execute(createStakingCallInput("changeRewardAddress", "staker", 0, []byte("reward")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	_ = stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 200, []byte("blsKey1"), []byte("blsKey2")))
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("changeRewardAddress", "staker", 0))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("changeRewardAddress", "staker", 0, []byte("reward")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	stakerData := getStakerData(eei, []byte("staker"))
+	assert.Equal(t, []byte("reward"), stakerData.RewardAddress)
+	assert.Equal(t, []byte("reward"), getStakingData(eei, []byte("blsKey1")).RewardAddress)
+	assert.Equal(t, []byte("reward"), getStakingData(eei, []byte("blsKey2")).RewardAddress)
+
+	_ = stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 100, []byte("blsKey3")))
+	assert.Equal(t, []byte("reward"), getStakingData(eei, []byte("blsKey3")).RewardAddress)
+	assert.Equal(t, []byte("staker"), getStakingData(eei, []byte("blsKey3")).OwnerAddress)
 }
 
 func TestStakingSC_ExecuteUnStakeAddressNotStakedShouldErr(t *testing.T) {
@@ -223,7 +408,7 @@ func TestStakingSC_ExecuteUnStakeAddressNotStakedShouldErr(t *testing.T) {
 
 	stakeValue := big.NewInt(100)
 	eei := &mock.SystemEIStub{}
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "unStake"
 
@@ -239,7 +424,7 @@ func TestStakingSC_ExecuteUnStakeUnmarshalErr(t *testing.T) {
 	eei.GetStorageCalled = func(key []byte) []byte {
 		return []byte("data")
 	}
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "unStake"
 
@@ -262,7 +447,7 @@ func TestStakingSC_ExecuteUnStakeAlreadyUnStakedAddrShouldErr(t *testing.T) {
 	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook())
 	eei.SetSCAddress([]byte("addr"))
 
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "unStake"
 	arguments.Arguments = [][]byte{big.NewInt(100).Bytes(), big.NewInt(200).Bytes()}
@@ -280,39 +465,75 @@ func TestStakingSC_ExecuteUnStake(t *testing.T) {
 		StartNonce:    0,
 		Staked:        false,
 		UnStakedNonce: 0,
-		BlsPubKey:     nil,
+		BlsPubKey:     []byte("blsKey"),
 		StakeValue:    nil,
+		OwnerAddress:  []byte("tralala1"),
+		RewardAddress: []byte("tralala1"),
 	}
 
 	stakedRegistrationData := StakingData{
 		StartNonce:    0,
 		Staked:        true,
 		UnStakedNonce: 0,
-		BlsPubKey:     nil,
+		BlsPubKey:     []byte("blsKey"),
 		StakeValue:    nil,
+		OwnerAddress:  []byte("tralala1"),
+		RewardAddress: []byte("tralala1"),
 	}
 
 	stakeValue := big.NewInt(100)
 	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook())
 	eei.SetSCAddress([]byte("addr"))
 
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "unStake"
-	arguments.Arguments = [][]byte{big.NewInt(100).Bytes(), big.NewInt(200).Bytes()}
-	marshalizedExpectedRegData, _ := json.Marshal(&stakedRegistrationData)
-	stakingSmartContract.eei.SetStorage(arguments.CallerAddr, marshalizedExpectedRegData)
+	setStakedNodes(eei, arguments.CallerAddr, big.NewInt(0), &stakedRegistrationData)
 
 	retCode := stakingSmartContract.Execute(arguments)
 	assert.Equal(t, vmcommon.Ok, retCode)
 
 	var registrationData StakingData
-	data := stakingSmartContract.eei.GetStorage(arguments.CallerAddr)
+	data := stakingSmartContract.eei.GetStorage([]byte("blsKey"))
 	err := json.Unmarshal(data, &registrationData)
 	assert.Nil(t, err)
 	assert.Equal(t, expectedRegistrationData, registrationData)
 }
 
+func TestStakingSC_ExecuteUnStakeSelectedNodes(t *testing.T) {
+	t.Parallel()
+
+	eei, stakingSmartContract, nonce := createStakingAndContext(100, 0)
+	_ = stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 200, []byte("blsKey1"), []byte("blsKey2")))
+
+	*nonce = 5
+	retCode := stakingSmartContract.Execute(createStakingCallInput("unStake", "staker", 0, []byte("blsKey2")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	assert.True(t, getStakingData(eei, []byte("blsKey1")).Staked)
+	registrationData := getStakingData(eei, []byte("blsKey2"))
+	assert.False(t, registrationData.Staked)
+	assert.Equal(t, uint64(5), registrationData.UnStakedNonce)
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("unStake", "staker", 0, []byte("blsKey2")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("unStake", "staker", 0, []byte("unknown")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestStakingSC_ExecuteUnStakeNodeOfOtherOwnerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	eei, stakingSmartContract, _ := createStakingAndContext(100, 0)
+	_ = stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 100, []byte("blsKey1")))
+	_ = stakingSmartContract.Execute(createStakingCallInput("stake", "other", 100, []byte("blsKey2")))
+
+	retCode := stakingSmartContract.Execute(createStakingCallInput("unStake", "other", 0, []byte("blsKey1")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, getStakingData(eei, []byte("blsKey1")).Staked)
+}
+
 func TestStakingSC_ExecuteUnBoundUnmarshalErr(t *testing.T) {
 	t.Parallel()
 
@@ -321,7 +542,7 @@ func TestStakingSC_ExecuteUnBoundUnmarshalErr(t *testing.T) {
 	eei.GetStorageCalled = func(key []byte) []byte {
 		return []byte("data")
 	}
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.CallerAddr = []byte("data")
 	arguments.Function = "unBound"
@@ -350,7 +571,7 @@ func TestStakingSC_ExecuteUnBoundValidatorNotUnStakeShouldErr(t *testing.T) {
 			return 10000
 		}}
 	}
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 100, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 100, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.CallerAddr = []byte("data")
 	arguments.Function = "unBound"
@@ -382,7 +603,7 @@ func TestStakingSC_ExecuteFinalizeUnBoundBeforePeriodEnds(t *testing.T) {
 	eei.SetSCAddress([]byte("addr"))
 	eei.SetStorage([]byte(ownerKey), []byte("data"))
 	eei.SetStorage(blsPubKey.Bytes(), marshalizedRegData)
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 100, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 100, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.CallerAddr = []byte("data")
 	arguments.Function = "finalizeUnStake"
@@ -401,12 +622,13 @@ func TestStakingSC_ExecuteUnBound(t *testing.T) {
 		StartNonce:    0,
 		Staked:        false,
 		UnStakedNonce: unstakedNonce,
-		BlsPubKey:     nil,
+		BlsPubKey:     []byte("blsKey"),
 		StakeValue:    big.NewInt(100),
+		OwnerAddress:  []byte("address"),
+		RewardAddress: []byte("address"),
 	}
 
 	stakeValue := big.NewInt(100)
-	marshalizedRegData, _ := json.Marshal(&registrationData)
 	eei, _ := NewVMContext(&mock.BlockChainHookStub{
 		CurrentNonceCalled: func() uint64 {
 			return unstakedNonce + unBoundPeriod + 1
@@ -416,18 +638,20 @@ func TestStakingSC_ExecuteUnBound(t *testing.T) {
 	eei.SetSCAddress(scAddress)
 	eei.SetStorage([]byte(ownerKey), scAddress)
 
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, unBoundPeriod, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, unBoundPeriod, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 
 	arguments := CreateVmContractCallInput()
 	arguments.CallerAddr = []byte("address")
 	arguments.Function = "unBound"
 
-	stakingSmartContract.eei.SetStorage(arguments.CallerAddr, marshalizedRegData)
+	setStakedNodes(eei, arguments.CallerAddr, big.NewInt(0), &registrationData)
 
 	retCode := stakingSmartContract.Execute(arguments)
 	assert.Equal(t, vmcommon.Ok, retCode)
 
-	data := stakingSmartContract.eei.GetStorage(arguments.CallerAddr)
+	data := stakingSmartContract.eei.GetStorage([]byte("blsKey"))
+	assert.Equal(t, 0, len(data))
+	data = stakingSmartContract.eei.GetStorage(stakerKey(arguments.CallerAddr))
 	assert.Equal(t, 0, len(data))
 
 	destinationBalance := stakingSmartContract.eei.GetBalance(arguments.CallerAddr)
@@ -436,12 +660,41 @@ func TestStakingSC_ExecuteUnBound(t *testing.T) {
 	assert.Equal(t, 0, scBalance.Cmp(big.NewInt(0).Mul(stakeValue, big.NewInt(-1))))
 }
 
+func TestStakingSC_ExecuteUnBoundLastNodeReturnsTopUp(t *testing.T) {
+	t.Parallel()
+
+	unBoundPeriod := uint64(100)
+	eei, stakingSmartContract, nonce := createStakingAndContext(100, unBoundPeriod)
+	_ = stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 250, []byte("blsKey1"), []byte("blsKey2")))
+
+	*nonce = 10
+	_ = stakingSmartContract.Execute(createStakingCallInput("unStake", "staker", 0))
+
+	*nonce = 10 + unBoundPeriod
+	retCode := stakingSmartContract.Execute(createStakingCallInput("unBound", "staker", 0, []byte("blsKey1")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, big.NewInt(100), eei.GetBalance([]byte("staker")))
+	assert.Equal(t, 0, len(eei.GetStorage([]byte("blsKey1"))))
+
+	stakerData := getStakerData(eei, []byte("staker"))
+	assert.Equal(t, [][]byte{[]byte("blsKey2")}, stakerData.BlsPubKeys)
+	assert.Equal(t, big.NewInt(50), stakerData.TopUpValue)
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("unBound", "staker", 0, []byte("blsKey1")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("unBound", "staker", 0))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, big.NewInt(250), eei.GetBalance([]byte("staker")))
+	assert.Equal(t, 0, len(eei.GetStorage(stakerKey([]byte("staker")))))
+}
+
 func TestStakingSC_ExecuteSlashOwnerAddrNotOkShouldErr(t *testing.T) {
 	t.Parallel()
 
 	stakeValue := big.NewInt(100)
 	eei := &mock.SystemEIStub{}
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "slash"
 
@@ -457,7 +710,7 @@ func TestStakingSC_ExecuteSlashArgumentsNotOkShouldErr(t *testing.T) {
 	eei.GetStorageCalled = func(key []byte) []byte {
 		return []byte("data")
 	}
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "slash"
 	arguments.CallerAddr = []byte("data")
@@ -474,7 +727,7 @@ func TestStakingSC_ExecuteSlashUnmarhsalErr(t *testing.T) {
 	eei.GetStorageCalled = func(key []byte) []byte {
 		return []byte("data")
 	}
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "slash"
 	arguments.CallerAddr = []byte("data")
@@ -499,7 +752,7 @@ func TestStakingSC_ExecuteSlashNotStake(t *testing.T) {
 		}
 	}
 
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "slash"
 	arguments.CallerAddr = []byte("data")
@@ -524,7 +777,7 @@ func TestStakingSC_ExecuteSlashStaked(t *testing.T) {
 		}
 	}
 
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "slash"
 	arguments.CallerAddr = []byte("data")
@@ -549,32 +802,30 @@ func TestStakingSC_ExecuteUnStakeAndUnBoundStake(t *testing.T) {
 
 	ownerAddress := "ownerAddress"
 	eei.SetStorage([]byte(ownerKey), []byte(ownerAddress))
+	eei.SetStorage([]byte(initialStakeKey), stakeValue.Bytes())
 
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, unBoundPeriod, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, unBoundPeriod, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 
 	arguments := CreateVmContractCallInput()
-
-	stakedRegistrationData := StakingData{
-		StartNonce:    0,
-		Staked:        true,
-		UnStakedNonce: 0,
-		BlsPubKey:     nil,
-		StakeValue:    valueStakedByTheCaller,
-	}
-	marshalizedExpectedRegData, _ := json.Marshal(&stakedRegistrationData)
-	stakingSmartContract.eei.SetStorage(arguments.CallerAddr, marshalizedExpectedRegData)
+	arguments.Function = "stake"
+	arguments.Arguments = [][]byte{[]byte("blsKey")}
+	arguments.CallValue = valueStakedByTheCaller
+	retCode := stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
 
 	arguments.Function = "unStake"
+	arguments.Arguments = nil
+	arguments.CallValue = big.NewInt(0)
 
 	unStakeNonce := uint64(10)
 	blockChainHook.CurrentNonceCalled = func() uint64 {
 		return unStakeNonce
 	}
-	retCode := stakingSmartContract.Execute(arguments)
+	retCode = stakingSmartContract.Execute(arguments)
 	assert.Equal(t, vmcommon.Ok, retCode)
 
 	var registrationData StakingData
-	data := stakingSmartContract.eei.GetStorage(arguments.CallerAddr)
+	data := stakingSmartContract.eei.GetStorage([]byte("blsKey"))
 	err := json.Unmarshal(data, &registrationData)
 	assert.Nil(t, err)
 
@@ -582,8 +833,10 @@ func TestStakingSC_ExecuteUnStakeAndUnBoundStake(t *testing.T) {
 		StartNonce:    0,
 		Staked:        false,
 		UnStakedNonce: unStakeNonce,
-		BlsPubKey:     nil,
+		BlsPubKey:     []byte("blsKey"),
 		StakeValue:    valueStakedByTheCaller,
+		OwnerAddress:  arguments.CallerAddr,
+		RewardAddress: arguments.CallerAddr,
 	}
 	assert.Equal(t, expectedRegistrationData, registrationData)
 
@@ -608,7 +861,7 @@ func TestStakingSC_ExecuteGetShouldReturnUserErr(t *testing.T) {
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "get"
 	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook())
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	err := stakingSmartContract.Execute(arguments)
 
 	assert.Equal(t, vmcommon.UserError, err)
@@ -622,7 +875,7 @@ func TestStakingSC_ExecuteGetShouldOk(t *testing.T) {
 	arguments.Function = "get"
 	arguments.Arguments = [][]byte{arguments.CallerAddr}
 	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook())
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	err := stakingSmartContract.Execute(arguments)
 
 	assert.Equal(t, vmcommon.Ok, err)
@@ -642,7 +895,7 @@ func TestStakingSc_ExecuteSlashTwoTime(t *testing.T) {
 		StakeValue:    stakeValue,
 	}
 
-	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, eei)
+	stakingSmartContract, _ := NewStakingSmartContract(stakeValue, 0, []byte("burn"), &mock.DoubleSigningVerifierStub{}, eei)
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "slash"
 	arguments.CallerAddr = []byte("data")
@@ -674,3 +927,162 @@ func TestStakingSc_ExecuteSlashTwoTime(t *testing.T) {
 	expectedStake = big.NewInt(0).Sub(expectedStake, slashValue)
 	assert.Equal(t, expectedStake, registrationData.StakeValue)
 }
+
+func TestStakingSC_ExecuteIsStaked(t *testing.T) {
+	t.Parallel()
+
+	_, stakingSmartContract, _ := createStakingAndContext(100, 0)
+	_ = stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 200, []byte("blsKey1"), []byte("blsKey2")))
+
+	retCode := stakingSmartContract.Execute(createStakingCallInput("isStaked", "caller", 0, []byte("blsKey1")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("isStaked", "caller", 0, []byte("staker")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	_ = stakingSmartContract.Execute(createStakingCallInput("unStake", "staker", 0, []byte("blsKey1")))
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("isStaked", "caller", 0, []byte("blsKey1")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("isStaked", "caller", 0, []byte("staker")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	_ = stakingSmartContract.Execute(createStakingCallInput("unStake", "staker", 0))
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("isStaked", "caller", 0, []byte("staker")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestStakingSC_ExecuteGetStaker(t *testing.T) {
+	t.Parallel()
+
+	eei, stakingSmartContract, _ := createStakingAndContext(100, 0)
+
+	retCode := stakingSmartContract.Execute(createStakingCallInput("getStaker", "caller", 0, []byte("staker")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	_ = stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 100, []byte("blsKey1")))
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("getStaker", "caller", 0, []byte("staker")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	vmOutput := eei.CreateVMOutput()
+	stakerData := &StakerData{}
+	err := json.Unmarshal(vmOutput.ReturnData[0], stakerData)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("blsKey1")}, stakerData.BlsPubKeys)
+}
+
+func TestIsNodeKey(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsNodeKey([]byte("blsKey")))
+	assert.False(t, IsNodeKey(nil))
+	assert.False(t, IsNodeKey([]byte(ownerKey)))
+	assert.False(t, IsNodeKey([]byte(initialStakeKey)))
+	assert.False(t, IsNodeKey(stakerKey([]byte("staker"))))
+}
+
+func TestIsStakerKey(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsStakerKey(stakerKey([]byte("staker"))))
+	assert.False(t, IsStakerKey([]byte(stakerPrefix)))
+	assert.False(t, IsStakerKey([]byte("blsKey")))
+	assert.False(t, IsStakerKey([]byte(ownerKey)))
+}
+
+func TestStakingSC_ExecuteReportDoubleSignInvalidEvidenceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	eei, stakingSmartContract, _ := createStakingAndContext(100, 0)
+	stakingSmartContract.doubleSigningVerifier = &mock.DoubleSigningVerifierStub{
+		VerifyDoubleSigningCalled: func(firstMessage []byte, secondMessage []byte) ([]byte, uint64, error) {
+			return nil, 0, vm.ErrInvalidDoubleSigningEvidence
+		},
+	}
+	_ = stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 100, []byte("blsKey1")))
+
+	retCode := stakingSmartContract.Execute(createStakingCallInput("reportDoubleSign", "reporter", 0, []byte("first"), []byte("second")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, getStakingData(eei, []byte("blsKey1")).Staked)
+}
+
+func TestStakingSC_ExecuteReportDoubleSignWrongArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, stakingSmartContract, _ := createStakingAndContext(100, 0)
+
+	retCode := stakingSmartContract.Execute(createStakingCallInput("reportDoubleSign", "reporter", 0, []byte("first")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("reportDoubleSign", "reporter", 10, []byte("first"), []byte("second")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestStakingSC_ExecuteReportDoubleSignShouldSlashAndUnStake(t *testing.T) {
+	t.Parallel()
+
+	eei, stakingSmartContract, nonce := createStakingAndContext(100, 0)
+	stakingSmartContract.doubleSigningVerifier = &mock.DoubleSigningVerifierStub{
+		VerifyDoubleSigningCalled: func(firstMessage []byte, secondMessage []byte) ([]byte, uint64, error) {
+			assert.Equal(t, []byte("first"), firstMessage)
+			assert.Equal(t, []byte("second"), secondMessage)
+			return []byte("blsKey1"), 5, nil
+		},
+	}
+	_ = stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 200, []byte("blsKey1"), []byte("blsKey2")))
+
+	*nonce = 7
+	retCode := stakingSmartContract.Execute(createStakingCallInput("reportDoubleSign", "reporter", 0, []byte("first"), []byte("second")))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	registrationData := getStakingData(eei, []byte("blsKey1"))
+	assert.False(t, registrationData.Staked)
+	assert.Equal(t, uint64(7), registrationData.UnStakedNonce)
+	assert.Equal(t, big.NewInt(0), registrationData.StakeValue)
+	assert.True(t, getStakingData(eei, []byte("blsKey2")).Staked)
+	assert.Equal(t, big.NewInt(100), eei.outputAccounts["burn"].BalanceDelta)
+	assert.Equal(t, big.NewInt(-100), eei.outputAccounts["owner"].BalanceDelta)
+
+	retCode = stakingSmartContract.Execute(createStakingCallInput("reportDoubleSign", "reporter", 0, []byte("first"), []byte("second")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestStakingSC_ExecuteReportDoubleSignBeforeStakeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	round := uint64(0)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentRoundCalled: func() uint64 {
+			return round
+		},
+	}
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook())
+	eei.SetSCAddress([]byte("staking"))
+	eei.SetStorage([]byte(ownerKey), []byte("owner"))
+	eei.SetStorage([]byte(initialStakeKey), big.NewInt(100).Bytes())
+	stakingSmartContract, _ := NewStakingSmartContract(
+		big.NewInt(100),
+		0,
+		[]byte("burn"),
+		&mock.DoubleSigningVerifierStub{
+			VerifyDoubleSigningCalled: func(firstMessage []byte, secondMessage []byte) ([]byte, uint64, error) {
+				return []byte("blsKey1"), 5, nil
+			},
+		},
+		eei,
+	)
+
+	round = 6
+	_ = stakingSmartContract.Execute(createStakingCallInput("stake", "staker", 100, []byte("blsKey1")))
+
+	retCode := stakingSmartContract.Execute(createStakingCallInput("reportDoubleSign", "reporter", 0, []byte("first"), []byte("second")))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	registrationData := getStakingData(eei, []byte("blsKey1"))
+	assert.True(t, registrationData.Staked)
+	assert.Equal(t, uint64(6), registrationData.StartRound)
+	assert.Equal(t, big.NewInt(100), registrationData.StakeValue)
+}